@@ -0,0 +1,431 @@
+package controllers
+
+import (
+	"coeus/models"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// The class session actions below are shared by the HTTP handlers and the class session websocket,
+// so both check and do the same things. Each returns the HTTP status of the outcome and the body
+// sent back to the client; the websocket sends the same status and body in its ack or error reply.
+
+// postQuestionAction posts a question to a class session for a user who belongs to its section.
+// Unless confirmed, an open question that looks the same is suggested instead. In a pre-moderated
+// section, questions from students wait for a moderator.
+func postQuestionAction(userID int, classSessionID int, questionText string, confirmed bool) (int, gin.H) {
+	questionText, ok := validQuestionText(questionText)
+	if !ok {
+		return http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Questions must be 1 to %d characters long", maxQuestionLength)}
+	}
+
+	classSession, err := new(models.ClassSession).Get(classSessionID, 0)
+	if err == sql.ErrNoRows {
+		return http.StatusNotFound, gin.H{"error": "Class session not found"}
+	}
+	if err != nil {
+		fmt.Println(err)
+		return http.StatusInternalServerError, gin.H{"error": "Failed to get the class session"}
+	}
+	if !canJoinSection(userID, classSession.SectionID) {
+		return http.StatusForbidden, gin.H{"error": "Not a member of this section"}
+	}
+
+	// Suggest upvoting an open question that looks the same instead; the student can still post
+	if !confirmed {
+		similar, err := new(models.Question).FindSimilar(classSessionID, questionText, 0)
+		if err != nil {
+			fmt.Println(err)
+		}
+		if len(similar) > 0 {
+			return http.StatusConflict, gin.H{
+				"status":  "similar",
+				"similar": similar,
+			}
+		}
+	}
+
+	premoderation, err := new(models.Section).GetPremoderation(classSession.SectionID)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	if premoderation && !canModerateSection(userID, classSession.SectionID) {
+		questionID, err := new(models.Question).PostPendingQuestion(userID, classSessionID, questionText)
+		if err != nil {
+			fmt.Println(err)
+			return http.StatusInternalServerError, gin.H{"error": "Failed to post the question"}
+		}
+
+		broadcastPendingQuestion(classSessionID, questionID)
+		return http.StatusOK, gin.H{
+			"questionID": questionID,
+			"status":     models.QuestionPending,
+		}
+	}
+
+	// Add the question to the database
+	questionID, err := new(models.Question).PostQuestion(userID, classSessionID, questionText)
+	if err != nil {
+		fmt.Println(err)
+		return http.StatusInternalServerError, gin.H{"error": "Failed to post the question"}
+	}
+
+	// The frontend is going to handle the timezone formatting so we can just set it to 0 or UTC
+	question, err := new(models.Question).GetByID(questionID, 0)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	broadcastNewQuestion(classSessionID, question)
+	return http.StatusOK, gin.H{
+		"questionID": questionID,
+		"status":     models.QuestionApproved,
+	}
+}
+
+// classQuestion returns a question the class can see, along with the section of its class session.
+// Outside of the class session classSessionID, when it isn't 0, the question is not found.
+// It returns the question, the section id and, when it can't be used, the status and body to reply with.
+func classQuestion(classSessionID int, questionID int) (models.Question, int, int, gin.H) {
+	question, err := new(models.Question).GetByID(questionID, 0)
+	if err == nil && (question.Status != models.QuestionApproved || (classSessionID != 0 && question.SessionID != classSessionID)) {
+		err = sql.ErrNoRows
+	}
+	if err == sql.ErrNoRows {
+		return question, 0, http.StatusNotFound, gin.H{"error": "Question not found"}
+	}
+	if err != nil {
+		fmt.Println(err)
+		return question, 0, http.StatusInternalServerError, gin.H{"error": "Failed to get the question"}
+	}
+
+	sectionID, err := new(models.ClassSession).GetSectionID(question.SessionID)
+	if err != nil {
+		fmt.Println(err)
+		return question, 0, http.StatusInternalServerError, gin.H{"error": "Failed to get the class session"}
+	}
+
+	return question, sectionID, 0, nil
+}
+
+// voteUpAction adds a user's vote to a question of a section they belong to, once.
+func voteUpAction(userID int, classSessionID int, questionID int) (int, gin.H) {
+	question, sectionID, status, body := classQuestion(classSessionID, questionID)
+	if body != nil {
+		return status, body
+	}
+	if !canJoinSection(userID, sectionID) {
+		return http.StatusForbidden, gin.H{"error": "Not a member of this section"}
+	}
+
+	hasVoted, err := new(models.Question).HasVoted(questionID, userID)
+	if err != nil {
+		fmt.Println(err)
+	}
+	if hasVoted {
+		return http.StatusOK, gin.H{"status": "already voted"}
+	}
+
+	// Add the vote to the database
+	err = new(models.Question).VoteQuestion(questionID, userID)
+	if err != nil {
+		fmt.Println(err)
+		return http.StatusInternalServerError, gin.H{"error": "Failed to vote"}
+	}
+
+	// Get the updated vote count for the question
+	updatedVoteCount, err := new(models.Question).GetVoteCount(questionID)
+	if err != nil {
+		fmt.Println(err)
+		return http.StatusOK, gin.H{"status": "success"}
+	}
+
+	// Broadcast the "vote-up" action to all WebSocket connections
+	constructVoteUp(question.SessionID, userID, questionID, updatedVoteCount)
+	return http.StatusOK, gin.H{
+		"status": "success",
+		"votes":  updatedVoteCount,
+	}
+}
+
+// markQuestionAction marks a question answered for a moderator of its section.
+func markQuestionAction(userID int, classSessionID int, questionID int) (int, gin.H) {
+	question, sectionID, status, body := classQuestion(classSessionID, questionID)
+	if body != nil {
+		return status, body
+	}
+	if !canModerateSection(userID, sectionID) {
+		return http.StatusForbidden, gin.H{"error": "Only moderators can mark questions answered"}
+	}
+
+	err := new(models.Question).MarkQuestion(questionID)
+	if err != nil {
+		fmt.Println(err)
+		return http.StatusInternalServerError, gin.H{"error": "Failed to mark the question"}
+	}
+
+	constructMarkQuestion(question.SessionID, questionID)
+	return http.StatusOK, gin.H{"status": "success"}
+}
+
+// classPoll returns a poll, along with the section of its class session.
+// Outside of the class session classSessionID, when it isn't 0, the poll is not found.
+// It returns the poll, the section id and, when it can't be used, the status and body to reply with.
+func classPoll(classSessionID int, pollID int) (models.Poll, int, int, gin.H) {
+	poll, err := new(models.Poll).GetByID(pollID, 0, 0)
+	if err == nil && classSessionID != 0 && poll.SessionID != classSessionID {
+		err = sql.ErrNoRows
+	}
+	if err == sql.ErrNoRows {
+		return poll, 0, http.StatusNotFound, gin.H{"error": "Poll not found"}
+	}
+	if err != nil {
+		fmt.Println(err)
+		return poll, 0, http.StatusInternalServerError, gin.H{"error": "Failed to get the poll"}
+	}
+
+	sectionID, err := new(models.ClassSession).GetSectionID(poll.SessionID)
+	if err != nil {
+		fmt.Println(err)
+		return poll, 0, http.StatusInternalServerError, gin.H{"error": "Failed to get the class session"}
+	}
+
+	return poll, sectionID, 0, nil
+}
+
+// respondPollAction records the option a user of the poll's section chose in an open poll, once,
+// and streams the new results to the class session.
+func respondPollAction(userID int, classSessionID int, pollID int, optionID int) (int, gin.H) {
+	_, sectionID, status, body := classPoll(classSessionID, pollID)
+	if body != nil {
+		return status, body
+	}
+	if !canJoinSection(userID, sectionID) {
+		return http.StatusForbidden, gin.H{"error": "Not a member of this section"}
+	}
+
+	err := new(models.Poll).Respond(pollID, optionID, userID)
+	switch err {
+	case nil:
+	case models.ErrInvalidPollOption:
+		return http.StatusBadRequest, gin.H{"error": "Choose one of the options of the poll"}
+	case models.ErrPollNotOpen:
+		return http.StatusConflict, gin.H{"error": "The poll is not open"}
+	case models.ErrPollAnswered:
+		return http.StatusConflict, gin.H{"error": "You already answered this poll"}
+	default:
+		fmt.Println(err)
+		return http.StatusInternalServerError, gin.H{"error": "Failed to answer the poll"}
+	}
+
+	poll, err := new(models.Poll).GetByID(pollID, userID, 0)
+	if err != nil {
+		fmt.Println(err)
+		return http.StatusOK, gin.H{"status": "success"}
+	}
+
+	broadcastPollResults(poll)
+	return http.StatusOK, gin.H{
+		"status":   "success",
+		"optionID": optionID,
+	}
+}
+
+// classQuiz returns a quiz, along with the section of its class session.
+// Outside of the class session classSessionID, when it isn't 0, the quiz is not found.
+// It returns the quiz, the section id and, when it can't be used, the status and body to reply with.
+func classQuiz(classSessionID int, quizID int) (models.Quiz, int, int, gin.H) {
+	quiz, err := new(models.Quiz).GetByID(quizID, 0)
+	if err == nil && classSessionID != 0 && quiz.SessionID != classSessionID {
+		err = sql.ErrNoRows
+	}
+	if err == sql.ErrNoRows {
+		return quiz, 0, http.StatusNotFound, gin.H{"error": "Quiz not found"}
+	}
+	if err != nil {
+		fmt.Println(err)
+		return quiz, 0, http.StatusInternalServerError, gin.H{"error": "Failed to get the quiz"}
+	}
+
+	sectionID, err := new(models.ClassSession).GetSectionID(quiz.SessionID)
+	if err != nil {
+		fmt.Println(err)
+		return quiz, 0, http.StatusInternalServerError, gin.H{"error": "Failed to get the class session"}
+	}
+
+	return quiz, sectionID, 0, nil
+}
+
+// submitQuizAction scores and records the answers of a user of the quiz's section to an open quiz, once,
+// and tells the class session how many submitted. answers maps question ids to the option ids chosen.
+func submitQuizAction(userID int, classSessionID int, quizID int, answers map[int]int) (int, gin.H) {
+	_, sectionID, status, body := classQuiz(classSessionID, quizID)
+	if body != nil {
+		return status, body
+	}
+	if !canJoinSection(userID, sectionID) {
+		return http.StatusForbidden, gin.H{"error": "Not a member of this section"}
+	}
+
+	submission, err := new(models.Quiz).Submit(quizID, userID, answers)
+	switch err {
+	case nil:
+	case models.ErrInvalidQuizAnswer:
+		return http.StatusBadRequest, gin.H{"error": "Answer each question with one of its options"}
+	case models.ErrQuizNotOpen:
+		return http.StatusConflict, gin.H{"error": "The quiz is not open"}
+	case models.ErrQuizTimeUp:
+		return http.StatusConflict, gin.H{"error": "The time to submit the quiz is over"}
+	case models.ErrQuizSubmitted:
+		return http.StatusConflict, gin.H{"error": "You already submitted this quiz"}
+	default:
+		fmt.Println(err)
+		return http.StatusInternalServerError, gin.H{"error": "Failed to submit the quiz"}
+	}
+
+	quiz, err := new(models.Quiz).GetByID(quizID, 0)
+	if err != nil {
+		fmt.Println(err)
+	} else {
+		broadcastQuizSubmitted(quiz)
+	}
+
+	return http.StatusOK, gin.H{
+		"status":       "success",
+		"submissionID": submission.ID,
+		"score":        submission.Score,
+		"maxScore":     submission.MaxScore,
+	}
+}
+
+// defaultLateAfter is how long after the scheduled start of a meeting students who check in are late.
+const defaultLateAfter = 10 * time.Minute
+
+// lateAfter returns how long after the scheduled start of a meeting students who check in are late.
+// The ATTENDANCE_LATE_MINUTES environment variable overrides the default when set.
+func lateAfter() time.Duration {
+	if n, err := strconv.Atoi(os.Getenv("ATTENDANCE_LATE_MINUTES")); err == nil && n >= 0 {
+		return time.Duration(n) * time.Minute
+	}
+	return defaultLateAfter
+}
+
+// checkInStatus returns the attendance status of a student checking in to a class session at now.
+func checkInStatus(classSessionID int, sectionID int, now time.Time) string {
+	classSession, err := new(models.ClassSession).Get(classSessionID, 0)
+	if err != nil {
+		fmt.Println(err)
+		return models.AttendancePresent
+	}
+	startedAt, err := time.ParseInLocation(models.TimestampLayout, classSession.StartedAt, time.UTC)
+	if err != nil {
+		return models.AttendancePresent
+	}
+
+	occurrences, err := new(models.Schedule).GetOccurrences(sectionID, startedAt.AddDate(0, 0, -1), startedAt.AddDate(0, 0, 1))
+	if err != nil {
+		fmt.Println(err)
+		return models.AttendancePresent
+	}
+
+	return attendanceStatusAt(occurrences, startedAt, now, DefaultSchedulerConfig().OpenBefore, lateAfter())
+}
+
+// attendanceStatusAt returns late when now is more than lateAfter past the scheduled start of the meeting
+// a session started at startedAt was started for, and present before then or for a session started
+// outside the schedule.
+func attendanceStatusAt(occurrences []models.Occurrence, startedAt time.Time, now time.Time, openBefore time.Duration, lateAfter time.Duration) string {
+	meeting, ok := startedMeeting(occurrences, startedAt, openBefore)
+	if ok && now.After(meeting.Start.Add(lateAfter)) {
+		return models.AttendanceLate
+	}
+	return models.AttendancePresent
+}
+
+// checkInAction marks a user of the section present for an attendance when the code they submit
+// is the one shown by its open check-in. Every code submitted is logged, and a user who submitted
+// too many wrong or expired codes is refused until the next check-in.
+func checkInAction(userID int, attendanceID int, code string) (int, gin.H) {
+	attendance, err := new(models.Attendance).GetByID(attendanceID)
+	if err == sql.ErrNoRows {
+		return http.StatusNotFound, gin.H{"error": "Attendance not found"}
+	}
+	if err != nil {
+		fmt.Println(err)
+		return http.StatusInternalServerError, gin.H{"error": "Failed to get the attendance"}
+	}
+	if !canJoinSection(userID, attendance.Section) {
+		return http.StatusForbidden, gin.H{"error": "Not a member of this section"}
+	}
+
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return http.StatusBadRequest, gin.H{"error": "Enter the check-in code"}
+	}
+	logAttempt := func(checkInID int, result string) {
+		if err := new(models.CheckInAttempt).LogAttempt(attendanceID, checkInID, userID, code, result); err != nil {
+			fmt.Println(err)
+		}
+	}
+
+	checkIn, err := new(models.CheckIn).GetOpen(attendanceID)
+	if err == sql.ErrNoRows {
+		logAttempt(0, models.CheckInClosed)
+		return http.StatusConflict, gin.H{"error": "Check-in is not open"}
+	}
+	if err != nil {
+		fmt.Println(err)
+		return http.StatusInternalServerError, gin.H{"error": "Failed to get the check-in"}
+	}
+
+	rejected, err := new(models.CheckInAttempt).CountRejected(checkIn.ID, userID)
+	if err != nil {
+		fmt.Println(err)
+		return http.StatusInternalServerError, gin.H{"error": "Failed to get the check-in"}
+	}
+	if rejected >= models.CheckInAttemptLimit {
+		logAttempt(checkIn.ID, models.CheckInLimited)
+		return http.StatusTooManyRequests, gin.H{"error": "Too many wrong codes, ask your instructor"}
+	}
+
+	result := checkIn.Verify(code, time.Now())
+	logAttempt(checkIn.ID, result)
+	switch result {
+	case models.CheckInWrong:
+		return http.StatusBadRequest, gin.H{"error": "Wrong check-in code"}
+	case models.CheckInExpired:
+		return http.StatusBadRequest, gin.H{"error": "This code has expired, enter the one shown now"}
+	}
+
+	// Checking in again keeps the status of the first check-in
+	records, err := new(models.Attendance).GetByUser(userID, attendanceID)
+	if err != nil {
+		fmt.Println(err)
+		return http.StatusInternalServerError, gin.H{"error": "Failed to mark you present"}
+	}
+	status := checkInStatus(attendance.ClassSession, attendance.Section, time.Now())
+	if len(records) > 0 && (records[0].Status == models.AttendancePresent || records[0].Status == models.AttendanceLate) {
+		status = records[0].Status
+	} else {
+		// Any status the user has, from the end of the class session or an excuse, gives way to the check-in
+		_, err = new(models.Attendance).SetUserAttendance(attendanceID, userID, status, userID, models.StatusSourceCheckIn, "")
+		if err != nil {
+			fmt.Println(err)
+			return http.StatusInternalServerError, gin.H{"error": "Failed to mark you present"}
+		}
+	}
+
+	return http.StatusOK, gin.H{
+		"status":           "success",
+		"attendanceID":     attendanceID,
+		"attendanceStatus": status,
+	}
+}
@@ -0,0 +1,156 @@
+package controllers
+
+import (
+	"coeus/models"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// request sends an action over a class session websocket and returns the reply to it,
+// skipping the broadcasts that arrive in between.
+func request(t *testing.T, socket *websocket.Conn, action map[string]interface{}) map[string]interface{} {
+	t.Helper()
+
+	if err := socket.WriteJSON(action); err != nil {
+		t.Fatalf("write failed with error: %v", err)
+	}
+
+	socket.SetReadDeadline(time.Now().Add(5 * time.Second))
+	for {
+		var reply map[string]interface{}
+		if err := socket.ReadJSON(&reply); err != nil {
+			t.Fatalf("read failed with error: %v", err)
+		}
+		if reply["type"] == "ack" || reply["type"] == "error" {
+			if payload(reply)["requestId"] != action["requestId"] {
+				t.Fatalf("reply to request %v, want %v", payload(reply)["requestId"], action["requestId"])
+			}
+			return reply
+		}
+	}
+}
+
+// payload returns the payload of an event read as a map.
+func payload(event map[string]interface{}) map[string]interface{} {
+	payload, _ := event["payload"].(map[string]interface{})
+	return payload
+}
+
+func TestWebsocketActions(t *testing.T) {
+	server, dial := newWebsocketServer(t)
+	defer server.Close()
+	db := models.DB()
+
+	student, _, err := dial(1, "/ws/3")
+	if err != nil {
+		t.Fatalf("dial failed with error: %v", err)
+	}
+	defer student.Close()
+	instructor, _, err := dial(3, "/ws/3")
+	if err != nil {
+		t.Fatalf("dial failed with error: %v", err)
+	}
+	defer instructor.Close()
+
+	reply := request(t, student, map[string]interface{}{
+		"action":       "post-question",
+		"requestId":    "1",
+		"questionText": "Is the websocket protocol on the final exam?",
+		"confirmed":    true,
+	})
+	if reply["type"] != "ack" || payload(reply)["status"].(float64) != http.StatusOK {
+		t.Fatalf("post-question reply = %v", reply)
+	}
+	questionID := int(payload(reply)["data"].(map[string]interface{})["questionID"].(float64))
+	defer db.Exec("DELETE FROM question WHERE id = $1", questionID)
+	defer db.Exec("DELETE FROM vote WHERE question_id = $1", questionID)
+
+	// The other clients of the class session see the new question
+	instructor.SetReadDeadline(time.Now().Add(5 * time.Second))
+	var event map[string]interface{}
+	for event["type"] != "new-question" {
+		if err := instructor.ReadJSON(&event); err != nil {
+			t.Fatalf("instructor read failed with error: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		socket *websocket.Conn
+		action map[string]interface{}
+		status int
+		reply  string
+	}{
+		{"vote", student, map[string]interface{}{"action": "vote-up", "questionID": questionID}, http.StatusOK, "ack"},
+		{"vote twice", student, map[string]interface{}{"action": "vote-up", "questionID": questionID}, http.StatusOK, "ack"},
+		{"student marks answered", student, map[string]interface{}{"action": "mark-question", "questionID": questionID}, http.StatusForbidden, "error"},
+		{"instructor marks answered", instructor, map[string]interface{}{"action": "mark-question", "questionID": questionID}, http.StatusOK, "ack"},
+		{"unknown question", student, map[string]interface{}{"action": "vote-up", "questionID": 999999}, http.StatusNotFound, "error"},
+		{"empty question", student, map[string]interface{}{"action": "post-question", "questionText": "  "}, http.StatusBadRequest, "error"},
+		{"unknown action", student, map[string]interface{}{"action": "delete-everything"}, http.StatusBadRequest, "error"},
+	}
+
+	for i, test := range tests {
+		test.action["requestId"] = fmt.Sprint(i + 2)
+		reply := request(t, test.socket, test.action)
+		if reply["type"] != test.reply || int(payload(reply)["status"].(float64)) != test.status {
+			t.Errorf("%s: reply = %v, want %s %d", test.name, reply, test.reply, test.status)
+		}
+	}
+
+	question, _ := new(models.Question).GetByID(questionID, 0)
+	if question.Votes != 1 || !question.Answered {
+		t.Errorf("question after the actions = %+v, want 1 vote and answered", question)
+	}
+
+	// A message that isn't JSON is answered with an error and the connection stays open
+	student.WriteMessage(websocket.TextMessage, []byte("not json"))
+	student.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if err := student.ReadJSON(&event); err != nil || event["type"] != "error" {
+		t.Errorf("reply to an invalid message = %v, %v", event, err)
+	}
+	reply = request(t, student, map[string]interface{}{"action": "vote-up", "requestId": "last", "questionID": questionID})
+	if reply["type"] != "ack" {
+		t.Errorf("connection unusable after an invalid message: %v", reply)
+	}
+}
+
+func TestActionsMatchHTTP(t *testing.T) {
+	server, dial := newWebsocketServer(t)
+	defer server.Close()
+
+	socket, _, err := dial(1, "/ws/3")
+	if err != nil {
+		t.Fatalf("dial failed with error: %v", err)
+	}
+	defer socket.Close()
+
+	// The HTTP endpoint and the websocket action refuse a student marking a question answered alike
+	response, err := http.Get(fmt.Sprintf("%s/test-sign-in/1", server.URL))
+	if err != nil {
+		t.Fatalf("sign in failed with error: %v", err)
+	}
+	response.Body.Close()
+	httpRequest, _ := http.NewRequest("POST", fmt.Sprintf("%s/api/mark-question/6", server.URL), nil)
+	httpRequest.Header.Set("Cookie", response.Header.Get("Set-Cookie"))
+	response, err = http.DefaultClient.Do(httpRequest)
+	if err != nil {
+		t.Fatalf("mark-question request failed with error: %v", err)
+	}
+	var httpBody map[string]interface{}
+	json.NewDecoder(response.Body).Decode(&httpBody)
+	response.Body.Close()
+
+	reply := request(t, socket, map[string]interface{}{"action": "mark-question", "requestId": "1", "questionID": 6})
+	if int(payload(reply)["status"].(float64)) != response.StatusCode || payload(reply)["error"] != httpBody["error"] {
+		t.Errorf("websocket replied %v %v, HTTP %d %v", payload(reply)["status"], payload(reply)["error"], response.StatusCode, httpBody["error"])
+	}
+	if response.StatusCode != http.StatusForbidden {
+		t.Errorf("student marking a question answered got status %d, want %d", response.StatusCode, http.StatusForbidden)
+	}
+}
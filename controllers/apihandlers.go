@@ -1,1116 +1,4007 @@
-package controllers
-
-import (
-	"coeus/models"
-	"fmt"
-	"io/ioutil"
-	"os"
-	"path/filepath"
-	"regexp"
-	"strings"
-
-	"log"
-	"net/http"
-	"strconv"
-
-	"github.com/gin-contrib/sessions"
-
-	"github.com/gin-gonic/gin"
-)
-
-func APIDarkThemePostHandler(c *gin.Context) {
-	session := sessions.Default(c)
-	userID := session.Get("userID").(int)
-
-	theme, err := new(models.Setting).ToggleDarkTheme(userID)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	if theme {
-		session.Set("cssStyle", "style-dark")
-		session.Save()
-	} else {
-		session.Set("cssStyle", "")
-		session.Save()
-	}
-
-	c.JSON(http.StatusOK, gin.H{
-		"status": "success",
-	})
-}
-
-type timezoneRequest struct {
-	Timezone string `json:"timezone"`
-}
-
-func APITimezonePostHandler(c *gin.Context) {
-	session := sessions.Default(c)
-	userID := session.Get("userID").(int)
-
-	var req timezoneRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-
-	// convert the timezone to an int
-	timezoneInt, err := strconv.Atoi(req.Timezone)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	err = new(models.Setting).UpdateTimezone(userID, timezoneInt)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	session.Set("timezone", req.Timezone)
-	session.Save()
-
-	c.JSON(http.StatusOK, gin.H{
-		"status": "success",
-	})
-}
-
-func APIQuestionsPostHandler(c *gin.Context) {
-	session := sessions.Default(c)
-	userID := session.Get("userID").(int)
-
-	// Get the question from the form
-	questionText := c.PostForm("questionText")
-
-	// Get the class section id from the url
-	classSessionID := c.Param("classSessionID")
-
-	// convert classSessionIDInt to int
-	classSessionIDInt, err := strconv.Atoi(classSessionID)
-
-	// Add the question to the database
-	questionID, err := new(models.Question).PostQuestion(userID, classSessionIDInt, questionText)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// The frontend is going to handle the timezone formatting so we can just set it to 0 or UTC
-	timezone := 0
-
-	// GetByID the question from the database
-	question, err := new(models.Question).GetByID(questionID, timezone)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	broadcastNewQuestion(classSessionIDInt, question)
-}
-
-func APIMessagesGetHandler(c *gin.Context) {
-	session := sessions.Default(c)
-	userID := session.Get("userID").(int)
-	classSessionID := c.Param("classSessionID")
-	moderatorType := session.Get("moderatorType")
-
-	timezone, _ := session.Get("timezone").(string)
-
-	// Parse the class sessionID and timezone to an int
-	classSessionIDInt, err := strconv.Atoi(classSessionID)
-	timezoneInt, err := strconv.Atoi(timezone)
-
-	// Get the questions from the database by the created at time
-	questionsByTimeSlice, err := new(models.Question).GetAllQuestions(classSessionIDInt, "created_at", timezoneInt)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Get the questions from the database by the created at time
-	questionsByVoteSlice, err := new(models.Question).GetAllQuestions(classSessionIDInt, "votes", timezoneInt)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Get the userHasVoted slice from the database
-	userHasVotedSlice, err := new(models.Question).HasVotedAll(classSessionIDInt, userID)
-
-	questionsByVoteUnanswered, err := new(models.Question).GetUnansweredQuestions(classSessionIDInt, timezoneInt, "votes")
-	questionsByTimeUnanswered, err := new(models.Question).GetUnansweredQuestions(classSessionIDInt, timezoneInt, "time")
-
-	// Append the userHasVoted bool to the questionsBy slices
-	questionsByTime := new(models.Question).HasVotedAppend(questionsByTimeSlice, userHasVotedSlice)
-	questionsByVote := new(models.Question).HasVotedAppend(questionsByVoteSlice, userHasVotedSlice)
-
-	c.JSON(http.StatusOK, gin.H{
-		"moderatorType":             moderatorType,
-		"questionsByTime":           questionsByTime,
-		"questionsByVote":           questionsByVote,
-		"questionsByVoteUnanswered": questionsByVoteUnanswered,
-		"questionsByTimeUnanswered": questionsByTimeUnanswered,
-		"user":                      userID,
-	})
-}
-
-func APIModeratorsForSectionGetHandler(c *gin.Context) {
-	sectionID := c.Param("sectionID")
-	sectionID = strings.Trim(sectionID, "\"")
-	sectionIDInt, err := strconv.Atoi(sectionID)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	moderators, err := new(models.Moderator).GetAllModerators(sectionIDInt)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	c.JSON(http.StatusOK, gin.H{
-		"moderators": moderators,
-	})
-}
-
-func APIAddModeratorPostHandler(c *gin.Context) {
-	email := c.Param("email")
-	SectionID := c.Param("sectionID")
-
-	// Get user by email to check if they exist
-	_, err := new(models.User).GetUserId(email)
-	if err != nil {
-		fmt.Println(err)
-
-		// Send a 400 status code to the client if the user doesn't exist
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status": "user doesn't exist",
-		})
-
-		return
-	}
-
-	// Parse the section id to an int
-	SectionIDInt, err := strconv.Atoi(SectionID)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	moderatorUserID, err := new(models.User).GetUserId(email)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Add the new moderator to the database
-	_, err = new(models.Moderator).Update(moderatorUserID, SectionIDInt, "teacher assistant")
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	//send a 200 status code to the client if the adding was successful
-	c.JSON(http.StatusOK, gin.H{
-		"status": "updated moderator to teacher assistant",
-	})
-}
-
-func APIRemoveModeratorDeleteHandler(c *gin.Context) {
-	userID := c.Param("userID")
-	sectionID := c.Param("sectionID")
-
-	// Parse the section and user id to an int
-	sectionIDInt, err := strconv.Atoi(sectionID)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	userIDInt, err := strconv.Atoi(userID)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Remove the moderator from the database
-	err = new(models.Moderator).Delete(userIDInt, sectionIDInt)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	//send a 200 status code to the client if the adding was successful
-	c.JSON(http.StatusOK, gin.H{
-		"status": "removed moderator",
-	})
-
-}
-
-func APIStartSessionPostHandler(c *gin.Context) {
-	session := sessions.Default(c)
-	instructorIDInt := session.Get("userID").(int)
-	sectionID := c.Param("sectionID")
-
-	// convert sectionID to int
-	sectionIDInt, err := strconv.Atoi(sectionID)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Start the class session in the database
-	classSessionID, err := new(models.ClassSession).Start(sectionIDInt)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Create an attendance table for the class session
-	attendanceID, err := new(models.Attendance).Add(classSessionID, sectionIDInt, instructorIDInt)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	constructStartSession(sectionIDInt, attendanceID)
-}
-
-func APIEndSessionPostHandler(c *gin.Context) {
-	classSessionID := c.Param("classSessionID")
-
-	// convert classSessionIDInt to int
-	classSessionIDInt, err := strconv.Atoi(classSessionID)
-
-	// Get the section id from the database by the class session id
-	sectionID, err := new(models.ClassSession).GetSectionID(classSessionIDInt)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Get the attendance data for the instructor by their user id
-	attendanceID, err := new(models.Attendance).GetAttendanceIDBySectionID(sectionID)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Gets the list of users who are enrolled in the section
-	enrolledUsers, err := new(models.Enrollment).GetEnrolledUsersBySectionID(sectionID)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Get the list of users in the participants table
-	participantUsers, err := new(models.Participant).GetParticipants(classSessionIDInt)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Find users who are enrolled but not participating
-	nonParticipantUserIDs := findAbsentUsers(enrolledUsers, participantUsers)
-
-	// Create new user_attendance entries for those users
-	for _, userID := range nonParticipantUserIDs {
-		_, err = new(models.Attendance).AddUserAttendance(attendanceID, userID, "absent")
-		if err != nil {
-			fmt.Println(err)
-		}
-	}
-
-	// End the class session in the database
-	err = new(models.ClassSession).End(classSessionIDInt)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	constructEndSession(classSessionIDInt, sectionID)
-}
-
-// findAbsentUsers returns a slice of user ids who are enrolled but not participating it is used in APIEndSessionPostHandler.
-func findAbsentUsers(enrolledUsers []models.Enrollment, participantUsers []models.Participant) []int {
-	nonParticipantUserIDs := make([]int, 0)
-
-	for _, enrolledUser := range enrolledUsers {
-		found := false
-		for _, participantUser := range participantUsers {
-			if enrolledUser.UserId == participantUser.UserID {
-				found = true
-				break
-			}
-		}
-		if !found {
-			nonParticipantUserIDs = append(nonParticipantUserIDs, enrolledUser.UserId)
-		}
-	}
-
-	return nonParticipantUserIDs
-}
-
-func APIGetUserGetHandler(c *gin.Context) {
-
-	// Get the users from the database
-	users, err := new(models.User).GetAll()
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	isDemo, err := new(models.Organization).GetStatus()
-	if err != nil {
-		fmt.Println(err)
-		return
-	}
-
-	// If the database is for softlaunch, then remove the admin user from the slice of users structs
-	// this is done so that the admin user cannot be seen in the users table in the softlaunch database
-	// preventing someone from changing the admin credentials
-	if isDemo == "true" {
-		filteredUsers := make([]struct {
-			ID                   int64
-			Email                string
-			LastName             string
-			FirstName            string
-			CreatedAt            string
-			UpdatedAt            string
-			HighestModeratorType string
-		}, 0)
-		for _, user := range users {
-			if user.Email != "admin@coeus.education" {
-				filteredUsers = append(filteredUsers, user)
-			}
-		}
-		users = filteredUsers
-	}
-
-	c.JSON(http.StatusOK, gin.H{
-		"users": users,
-	})
-}
-
-func APIAddUserPostHandler(c *gin.Context) {
-
-	// Struct to hold the JSON data
-	type UserData struct {
-		Email         string `json:"email"`
-		FirstName     string `json:"firstName"`
-		LastName      string `json:"lastName"`
-		Password      string `json:"password"`
-		ModeratorType string `json:"moderatorType"`
-	}
-
-	// Parse the JSON data from the request body
-	var userData UserData
-	if err := c.ShouldBindJSON(&userData); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-
-	// Get the added user info from the struct
-	email := userData.Email
-	firstName := userData.FirstName
-	lastName := userData.LastName
-	password := userData.Password
-	moderatorType := userData.ModeratorType
-
-	// Add the user to the database
-	newUserID, err := new(models.User).Add(email, password, lastName, firstName)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Parse the newUserID from an int64 to an int
-	newUserIDInt := int(newUserID)
-
-	// Add the user mod status to the database with the section id as NULL
-	_, err = new(models.Moderator).AdminAdd(newUserIDInt, moderatorType)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Get the organization id
-	organizationID, err := new(models.Organization).GetOrganizationID()
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Add the user to the default organization
-	err = new(models.User).AddUserToOrganization(newUserIDInt, organizationID)
-	if err != nil {
-		log.Println("Failed to add user to organization:", err)
-	}
-
-	// Add a setting for the user
-	_, err = new(models.Setting).Add(newUserIDInt)
-	if err != nil {
-		fmt.Println(err)
-	}
-}
-
-func APIUpdateUserPutHandler(c *gin.Context) {
-
-	// Struct to hold the JSON data
-	type UserData struct {
-		UserId        string `json:"userId"`
-		Email         string `json:"email"`
-		FirstName     string `json:"firstName"`
-		LastName      string `json:"lastName"`
-		Password      string `json:"password"`
-		ModeratorType string `json:"moderatorType"`
-	}
-
-	// Parse the JSON data from the request body
-	var userData UserData
-	if err := c.ShouldBindJSON(&userData); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-
-	// Get the edited user info from the struct
-	userID := userData.UserId
-	email := userData.Email
-	firstName := userData.FirstName
-	lastName := userData.LastName
-	password := userData.Password
-	moderatorType := userData.ModeratorType
-
-	// Parse the userID to an int
-	userIDInt, err := strconv.Atoi(userID)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// If the moderatorType is not empty, then update the user mod status (this is mainly for admins)
-	if moderatorType != "" {
-		// Update the user mod status to the database with the section id as NULL
-		_, err = new(models.Moderator).AdminUpdate(userIDInt, moderatorType)
-		if err != nil {
-			fmt.Println(err)
-		}
-	}
-
-	// Parse the userID to an int64
-	userIDInt64, err := strconv.ParseInt(userID, 10, 32)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Update the user in the database
-	err = new(models.User).Update(userIDInt64, email, lastName, firstName, password)
-	if err != nil {
-		fmt.Println(err)
-	}
-}
-
-func APIDeleteUserDeleteHandler(c *gin.Context) {
-	// Get the user from the form
-	userID := c.Param("ID")
-
-	// Parse the userID to an int64
-	userIDInt64, err := strconv.ParseInt(userID, 10, 64)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Delete the user to the database
-	err = new(models.User).Delete(userIDInt64)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Parse the userID to an int
-	userIDInt, err := strconv.Atoi(userID)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Delete the setting for the user
-	err = new(models.Setting).Delete(userIDInt)
-	if err != nil {
-		fmt.Println(err)
-	}
-}
-
-func APIAddAdminPostHandler(c *gin.Context) {
-
-	type Admin struct {
-		FirstName string `json:"firstName"`
-		LastName  string `json:"lastName"`
-		Email     string `json:"email"`
-		Password  string `json:"password"`
-	}
-
-	admin := &Admin{}
-
-	// Bind the JSON to the struct
-	if err := c.ShouldBindJSON(admin); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-
-	// Add the admin to the database
-	adminID, err := new(models.User).Add(admin.Email, admin.Password, admin.LastName, admin.FirstName)
-	if err != nil {
-		// Log the error and respond with an error status and message
-		fmt.Println(err)
-		c.JSON(http.StatusInternalServerError, gin.H{"success": false, "error": "Failed to add admin."})
-		return
-	}
-
-	// convert adminID to int
-	adminIDInt := int(adminID)
-
-	// Adds the admin to a flag table to indicate that they are an admin during sign-in
-	err = new(models.Organization).SetAdmin(adminIDInt)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Return a success response
-	c.JSON(http.StatusOK, gin.H{"success": true, "adminName": admin.FirstName})
-}
-
-func APIOnboardingPostHandler(c *gin.Context) {
-	session := sessions.Default(c)
-
-	type Organization struct {
-		ID                   int    `json:"id"`
-		Name                 string `json:"name"`
-		OrganizationTimezone string `json:"timezone"`
-		LogoPath             string `json:"logoPath"`
-		APIKey               string `json:"apiKey"`
-		Email                string `json:"email"`
-		CreatedAt            string `json:"created_at"`
-		UpdatedAt            string `json:"updated_at"`
-	}
-
-	// Create a new Organization
-	var org Organization
-
-	// Parse multipart form data
-	err := c.Request.ParseMultipartForm(32 << 20)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-
-	// Get the values from the form
-	org.Name = c.PostForm("name")
-	org.OrganizationTimezone = c.PostForm("timezone")
-	org.APIKey = c.PostForm("apiKey")
-	org.Email = c.PostForm("email")
-
-	// If the API key exists, write it to the .env file
-	if org.APIKey != "" {
-
-		// Set usingSendGrid to true in the session
-		session.Set("usingSendGrid", true)
-
-		// Write the API key and email to the .env file
-		err = writeAPIKeyToEnv(org.APIKey, org.Email)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-			return
-		}
-	} else {
-		session.Set("usingSendGrid", false)
-	}
-
-	// Process the file
-	file, err := c.FormFile("logoPath")
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-
-	// Get the file extension
-	fileExtension := filepath.Ext(file.Filename)
-
-	// Create the file name
-	fileName := "logo" + fileExtension
-
-	// Remove existing logo files if they exist
-	existingFiles, err := filepath.Glob("./views/static/logo/logo.*")
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-	for _, f := range existingFiles {
-		err := os.Remove(f)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-			return
-		}
-	}
-
-	// Save the file to the server
-	err = c.SaveUploadedFile(file, "./views/static/logo/"+fileName)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-
-	// The file path is set as the logoPath of the organization
-	org.LogoPath = "/static/logo/" + fileName
-
-	// Add the organization to the database
-	organizationID, err := new(models.Organization).Add(org.Name, org.OrganizationTimezone, org.LogoPath, org.APIKey, org.Email)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-
-	// Return a success response
-	c.JSON(http.StatusOK, gin.H{"success": true, "organizationID": organizationID})
-}
-
-func APIOrganizationPostHandler(c *gin.Context) {
-	session := sessions.Default(c)
-
-	// Get the organization id
-	organizationID, err := new(models.Organization).GetOrganizationID()
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// If a logo was uploaded, process it
-	file, err := c.FormFile("upload-logo-input")
-	if err == nil {
-		// Get the file extension
-		fileExtension := filepath.Ext(file.Filename)
-
-		// Create the file name
-		fileName := "logo" + fileExtension
-
-		// Remove existing logo files if they exist
-		existingFiles, err := filepath.Glob("./views/static/logo/logo.*")
-		if err != nil {
-			fmt.Println(err)
-		}
-		for _, f := range existingFiles {
-			err := os.Remove(f)
-			if err != nil {
-				fmt.Println(err)
-			}
-		}
-
-		// Save the file to the server
-		err = c.SaveUploadedFile(file, "./views/static/logo/"+fileName)
-		if err != nil {
-			fmt.Println(err)
-		}
-
-		// Append the logo path of /static/logo/ to the file name
-		fileName = "/static/logo/" + fileName
-
-		// Update the logo in the database
-		err = new(models.Organization).StoreLogo(organizationID, fileName)
-		if err != nil {
-			fmt.Println(err)
-		}
-
-		// Get the organization from the database
-		organization, err := new(models.Organization).Get(organizationID)
-		if err != nil {
-			fmt.Println(err)
-		}
-
-		// set the organization logo to the session
-		session.Set("organizationLogo", organization.LogoPath)
-	}
-
-	// Handle time zone update
-	timeZone := c.PostForm("time-zone-select")
-	if timeZone != "" {
-		err := new(models.Organization).UpdateTimeZone(organizationID, timeZone)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update time zone"})
-			return
-		}
-	}
-
-	// Handle organization name update
-	orgName := c.PostForm("org-name")
-	if orgName != "" {
-		err := new(models.Organization).UpdateName(organizationID, orgName)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update organization name"})
-			return
-		}
-	}
-
-	if c.PostForm("org-settings-sendgrid-api-key") != "" {
-		apiKey := c.PostForm("org-settings-sendgrid-api-key")
-		email := c.PostForm("org-settings-sendgrid-email")
-
-		// Write the API key and email to the .env file
-		err = writeAPIKeyToEnv(apiKey, email)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-			return
-		}
-
-		// Update the API key and email in the database
-		err = new(models.Organization).UpdateAPIKeyAndEmail(organizationID, apiKey, email)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-			return
-		}
-
-	}
-
-	session.Save()
-}
-
-// HELPER FUNCTION
-// writeAPIKeyToEnv writes the API key and organization email to the .env file
-func writeAPIKeyToEnv(apiKey string, email string) error {
-	// Read the existing .env file
-	data, err := ioutil.ReadFile("globals/.env")
-	if err != nil {
-		return err
-	}
-
-	// Convert to string
-	envData := string(data)
-
-	// Check if the SENDGRID_API_KEY already exists
-	if strings.Contains(envData, "SENDGRID_API_KEY") {
-		// If it does, replace the existing key with the new one
-		re := regexp.MustCompile(`(?m)SENDGRID_API_KEY='.*'`)
-		envData = re.ReplaceAllString(envData, "SENDGRID_API_KEY='"+apiKey+"'")
-	} else {
-		// If it doesn't, add the new API key
-		envData += "\nSENDGRID_API_KEY='" + apiKey + "'"
-	}
-
-	// Check if the SENDGRID_ORGANIZATION_EMAIL already exists
-	if strings.Contains(envData, "SENDGRID_ORGANIZATION_EMAIL") {
-		// If it does, replace the existing email with the new one
-		re := regexp.MustCompile(`(?m)SENDGRID_ORGANIZATION_EMAIL='.*'`)
-		envData = re.ReplaceAllString(envData, "SENDGRID_ORGANIZATION_EMAIL='"+email+"'")
-	} else {
-		// If it doesn't, add the new email
-		envData += "\nSENDGRID_ORGANIZATION_EMAIL='" + email + "'"
-	}
-
-	// Write the new data back to the .env file
-	err = ioutil.WriteFile("globals/.env", []byte(envData), 0644)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func APICoursesGetHandler(c *gin.Context) {
-	session := sessions.Default(c)
-	userID := session.Get("userID").(int)
-
-	// Get the course and section data
-	courseSections, err := new(models.Course).GetCourseSectionsByIntructor(userID)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	c.JSON(http.StatusOK, gin.H{
-		"courseSections": courseSections,
-	})
-}
-
-type Course struct {
-	CourseNumber    string   `json:"courseNumber"`
-	CourseTitle     string   `json:"courseTitle"`
-	CourseSemester  string   `json:"courseSemester"`
-	CourseStartDate string   `json:"courseStartDate"`
-	CourseEndDate   string   `json:"courseEndDate"`
-	CourseYear      string   `json:"courseYear"`
-	CourseSections  string   `json:"courseSections"`
-	Schedules       []string `json:"schedules"`
-}
-
-func APICoursesPostHandler(c *gin.Context) {
-	session := sessions.Default(c)
-	userID := session.Get("userID").(int)
-
-	var course Course
-
-	if err := c.ShouldBindJSON(&course); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-
-	// Parse the course sections to an int
-	courseSectionsInt, err := strconv.Atoi(course.CourseSections)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Add the course to the database
-	courseID, err := new(models.Course).AddCourseAndSections(course.CourseNumber, course.CourseTitle, course.CourseSemester, course.CourseStartDate, course.CourseEndDate, course.CourseYear, courseSectionsInt)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	sectionIDs, err := new(models.Course).GetSectionIds(courseID)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// For each section id, add the instructor to an enrollment table
-	// and create a schedule for the section
-	for i, sectionID := range sectionIDs {
-		err = new(models.Section).AddEnrollment(sectionID, userID)
-		if err != nil {
-			fmt.Println(err)
-		}
-
-		// Add the schedule to the database
-		scheduleID, err := new(models.Section).CreateSchedule(sectionID, course.Schedules[i])
-		if err != nil {
-			fmt.Println(err)
-		}
-
-		// Add class sessions to the database
-		err = new(models.Section).AddClassSession(sectionID, scheduleID)
-		if err != nil {
-			fmt.Println(err)
-		}
-
-		// Add a moderator status for that instructor given the section id
-		_, err = new(models.Moderator).Add(userID, sectionID, "instructor")
-		if err != nil {
-			fmt.Println(err)
-		}
-	}
-}
-
-func APICourseSectionDeleteHandler(c *gin.Context) {
-
-	// Get the course id and section number from the form
-	courseID := c.Param("courseID")
-	sectionNumber := c.Param("sectionNumber")
-
-	// Parse the course id and section number to an int
-	courseIDInt, err := strconv.Atoi(courseID)
-	sectionNumberInt, err := strconv.Atoi(sectionNumber)
-
-	// Delete the section from the database
-	err = new(models.Section).DeleteByCourseIdAndSection(courseIDInt, sectionNumberInt)
-	if err != nil {
-		fmt.Println(err)
-	}
-}
-
-func APICourseSectionPutHandler(c *gin.Context) {
-	// Define a struct to hold the JSON data
-	type CourseData struct {
-		CourseID        int    `json:"courseID"`
-		SectionID       int    `json:"sectionID"`
-		CourseNumber    string `json:"courseNumber"`
-		CourseTitle     string `json:"courseTitle"`
-		Semester        string `json:"semester"`
-		Year            string `json:"year"`
-		SectionName     string `json:"sectionName"`
-		CourseStartDate string `json:"courseStartDate"`
-		CourseEndDate   string `json:"courseEndDate"`
-		ScheduleDays    string `json:"scheduleDays"`
-		ScheduleTime    string `json:"scheduleTime"`
-	}
-
-	// Parse the JSON request body
-	var courseData CourseData
-	if err := c.ShouldBindJSON(&courseData); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-
-	// Update the course and section
-	err := new(models.Course).UpdateCourseAndSection(courseData.CourseID, courseData.SectionID, courseData.CourseNumber, courseData.CourseTitle, courseData.Semester, courseData.Year, courseData.SectionName, courseData.CourseStartDate, courseData.CourseEndDate, courseData.ScheduleDays, courseData.ScheduleTime)
-	if err != nil {
-		fmt.Println(err)
-	}
-}
-
-type EmailRequest struct {
-	Email string `json:"email"`
-}
-
-func APIPasswordResetSendEmailPostHandler(c *gin.Context) {
-	var emailRequest EmailRequest
-
-	if err := c.ShouldBindJSON(&emailRequest); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-
-	email := emailRequest.Email
-
-	userID, err := new(models.User).GetUserId(email)
-
-	if userID == 0 {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status":  http.StatusBadRequest,
-			"message": "Email does not exist",
-		})
-		return
-	}
-
-	// remove any existing tokens
-	err = new(models.VerifyUser).DeleteToken(userID)
-	if err != nil {
-		log.Println("Failed to delete token:", err)
-	}
-
-	_, err = new(models.VerifyUser).CreateToken(email)
-	if err != nil {
-		log.Println("Failed to send email:", err)
-	}
-
-	c.JSON(http.StatusOK, gin.H{
-		"status": http.StatusOK,
-		"userID": userID,
-	})
-}
-
-type PinRequest struct {
-	Pin string `json:"pin"`
-}
-
-func APIPasswordResetVerifyPinPostHandler(c *gin.Context) {
-	var pinRequest PinRequest
-
-	if err := c.ShouldBindJSON(&pinRequest); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-
-	pin := pinRequest.Pin
-
-	_, userID, err := new(models.VerifyUser).MatchToken(pin)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status":  http.StatusBadRequest,
-			"message": "Pin does not exist",
-		})
-		return
-	}
-
-	c.JSON(http.StatusOK, gin.H{
-		"status": http.StatusOK,
-		"userID": userID,
-	})
-}
-
-type PasswordRequest struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
-}
-
-func APIPasswordResetPostHandler(c *gin.Context) {
-
-	var passwordRequest PasswordRequest
-
-	if err := c.ShouldBindJSON(&passwordRequest); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-
-	email := passwordRequest.Email
-	password := passwordRequest.Password
-
-	// Get the user id from the email
-	userID, err := new(models.User).GetUserId(email)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Update the user's password
-	err = new(models.User).UpdatePassword(userID, password)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Delete the token
-	err = new(models.VerifyUser).DeleteToken(userID)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	c.JSON(http.StatusOK, gin.H{
-		"status": http.StatusOK,
-	})
-
-}
-
-func APIAttendanceSectionsGetHandler(c *gin.Context) {
-	// Get the user id from the session
-	instructorID := sessions.Default(c).Get("userID").(int)
-
-	// Get the course id from the url
-	courseID := c.Param("courseID")
-
-	// Parse the course id to an int
-	courseIDInt, err := strconv.Atoi(courseID)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Get the attendance data for the instructor by their user id
-	sections, err := new(models.Attendance).GetSectionsByInstructorAndCourse(instructorID, courseIDInt)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	c.JSON(http.StatusOK, gin.H{
-		"sections": sections,
-	})
-}
-
-func APIGetAttendanceIDBySectionIDHandler(c *gin.Context) {
-
-	// Get the section id from the url
-	sectionID := c.Param("sectionID")
-
-	// Parse the section id to an int
-	sectionIDInt, err := strconv.Atoi(sectionID)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Get the attendance data for the instructor by their user id
-	attendanceID, err := new(models.Attendance).GetAttendanceIDBySectionID(sectionIDInt)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	c.JSON(http.StatusOK, gin.H{
-		"attendanceID": attendanceID,
-	})
-}
-
-func APIAttendanceStudentsGetHandler(c *gin.Context) {
-
-	// Get the attendance id from the url
-	attendanceID := c.Param("attendanceID")
-
-	// Parse the attendance id to an int
-	attendanceIDInt, err := strconv.Atoi(attendanceID)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Get the attendance data for the instructor by their user id
-	students, err := new(models.AttendanceRecord).GetStudentsByAttendance(attendanceIDInt)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	c.JSON(http.StatusOK, gin.H{
-		"students": students,
-	})
-}
-
-func APIMarkPresentPostHandler(c *gin.Context) {
-	session := sessions.Default(c)
-	studentID := session.Get("userID").(int)
-	attendanceID := c.Param("attendanceID")
-
-	// Parse the attendance id to an int
-	attendanceIDInt, err := strconv.Atoi(attendanceID)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Get the attendance data for the instructor by their user id
-	_, err = new(models.Attendance).AddUserAttendance(attendanceIDInt, studentID, "present")
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	c.JSON(http.StatusOK, gin.H{
-		"status": "success",
-	})
-}
+package controllers
+
+import (
+	"archive/zip"
+	"bytes"
+	"coeus/email"
+	"coeus/models"
+	"coeus/qrcode"
+	"coeus/xlsx"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-contrib/sessions"
+
+	"github.com/gin-gonic/gin"
+)
+
+func APIDarkThemePostHandler(c *gin.Context) {
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+
+	theme, err := new(models.Setting).ToggleDarkTheme(userID)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	if theme {
+		session.Set("cssStyle", "style-dark")
+		session.Save()
+	} else {
+		session.Set("cssStyle", "")
+		session.Save()
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status": "success",
+	})
+}
+
+type timezoneRequest struct {
+	Timezone string `json:"timezone"`
+}
+
+func APITimezonePostHandler(c *gin.Context) {
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+
+	var req timezoneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// convert the timezone to an int
+	timezoneInt, err := strconv.Atoi(req.Timezone)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	err = new(models.Setting).UpdateTimezone(userID, timezoneInt)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	session.Set("timezone", req.Timezone)
+	session.Save()
+
+	c.JSON(http.StatusOK, gin.H{
+		"status": "success",
+	})
+}
+
+func APIQuestionsPostHandler(c *gin.Context) {
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+
+	// Get the question from the form
+	questionText := c.PostForm("questionText")
+
+	// Get the class section id from the url
+	classSessionIDInt, err := strconv.Atoi(c.Param("classSessionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid class session ID"})
+		return
+	}
+
+	status, body := postQuestionAction(userID, classSessionIDInt, questionText, c.PostForm("confirmed") == "true")
+	c.JSON(status, body)
+}
+
+func APIMessagesGetHandler(c *gin.Context) {
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+	classSessionID := c.Param("classSessionID")
+	moderatorType := session.Get("moderatorType")
+
+	timezone, _ := session.Get("timezone").(string)
+
+	// Parse the class sessionID and timezone to an int
+	classSessionIDInt, _ := strconv.Atoi(classSessionID)
+	timezoneInt, _ := strconv.Atoi(timezone)
+
+	questions := classSessionQuestions(classSessionIDInt, userID, timezoneInt)
+	questions["moderatorType"] = moderatorType
+	questions["user"] = userID
+
+	c.JSON(http.StatusOK, questions)
+}
+
+// sessionQuestions holds the orderings of the questions of a class session returned by getSessionQuestions.
+type sessionQuestions struct {
+	byTime           []models.Question
+	byVote           []models.Question
+	byVoteUnanswered []models.Question
+	byTimeUnanswered []models.Question
+}
+
+// classSessionQuestions returns the questions of a class session as the user sees them, sorted
+// by time and by votes, all of them and only the unanswered ones, with their answers.
+func classSessionQuestions(classSessionID int, userID int, timezone int) gin.H {
+	questions := getSessionQuestions(classSessionID, userID, timezone)
+	return gin.H{
+		"questionsByTime":           questions.byTime,
+		"questionsByVote":           questions.byVote,
+		"questionsByVoteUnanswered": questions.byVoteUnanswered,
+		"questionsByTimeUnanswered": questions.byTimeUnanswered,
+	}
+}
+
+// getSessionQuestions reads the questions of a class session as the user sees them, with their answers attached.
+func getSessionQuestions(classSessionID int, userID int, timezone int) sessionQuestions {
+	// Get the questions from the database by the created at time
+	questionsByTimeSlice, err := new(models.Question).GetAllQuestions(classSessionID, "created_at", timezone)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Get the questions from the database by the created at time
+	questionsByVoteSlice, err := new(models.Question).GetAllQuestions(classSessionID, "votes", timezone)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Get the userHasVoted slice from the database
+	userHasVotedSlice, err := new(models.Question).HasVotedAll(classSessionID, userID)
+
+	questionsByVoteUnanswered, err := new(models.Question).GetUnansweredQuestions(classSessionID, timezone, "votes")
+	questionsByTimeUnanswered, err := new(models.Question).GetUnansweredQuestions(classSessionID, timezone, "time")
+
+	// Append the userHasVoted bool to the questionsBy slices
+	questionsByTime := new(models.Question).HasVotedAppend(questionsByTimeSlice, userHasVotedSlice)
+	questionsByVote := new(models.Question).HasVotedAppend(questionsByVoteSlice, userHasVotedSlice)
+
+	// Attach the answers to each question
+	answers, err := new(models.Answer).GetByClassSessionID(classSessionID, timezone)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	return sessionQuestions{
+		byTime:           new(models.Question).AnswersAppend(questionsByTime, answers),
+		byVote:           new(models.Question).AnswersAppend(questionsByVote, answers),
+		byVoteUnanswered: new(models.Question).AnswersAppend(questionsByVoteUnanswered, answers),
+		byTimeUnanswered: new(models.Question).AnswersAppend(questionsByTimeUnanswered, answers),
+	}
+}
+
+// maxAnswerLength is the longest answer, in characters, a moderator can post.
+const maxAnswerLength = 1000
+
+// APIAnswersPostHandler posts an answer to a question and broadcasts it to the class session.
+// Only the instructor, teacher assistants and moderators of the question's section can answer.
+func APIAnswersPostHandler(c *gin.Context) {
+	session := sessions.Default(c)
+	userID, ok := session.Get("userID").(int)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "not signed in"})
+		return
+	}
+
+	questionIDInt, err := strconv.Atoi(c.Param("questionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// The frontend is going to handle the timezone formatting so we can just set it to 0 or UTC
+	timezone := 0
+
+	question, err := new(models.Question).GetByID(questionIDInt, timezone)
+	if err != nil || question.Status != models.QuestionApproved {
+		c.JSON(http.StatusNotFound, gin.H{"error": "question not found"})
+		return
+	}
+
+	classSession, err := new(models.ClassSession).Get(question.SessionID, timezone)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !isSectionModerator(c, classSession.SectionID) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a moderator of this section"})
+		return
+	}
+
+	answerText := strings.TrimSpace(c.PostForm("answerText"))
+	if answerText == "" || len([]rune(answerText)) > maxAnswerLength {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("answer must be between 1 and %d characters", maxAnswerLength)})
+		return
+	}
+
+	answerID, err := new(models.Answer).PostAnswer(questionIDInt, userID, answerText)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	answer, err := new(models.Answer).GetByID(answerID, timezone)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"answerID": answerID,
+	})
+
+	broadcastNewAnswer(question.SessionID, answer)
+	if !question.Answered {
+		constructMarkQuestion(question.SessionID, question.ID)
+	}
+}
+
+func APIModeratorsForSectionGetHandler(c *gin.Context) {
+	sectionID := c.Param("sectionID")
+	sectionID = strings.Trim(sectionID, "\"")
+	sectionIDInt, err := strconv.Atoi(sectionID)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	moderators, err := new(models.Moderator).GetAllModerators(sectionIDInt)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"moderators": moderators,
+	})
+}
+
+func APIAddModeratorPostHandler(c *gin.Context) {
+	email := c.Param("email")
+	SectionID := c.Param("sectionID")
+
+	// Get user by email to check if they exist
+	_, err := new(models.User).GetUserId(email)
+	if err != nil {
+		fmt.Println(err)
+
+		// Send a 400 status code to the client if the user doesn't exist
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status": "user doesn't exist",
+		})
+
+		return
+	}
+
+	// Parse the section id to an int
+	SectionIDInt, err := strconv.Atoi(SectionID)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	moderatorUserID, err := new(models.User).GetUserId(email)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Add the new moderator to the database
+	_, err = new(models.Moderator).Update(moderatorUserID, SectionIDInt, "teacher assistant")
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Reconnect the new moderator to the section's class sessions with their new role
+	revokeWebsocketAccess(moderatorUserID, SectionIDInt)
+
+	//send a 200 status code to the client if the adding was successful
+	c.JSON(http.StatusOK, gin.H{
+		"status": "updated moderator to teacher assistant",
+	})
+}
+
+func APIRemoveModeratorDeleteHandler(c *gin.Context) {
+	userID := c.Param("userID")
+	sectionID := c.Param("sectionID")
+
+	// Parse the section and user id to an int
+	sectionIDInt, err := strconv.Atoi(sectionID)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	userIDInt, err := strconv.Atoi(userID)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Remove the moderator from the database
+	err = new(models.Moderator).Delete(userIDInt, sectionIDInt)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Disconnect the user from the section's class sessions if they no longer belong to it,
+	// or reconnect them as a student
+	revokeWebsocketAccess(userIDInt, sectionIDInt)
+
+	//send a 200 status code to the client if the adding was successful
+	c.JSON(http.StatusOK, gin.H{
+		"status": "removed moderator",
+	})
+
+}
+
+func APIStartSessionPostHandler(c *gin.Context) {
+	session := sessions.Default(c)
+	instructorIDInt := session.Get("userID").(int)
+	sectionID := c.Param("sectionID")
+
+	// convert sectionID to int
+	sectionIDInt, err := strconv.Atoi(sectionID)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	classSessionID, attendanceID, err := startClassSession(sectionIDInt, instructorIDInt)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"classSessionID": classSessionID,
+		"attendanceID":   attendanceID,
+	})
+}
+
+func APIEndSessionPostHandler(c *gin.Context) {
+	classSessionID := c.Param("classSessionID")
+
+	// convert classSessionIDInt to int
+	classSessionIDInt, err := strconv.Atoi(classSessionID)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	err = endClassSession(classSessionIDInt)
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+// startClassSession starts a class session for a section, takes attendance for it and broadcasts the start.
+// It is shared by APIStartSessionPostHandler and the session scheduler.
+// It returns the class session id, the attendance id and any error encountered.
+func startClassSession(sectionID, instructorID int) (int, int, error) {
+	// Start the class session in the database
+	classSessionID, err := new(models.ClassSession).Start(sectionID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// Create an attendance table for the class session unless it is already running
+	attendanceID, err := new(models.Attendance).GetAttendanceIDByClassSessionID(classSessionID)
+	if err != nil {
+		attendanceID, err = new(models.Attendance).Add(classSessionID, sectionID, instructorID)
+		if err != nil {
+			fmt.Println(err)
+		}
+	}
+
+	constructStartSession(sectionID, classSessionID)
+
+	return classSessionID, attendanceID, nil
+}
+
+// endClassSession ends the class session, marks the students without a status absent and broadcasts the end.
+// It is shared by APIEndSessionPostHandler and the session scheduler. A session that already ended is left alone.
+// It returns any error encountered.
+func endClassSession(classSessionID int) error {
+	// Get the section id from the database by the class session id
+	sectionID, err := new(models.ClassSession).GetSectionID(classSessionID)
+	if err != nil {
+		return err
+	}
+
+	// End the class session in the database, once
+	ended, err := new(models.ClassSession).End(classSessionID)
+	if err != nil {
+		return err
+	}
+	if !ended {
+		return nil
+	}
+
+	// Get the attendance taken for this class session
+	attendanceID, err := new(models.Attendance).GetAttendanceIDByClassSessionID(classSessionID)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Mark every student of the section without a status absent, whether or not they joined,
+	// leaving those who already have one such as an approved excuse
+	roster, err := new(models.AttendanceRecord).GetRoster(attendanceID)
+	if err != nil {
+		fmt.Println(err)
+	}
+	for _, student := range roster {
+		if student.Status != "" {
+			continue
+		}
+		_, err = new(models.Attendance).SetUserAttendance(attendanceID, student.ID, models.AttendanceAbsent, 0, models.StatusSourceSessionEnd, "")
+		if err != nil {
+			fmt.Println(err)
+		}
+	}
+
+	closeClassSessionPolls(classSessionID)
+	closeClassSessionQuizzes(classSessionID)
+	closeClassSessionCheckIn(classSessionID, attendanceID)
+	checkAttendanceAlerts(sectionID, attendanceID)
+	constructEndSession(classSessionID, sectionID)
+
+	// Clients that reconnect from now on get a snapshot of the ended session
+	relay.Forget(classSessionID)
+
+	return nil
+}
+
+// checkAttendanceAlerts raises an alert for each student of a section who crossed one of its attendance
+// thresholds with the attendance just taken, and sends the emails of the new ones when the section wants them.
+// A student who already crossed a threshold isn't alerted about it again.
+func checkAttendanceAlerts(sectionID int, attendanceID int) {
+	thresholds, err := new(models.AttendanceThresholds).GetThresholds(sectionID)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	if thresholds.Absences == 0 && thresholds.Rate == 0 {
+		return
+	}
+
+	sheet, err := new(models.AttendanceSheet).Get(sectionID, "", "", 0)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, student := range sheet.Students {
+		for _, alert := range thresholds.Check(student.Statuses) {
+			alertID, created, err := new(models.AttendanceAlert).Add(sectionID, student.UserID, alert.Kind, alert.Threshold, alert.Observed, attendanceID)
+			if err != nil {
+				fmt.Println(err)
+				continue
+			}
+			if !created || !thresholds.Email {
+				continue
+			}
+
+			alert, err = new(models.AttendanceAlert).GetByID(alertID, 0)
+			if err != nil {
+				fmt.Println(err)
+				continue
+			}
+			go notifyAttendanceAlert(alert)
+		}
+	}
+}
+
+// notifyAttendanceAlert sends the emails of a new attendance alert. Tests replace it to see them.
+var notifyAttendanceAlert = sendAttendanceAlertEmails
+
+// sendAttendanceAlertEmails emails a new attendance alert to the instructor of the section and to the student.
+func sendAttendanceAlertEmails(alert models.AttendanceAlert) {
+	course := fmt.Sprintf("%s %s, section %s", alert.CourseNumber, alert.CourseTitle, alert.SectionName)
+	reason := attendanceAlertReason(alert)
+	student := email.Recipient{FirstName: alert.FirstName, LastName: alert.LastName, Email: alert.Email}
+
+	instructorID, err := new(models.Moderator).GetInstructorID(alert.SectionID)
+	if err != nil {
+		fmt.Println(err)
+	} else if instructor, err := new(models.User).Get(int64(instructorID)); err != nil {
+		fmt.Println(err)
+	} else {
+		email.SendAttendanceAlertEmail(email.Recipient{FirstName: instructor.FirstName, LastName: instructor.LastName, Email: instructor.Email}, student, course, reason)
+	}
+
+	email.SendStudentAttendanceAlertEmail(student, course, reason)
+}
+
+// attendanceAlertReason says which threshold an alert is about, as in "reached 3 absences (alert at 3)".
+func attendanceAlertReason(alert models.AttendanceAlert) string {
+	if alert.Kind == models.AlertRate {
+		return fmt.Sprintf("fell to %d%% attendance (alert below %d%%)", alert.Observed, alert.Threshold)
+	}
+	return fmt.Sprintf("reached %d absences (alert at %d)", alert.Observed, alert.Threshold)
+}
+
+func APIGetUserGetHandler(c *gin.Context) {
+
+	// Get the users from the database
+	users, err := new(models.User).GetAll()
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	isDemo, err := new(models.Organization).GetStatus()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	// If the database is for softlaunch, then remove the admin user from the slice of users structs
+	// this is done so that the admin user cannot be seen in the users table in the softlaunch database
+	// preventing someone from changing the admin credentials
+	if isDemo == "true" {
+		filteredUsers := make([]struct {
+			ID                   int64
+			Email                string
+			LastName             string
+			FirstName            string
+			CreatedAt            string
+			UpdatedAt            string
+			HighestModeratorType string
+		}, 0)
+		for _, user := range users {
+			if user.Email != "admin@coeus.education" {
+				filteredUsers = append(filteredUsers, user)
+			}
+		}
+		users = filteredUsers
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"users": users,
+	})
+}
+
+func APIAddUserPostHandler(c *gin.Context) {
+
+	// Struct to hold the JSON data
+	type UserData struct {
+		Email         string `json:"email"`
+		FirstName     string `json:"firstName"`
+		LastName      string `json:"lastName"`
+		Password      string `json:"password"`
+		ModeratorType string `json:"moderatorType"`
+	}
+
+	// Parse the JSON data from the request body
+	var userData UserData
+	if err := c.ShouldBindJSON(&userData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Get the added user info from the struct
+	email := userData.Email
+	firstName := userData.FirstName
+	lastName := userData.LastName
+	password := userData.Password
+	moderatorType := userData.ModeratorType
+
+	// Add the user to the database
+	newUserID, err := new(models.User).Add(email, password, lastName, firstName)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Parse the newUserID from an int64 to an int
+	newUserIDInt := int(newUserID)
+
+	// Add the user mod status to the database with the section id as NULL
+	_, err = new(models.Moderator).AdminAdd(newUserIDInt, moderatorType)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Get the organization id
+	organizationID, err := new(models.Organization).GetOrganizationID()
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Add the user to the default organization
+	err = new(models.User).AddUserToOrganization(newUserIDInt, organizationID)
+	if err != nil {
+		log.Println("Failed to add user to organization:", err)
+	}
+
+	// Add a setting for the user
+	_, err = new(models.Setting).Add(newUserIDInt)
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+func APIUpdateUserPutHandler(c *gin.Context) {
+
+	// Struct to hold the JSON data
+	type UserData struct {
+		UserId        string `json:"userId"`
+		Email         string `json:"email"`
+		FirstName     string `json:"firstName"`
+		LastName      string `json:"lastName"`
+		Password      string `json:"password"`
+		ModeratorType string `json:"moderatorType"`
+	}
+
+	// Parse the JSON data from the request body
+	var userData UserData
+	if err := c.ShouldBindJSON(&userData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Get the edited user info from the struct
+	userID := userData.UserId
+	email := userData.Email
+	firstName := userData.FirstName
+	lastName := userData.LastName
+	password := userData.Password
+	moderatorType := userData.ModeratorType
+
+	// Parse the userID to an int
+	userIDInt, err := strconv.Atoi(userID)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// If the moderatorType is not empty, then update the user mod status (this is mainly for admins)
+	if moderatorType != "" {
+		// Update the user mod status to the database with the section id as NULL
+		_, err = new(models.Moderator).AdminUpdate(userIDInt, moderatorType)
+		if err != nil {
+			fmt.Println(err)
+		}
+	}
+
+	// Parse the userID to an int64
+	userIDInt64, err := strconv.ParseInt(userID, 10, 32)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Update the user in the database
+	err = new(models.User).Update(userIDInt64, email, lastName, firstName, password)
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+func APIDeleteUserDeleteHandler(c *gin.Context) {
+	// Get the user from the form
+	userID := c.Param("ID")
+
+	// Parse the userID to an int64
+	userIDInt64, err := strconv.ParseInt(userID, 10, 64)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Delete the user to the database
+	err = new(models.User).Delete(userIDInt64)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Parse the userID to an int
+	userIDInt, err := strconv.Atoi(userID)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Delete the setting for the user
+	err = new(models.Setting).Delete(userIDInt)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Disconnect the deleted user's websockets
+	revokeUserWebsockets(userIDInt)
+}
+
+func APIAddAdminPostHandler(c *gin.Context) {
+
+	type Admin struct {
+		FirstName string `json:"firstName"`
+		LastName  string `json:"lastName"`
+		Email     string `json:"email"`
+		Password  string `json:"password"`
+	}
+
+	admin := &Admin{}
+
+	// Bind the JSON to the struct
+	if err := c.ShouldBindJSON(admin); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Add the admin to the database
+	adminID, err := new(models.User).Add(admin.Email, admin.Password, admin.LastName, admin.FirstName)
+	if err != nil {
+		// Log the error and respond with an error status and message
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"success": false, "error": "Failed to add admin."})
+		return
+	}
+
+	// convert adminID to int
+	adminIDInt := int(adminID)
+
+	// Adds the admin to a flag table to indicate that they are an admin during sign-in
+	err = new(models.Organization).SetAdmin(adminIDInt)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Return a success response
+	c.JSON(http.StatusOK, gin.H{"success": true, "adminName": admin.FirstName})
+}
+
+func APIOnboardingPostHandler(c *gin.Context) {
+	session := sessions.Default(c)
+
+	type Organization struct {
+		ID                   int    `json:"id"`
+		Name                 string `json:"name"`
+		OrganizationTimezone string `json:"timezone"`
+		LogoPath             string `json:"logoPath"`
+		APIKey               string `json:"apiKey"`
+		Email                string `json:"email"`
+		CreatedAt            string `json:"created_at"`
+		UpdatedAt            string `json:"updated_at"`
+	}
+
+	// Create a new Organization
+	var org Organization
+
+	// Parse multipart form data
+	err := c.Request.ParseMultipartForm(32 << 20)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Get the values from the form
+	org.Name = c.PostForm("name")
+	org.OrganizationTimezone = c.PostForm("timezone")
+	org.APIKey = c.PostForm("apiKey")
+	org.Email = c.PostForm("email")
+
+	// If the API key exists, write it to the .env file
+	if org.APIKey != "" {
+
+		// Set usingSendGrid to true in the session
+		session.Set("usingSendGrid", true)
+
+		// Write the API key and email to the .env file
+		err = writeAPIKeyToEnv(org.APIKey, org.Email)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+	} else {
+		session.Set("usingSendGrid", false)
+	}
+
+	// Process the file
+	file, err := c.FormFile("logoPath")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Get the file extension
+	fileExtension := filepath.Ext(file.Filename)
+
+	// Create the file name
+	fileName := "logo" + fileExtension
+
+	// Remove existing logo files if they exist
+	existingFiles, err := filepath.Glob("./views/static/logo/logo.*")
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	for _, f := range existingFiles {
+		err := os.Remove(f)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	// Save the file to the server
+	err = c.SaveUploadedFile(file, "./views/static/logo/"+fileName)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	// The file path is set as the logoPath of the organization
+	org.LogoPath = "/static/logo/" + fileName
+
+	// Add the organization to the database
+	organizationID, err := new(models.Organization).Add(org.Name, org.OrganizationTimezone, org.LogoPath, org.APIKey, org.Email)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Return a success response
+	c.JSON(http.StatusOK, gin.H{"success": true, "organizationID": organizationID})
+}
+
+func APIOrganizationPostHandler(c *gin.Context) {
+	session := sessions.Default(c)
+
+	// Get the organization id
+	organizationID, err := new(models.Organization).GetOrganizationID()
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// If a logo was uploaded, process it
+	file, err := c.FormFile("upload-logo-input")
+	if err == nil {
+		// Get the file extension
+		fileExtension := filepath.Ext(file.Filename)
+
+		// Create the file name
+		fileName := "logo" + fileExtension
+
+		// Remove existing logo files if they exist
+		existingFiles, err := filepath.Glob("./views/static/logo/logo.*")
+		if err != nil {
+			fmt.Println(err)
+		}
+		for _, f := range existingFiles {
+			err := os.Remove(f)
+			if err != nil {
+				fmt.Println(err)
+			}
+		}
+
+		// Save the file to the server
+		err = c.SaveUploadedFile(file, "./views/static/logo/"+fileName)
+		if err != nil {
+			fmt.Println(err)
+		}
+
+		// Append the logo path of /static/logo/ to the file name
+		fileName = "/static/logo/" + fileName
+
+		// Update the logo in the database
+		err = new(models.Organization).StoreLogo(organizationID, fileName)
+		if err != nil {
+			fmt.Println(err)
+		}
+
+		// Get the organization from the database
+		organization, err := new(models.Organization).Get(organizationID)
+		if err != nil {
+			fmt.Println(err)
+		}
+
+		// set the organization logo to the session
+		session.Set("organizationLogo", organization.LogoPath)
+	}
+
+	// Handle time zone update
+	timeZone := c.PostForm("time-zone-select")
+	if timeZone != "" {
+		err := new(models.Organization).UpdateTimeZone(organizationID, timeZone)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update time zone"})
+			return
+		}
+	}
+
+	// Handle organization name update
+	orgName := c.PostForm("org-name")
+	if orgName != "" {
+		err := new(models.Organization).UpdateName(organizationID, orgName)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update organization name"})
+			return
+		}
+	}
+
+	if c.PostForm("org-settings-sendgrid-api-key") != "" {
+		apiKey := c.PostForm("org-settings-sendgrid-api-key")
+		email := c.PostForm("org-settings-sendgrid-email")
+
+		// Write the API key and email to the .env file
+		err = writeAPIKeyToEnv(apiKey, email)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		// Update the API key and email in the database
+		err = new(models.Organization).UpdateAPIKeyAndEmail(organizationID, apiKey, email)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+	}
+
+	session.Save()
+}
+
+// HELPER FUNCTION
+// writeAPIKeyToEnv writes the API key and organization email to the .env file
+func writeAPIKeyToEnv(apiKey string, email string) error {
+	// Read the existing .env file
+	data, err := ioutil.ReadFile("globals/.env")
+	if err != nil {
+		return err
+	}
+
+	// Convert to string
+	envData := string(data)
+
+	// Check if the SENDGRID_API_KEY already exists
+	if strings.Contains(envData, "SENDGRID_API_KEY") {
+		// If it does, replace the existing key with the new one
+		re := regexp.MustCompile(`(?m)SENDGRID_API_KEY='.*'`)
+		envData = re.ReplaceAllString(envData, "SENDGRID_API_KEY='"+apiKey+"'")
+	} else {
+		// If it doesn't, add the new API key
+		envData += "\nSENDGRID_API_KEY='" + apiKey + "'"
+	}
+
+	// Check if the SENDGRID_ORGANIZATION_EMAIL already exists
+	if strings.Contains(envData, "SENDGRID_ORGANIZATION_EMAIL") {
+		// If it does, replace the existing email with the new one
+		re := regexp.MustCompile(`(?m)SENDGRID_ORGANIZATION_EMAIL='.*'`)
+		envData = re.ReplaceAllString(envData, "SENDGRID_ORGANIZATION_EMAIL='"+email+"'")
+	} else {
+		// If it doesn't, add the new email
+		envData += "\nSENDGRID_ORGANIZATION_EMAIL='" + email + "'"
+	}
+
+	// Write the new data back to the .env file
+	err = ioutil.WriteFile("globals/.env", []byte(envData), 0644)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func APICoursesGetHandler(c *gin.Context) {
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+
+	// Get the course and section data
+	courseSections, err := new(models.Course).GetCourseSectionsByIntructor(userID)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"courseSections": courseSections,
+	})
+}
+
+type Course struct {
+	CourseNumber    string   `json:"courseNumber"`
+	CourseTitle     string   `json:"courseTitle"`
+	CourseSemester  string   `json:"courseSemester"`
+	CourseStartDate string   `json:"courseStartDate"`
+	CourseEndDate   string   `json:"courseEndDate"`
+	CourseYear      string   `json:"courseYear"`
+	CourseSections  string   `json:"courseSections"`
+	Schedules       []string `json:"schedules"`
+}
+
+func APICoursesPostHandler(c *gin.Context) {
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+
+	var course Course
+
+	if err := c.ShouldBindJSON(&course); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Refuse the course before anything is created when one of its schedules doesn't parse
+	for _, schedule := range course.Schedules {
+		if _, err := models.ParseScheduleString(schedule); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	// Parse the course sections to an int
+	courseSectionsInt, err := strconv.Atoi(course.CourseSections)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Add the course to the database
+	courseID, err := new(models.Course).AddCourseAndSections(course.CourseNumber, course.CourseTitle, course.CourseSemester, course.CourseStartDate, course.CourseEndDate, course.CourseYear, courseSectionsInt)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	sectionIDs, err := new(models.Course).GetSectionIds(courseID)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// For each section id, add the instructor to an enrollment table
+	// and create a schedule for the section
+	for i, sectionID := range sectionIDs {
+		err = new(models.Section).AddEnrollment(sectionID, userID)
+		if err != nil {
+			fmt.Println(err)
+		}
+
+		// Add the schedule to the database
+		scheduleID, err := new(models.Section).CreateSchedule(sectionID, course.Schedules[i])
+		if err != nil {
+			fmt.Println(err)
+		}
+
+		// Add class sessions to the database
+		err = new(models.Section).AddClassSession(sectionID, scheduleID)
+		if err != nil {
+			fmt.Println(err)
+		}
+
+		// Add a moderator status for that instructor given the section id
+		_, err = new(models.Moderator).Add(userID, sectionID, "instructor")
+		if err != nil {
+			fmt.Println(err)
+		}
+	}
+}
+
+func APICourseSectionDeleteHandler(c *gin.Context) {
+
+	// Get the course id and section number from the form
+	courseID := c.Param("courseID")
+	sectionNumber := c.Param("sectionNumber")
+
+	// Parse the course id and section number to an int
+	courseIDInt, err := strconv.Atoi(courseID)
+	sectionNumberInt, err := strconv.Atoi(sectionNumber)
+
+	// Delete the section from the database
+	err = new(models.Section).DeleteByCourseIdAndSection(courseIDInt, sectionNumberInt)
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+func APICourseSectionPutHandler(c *gin.Context) {
+	// Define a struct to hold the JSON data
+	type CourseData struct {
+		CourseID        int    `json:"courseID"`
+		SectionID       int    `json:"sectionID"`
+		ScheduleID      int    `json:"scheduleID"`
+		CourseNumber    string `json:"courseNumber"`
+		CourseTitle     string `json:"courseTitle"`
+		Semester        string `json:"semester"`
+		Year            string `json:"year"`
+		SectionName     string `json:"sectionName"`
+		CourseStartDate string `json:"courseStartDate"`
+		CourseEndDate   string `json:"courseEndDate"`
+		ScheduleDays    string `json:"scheduleDays"`
+		ScheduleTime    string `json:"scheduleTime"`
+	}
+
+	// Parse the JSON request body
+	var courseData CourseData
+	if err := c.ShouldBindJSON(&courseData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Refuse a schedule that doesn't parse
+	if _, err := models.ParseSchedule(courseData.ScheduleDays, courseData.ScheduleTime); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Update the course, the section and the schedule
+	err := new(models.Course).UpdateCourseAndSection(courseData.CourseID, courseData.SectionID, courseData.ScheduleID, courseData.CourseNumber, courseData.CourseTitle, courseData.Semester, courseData.Year, courseData.SectionName, courseData.CourseStartDate, courseData.CourseEndDate, courseData.ScheduleDays, courseData.ScheduleTime)
+	if err == sql.ErrNoRows {
+		c.JSON(http.StatusNotFound, gin.H{"error": "schedule not found"})
+		return
+	}
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+}
+
+type EmailRequest struct {
+	Email string `json:"email"`
+}
+
+func APIPasswordResetSendEmailPostHandler(c *gin.Context) {
+	var emailRequest EmailRequest
+
+	if err := c.ShouldBindJSON(&emailRequest); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	email := emailRequest.Email
+
+	userID, err := new(models.User).GetUserId(email)
+
+	if userID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": "Email does not exist",
+		})
+		return
+	}
+
+	// remove any existing tokens
+	err = new(models.VerifyUser).DeleteToken(userID)
+	if err != nil {
+		log.Println("Failed to delete token:", err)
+	}
+
+	_, err = new(models.VerifyUser).CreateToken(email)
+	if err != nil {
+		log.Println("Failed to send email:", err)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"userID": userID,
+	})
+}
+
+type PinRequest struct {
+	Pin string `json:"pin"`
+}
+
+func APIPasswordResetVerifyPinPostHandler(c *gin.Context) {
+	var pinRequest PinRequest
+
+	if err := c.ShouldBindJSON(&pinRequest); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	pin := pinRequest.Pin
+
+	_, userID, err := new(models.VerifyUser).MatchToken(pin)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": "Pin does not exist",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"userID": userID,
+	})
+}
+
+type PasswordRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+func APIPasswordResetPostHandler(c *gin.Context) {
+
+	var passwordRequest PasswordRequest
+
+	if err := c.ShouldBindJSON(&passwordRequest); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	email := passwordRequest.Email
+	password := passwordRequest.Password
+
+	// Get the user id from the email
+	userID, err := new(models.User).GetUserId(email)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Update the user's password
+	err = new(models.User).UpdatePassword(userID, password)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Delete the token
+	err = new(models.VerifyUser).DeleteToken(userID)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+	})
+
+}
+
+func APIAttendanceSectionsGetHandler(c *gin.Context) {
+	// Get the user id from the session
+	instructorID := sessions.Default(c).Get("userID").(int)
+
+	// Get the course id from the url
+	courseID := c.Param("courseID")
+
+	// Parse the course id to an int
+	courseIDInt, err := strconv.Atoi(courseID)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Get the attendance data for the instructor by their user id
+	sections, err := new(models.Attendance).GetSectionsByInstructorAndCourse(instructorID, courseIDInt)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"sections": sections,
+	})
+}
+
+func APIGetAttendanceIDBySectionIDHandler(c *gin.Context) {
+
+	// Get the section id from the url
+	sectionID := c.Param("sectionID")
+
+	// Parse the section id to an int
+	sectionIDInt, err := strconv.Atoi(sectionID)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Get the attendance data for the instructor by their user id
+	attendanceID, err := new(models.Attendance).GetAttendanceIDBySectionID(sectionIDInt)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"attendanceID": attendanceID,
+	})
+}
+
+func APIAttendanceStudentsGetHandler(c *gin.Context) {
+	attendance, ok := staffAttendance(c)
+	if !ok {
+		return
+	}
+
+	// Get the students of the section with their status, including those who have none yet
+	students, err := new(models.AttendanceRecord).GetRoster(attendance.ID)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Get the quiz scores of the students in the class session
+	timezone, _ := sessions.Default(c).Get("timezone").(string)
+	timezoneInt, _ := strconv.Atoi(timezone)
+	quizzes, err := new(models.QuizSubmission).GetByAttendanceID(attendance.ID, timezoneInt)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Get the excuse requests of the students, pending first
+	excuses, err := new(models.ExcuseRequest).GetByAttendanceID(attendance.ID, timezoneInt)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"students": students,
+		"quizzes":  quizzes,
+		"excuses":  excuses,
+		"statuses": models.AttendanceStatuses,
+	})
+}
+
+// staffAttendance gets the attendance given by the attendanceID url parameter when the signed in user
+// is an instructor or teacher assistant of its section, responding with an error otherwise.
+func staffAttendance(c *gin.Context) (models.Attendance, bool) {
+	attendanceID, err := strconv.Atoi(c.Param("attendanceID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return models.Attendance{}, false
+	}
+
+	attendance, err := new(models.Attendance).GetByID(attendanceID)
+	if err == sql.ErrNoRows {
+		c.JSON(http.StatusNotFound, gin.H{"error": "attendance not found"})
+		return models.Attendance{}, false
+	}
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get the attendance"})
+		return models.Attendance{}, false
+	}
+
+	if !isAttendanceStaff(c, attendance) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not an instructor of this section"})
+		return models.Attendance{}, false
+	}
+
+	return attendance, true
+}
+
+// isAttendanceStaff reports whether the signed in user took an attendance or is an instructor
+// or teacher assistant of its section.
+func isAttendanceStaff(c *gin.Context, attendance models.Attendance) bool {
+	userID, _ := sessions.Default(c).Get("userID").(int)
+	return attendance.InstructorID == userID || isSectionStaff(c, attendance.Section)
+}
+
+// maxAttendanceReasonLength is the longest reason a status change or excuse request can give.
+const maxAttendanceReasonLength = 500
+
+// APIAttendanceStatusPostHandler sets the status of a student for an attendance, recording who changed it and why.
+func APIAttendanceStatusPostHandler(c *gin.Context) {
+	type StatusData struct {
+		UserID int    `json:"userID"`
+		Status string `json:"status"`
+		Reason string `json:"reason"`
+	}
+
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+
+	attendance, ok := staffAttendance(c)
+	if !ok {
+		return
+	}
+
+	var statusData StatusData
+	if err := c.ShouldBindJSON(&statusData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	statusData.Reason = strings.TrimSpace(statusData.Reason)
+	if !models.ValidAttendanceStatus(statusData.Status) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("status must be one of %s", strings.Join(models.AttendanceStatuses, ", "))})
+		return
+	}
+	if len(statusData.Reason) > maxAttendanceReasonLength {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("reason must be at most %d characters", maxAttendanceReasonLength)})
+		return
+	}
+
+	// Only the students on the roster of the attendance have a status
+	students, err := new(models.AttendanceRecord).GetRoster(attendance.ID)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to change the attendance status"})
+		return
+	}
+	onRoster := false
+	for _, student := range students {
+		onRoster = onRoster || student.ID == statusData.UserID
+	}
+	if !onRoster {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user is not a student of this section"})
+		return
+	}
+
+	changed, err := new(models.Attendance).SetUserAttendance(attendance.ID, statusData.UserID, statusData.Status, userID, models.StatusSourceManual, statusData.Reason)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to change the attendance status"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":           "success",
+		"changed":          changed,
+		"attendanceStatus": statusData.Status,
+	})
+}
+
+// APIAttendanceHistoryGetHandler returns who changed the statuses of an attendance, when and why, newest first.
+func APIAttendanceHistoryGetHandler(c *gin.Context) {
+	attendance, ok := staffAttendance(c)
+	if !ok {
+		return
+	}
+
+	timezone, _ := sessions.Default(c).Get("timezone").(string)
+	timezoneInt, _ := strconv.Atoi(timezone)
+	changes, err := new(models.StatusChange).GetStatusChanges(attendance.ID, timezoneInt)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get the attendance history"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"changes": changes})
+}
+
+// APIExcuseRequestsGetHandler returns the excuse requests of an attendance: all of them to the staff
+// of its section, and their own to students.
+func APIExcuseRequestsGetHandler(c *gin.Context) {
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+	timezone, _ := session.Get("timezone").(string)
+	timezoneInt, _ := strconv.Atoi(timezone)
+
+	attendanceID, err := strconv.Atoi(c.Param("attendanceID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	attendance, err := new(models.Attendance).GetByID(attendanceID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "attendance not found"})
+		return
+	}
+
+	var excuses []models.ExcuseRequest
+	staff := isAttendanceStaff(c, attendance)
+	switch {
+	case staff:
+		excuses, err = new(models.ExcuseRequest).GetByAttendanceID(attendanceID, timezoneInt)
+	case canJoinSection(userID, attendance.Section):
+		excuses, err = new(models.ExcuseRequest).GetByUser(attendanceID, userID, timezoneInt)
+	default:
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a member of this section"})
+		return
+	}
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get the excuse requests"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"staff":   staff,
+		"excuses": excuses,
+	})
+}
+
+// APIExcuseRequestPostHandler asks for the signed in student to be excused from an attendance.
+func APIExcuseRequestPostHandler(c *gin.Context) {
+	type ExcuseData struct {
+		Reason string `json:"reason"`
+	}
+
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+
+	attendanceID, err := strconv.Atoi(c.Param("attendanceID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	attendance, err := new(models.Attendance).GetByID(attendanceID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "attendance not found"})
+		return
+	}
+	if !canJoinSection(userID, attendance.Section) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a member of this section"})
+		return
+	}
+
+	var excuseData ExcuseData
+	if err := c.ShouldBindJSON(&excuseData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	excuseData.Reason = strings.TrimSpace(excuseData.Reason)
+	if excuseData.Reason == "" || len(excuseData.Reason) > maxAttendanceReasonLength {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("reason must be between 1 and %d characters", maxAttendanceReasonLength)})
+		return
+	}
+
+	records, err := new(models.Attendance).GetByUser(userID, attendanceID)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to request an excuse"})
+		return
+	}
+	if len(records) > 0 && records[0].Status == models.AttendanceExcused {
+		c.JSON(http.StatusConflict, gin.H{"error": "You are already excused"})
+		return
+	}
+
+	excuseID, err := new(models.ExcuseRequest).Add(attendanceID, userID, excuseData.Reason)
+	if err == models.ErrExcusePending {
+		c.JSON(http.StatusConflict, gin.H{"error": "Your excuse request is waiting for an answer"})
+		return
+	}
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to request an excuse"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":   "success",
+		"excuseID": excuseID,
+	})
+}
+
+// APIExcuseRequestApprovePostHandler approves an excuse request, marking the student excused.
+func APIExcuseRequestApprovePostHandler(c *gin.Context) {
+	decideExcuseRequest(c, true)
+}
+
+// APIExcuseRequestDenyPostHandler denies an excuse request, leaving the status of the student as it is.
+func APIExcuseRequestDenyPostHandler(c *gin.Context) {
+	decideExcuseRequest(c, false)
+}
+
+// decideExcuseRequest approves or denies the excuse request given by the excuseID url parameter, with an optional
+// response, when the signed in user is an instructor or teacher assistant of its section.
+func decideExcuseRequest(c *gin.Context, approve bool) {
+	type DecisionData struct {
+		Response string `json:"response"`
+	}
+
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+
+	excuseID, err := strconv.Atoi(c.Param("excuseID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	excuse, err := new(models.ExcuseRequest).GetByID(excuseID, 0)
+	if err == sql.ErrNoRows {
+		c.JSON(http.StatusNotFound, gin.H{"error": "excuse request not found"})
+		return
+	}
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get the excuse request"})
+		return
+	}
+	attendance, err := new(models.Attendance).GetByID(excuse.AttendanceID)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get the excuse request"})
+		return
+	}
+	if !isAttendanceStaff(c, attendance) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not an instructor of this section"})
+		return
+	}
+
+	var decisionData DecisionData
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&decisionData); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	decisionData.Response = strings.TrimSpace(decisionData.Response)
+	if len(decisionData.Response) > maxAttendanceReasonLength {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("response must be at most %d characters", maxAttendanceReasonLength)})
+		return
+	}
+
+	err = new(models.ExcuseRequest).Decide(excuseID, userID, approve, decisionData.Response)
+	if err == models.ErrExcuseDecided {
+		c.JSON(http.StatusConflict, gin.H{"error": "This excuse request was already answered"})
+		return
+	}
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to answer the excuse request"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "success"})
+}
+
+// APIMarkPresentPostHandler marks the user present for an attendance with the code shown by its open check-in.
+func APIMarkPresentPostHandler(c *gin.Context) {
+	type MarkPresentData struct {
+		Code string `json:"code"`
+	}
+
+	session := sessions.Default(c)
+	studentID := session.Get("userID").(int)
+
+	// Parse the attendance id to an int
+	attendanceIDInt, err := strconv.Atoi(c.Param("attendanceID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var markPresentData MarkPresentData
+	if err := c.ShouldBindJSON(&markPresentData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	status, body := checkInAction(studentID, attendanceIDInt, markPresentData.Code)
+	c.JSON(status, body)
+}
+
+func APIClassSessionsGetHandler(c *gin.Context) {
+	session := sessions.Default(c)
+	timezone, _ := session.Get("timezone").(string)
+	timezoneInt, _ := strconv.Atoi(timezone)
+
+	sectionIDInt, err := strconv.Atoi(c.Param("sectionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Only the instructor and teacher assistants of the section can browse its history
+	if !isSectionStaff(c, sectionIDInt) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a moderator of this section"})
+		return
+	}
+
+	classSessions, err := new(models.ClassSession).GetBySectionID(sectionIDInt, timezoneInt)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"classSessions": classSessions,
+	})
+}
+
+func APIClassSessionGetHandler(c *gin.Context) {
+	session := sessions.Default(c)
+	timezone, _ := session.Get("timezone").(string)
+	timezoneInt, _ := strconv.Atoi(timezone)
+
+	sectionIDInt, err := strconv.Atoi(c.Param("sectionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	classSessionIDInt, err := strconv.Atoi(c.Param("classSessionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Only the instructor and teacher assistants of the section can browse its history
+	if !isSectionStaff(c, sectionIDInt) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a moderator of this section"})
+		return
+	}
+
+	classSession, err := new(models.ClassSession).Get(classSessionIDInt, timezoneInt)
+	if err != nil || classSession.SectionID != sectionIDInt {
+		c.JSON(http.StatusNotFound, gin.H{"error": "class session not found"})
+		return
+	}
+
+	questions, err := new(models.Question).GetAllQuestions(classSessionIDInt, "created_at", timezoneInt)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	answers, err := new(models.Answer).GetByClassSessionID(classSessionIDInt, timezoneInt)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	questions = new(models.Question).AnswersAppend(questions, answers)
+
+	polls, err := new(models.Poll).GetByClassSessionID(classSessionIDInt, 0, timezoneInt)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	participants, err := new(models.Participant).GetParticipantDetails(classSessionIDInt)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"classSession": classSession,
+		"questions":    questions,
+		"polls":        polls,
+		"participants": participants,
+	})
+}
+
+// scheduleRequest is the JSON body of the schedule create and update endpoints.
+type scheduleRequest struct {
+	Days      string `json:"days"`
+	StartTime string `json:"startTime"`
+	EndTime   string `json:"endTime"`
+	Room      string `json:"room"`
+}
+
+// schedule converts the request into a schedule for a section.
+func (r scheduleRequest) schedule(sectionID int) (models.Schedule, error) {
+	weekdays, err := models.ParseScheduleDays(r.Days)
+	if err != nil {
+		return models.Schedule{}, err
+	}
+
+	schedule := models.Schedule{
+		SectionID: sectionID,
+		Weekdays:  weekdays,
+		StartTime: strings.TrimSpace(r.StartTime),
+		EndTime:   strings.TrimSpace(r.EndTime),
+		Room:      strings.TrimSpace(r.Room),
+	}
+
+	return schedule, schedule.Validate()
+}
+
+func APISchedulesGetHandler(c *gin.Context) {
+	sectionIDInt, err := strconv.Atoi(c.Param("sectionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !isSectionMember(c, sectionIDInt) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a member of this section"})
+		return
+	}
+
+	schedules, err := new(models.Schedule).GetBySectionID(sectionIDInt)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	holidays, err := new(models.Holiday).GetBySectionID(sectionIDInt)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"schedules": schedules,
+		"holidays":  holidays,
+	})
+}
+
+func APISchedulePostHandler(c *gin.Context) {
+	sectionIDInt, err := strconv.Atoi(c.Param("sectionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !isSectionStaff(c, sectionIDInt) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a moderator of this section"})
+		return
+	}
+
+	var request scheduleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	schedule, err := request.schedule(sectionIDInt)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	scheduleID, err := new(models.Schedule).Add(schedule)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"scheduleID": scheduleID,
+	})
+}
+
+func APISchedulePutHandler(c *gin.Context) {
+	sectionIDInt, err := strconv.Atoi(c.Param("sectionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	scheduleIDInt, err := strconv.Atoi(c.Param("scheduleID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !isSectionStaff(c, sectionIDInt) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a moderator of this section"})
+		return
+	}
+
+	existing, err := new(models.Schedule).Get(scheduleIDInt)
+	if err != nil || existing.SectionID != sectionIDInt {
+		c.JSON(http.StatusNotFound, gin.H{"error": "schedule not found"})
+		return
+	}
+
+	var request scheduleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	schedule, err := request.schedule(sectionIDInt)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	schedule.ID = scheduleIDInt
+
+	err = new(models.Schedule).Update(schedule)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Schedule updated"})
+}
+
+func APIScheduleDeleteHandler(c *gin.Context) {
+	sectionIDInt, err := strconv.Atoi(c.Param("sectionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	scheduleIDInt, err := strconv.Atoi(c.Param("scheduleID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !isSectionStaff(c, sectionIDInt) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a moderator of this section"})
+		return
+	}
+
+	existing, err := new(models.Schedule).Get(scheduleIDInt)
+	if err != nil || existing.SectionID != sectionIDInt {
+		c.JSON(http.StatusNotFound, gin.H{"error": "schedule not found"})
+		return
+	}
+
+	err = new(models.Schedule).Delete(scheduleIDInt)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Schedule deleted"})
+}
+
+func APIHolidayPostHandler(c *gin.Context) {
+	type HolidayData struct {
+		Date        string `json:"date"`
+		Description string `json:"description"`
+	}
+
+	sectionIDInt, err := strconv.Atoi(c.Param("sectionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !isSectionStaff(c, sectionIDInt) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a moderator of this section"})
+		return
+	}
+
+	var holidayData HolidayData
+	if err := c.ShouldBindJSON(&holidayData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if _, err := time.Parse(models.DateLayout, holidayData.Date); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "date must be in the form YYYY-MM-DD"})
+		return
+	}
+
+	holidayID, err := new(models.Holiday).Add(sectionIDInt, holidayData.Date, strings.TrimSpace(holidayData.Description))
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"holidayID": holidayID,
+	})
+}
+
+func APIHolidayDeleteHandler(c *gin.Context) {
+	sectionIDInt, err := strconv.Atoi(c.Param("sectionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	holidayIDInt, err := strconv.Atoi(c.Param("holidayID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !isSectionStaff(c, sectionIDInt) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a moderator of this section"})
+		return
+	}
+
+	err = new(models.Holiday).Delete(sectionIDInt, holidayIDInt)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Holiday deleted"})
+}
+
+// APIScheduleOccurrencesGetHandler lists the meetings of a section between the from and to dates.
+// Without dates it returns the next four weeks.
+func APIScheduleOccurrencesGetHandler(c *gin.Context) {
+	sectionIDInt, err := strconv.Atoi(c.Param("sectionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !isSectionMember(c, sectionIDInt) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a member of this section"})
+		return
+	}
+
+	loc := models.OrganizationLocation()
+	now := time.Now().In(loc)
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	to := from.AddDate(0, 0, 28)
+
+	if value := c.Query("from"); value != "" {
+		from, err = time.ParseInLocation(models.DateLayout, value, loc)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "from must be in the form YYYY-MM-DD"})
+			return
+		}
+	}
+	if value := c.Query("to"); value != "" {
+		to, err = time.ParseInLocation(models.DateLayout, value, loc)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "to must be in the form YYYY-MM-DD"})
+			return
+		}
+		// Include meetings on the last day
+		to = to.AddDate(0, 0, 1)
+	}
+
+	occurrences, err := new(models.Schedule).GetOccurrences(sectionIDInt, from, to)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"occurrences": occurrences,
+	})
+}
+
+// calendarFeedURLs returns the per user feed URL of a calendar token and the per section feed URLs
+// of the user's sections, keyed by section id.
+func calendarFeedURLs(c *gin.Context, token string, sectionIDs []string) (string, map[string]string) {
+	feedURL := requestBaseURL(c) + "/calendar/" + token
+	sectionURLs := make(map[string]string)
+	for _, sectionID := range sectionIDs {
+		sectionURLs[sectionID] = feedURL + "/" + sectionID
+	}
+	return feedURL, sectionURLs
+}
+
+// calendarEvents builds the calendar events of every section a user is enrolled in or instructs.
+// When sectionID is not 0 only that section is included.
+func calendarEvents(c *gin.Context, userID int, sectionID int) ([]models.CalendarEvent, error) {
+	courses, err := new(models.Course).GetByUserId(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []models.CalendarEvent
+	seen := make(map[string]bool)
+	for _, course := range courses {
+		if seen[course["sectionID"]] {
+			continue
+		}
+		seen[course["sectionID"]] = true
+
+		courseSectionID, err := strconv.Atoi(course["sectionID"])
+		if err != nil || (sectionID != 0 && courseSectionID != sectionID) {
+			continue
+		}
+
+		schedules, err := new(models.Schedule).GetBySectionID(courseSectionID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Link each meeting to the section's class session; the join page follows the latest one
+		url := requestBaseURL(c) + "/"
+		if course["classSessionID"] != "0" {
+			url = requestBaseURL(c) + "/class-session/" + course["sectionID"] + "/" + course["classSessionID"]
+		}
+
+		for _, schedule := range schedules {
+			events = append(events, models.CalendarEvent{
+				Summary:  course["number"] + " " + course["title"] + " - Section " + course["name"],
+				URL:      url,
+				Schedule: schedule,
+			})
+		}
+	}
+
+	return events, nil
+}
+
+// APICalendarGetHandler returns the calendar feed URLs of the signed in user.
+func APICalendarGetHandler(c *gin.Context) {
+	session := sessions.Default(c)
+	userID, ok := session.Get("userID").(int)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "not signed in"})
+		return
+	}
+
+	token, err := new(models.CalendarToken).GetByUserID(userID)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	sectionIDs, err := calendarSectionIDs(userID)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	feedURL, sectionURLs := calendarFeedURLs(c, token, sectionIDs)
+	c.JSON(http.StatusOK, gin.H{
+		"feedURL":     feedURL,
+		"sectionURLs": sectionURLs,
+	})
+}
+
+// APICalendarResetPostHandler replaces the calendar token of the signed in user so the old feed URLs stop working.
+func APICalendarResetPostHandler(c *gin.Context) {
+	session := sessions.Default(c)
+	userID, ok := session.Get("userID").(int)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "not signed in"})
+		return
+	}
+
+	token, err := new(models.CalendarToken).Reset(userID)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	feedURL, _ := calendarFeedURLs(c, token, nil)
+	c.JSON(http.StatusOK, gin.H{
+		"feedURL": feedURL,
+	})
+}
+
+// calendarSectionIDs returns the ids of the sections a user is enrolled in or instructs.
+func calendarSectionIDs(userID int) ([]string, error) {
+	courses, err := new(models.Course).GetByUserId(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var sectionIDs []string
+	seen := make(map[string]bool)
+	for _, course := range courses {
+		if !seen[course["sectionID"]] {
+			seen[course["sectionID"]] = true
+			sectionIDs = append(sectionIDs, course["sectionID"])
+		}
+	}
+	return sectionIDs, nil
+}
+
+// CalendarFeedGetHandler serves the iCalendar feed of a calendar token's user. Calendar apps
+// can't sign in, so the secret token in the URL stands in for the session. With a sectionID
+// parameter the feed only holds that section's meetings.
+func CalendarFeedGetHandler(c *gin.Context) {
+	userID, err := new(models.CalendarToken).GetUserID(c.Param("token"))
+	if err != nil {
+		c.String(http.StatusNotFound, "calendar not found")
+		return
+	}
+
+	sectionIDInt := 0
+	if sectionID := c.Param("sectionID"); sectionID != "" {
+		sectionIDInt, err = strconv.Atoi(sectionID)
+		if err != nil {
+			c.String(http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	events, err := calendarEvents(c, userID, sectionIDInt)
+	if err != nil {
+		fmt.Println(err)
+		c.String(http.StatusInternalServerError, err.Error())
+		return
+	}
+	if sectionIDInt != 0 && len(events) == 0 {
+		// Not one of the user's sections, or a section without a schedule
+		sectionIDs, err := calendarSectionIDs(userID)
+		if err != nil || !containsString(sectionIDs, c.Param("sectionID")) {
+			c.String(http.StatusNotFound, "calendar not found")
+			return
+		}
+	}
+
+	name := "Coeus classes"
+	if sectionIDInt != 0 && len(events) > 0 {
+		name = events[0].Summary
+	}
+
+	c.Header("Content-Disposition", `inline; filename="coeus.ics"`)
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", []byte(models.Calendar(name, events, models.OrganizationLocation(), time.Now())))
+}
+
+// maxQuestionLength is the longest question, in characters, that fits the question table.
+const maxQuestionLength = 140
+
+// pendingQuestion loads the question of a moderation request and checks that the signed in
+// user moderates its section. On failure it writes the error response and returns false.
+func pendingQuestion(c *gin.Context) (models.Question, bool) {
+	questionIDInt, err := strconv.Atoi(c.Param("questionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return models.Question{}, false
+	}
+
+	// The frontend is going to handle the timezone formatting so we can just set it to 0 or UTC
+	question, err := new(models.Question).GetByID(questionIDInt, 0)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "question not found"})
+		return models.Question{}, false
+	}
+
+	classSession, err := new(models.ClassSession).Get(question.SessionID, 0)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return models.Question{}, false
+	}
+
+	if !isSectionModerator(c, classSession.SectionID) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a moderator of this section"})
+		return models.Question{}, false
+	}
+
+	return question, true
+}
+
+// moderationError writes the response for an error from a moderation action.
+func moderationError(c *gin.Context, err error) {
+	if err == models.ErrQuestionNotPending {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	fmt.Println(err)
+	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
+
+// validQuestionText trims a question and reports whether its length is allowed.
+func validQuestionText(text string) (string, bool) {
+	text = strings.TrimSpace(text)
+	return text, text != "" && len([]rune(text)) <= maxQuestionLength
+}
+
+// APIModerationQueueGetHandler returns the questions of a class session waiting for a moderator.
+func APIModerationQueueGetHandler(c *gin.Context) {
+	session := sessions.Default(c)
+	timezone, _ := session.Get("timezone").(string)
+	timezoneInt, _ := strconv.Atoi(timezone)
+
+	classSessionIDInt, err := strconv.Atoi(c.Param("classSessionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	classSession, err := new(models.ClassSession).Get(classSessionIDInt, 0)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "class session not found"})
+		return
+	}
+
+	if !isSectionModerator(c, classSession.SectionID) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a moderator of this section"})
+		return
+	}
+
+	premoderation, err := new(models.Section).GetPremoderation(classSession.SectionID)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	questions, err := new(models.Question).GetPendingQuestions(classSessionIDInt, timezoneInt)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"premoderation": premoderation,
+		"questions":     questions,
+	})
+}
+
+// presentParticipant is a user who joined a class session, and whether they are connected to it right now.
+type presentParticipant struct {
+	models.ParticipantDetail
+	Present bool
+}
+
+// APIPresenceGetHandler lists who is connected to a class session right now and who has joined it
+// at some point, for the moderators of its section.
+func APIPresenceGetHandler(c *gin.Context) {
+	classSessionIDInt, err := strconv.Atoi(c.Param("classSessionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	classSession, err := new(models.ClassSession).Get(classSessionIDInt, 0)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "class session not found"})
+		return
+	}
+
+	if !isSectionModerator(c, classSession.SectionID) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a moderator of this section"})
+		return
+	}
+
+	joined, err := new(models.Participant).GetParticipantDetails(classSessionIDInt)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	presentUsers := make(map[int]bool)
+	for _, userID := range relay.PresentUsers(classSessionIDInt) {
+		presentUsers[userID] = true
+	}
+
+	present := make([]presentParticipant, 0)
+	participants := make([]presentParticipant, 0, len(joined))
+	for _, participant := range joined {
+		p := presentParticipant{ParticipantDetail: participant, Present: presentUsers[participant.UserID]}
+		participants = append(participants, p)
+		if p.Present {
+			present = append(present, p)
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"presentCount": len(presentUsers),
+		"joinedCount":  len(participants),
+		"present":      present,
+		"joined":       participants,
+	})
+}
+
+// APIModerationEditPutHandler changes the text of a pending question before it is approved.
+func APIModerationEditPutHandler(c *gin.Context) {
+	type EditData struct {
+		Text string `json:"text"`
+	}
+
+	question, ok := pendingQuestion(c)
+	if !ok {
+		return
+	}
+
+	var editData EditData
+	if err := c.ShouldBindJSON(&editData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	text, valid := validQuestionText(editData.Text)
+	if !valid {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("question must be between 1 and %d characters", maxQuestionLength)})
+		return
+	}
+
+	err := new(models.Question).EditPending(question.ID, text)
+	if err != nil {
+		moderationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Question updated"})
+}
+
+// APIModerationApprovePostHandler publishes a pending question to its class session.
+// An optional text in the body replaces the question's text first.
+func APIModerationApprovePostHandler(c *gin.Context) {
+	type ApproveData struct {
+		Text string `json:"text"`
+	}
+
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+
+	question, ok := pendingQuestion(c)
+	if !ok {
+		return
+	}
+
+	var approveData ApproveData
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&approveData); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	if approveData.Text != "" {
+		text, valid := validQuestionText(approveData.Text)
+		if !valid {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("question must be between 1 and %d characters", maxQuestionLength)})
+			return
+		}
+		if err := new(models.Question).EditPending(question.ID, text); err != nil {
+			moderationError(c, err)
+			return
+		}
+	}
+
+	err := new(models.Question).Approve(question.ID, userID)
+	if err != nil {
+		moderationError(c, err)
+		return
+	}
+
+	question, err = new(models.Question).GetByID(question.ID, 0)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Question approved"})
+
+	broadcastQuestionModerated(question)
+	broadcastNewQuestion(question.SessionID, question)
+}
+
+// APIModerationRejectPostHandler removes a pending question from the queue, recording why.
+func APIModerationRejectPostHandler(c *gin.Context) {
+	type RejectData struct {
+		Reason string `json:"reason"`
+	}
+
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+
+	question, ok := pendingQuestion(c)
+	if !ok {
+		return
+	}
+
+	var rejectData RejectData
+	if err := c.ShouldBindJSON(&rejectData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	reason := strings.TrimSpace(rejectData.Reason)
+	if reason == "" || len([]rune(reason)) > maxAnswerLength {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("reason must be between 1 and %d characters", maxAnswerLength)})
+		return
+	}
+
+	err := new(models.Question).Reject(question.ID, userID, reason)
+	if err != nil {
+		moderationError(c, err)
+		return
+	}
+
+	question.Status = models.QuestionRejected
+	question.RejectionReason = reason
+
+	c.JSON(http.StatusOK, gin.H{"message": "Question rejected"})
+
+	broadcastQuestionModerated(question)
+}
+
+// APIPremoderationPutHandler turns pre-moderation of new questions on or off for a section.
+func APIPremoderationPutHandler(c *gin.Context) {
+	type PremoderationData struct {
+		Enabled bool `json:"enabled"`
+	}
+
+	sectionIDInt, err := strconv.Atoi(c.Param("sectionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !isSectionStaff(c, sectionIDInt) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a moderator of this section"})
+		return
+	}
+
+	var premoderationData PremoderationData
+	if err := c.ShouldBindJSON(&premoderationData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	err = new(models.Section).SetPremoderation(sectionIDInt, premoderationData.Enabled)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"premoderation": premoderationData.Enabled,
+	})
+}
+
+// APISimilarQuestionsGetHandler returns the open questions that look like the given one,
+// so moderators can find the duplicates to merge.
+func APISimilarQuestionsGetHandler(c *gin.Context) {
+	questionIDInt, err := strconv.Atoi(c.Param("questionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	question, err := new(models.Question).GetByID(questionIDInt, 0)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "question not found"})
+		return
+	}
+
+	classSession, err := new(models.ClassSession).Get(question.SessionID, 0)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !isSectionModerator(c, classSession.SectionID) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a moderator of this section"})
+		return
+	}
+
+	similar, err := new(models.Question).FindSimilar(question.SessionID, question.Text, question.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"similar": similar,
+	})
+}
+
+// APIMergeQuestionPostHandler merges a question into a duplicate and broadcasts the result.
+func APIMergeQuestionPostHandler(c *gin.Context) {
+	type MergeData struct {
+		TargetID int    `json:"targetID"`
+		Text     string `json:"text"`
+	}
+
+	questionIDInt, err := strconv.Atoi(c.Param("questionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var mergeData MergeData
+	if err := c.ShouldBindJSON(&mergeData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	question, err := new(models.Question).GetByID(questionIDInt, 0)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "question not found"})
+		return
+	}
+
+	classSession, err := new(models.ClassSession).Get(question.SessionID, 0)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !isSectionModerator(c, classSession.SectionID) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a moderator of this section"})
+		return
+	}
+
+	text := ""
+	if mergeData.Text != "" {
+		var valid bool
+		text, valid = validQuestionText(mergeData.Text)
+		if !valid {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("question must be between 1 and %d characters", maxQuestionLength)})
+			return
+		}
+	}
+
+	target, err := new(models.Question).Merge(questionIDInt, mergeData.TargetID, text)
+	if err == models.ErrInvalidMerge {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"question": target,
+	})
+
+	broadcastMergeQuestion(questionIDInt, target)
+}
+
+// Limits on the polls moderators can create
+const (
+	maxPollQuestionLength = 280
+	maxPollOptionLength   = 100
+	minPollOptions        = 2
+	maxPollOptions        = 6
+)
+
+// APIPollsGetHandler returns the polls of a class session with their results and the option the user chose.
+// Students of the section only see the polls that were opened.
+func APIPollsGetHandler(c *gin.Context) {
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+	timezone, _ := session.Get("timezone").(string)
+	timezoneInt, _ := strconv.Atoi(timezone)
+
+	classSessionIDInt, err := strconv.Atoi(c.Param("classSessionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	sectionID, err := new(models.ClassSession).GetSectionID(classSessionIDInt)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "class session not found"})
+		return
+	}
+	if !canJoinSection(userID, sectionID) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a member of this section"})
+		return
+	}
+
+	polls, err := new(models.Poll).GetByClassSessionID(classSessionIDInt, userID, timezoneInt)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	moderator := canModerateSection(userID, sectionID)
+	if !moderator {
+		opened := make([]models.Poll, 0, len(polls))
+		for _, poll := range polls {
+			if poll.Status != models.PollDraft {
+				opened = append(opened, poll)
+			}
+		}
+		polls = opened
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"polls":     polls,
+		"moderator": moderator,
+	})
+}
+
+// APIPollsPostHandler creates a poll in a class session for a moderator of its section.
+// The poll is a draft until it is opened, right away when open is set.
+func APIPollsPostHandler(c *gin.Context) {
+	type PollData struct {
+		Question string   `json:"question"`
+		Kind     string   `json:"kind"`
+		Options  []string `json:"options"`
+		Open     bool     `json:"open"`
+	}
+
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+
+	classSessionIDInt, err := strconv.Atoi(c.Param("classSessionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	sectionID, err := new(models.ClassSession).GetSectionID(classSessionIDInt)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "class session not found"})
+		return
+	}
+	if !isSectionModerator(c, sectionID) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a moderator of this section"})
+		return
+	}
+
+	var pollData PollData
+	if err := c.ShouldBindJSON(&pollData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	question := strings.TrimSpace(pollData.Question)
+	if question == "" || len([]rune(question)) > maxPollQuestionLength {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("question must be between 1 and %d characters", maxPollQuestionLength)})
+		return
+	}
+
+	var options []string
+	switch pollData.Kind {
+	case models.PollYesNo:
+	case models.PollChoice:
+		for _, option := range pollData.Options {
+			option = strings.TrimSpace(option)
+			if option == "" {
+				continue
+			}
+			if len([]rune(option)) > maxPollOptionLength {
+				c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("options must be at most %d characters", maxPollOptionLength)})
+				return
+			}
+			options = append(options, option)
+		}
+		if len(options) < minPollOptions || len(options) > maxPollOptions {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("a poll needs %d to %d options", minPollOptions, maxPollOptions)})
+			return
+		}
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "kind must be choice or yes-no"})
+		return
+	}
+
+	pollID, err := new(models.Poll).CreatePoll(classSessionIDInt, userID, question, pollData.Kind, options)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if pollData.Open {
+		openPoll(c, pollID)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"pollID": pollID,
+		"status": models.PollDraft,
+	})
+}
+
+// moderatedPoll returns the poll of the request for a moderator of its section.
+// It replies with an error and returns false when the poll can't be found or the user isn't a moderator.
+func moderatedPoll(c *gin.Context) (models.Poll, bool) {
+	pollIDInt, err := strconv.Atoi(c.Param("pollID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return models.Poll{}, false
+	}
+
+	poll, sectionID, status, body := classPoll(0, pollIDInt)
+	if body != nil {
+		c.JSON(status, body)
+		return models.Poll{}, false
+	}
+	if !isSectionModerator(c, sectionID) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a moderator of this section"})
+		return models.Poll{}, false
+	}
+
+	return poll, true
+}
+
+// APIPollOpenPostHandler opens a draft poll to the class session, which must be in progress.
+func APIPollOpenPostHandler(c *gin.Context) {
+	poll, ok := moderatedPoll(c)
+	if !ok {
+		return
+	}
+
+	openPoll(c, poll.ID)
+}
+
+// openPoll opens a draft poll of a class session in progress and shows it to the class.
+func openPoll(c *gin.Context, pollID int) {
+	poll, err := new(models.Poll).GetByID(pollID, 0, 0)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	inProgress, err := new(models.ClassSession).GetInProgress(poll.SessionID)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if !inProgress {
+		c.JSON(http.StatusConflict, gin.H{"pollID": pollID, "error": "the class session is not in progress"})
+		return
+	}
+
+	err = new(models.Poll).Open(pollID)
+	if err == models.ErrPollStatus {
+		c.JSON(http.StatusConflict, gin.H{"pollID": pollID, "error": "only draft polls can be opened"})
+		return
+	}
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"pollID": pollID,
+		"status": models.PollOpen,
+	})
+
+	broadcastPollOpened(poll)
+}
+
+// APIPollClosePostHandler stops an open poll from taking answers and sends its final results to the class session.
+func APIPollClosePostHandler(c *gin.Context) {
+	poll, ok := moderatedPoll(c)
+	if !ok {
+		return
+	}
+
+	err := new(models.Poll).Close(poll.ID)
+	if err == models.ErrPollStatus {
+		c.JSON(http.StatusConflict, gin.H{"error": "only open polls can be closed"})
+		return
+	}
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"pollID": poll.ID,
+		"status": models.PollClosed,
+	})
+
+	// The results may have changed since the poll was read
+	closedPoll, err := new(models.Poll).GetByID(poll.ID, 0, 0)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	broadcastPollClosed(closedPoll)
+}
+
+// APIPollRespondPostHandler answers an open poll with one of its options, once.
+func APIPollRespondPostHandler(c *gin.Context) {
+	type ResponseData struct {
+		OptionID int `json:"optionID"`
+	}
+
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+
+	pollIDInt, err := strconv.Atoi(c.Param("pollID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid poll ID"})
+		return
+	}
+
+	var responseData ResponseData
+	if err := c.ShouldBindJSON(&responseData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	status, body := respondPollAction(userID, 0, pollIDInt, responseData.OptionID)
+	c.JSON(status, body)
+}
+
+// closeClassSessionPolls closes the polls left open in a class session that ended and sends their final results.
+func closeClassSessionPolls(classSessionID int) {
+	pollIDs, err := new(models.Poll).CloseAll(classSessionID)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	for _, pollID := range pollIDs {
+		poll, err := new(models.Poll).GetByID(pollID, 0, 0)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		broadcastPollClosed(poll)
+	}
+}
+
+// Limits on the quizzes moderators can create
+const (
+	maxQuizTitleLength    = 140
+	maxQuizQuestions      = 20
+	maxQuizQuestionLength = 280
+	maxQuizOptionLength   = 100
+	minQuizOptions        = 2
+	maxQuizOptions        = 6
+	maxQuizPoints         = 100
+	maxQuizTimeLimit      = 60 * 60
+)
+
+// withoutQuizAnswers returns a copy of a quiz that doesn't say which options are correct.
+func withoutQuizAnswers(quiz models.Quiz) models.Quiz {
+	questions := make([]models.QuizQuestion, len(quiz.Questions))
+	for i, question := range quiz.Questions {
+		options := make([]models.QuizOption, len(question.Options))
+		for j, option := range question.Options {
+			option.Correct = false
+			options[j] = option
+		}
+		question.Options = options
+		questions[i] = question
+	}
+	quiz.Questions = questions
+	return quiz
+}
+
+// APIQuizzesGetHandler returns the quizzes of a class session, with the submissions of the user.
+// Students of the section only see the quizzes that were opened, and which answers are correct once they are closed.
+func APIQuizzesGetHandler(c *gin.Context) {
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+	timezone, _ := session.Get("timezone").(string)
+	timezoneInt, _ := strconv.Atoi(timezone)
+
+	classSessionIDInt, err := strconv.Atoi(c.Param("classSessionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	sectionID, err := new(models.ClassSession).GetSectionID(classSessionIDInt)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "class session not found"})
+		return
+	}
+	if !canJoinSection(userID, sectionID) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a member of this section"})
+		return
+	}
+
+	quizzes, err := new(models.Quiz).GetByClassSessionID(classSessionIDInt, timezoneInt)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	moderator := canModerateSection(userID, sectionID)
+	shown := make([]models.Quiz, 0, len(quizzes))
+	submissions := make(map[int]models.QuizSubmission)
+	for _, quiz := range quizzes {
+		if !moderator {
+			if quiz.Status == models.QuizDraft {
+				continue
+			}
+			if quiz.Status == models.QuizOpen {
+				quiz = withoutQuizAnswers(quiz)
+			}
+		}
+		shown = append(shown, quiz)
+
+		submission, err := new(models.QuizSubmission).GetByUser(quiz.ID, userID, timezoneInt)
+		if err == nil {
+			submissions[quiz.ID] = submission
+		} else if err != sql.ErrNoRows {
+			fmt.Println(err)
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"quizzes":     shown,
+		"submissions": submissions,
+		"moderator":   moderator,
+	})
+}
+
+// APIQuizzesPostHandler creates a quiz in a class session for a moderator of its section.
+// Each question has the options given and is worth its points when answered with the option at correct.
+// The quiz is a draft until it is opened, right away when open is set.
+func APIQuizzesPostHandler(c *gin.Context) {
+	type QuestionData struct {
+		Text    string   `json:"text"`
+		Points  int      `json:"points"`
+		Options []string `json:"options"`
+		Correct int      `json:"correct"`
+	}
+	type QuizData struct {
+		Title     string         `json:"title"`
+		TimeLimit int            `json:"timeLimit"`
+		Questions []QuestionData `json:"questions"`
+		Open      bool           `json:"open"`
+	}
+
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+
+	classSessionIDInt, err := strconv.Atoi(c.Param("classSessionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	sectionID, err := new(models.ClassSession).GetSectionID(classSessionIDInt)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "class session not found"})
+		return
+	}
+	if !isSectionModerator(c, sectionID) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a moderator of this section"})
+		return
+	}
+
+	var quizData QuizData
+	if err := c.ShouldBindJSON(&quizData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	title := strings.TrimSpace(quizData.Title)
+	if title == "" || len([]rune(title)) > maxQuizTitleLength {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("title must be between 1 and %d characters", maxQuizTitleLength)})
+		return
+	}
+	if quizData.TimeLimit < 0 || quizData.TimeLimit > maxQuizTimeLimit {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("time limit must be between 0 and %d seconds", maxQuizTimeLimit)})
+		return
+	}
+	if len(quizData.Questions) == 0 || len(quizData.Questions) > maxQuizQuestions {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("a quiz needs 1 to %d questions", maxQuizQuestions)})
+		return
+	}
+
+	questions := make([]models.QuizQuestion, 0, len(quizData.Questions))
+	for i, questionData := range quizData.Questions {
+		text := strings.TrimSpace(questionData.Text)
+		if text == "" || len([]rune(text)) > maxQuizQuestionLength {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("question %d must be between 1 and %d characters", i+1, maxQuizQuestionLength)})
+			return
+		}
+		if questionData.Points < 1 || questionData.Points > maxQuizPoints {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("question %d must be worth 1 to %d points", i+1, maxQuizPoints)})
+			return
+		}
+		if len(questionData.Options) < minQuizOptions || len(questionData.Options) > maxQuizOptions {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("question %d needs %d to %d options", i+1, minQuizOptions, maxQuizOptions)})
+			return
+		}
+		if questionData.Correct < 0 || questionData.Correct >= len(questionData.Options) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("question %d needs one of its options as the correct answer", i+1)})
+			return
+		}
+
+		question := models.QuizQuestion{Text: text, Points: questionData.Points}
+		for j, optionText := range questionData.Options {
+			optionText = strings.TrimSpace(optionText)
+			if optionText == "" || len([]rune(optionText)) > maxQuizOptionLength {
+				c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("the options of question %d must be between 1 and %d characters", i+1, maxQuizOptionLength)})
+				return
+			}
+			question.Options = append(question.Options, models.QuizOption{Text: optionText, Correct: j == questionData.Correct})
+		}
+		questions = append(questions, question)
+	}
+
+	quizID, err := new(models.Quiz).CreateQuiz(classSessionIDInt, userID, title, quizData.TimeLimit, questions)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if quizData.Open {
+		openQuiz(c, quizID)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"quizID": quizID,
+		"status": models.QuizDraft,
+	})
+}
+
+// moderatedQuiz returns the quiz of the request for a moderator of its section.
+// It replies with an error and returns false when the quiz can't be found or the user isn't a moderator.
+func moderatedQuiz(c *gin.Context) (models.Quiz, bool) {
+	quizIDInt, err := strconv.Atoi(c.Param("quizID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return models.Quiz{}, false
+	}
+
+	quiz, sectionID, status, body := classQuiz(0, quizIDInt)
+	if body != nil {
+		c.JSON(status, body)
+		return models.Quiz{}, false
+	}
+	if !isSectionModerator(c, sectionID) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a moderator of this section"})
+		return models.Quiz{}, false
+	}
+
+	return quiz, true
+}
+
+// APIQuizOpenPostHandler opens a draft quiz to the class session, which must be in progress.
+func APIQuizOpenPostHandler(c *gin.Context) {
+	quiz, ok := moderatedQuiz(c)
+	if !ok {
+		return
+	}
+
+	openQuiz(c, quiz.ID)
+}
+
+// openQuiz opens a draft quiz of a class session in progress, shows it to the class and,
+// when it has a time limit, closes it once the time is up.
+func openQuiz(c *gin.Context, quizID int) {
+	quiz, err := new(models.Quiz).GetByID(quizID, 0)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	inProgress, err := new(models.ClassSession).GetInProgress(quiz.SessionID)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if !inProgress {
+		c.JSON(http.StatusConflict, gin.H{"quizID": quizID, "error": "the class session is not in progress"})
+		return
+	}
+
+	err = new(models.Quiz).Open(quizID)
+	if err == models.ErrQuizStatus {
+		c.JSON(http.StatusConflict, gin.H{"quizID": quizID, "error": "only draft quizzes can be opened"})
+		return
+	}
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"quizID": quizID,
+		"status": models.QuizOpen,
+	})
+
+	if quiz.TimeLimit > 0 {
+		quiz.Remaining = quiz.TimeLimit
+		time.AfterFunc(time.Duration(quiz.TimeLimit)*time.Second+models.QuizGrace, func() {
+			closeQuiz(quizID)
+		})
+	}
+	broadcastQuizOpened(quiz)
+}
+
+// APIQuizClosePostHandler stops an open quiz from taking submissions and shows the class its answers.
+func APIQuizClosePostHandler(c *gin.Context) {
+	quiz, ok := moderatedQuiz(c)
+	if !ok {
+		return
+	}
+
+	err := new(models.Quiz).Close(quiz.ID)
+	if err == models.ErrQuizStatus {
+		c.JSON(http.StatusConflict, gin.H{"error": "only open quizzes can be closed"})
+		return
+	}
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"quizID": quiz.ID,
+		"status": models.QuizClosed,
+	})
+
+	broadcastClosedQuiz(quiz.ID)
+}
+
+// closeQuiz closes a quiz whose time is up, unless it was closed already, and tells the class session.
+func closeQuiz(quizID int) {
+	err := new(models.Quiz).Close(quizID)
+	if err == models.ErrQuizStatus {
+		return
+	}
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	broadcastClosedQuiz(quizID)
+}
+
+// broadcastClosedQuiz reads a quiz that was just closed and tells the class session.
+func broadcastClosedQuiz(quizID int) {
+	// The submissions may have changed since the quiz was read
+	quiz, err := new(models.Quiz).GetByID(quizID, 0)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	broadcastQuizClosed(quiz)
+}
+
+// APIQuizSubmitPostHandler scores and records the answers of a student to an open quiz, once.
+func APIQuizSubmitPostHandler(c *gin.Context) {
+	type SubmissionData struct {
+		// Answers maps question ids to the option ids chosen
+		Answers map[int]int `json:"answers"`
+	}
+
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+
+	quizIDInt, err := strconv.Atoi(c.Param("quizID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid quiz ID"})
+		return
+	}
+
+	var submissionData SubmissionData
+	if err := c.ShouldBindJSON(&submissionData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	status, body := submitQuizAction(userID, 0, quizIDInt, submissionData.Answers)
+	c.JSON(status, body)
+}
+
+// APIQuizResultsGetHandler returns a quiz with its answers and the score of every student who submitted it,
+// for a moderator of its section.
+func APIQuizResultsGetHandler(c *gin.Context) {
+	session := sessions.Default(c)
+	timezone, _ := session.Get("timezone").(string)
+	timezoneInt, _ := strconv.Atoi(timezone)
+
+	quiz, ok := moderatedQuiz(c)
+	if !ok {
+		return
+	}
+
+	submissions, err := new(models.QuizSubmission).GetByQuizID(quiz.ID, timezoneInt)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"quiz":        quiz,
+		"submissions": submissions,
+	})
+}
+
+// APIQuizScoresExportGetHandler downloads the quiz scores of every student of a section as CSV,
+// for the instructor and teacher assistants of the section.
+func APIQuizScoresExportGetHandler(c *gin.Context) {
+	session := sessions.Default(c)
+	timezone, _ := session.Get("timezone").(string)
+	timezoneInt, _ := strconv.Atoi(timezone)
+
+	sectionIDInt, err := strconv.Atoi(c.Param("sectionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if !isSectionStaff(c, sectionIDInt) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a moderator of this section"})
+		return
+	}
+
+	scores, err := new(models.Quiz).GetScoresBySectionID(sectionIDInt, timezoneInt)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var b bytes.Buffer
+	w := csv.NewWriter(&b)
+	w.Write([]string{"Date", "Class Session", "Quiz", "Email", "First Name", "Last Name", "Score", "Max Score", "Submitted At", "Attendance"})
+	for _, score := range scores {
+		submittedAt := "not submitted"
+		if score.Submitted {
+			submittedAt = score.SubmittedAt
+		}
+		w.Write([]string{
+			score.Date,
+			strconv.Itoa(score.ClassSessionID),
+			csvCell(score.QuizTitle),
+			csvCell(score.Email),
+			csvCell(score.FirstName),
+			csvCell(score.LastName),
+			strconv.Itoa(score.Score),
+			strconv.Itoa(score.MaxScore),
+			submittedAt,
+			score.AttendanceStatus,
+		})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="quiz-scores-section-%d.csv"`, sectionIDInt))
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", b.Bytes())
+}
+
+// APIMyAttendanceGetHandler returns the attendance of the signed in user in each section they are enrolled in:
+// their status on each day attendance was taken, the questions they asked and the votes they cast, and their
+// attendance rate so far.
+func APIMyAttendanceGetHandler(c *gin.Context) {
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+	timezone, _ := session.Get("timezone").(string)
+	timezoneInt, _ := strconv.Atoi(timezone)
+
+	sections, err := new(models.StudentAttendance).GetByUser(userID, timezoneInt)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get your attendance"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"sections": sections})
+}
+
+// maxAlertAbsences is the most absences an alert threshold can be set to.
+const maxAlertAbsences = 100
+
+// APIAttendanceThresholdsGetHandler returns the attendance thresholds of a section to its staff.
+func APIAttendanceThresholdsGetHandler(c *gin.Context) {
+	sectionID, err := strconv.Atoi(c.Param("sectionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if !isSectionStaff(c, sectionID) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not an instructor of this section"})
+		return
+	}
+
+	thresholds, err := new(models.AttendanceThresholds).GetThresholds(sectionID)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get the attendance alerts"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"thresholds": thresholds})
+}
+
+// APIAttendanceThresholdsPutHandler sets the attendance thresholds of a section: the number of absences and
+// the attendance rate in percent past which its students are at risk, 0 turning either off, and whether the
+// alerts are emailed.
+func APIAttendanceThresholdsPutHandler(c *gin.Context) {
+	type ThresholdsData struct {
+		Absences int  `json:"absences"`
+		Rate     int  `json:"rate"`
+		Email    bool `json:"email"`
+	}
+
+	sectionID, err := strconv.Atoi(c.Param("sectionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if !isSectionStaff(c, sectionID) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not an instructor of this section"})
+		return
+	}
+
+	var thresholdsData ThresholdsData
+	if err := c.ShouldBindJSON(&thresholdsData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if thresholdsData.Absences < 0 || thresholdsData.Absences > maxAlertAbsences {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("absences must be between 0 and %d", maxAlertAbsences)})
+		return
+	}
+	if thresholdsData.Rate < 0 || thresholdsData.Rate > 100 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "rate must be between 0 and 100"})
+		return
+	}
+
+	thresholds := models.AttendanceThresholds{
+		SectionID: sectionID,
+		Absences:  thresholdsData.Absences,
+		Rate:      thresholdsData.Rate,
+		Email:     thresholdsData.Email,
+	}
+	err = new(models.AttendanceThresholds).SetThresholds(thresholds)
+	if err == sql.ErrNoRows {
+		c.JSON(http.StatusNotFound, gin.H{"error": "section not found"})
+		return
+	}
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save the attendance alerts"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"thresholds": thresholds})
+}
+
+// APIAttendanceAlertsGetHandler returns the alerts not dismissed yet of the sections the user teaches.
+func APIAttendanceAlertsGetHandler(c *gin.Context) {
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+	timezone, _ := session.Get("timezone").(string)
+	timezoneInt, _ := strconv.Atoi(timezone)
+
+	alerts, err := new(models.AttendanceAlert).GetOpenByTeacher(userID, timezoneInt)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get the attendance alerts"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"alerts": alerts})
+}
+
+// APIAttendanceAlertDismissPostHandler takes an alert off the dashboard once the student was followed up.
+func APIAttendanceAlertDismissPostHandler(c *gin.Context) {
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+
+	alertID, err := strconv.Atoi(c.Param("alertID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	alert, err := new(models.AttendanceAlert).GetByID(alertID, 0)
+	if err == sql.ErrNoRows {
+		c.JSON(http.StatusNotFound, gin.H{"error": "alert not found"})
+		return
+	}
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to dismiss the alert"})
+		return
+	}
+
+	taught, err := new(models.AttendanceSheet).GetTaughtSectionIDs(userID)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to dismiss the alert"})
+		return
+	}
+	teaches := false
+	for _, sectionID := range taught {
+		teaches = teaches || sectionID == alert.SectionID
+	}
+	if !teaches {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not an instructor of this section"})
+		return
+	}
+
+	dismissed, err := new(models.AttendanceAlert).Dismiss(alertID, userID)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to dismiss the alert"})
+		return
+	}
+	if !dismissed {
+		c.JSON(http.StatusConflict, gin.H{"error": "This alert was already dismissed"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "success"})
+}
+
+// Formats the attendance sheets export to
+const (
+	exportCSV  = "csv"
+	exportXLSX = "xlsx"
+)
+
+// APIAttendanceExportGetHandler exports the attendance sheet of a section the user teaches, a roster by date
+// with totals, as CSV or XLSX. The from and to query parameters limit it to the days between them, as YYYY-MM-DD.
+func APIAttendanceExportGetHandler(c *gin.Context) {
+	sectionID, err := strconv.Atoi(c.Param("sectionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	exportAttendanceSheets(c, sectionID)
+}
+
+// APIAttendanceExportAllGetHandler exports the attendance sheets of all the sections the user teaches:
+// as an XLSX workbook with a sheet per section, or a zip file of a CSV file per section.
+func APIAttendanceExportAllGetHandler(c *gin.Context) {
+	exportAttendanceSheets(c, 0)
+}
+
+// exportAttendanceSheets responds with the attendance sheet of a section the signed in user teaches,
+// or of all of them when sectionID is 0, in the format and date range of the query parameters.
+func exportAttendanceSheets(c *gin.Context, sectionID int) {
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+	timezone, _ := session.Get("timezone").(string)
+	timezoneInt, _ := strconv.Atoi(timezone)
+
+	format := c.DefaultQuery("format", exportCSV)
+	if format != exportCSV && format != exportXLSX {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "format must be csv or xlsx"})
+		return
+	}
+	from, to := c.Query("from"), c.Query("to")
+	for _, date := range []string{from, to} {
+		if _, err := time.Parse("2006-01-02", date); date != "" && err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "dates must be given as YYYY-MM-DD"})
+			return
+		}
+	}
+	if from != "" && to != "" && from > to {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "the start date must not be after the end date"})
+		return
+	}
+
+	taught, err := new(models.AttendanceSheet).GetTaughtSectionIDs(userID)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to export the attendance"})
+		return
+	}
+	sectionIDs := taught
+	if sectionID != 0 {
+		sectionIDs = nil
+		for _, id := range taught {
+			if id == sectionID {
+				sectionIDs = []int{sectionID}
+			}
+		}
+		if sectionIDs == nil {
+			c.JSON(http.StatusForbidden, gin.H{"error": "not an instructor of this section"})
+			return
+		}
+	}
+	if len(sectionIDs) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "You don't teach any section"})
+		return
+	}
+
+	sheets := make([]models.AttendanceSheet, 0, len(sectionIDs))
+	for _, id := range sectionIDs {
+		sheet, err := new(models.AttendanceSheet).Get(id, from, to, timezoneInt)
+		if err != nil {
+			fmt.Println(err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to export the attendance"})
+			return
+		}
+		sheets = append(sheets, sheet)
+	}
+
+	filename := "attendance"
+	if sectionID != 0 {
+		filename = fmt.Sprintf("attendance-section-%d", sectionID)
+	}
+	if from != "" {
+		filename += "-from-" + from
+	}
+	if to != "" {
+		filename += "-to-" + to
+	}
+
+	var b bytes.Buffer
+	contentType := "text/csv; charset=utf-8"
+	switch {
+	case format == exportXLSX:
+		err = writeAttendanceWorkbook(&b, sheets)
+		contentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+		filename += ".xlsx"
+	case len(sheets) == 1:
+		err = writeAttendanceCSV(&b, sheets[0])
+		filename += ".csv"
+	default:
+		err = writeAttendanceCSVZip(&b, sheets)
+		contentType = "application/zip"
+		filename += ".zip"
+	}
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to export the attendance"})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Data(http.StatusOK, contentType, b.Bytes())
+}
+
+// attendanceSheetRows lays an attendance sheet out as rows of cells: a header, a row per student with their
+// status each day, their totals and attendance rate, then the totals and attendance rate of each day.
+// Cells are strings, ints, xlsx.Percents for rates, or nil when there is no rate.
+func attendanceSheetRows(sheet models.AttendanceSheet) [][]interface{} {
+	header := []interface{}{"Last Name", "First Name", "Email"}
+	for _, date := range sheet.Dates {
+		header = append(header, date)
+	}
+	for _, status := range models.AttendanceStatuses {
+		header = append(header, strings.Title(status))
+	}
+	header = append(header, "Attendance Rate")
+	rows := [][]interface{}{header}
+
+	for _, student := range sheet.Students {
+		row := []interface{}{student.LastName, student.FirstName, student.Email}
+		for _, status := range student.Statuses {
+			row = append(row, status)
+		}
+		for _, status := range models.AttendanceStatuses {
+			row = append(row, student.Total(status))
+		}
+		rows = append(rows, append(row, attendanceRateCell(student.Rate())))
+	}
+
+	// A blank row, then the totals of each day under its column
+	rows = append(rows, []interface{}{})
+	for _, status := range models.AttendanceStatuses {
+		row := []interface{}{strings.Title(status), nil, nil}
+		for column := range sheet.Dates {
+			row = append(row, sheet.DateTotal(column, status))
+		}
+		rows = append(rows, row)
+	}
+	row := []interface{}{"Attendance Rate", nil, nil}
+	for column := range sheet.Dates {
+		row = append(row, attendanceRateCell(sheet.DateRate(column)))
+	}
+	return append(rows, row)
+}
+
+// attendanceRateCell is the cell of an attendance rate, empty when there is none.
+func attendanceRateCell(rate float64, ok bool) interface{} {
+	if !ok {
+		return nil
+	}
+	return xlsx.Percent(rate)
+}
+
+// csvCell returns the text of a CSV cell, with a ' before text starting with =, +, - or @ so that
+// spreadsheets show it instead of running it as a formula.
+func csvCell(text string) string {
+	if text != "" && strings.ContainsRune("=+-@", rune(text[0])) {
+		return "'" + text
+	}
+	return text
+}
+
+// writeAttendanceCSV writes an attendance sheet as CSV, with rates as percentages. Every record is as wide
+// as the header, for the readers that expect it.
+func writeAttendanceCSV(w io.Writer, sheet models.AttendanceSheet) error {
+	cw := csv.NewWriter(w)
+	rows := attendanceSheetRows(sheet)
+	for _, row := range rows {
+		record := make([]string, len(rows[0]))
+		for i, value := range row {
+			switch v := value.(type) {
+			case nil:
+			case xlsx.Percent:
+				record[i] = fmt.Sprintf("%.1f%%", float64(v)*100)
+			default:
+				record[i] = csvCell(fmt.Sprint(v))
+			}
+		}
+		cw.Write(record)
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeAttendanceCSVZip writes attendance sheets as a zip file of a CSV file each.
+func writeAttendanceCSVZip(w io.Writer, sheets []models.AttendanceSheet) error {
+	z := zip.NewWriter(w)
+	for _, sheet := range sheets {
+		f, err := z.CreateHeader(&zip.FileHeader{
+			Name:     fmt.Sprintf("attendance-section-%d.csv", sheet.SectionID),
+			Method:   zip.Deflate,
+			Modified: time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		if err := writeAttendanceCSV(f, sheet); err != nil {
+			return err
+		}
+	}
+	return z.Close()
+}
+
+// writeAttendanceWorkbook writes attendance sheets as an XLSX workbook with a sheet each, named after
+// the course and section, the header and names staying in view.
+func writeAttendanceWorkbook(w io.Writer, sheets []models.AttendanceSheet) error {
+	wb := xlsx.NewWorkbook()
+	for _, sheet := range sheets {
+		ws := wb.AddSheet(sheet.Name())
+		ws.Freeze(1, 3)
+		for i, row := range attendanceSheetRows(sheet) {
+			if i == 0 {
+				ws.AddHeader(row...)
+				continue
+			}
+			ws.AddRow(row...)
+		}
+	}
+	return wb.Write(w)
+}
+
+// closeClassSessionQuizzes closes the quizzes left open in a class session that ended.
+func closeClassSessionQuizzes(classSessionID int) {
+	quizIDs, err := new(models.Quiz).CloseAll(classSessionID)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	for _, quizID := range quizIDs {
+		broadcastClosedQuiz(quizID)
+	}
+}
+
+// Limits on the period of the check-in codes, in seconds
+const (
+	defaultCheckInPeriod = 30
+	minCheckInPeriod     = 10
+	maxCheckInPeriod     = 300
+)
+
+// checkInQRScale is the number of pixels per module of the check-in QR codes rendered as PNG.
+const checkInQRScale = 8
+
+// checkInAttendance gets the class session in the URL and the attendance taken for it, for a member of its section.
+// It writes the error response and returns false when the request can't go on.
+func checkInAttendance(c *gin.Context) (classSessionID int, sectionID int, attendanceID int, ok bool) {
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+
+	classSessionID, err := strconv.Atoi(c.Param("classSessionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return 0, 0, 0, false
+	}
+
+	sectionID, err = new(models.ClassSession).GetSectionID(classSessionID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "class session not found"})
+		return 0, 0, 0, false
+	}
+	if !canJoinSection(userID, sectionID) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not a member of this section"})
+		return 0, 0, 0, false
+	}
+
+	attendanceID, err = new(models.Attendance).GetAttendanceIDByClassSessionID(classSessionID)
+	if err != nil && err != sql.ErrNoRows {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return 0, 0, 0, false
+	}
+
+	return classSessionID, sectionID, attendanceID, true
+}
+
+// APICheckInGetHandler returns the check-in of a class session. Its staff get the code to show, how many
+// seconds until it changes and the codes that were refused; students get whether they checked in and their status.
+func APICheckInGetHandler(c *gin.Context) {
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+	timezone, _ := session.Get("timezone").(string)
+	timezoneInt, _ := strconv.Atoi(timezone)
+
+	_, sectionID, attendanceID, ok := checkInAttendance(c)
+	if !ok {
+		return
+	}
+	staff := isSectionStaff(c, sectionID)
+
+	checkIn, err := new(models.CheckIn).GetOpen(attendanceID)
+	if err != nil && err != sql.ErrNoRows {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	open := err == nil
+
+	if !staff {
+		records, err := new(models.Attendance).GetByUser(userID, attendanceID)
+		if err != nil {
+			fmt.Println(err)
+		}
+		status := ""
+		if len(records) > 0 {
+			status = records[0].Status
+		}
+		checkedIn := status == models.AttendancePresent || status == models.AttendanceLate
+
+		c.JSON(http.StatusOK, gin.H{
+			"open":             open,
+			"period":           checkIn.Period,
+			"checkedIn":        checkedIn,
+			"attendanceID":     attendanceID,
+			"attendanceStatus": status,
+		})
+		return
+	}
+
+	response := gin.H{
+		"open":         open,
+		"period":       checkIn.Period,
+		"staff":        true,
+		"attendanceID": attendanceID,
+	}
+	if open {
+		code, expiresIn := checkIn.CurrentCode(time.Now())
+		response["code"] = code
+		response["expiresIn"] = expiresIn.Seconds()
+	}
+
+	attempts, err := new(models.CheckInAttempt).GetRejected(attendanceID, timezoneInt)
+	if err != nil {
+		fmt.Println(err)
+	}
+	response["attempts"] = attempts
+
+	c.JSON(http.StatusOK, response)
+}
+
+// staffCheckIn checks that the user is on the staff of the section of the class session in the URL,
+// who can open and close its check-in and show its code.
+// It writes the error response and returns false when the request can't go on.
+func staffCheckIn(c *gin.Context) (classSessionID int, sectionID int, attendanceID int, ok bool) {
+	classSessionID, sectionID, attendanceID, ok = checkInAttendance(c)
+	if !ok {
+		return 0, 0, 0, false
+	}
+	if !isSectionStaff(c, sectionID) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not an instructor of this section"})
+		return 0, 0, 0, false
+	}
+	return classSessionID, sectionID, attendanceID, true
+}
+
+// APICheckInOpenPostHandler opens the check-in of a class session in progress, with codes that change
+// every period seconds. Opening it again starts over with new codes.
+func APICheckInOpenPostHandler(c *gin.Context) {
+	type OpenData struct {
+		Period int `json:"period"`
+	}
+
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+
+	classSessionID, _, attendanceID, ok := staffCheckIn(c)
+	if !ok {
+		return
+	}
+
+	openData := OpenData{Period: defaultCheckInPeriod}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&openData); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	if openData.Period < minCheckInPeriod || openData.Period > maxCheckInPeriod {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("period must be between %d and %d seconds", minCheckInPeriod, maxCheckInPeriod)})
+		return
+	}
+
+	inProgress, err := new(models.ClassSession).GetInProgress(classSessionID)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if !inProgress || attendanceID == 0 {
+		c.JSON(http.StatusConflict, gin.H{"error": "the class session is not in progress"})
+		return
+	}
+
+	checkIn, err := new(models.CheckIn).Open(attendanceID, userID, openData.Period)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	code, expiresIn := checkIn.CurrentCode(time.Now())
+	c.JSON(http.StatusOK, gin.H{
+		"open":      true,
+		"period":    checkIn.Period,
+		"code":      code,
+		"expiresIn": expiresIn.Seconds(),
+	})
+
+	broadcastCheckInOpened(classSessionID, checkIn.Period)
+}
+
+// APICheckInClosePostHandler closes the check-in of a class session.
+func APICheckInClosePostHandler(c *gin.Context) {
+	classSessionID, _, attendanceID, ok := staffCheckIn(c)
+	if !ok {
+		return
+	}
+
+	closed, err := new(models.CheckIn).Close(attendanceID)
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"open": false,
+	})
+
+	if closed {
+		broadcastCheckInClosed(classSessionID)
+	}
+}
+
+// APICheckInPostHandler checks the user in to a class session with the code shown by its instructor.
+func APICheckInPostHandler(c *gin.Context) {
+	type CheckInData struct {
+		Code string `json:"code"`
+	}
+
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+
+	_, _, attendanceID, ok := checkInAttendance(c)
+	if !ok {
+		return
+	}
+	if attendanceID == 0 {
+		c.JSON(http.StatusConflict, gin.H{"error": "Check-in is not open"})
+		return
+	}
+
+	var checkInData CheckInData
+	if err := c.ShouldBindJSON(&checkInData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	status, body := checkInAction(userID, attendanceID, checkInData.Code)
+	c.JSON(status, body)
+}
+
+// APICheckInQRGetHandler renders the current check-in code of a class session as a QR code, as SVG
+// or as PNG when the format is png. Scanning it opens the class session with the code filled in.
+func APICheckInQRGetHandler(c *gin.Context) {
+	classSessionID, sectionID, attendanceID, ok := staffCheckIn(c)
+	if !ok {
+		return
+	}
+
+	checkIn, err := new(models.CheckIn).GetOpen(attendanceID)
+	if err == sql.ErrNoRows {
+		c.JSON(http.StatusConflict, gin.H{"error": "check-in is not open"})
+		return
+	}
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	checkInCode, _ := checkIn.CurrentCode(time.Now())
+	code, err := qrcode.Encode(fmt.Sprintf("%s/class-session/%d/%d?check-in=%s", requestBaseURL(c), sectionID, classSessionID, checkInCode))
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	// The code changes every period, so the image must not be reused
+	c.Header("Cache-Control", "no-store")
+	if c.Query("format") == "png" {
+		image, err := code.PNG(checkInQRScale)
+		if err != nil {
+			fmt.Println(err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.Data(http.StatusOK, "image/png", image)
+		return
+	}
+	c.Data(http.StatusOK, "image/svg+xml", code.SVG())
+}
+
+// closeClassSessionCheckIn closes the check-in left open in a class session that ended.
+func closeClassSessionCheckIn(classSessionID int, attendanceID int) {
+	closed, err := new(models.CheckIn).Close(attendanceID)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	if closed {
+		broadcastCheckInClosed(classSessionID)
+	}
+}
@@ -1,47 +1,101 @@
-package controllers
-
-import (
-	"github.com/gin-gonic/gin"
-)
-
-func APIRoutes(g *gin.RouterGroup) {
-	g.POST("/api/settings/dark-theme", APIDarkThemePostHandler)
-	g.PUT("/api/settings/timezone", APITimezonePostHandler)
-
-	g.GET("/api/questions/:classSessionID", APIMessagesGetHandler)
-	g.POST("/api/questions/:classSessionID", APIQuestionsPostHandler)
-	g.POST("/api/vote-up/:questionID", VoteUpPostHandler)
-	g.POST("/api/mark-question/:questionID", MarkQuestionPostHandler)
-	g.PUT("/api/add-moderator/:email/:sectionID", APIAddModeratorPostHandler)
-	g.DELETE("/api/remove-moderator/:userID/:sectionID", APIRemoveModeratorDeleteHandler)
-	g.GET("/api/moderators/:sectionID", APIModeratorsForSectionGetHandler)
-
-	g.POST("/api/start-session/:sectionID", APIStartSessionPostHandler)
-	g.POST("/api/end-session/:classSessionID", APIEndSessionPostHandler)
-
-	g.GET("/api/user", APIGetUserGetHandler)
-	g.POST("/api/user", APIAddUserPostHandler)
-	g.PUT("/api/user", APIUpdateUserPutHandler)
-	g.DELETE("/api/user/:ID", APIDeleteUserDeleteHandler)
-
-	g.POST("/api/admin", APIAddAdminPostHandler)
-	g.POST("/api/onboarding", APIOnboardingPostHandler)
-
-	g.PUT("/api/organization", APIOrganizationPostHandler)
-
-	g.GET("/api/course", APICoursesGetHandler)
-	g.POST("/api/course", APICoursesPostHandler)
-
-	g.DELETE("/api/course/section/:courseID/:sectionNumber", APICourseSectionDeleteHandler)
-	g.PUT("/api/course/section", APICourseSectionPutHandler)
-
-	g.POST("/api/password-reset/send-email", APIPasswordResetSendEmailPostHandler)
-	g.POST("/api/password-reset/verify-pin", APIPasswordResetVerifyPinPostHandler)
-	g.POST("/api/password-reset", APIPasswordResetPostHandler)
-
-	g.GET("/api/attendance/:courseID", APIAttendanceSectionsGetHandler)
-	g.GET("/api/attendance/id/:sectionID", APIGetAttendanceIDBySectionIDHandler)
-	g.GET("/api/attendance/students/:attendanceID", APIAttendanceStudentsGetHandler)
-	g.POST("/api/attendance/mark-present/:attendanceID", APIMarkPresentPostHandler)
-
-}
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+func APIRoutes(g *gin.RouterGroup) {
+	g.POST("/api/settings/dark-theme", APIDarkThemePostHandler)
+	g.PUT("/api/settings/timezone", APITimezonePostHandler)
+
+	g.GET("/api/questions/:classSessionID", APIMessagesGetHandler)
+	g.POST("/api/questions/:classSessionID", APIQuestionsPostHandler)
+	g.POST("/api/vote-up/:questionID", VoteUpPostHandler)
+	g.POST("/api/mark-question/:questionID", MarkQuestionPostHandler)
+	g.POST("/api/answers/:questionID", APIAnswersPostHandler)
+	g.GET("/api/similar-questions/:questionID", APISimilarQuestionsGetHandler)
+	g.POST("/api/merge-question/:questionID", APIMergeQuestionPostHandler)
+	g.GET("/api/moderation-queue/:classSessionID", APIModerationQueueGetHandler)
+	g.PUT("/api/moderation/:questionID", APIModerationEditPutHandler)
+	g.POST("/api/moderation/:questionID/approve", APIModerationApprovePostHandler)
+	g.POST("/api/moderation/:questionID/reject", APIModerationRejectPostHandler)
+	g.PUT("/api/premoderation/:sectionID", APIPremoderationPutHandler)
+	g.GET("/api/polls/:classSessionID", APIPollsGetHandler)
+	g.POST("/api/polls/:classSessionID", APIPollsPostHandler)
+	g.POST("/api/poll/:pollID/open", APIPollOpenPostHandler)
+	g.POST("/api/poll/:pollID/close", APIPollClosePostHandler)
+	g.POST("/api/poll/:pollID/respond", APIPollRespondPostHandler)
+	g.GET("/api/quizzes/:classSessionID", APIQuizzesGetHandler)
+	g.POST("/api/quizzes/:classSessionID", APIQuizzesPostHandler)
+	g.POST("/api/quiz/:quizID/open", APIQuizOpenPostHandler)
+	g.POST("/api/quiz/:quizID/close", APIQuizClosePostHandler)
+	g.POST("/api/quiz/:quizID/submit", APIQuizSubmitPostHandler)
+	g.GET("/api/quiz/:quizID/results", APIQuizResultsGetHandler)
+	g.GET("/api/quiz-scores/:sectionID", APIQuizScoresExportGetHandler)
+	g.GET("/api/attendance-export", APIAttendanceExportAllGetHandler)
+	g.GET("/api/attendance-export/:sectionID", APIAttendanceExportGetHandler)
+	g.GET("/api/attendance-thresholds/:sectionID", APIAttendanceThresholdsGetHandler)
+	g.PUT("/api/attendance-thresholds/:sectionID", APIAttendanceThresholdsPutHandler)
+	g.GET("/api/attendance-alerts", APIAttendanceAlertsGetHandler)
+	g.POST("/api/attendance-alert/:alertID/dismiss", APIAttendanceAlertDismissPostHandler)
+	g.GET("/api/my-attendance", APIMyAttendanceGetHandler)
+	g.GET("/api/check-in/:classSessionID", APICheckInGetHandler)
+	g.POST("/api/check-in/:classSessionID", APICheckInPostHandler)
+	g.POST("/api/check-in/:classSessionID/open", APICheckInOpenPostHandler)
+	g.POST("/api/check-in/:classSessionID/close", APICheckInClosePostHandler)
+	g.GET("/api/check-in/:classSessionID/qr", APICheckInQRGetHandler)
+	g.PUT("/api/add-moderator/:email/:sectionID", APIAddModeratorPostHandler)
+	g.DELETE("/api/remove-moderator/:userID/:sectionID", APIRemoveModeratorDeleteHandler)
+	g.GET("/api/moderators/:sectionID", APIModeratorsForSectionGetHandler)
+
+	g.POST("/api/start-session/:sectionID", APIStartSessionPostHandler)
+	g.POST("/api/end-session/:classSessionID", APIEndSessionPostHandler)
+	g.GET("/api/class-sessions/:sectionID", APIClassSessionsGetHandler)
+	g.GET("/api/class-sessions/:sectionID/:classSessionID", APIClassSessionGetHandler)
+	g.GET("/api/presence/:classSessionID", APIPresenceGetHandler)
+
+	g.GET("/api/schedules/:sectionID", APISchedulesGetHandler)
+	g.POST("/api/schedules/:sectionID", APISchedulePostHandler)
+	g.PUT("/api/schedules/:sectionID/:scheduleID", APISchedulePutHandler)
+	g.DELETE("/api/schedules/:sectionID/:scheduleID", APIScheduleDeleteHandler)
+	g.POST("/api/holidays/:sectionID", APIHolidayPostHandler)
+	g.DELETE("/api/holidays/:sectionID/:holidayID", APIHolidayDeleteHandler)
+	g.GET("/api/occurrences/:sectionID", APIScheduleOccurrencesGetHandler)
+
+	g.GET("/api/calendar", APICalendarGetHandler)
+	g.POST("/api/calendar/reset", APICalendarResetPostHandler)
+	g.GET("/calendar/:token", CalendarFeedGetHandler)
+	g.GET("/calendar/:token/:sectionID", CalendarFeedGetHandler)
+
+	g.GET("/api/user", APIGetUserGetHandler)
+	g.POST("/api/user", APIAddUserPostHandler)
+	g.PUT("/api/user", APIUpdateUserPutHandler)
+	g.DELETE("/api/user/:ID", APIDeleteUserDeleteHandler)
+
+	g.POST("/api/admin", APIAddAdminPostHandler)
+	g.POST("/api/onboarding", APIOnboardingPostHandler)
+
+	g.PUT("/api/organization", APIOrganizationPostHandler)
+
+	g.GET("/api/course", APICoursesGetHandler)
+	g.POST("/api/course", APICoursesPostHandler)
+
+	g.DELETE("/api/course/section/:courseID/:sectionNumber", APICourseSectionDeleteHandler)
+	g.PUT("/api/course/section", APICourseSectionPutHandler)
+
+	g.POST("/api/password-reset/send-email", APIPasswordResetSendEmailPostHandler)
+	g.POST("/api/password-reset/verify-pin", APIPasswordResetVerifyPinPostHandler)
+	g.POST("/api/password-reset", APIPasswordResetPostHandler)
+
+	g.GET("/api/attendance/:courseID", APIAttendanceSectionsGetHandler)
+	g.GET("/api/attendance/id/:sectionID", APIGetAttendanceIDBySectionIDHandler)
+	g.GET("/api/attendance/students/:attendanceID", APIAttendanceStudentsGetHandler)
+	g.POST("/api/attendance/mark-present/:attendanceID", APIMarkPresentPostHandler)
+	g.POST("/api/attendance-status/:attendanceID", APIAttendanceStatusPostHandler)
+	g.GET("/api/attendance-history/:attendanceID", APIAttendanceHistoryGetHandler)
+	g.GET("/api/excuse-requests/:attendanceID", APIExcuseRequestsGetHandler)
+	g.POST("/api/excuse-requests/:attendanceID", APIExcuseRequestPostHandler)
+	g.POST("/api/excuse-request/:excuseID/approve", APIExcuseRequestApprovePostHandler)
+	g.POST("/api/excuse-request/:excuseID/deny", APIExcuseRequestDenyPostHandler)
+
+}
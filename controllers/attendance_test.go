@@ -0,0 +1,474 @@
+package controllers
+
+import (
+	"archive/zip"
+	"bytes"
+	"coeus/models"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAttendanceStatusAt(t *testing.T) {
+	start := time.Date(2026, 3, 2, 10, 10, 0, 0, time.UTC)
+	occurrences := []models.Occurrence{{SectionID: 3, Start: start, End: start.Add(50 * time.Minute)}}
+	openBefore, lateAfter := 5*time.Minute, 10*time.Minute
+
+	for _, test := range []struct {
+		name      string
+		startedAt time.Time
+		now       time.Time
+		want      string
+	}{
+		{"before the start", start.Add(-5 * time.Minute), start.Add(-time.Minute), models.AttendancePresent},
+		{"within the grace period", start, start.Add(10 * time.Minute), models.AttendancePresent},
+		{"after the grace period", start, start.Add(11 * time.Minute), models.AttendanceLate},
+		{"session opened too early", start.Add(-6 * time.Minute), start.Add(30 * time.Minute), models.AttendancePresent},
+		{"session outside the schedule", start.Add(time.Hour), start.Add(2 * time.Hour), models.AttendancePresent},
+	} {
+		if got := attendanceStatusAt(occurrences, test.startedAt, test.now, openBefore, lateAfter); got != test.want {
+			t.Errorf("%s: attendanceStatusAt = %s, want %s", test.name, got, test.want)
+		}
+	}
+}
+
+func TestAttendanceStatusEdits(t *testing.T) {
+	server, _ := newWebsocketServer(t)
+	defer server.Close()
+	db := models.DB()
+
+	// Class session 3 belongs to section 3, where user 3 is the instructor and users 1 and 22 are students
+	attendanceID, err := new(models.Attendance).Add(3, 3, 3)
+	if err != nil {
+		t.Fatalf("add attendance failed with error: %v", err)
+	}
+	defer db.Exec("DELETE FROM attendance WHERE id = $1", attendanceID)
+	defer db.Exec("DELETE FROM user_attendance WHERE attendance_id = $1", attendanceID)
+	defer db.Exec("DELETE FROM attendance_status_change WHERE attendance_id = $1", attendanceID)
+	defer db.Exec("DELETE FROM excuse_request WHERE attendance_id = $1", attendanceID)
+
+	statusPath := fmt.Sprintf("/api/attendance-status/%d", attendanceID)
+	historyPath := fmt.Sprintf("/api/attendance-history/%d", attendanceID)
+
+	// Only the staff change statuses, to a known one, for members of the section
+	for _, test := range []struct {
+		userID int
+		body   map[string]interface{}
+		want   int
+	}{
+		{1, map[string]interface{}{"userID": 1, "status": "present"}, http.StatusForbidden},
+		{3, map[string]interface{}{"userID": 1, "status": "tardy"}, http.StatusBadRequest},
+		{3, map[string]interface{}{"userID": 999999, "status": "late"}, http.StatusBadRequest},
+		{3, map[string]interface{}{"userID": 1, "status": "late", "reason": string(make([]byte, maxAttendanceReasonLength+1))}, http.StatusBadRequest},
+	} {
+		if status, reply := apiRequest(t, server, test.userID, "POST", statusPath, test.body); status != test.want {
+			t.Errorf("user %d setting %v got %d %v, want %d", test.userID, test.body, status, reply, test.want)
+		}
+	}
+
+	status, reply := apiRequest(t, server, 3, "POST", statusPath, map[string]interface{}{"userID": 1, "status": "late", "reason": "came in after the quiz"})
+	if status != http.StatusOK || reply["changed"] != true {
+		t.Fatalf("setting the student late = %d %v", status, reply)
+	}
+	if _, reply := apiRequest(t, server, 3, "POST", statusPath, map[string]interface{}{"userID": 1, "status": "late"}); reply["changed"] != false {
+		t.Errorf("setting the same status again = %v, want unchanged", reply)
+	}
+
+	// The roster shows the status, and the history who set it and why
+	_, reply = apiRequest(t, server, 3, "GET", fmt.Sprintf("/api/attendance/students/%d", attendanceID), nil)
+	found := false
+	for _, student := range reply["students"].([]interface{}) {
+		student := student.(map[string]interface{})
+		if int(student["ID"].(float64)) == 1 {
+			found = student["Status"] == "late"
+		}
+	}
+	if !found {
+		t.Errorf("students = %v, want student 1 late", reply["students"])
+	}
+	if status, _ := apiRequest(t, server, 1, "GET", historyPath, nil); status != http.StatusForbidden {
+		t.Errorf("student reading the history got status %d, want %d", status, http.StatusForbidden)
+	}
+	_, reply = apiRequest(t, server, 3, "GET", historyPath, nil)
+	changes := reply["changes"].([]interface{})
+	if len(changes) != 1 {
+		t.Fatalf("history = %v, want one change", changes)
+	}
+	change := changes[0].(map[string]interface{})
+	if change["OldStatus"] != "" || change["NewStatus"] != "late" || int(change["ChangedBy"].(float64)) != 3 ||
+		change["Source"] != models.StatusSourceManual || change["Reason"] != "came in after the quiz" {
+		t.Errorf("change = %v", change)
+	}
+}
+
+func TestExcuseRequestFlow(t *testing.T) {
+	server, _ := newWebsocketServer(t)
+	defer server.Close()
+	db := models.DB()
+
+	attendanceID, err := new(models.Attendance).Add(3, 3, 3)
+	if err != nil {
+		t.Fatalf("add attendance failed with error: %v", err)
+	}
+	defer db.Exec("DELETE FROM attendance WHERE id = $1", attendanceID)
+	defer db.Exec("DELETE FROM user_attendance WHERE attendance_id = $1", attendanceID)
+	defer db.Exec("DELETE FROM attendance_status_change WHERE attendance_id = $1", attendanceID)
+	defer db.Exec("DELETE FROM excuse_request WHERE attendance_id = $1", attendanceID)
+
+	path := fmt.Sprintf("/api/excuse-requests/%d", attendanceID)
+
+	// Students give a reason, and ask once at a time
+	if status, _ := apiRequest(t, server, 22, "POST", path, map[string]string{"reason": "  "}); status != http.StatusBadRequest {
+		t.Errorf("asking without a reason got status %d, want %d", status, http.StatusBadRequest)
+	}
+	status, reply := apiRequest(t, server, 22, "POST", path, map[string]string{"reason": "flu"})
+	if status != http.StatusOK {
+		t.Fatalf("asking to be excused = %d %v", status, reply)
+	}
+	excuseID := int(reply["excuseID"].(float64))
+	if status, _ := apiRequest(t, server, 22, "POST", path, map[string]string{"reason": "flu"}); status != http.StatusConflict {
+		t.Errorf("asking twice got status %d, want %d", status, http.StatusConflict)
+	}
+
+	// Students see their own requests, the staff see everyone's and answer them
+	if _, reply := apiRequest(t, server, 1, "GET", path, nil); len(reply["excuses"].([]interface{})) != 0 {
+		t.Errorf("another student sees the requests %v", reply["excuses"])
+	}
+	if _, reply := apiRequest(t, server, 3, "GET", path, nil); reply["staff"] != true || len(reply["excuses"].([]interface{})) != 1 {
+		t.Errorf("instructor sees the requests %v", reply)
+	}
+	approvePath := fmt.Sprintf("/api/excuse-request/%d/approve", excuseID)
+	if status, _ := apiRequest(t, server, 22, "POST", approvePath, nil); status != http.StatusForbidden {
+		t.Errorf("student approving their own request got status %d, want %d", status, http.StatusForbidden)
+	}
+	if status, _ := apiRequest(t, server, 3, "POST", "/api/excuse-request/999999/approve", nil); status != http.StatusNotFound {
+		t.Errorf("approving a missing request got status %d, want %d", status, http.StatusNotFound)
+	}
+	if status, reply := apiRequest(t, server, 3, "POST", approvePath, map[string]string{"response": "get well"}); status != http.StatusOK {
+		t.Fatalf("approving = %d %v", status, reply)
+	}
+	if status, _ := apiRequest(t, server, 3, "POST", fmt.Sprintf("/api/excuse-request/%d/deny", excuseID), nil); status != http.StatusConflict {
+		t.Errorf("denying an approved request got status %d, want %d", status, http.StatusConflict)
+	}
+	if status, _ := apiRequest(t, server, 22, "POST", path, map[string]string{"reason": "still sick"}); status != http.StatusConflict {
+		t.Errorf("asking once excused got status %d, want %d", status, http.StatusConflict)
+	}
+
+	// The end of the class session marks the others absent, including those who joined, but keeps the excuse
+	db.Exec("UPDATE class_session SET in_progress = true WHERE id = 3")
+	defer db.Exec("UPDATE class_session SET in_progress = false WHERE id = 3")
+	if err := endClassSession(3); err != nil {
+		t.Fatalf("endClassSession failed with error: %v", err)
+	}
+	records, err := new(models.Attendance).GetByUser(22, attendanceID)
+	if err != nil || len(records) != 1 || records[0].Status != models.AttendanceExcused {
+		t.Errorf("excused student attendance = %v %v, want excused", records, err)
+	}
+	changes, err := new(models.StatusChange).GetStatusChanges(attendanceID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, change := range changes {
+		if change.UserID == 22 && change.Source != models.StatusSourceExcuse {
+			t.Errorf("excused student status changed by %s", change.Source)
+		}
+	}
+	// Student 1 joined class session 3 without checking in, and user 3 teaches it
+	records, err = new(models.Attendance).GetByUser(1, attendanceID)
+	if err != nil || len(records) != 1 || records[0].Status != models.AttendanceAbsent {
+		t.Errorf("student who joined without a status = %v %v, want absent", records, err)
+	}
+	if records, err := new(models.Attendance).GetByUser(3, attendanceID); err != nil || len(records) != 0 {
+		t.Errorf("instructor attendance = %v %v, want none", records, err)
+	}
+}
+
+func TestAttendanceExport(t *testing.T) {
+	server, _ := newWebsocketServer(t)
+	defer server.Close()
+	db := models.DB()
+
+	attendanceID, err := new(models.Attendance).Add(3, 3, 3)
+	if err != nil {
+		t.Fatalf("add attendance failed with error: %v", err)
+	}
+	defer db.Exec("DELETE FROM attendance WHERE id = $1", attendanceID)
+	defer db.Exec("DELETE FROM user_attendance WHERE attendance_id = $1", attendanceID)
+	defer db.Exec("DELETE FROM attendance_status_change WHERE attendance_id = $1", attendanceID)
+	new(models.Attendance).SetUserAttendance(attendanceID, 1, models.AttendanceLate, 1, models.StatusSourceCheckIn, "")
+
+	// Only the sections the user teaches export, in a known format and date range
+	for _, test := range []struct {
+		userID int
+		path   string
+		want   int
+	}{
+		{1, "/api/attendance-export/3", http.StatusForbidden},
+		{1, "/api/attendance-export", http.StatusNotFound},
+		{3, "/api/attendance-export/3?format=pdf", http.StatusBadRequest},
+		{3, "/api/attendance-export/3?from=03/02/2026", http.StatusBadRequest},
+		{3, "/api/attendance-export/3?from=2026-03-02&to=2026-03-01", http.StatusBadRequest},
+	} {
+		if status, _ := apiRequest(t, server, test.userID, "GET", test.path, nil); status != test.want {
+			t.Errorf("user %d exporting %s got status %d, want %d", test.userID, test.path, status, test.want)
+		}
+	}
+
+	response, err := http.Get(fmt.Sprintf("%s/test-sign-in/3", server.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	cookie := response.Header.Get("Set-Cookie")
+	download := func(path string) (string, []byte) {
+		t.Helper()
+		request, _ := http.NewRequest("GET", server.URL+path, nil)
+		request.Header.Set("Cookie", cookie)
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer response.Body.Close()
+		body, _ := io.ReadAll(response.Body)
+		if response.StatusCode != http.StatusOK {
+			t.Fatalf("exporting %s got status %d: %s", path, response.StatusCode, body)
+		}
+		return response.Header.Get("Content-Type"), body
+	}
+
+	// The CSV sheet of the section has the status of the student today, their totals and the totals of the day
+	today := time.Now().UTC().Format("2006-01-02")
+	contentType, body := download("/api/attendance-export/3?format=csv&from=" + today + "&to=" + today)
+	if contentType != "text/csv; charset=utf-8" {
+		t.Errorf("CSV export has the content type %q", contentType)
+	}
+	records, err := csv.NewReader(bytes.NewReader(body)).ReadAll()
+	if err != nil {
+		t.Fatalf("export is not CSV: %v", err)
+	}
+	header := records[0]
+	column := len(header) - len(models.AttendanceStatuses) - 2
+	if header[0] != "Last Name" || !strings.HasPrefix(header[column], today) || header[len(header)-1] != "Attendance Rate" {
+		t.Fatalf("header = %v", header)
+	}
+	var student, late []string
+	for _, record := range records[1:] {
+		switch {
+		case len(record) > 2 && record[2] == "student@coeus.education":
+			student = record
+		case record[0] == "Late":
+			late = record
+		}
+	}
+	if student == nil || student[column] != "late" || student[column+2] != "1" || student[len(student)-1] != "100.0%" {
+		t.Errorf("student row = %v, want late once and a rate of 100.0%%", student)
+	}
+	if late == nil || late[column] != "1" {
+		t.Errorf("late totals = %v, want 1 today", late)
+	}
+
+	// All the sections taught export as a workbook
+	contentType, body = download("/api/attendance-export?format=xlsx")
+	if contentType != "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet" {
+		t.Errorf("XLSX export has the content type %q", contentType)
+	}
+	workbook, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		t.Fatalf("XLSX export is not a zip file: %v", err)
+	}
+	found := false
+	for _, f := range workbook.File {
+		found = found || f.Name == "xl/worksheets/sheet1.xml"
+	}
+	if !found {
+		t.Errorf("XLSX export has no sheet")
+	}
+}
+
+func TestAttendanceCSVFormulas(t *testing.T) {
+	sheet := models.AttendanceSheet{
+		SectionID: 3,
+		Dates:     []string{"2026-03-02"},
+		Students: []models.AttendanceSheetRow{
+			{UserID: 1, Email: "@student@coeus.education", FirstName: "+Ada", LastName: `=HYPERLINK("http://example.com")`, Statuses: []string{"present"}},
+			{UserID: 2, Email: "student2@coeus.education", FirstName: "-Bob", LastName: "O'Neil", Statuses: []string{""}},
+		},
+	}
+	var b bytes.Buffer
+	if err := writeAttendanceCSV(&b, sheet); err != nil {
+		t.Fatalf("writeAttendanceCSV failed with error: %v", err)
+	}
+	records, err := csv.NewReader(&b).ReadAll()
+	if err != nil {
+		t.Fatalf("export is not CSV: %v", err)
+	}
+
+	// Text that a spreadsheet would run as a formula is kept as text
+	if got := records[1][:3]; got[0] != `'=HYPERLINK("http://example.com")` || got[1] != "'+Ada" || got[2] != "'@student@coeus.education" {
+		t.Errorf("first student = %v", got)
+	}
+	if got := records[2][:3]; got[0] != "O'Neil" || got[1] != "'-Bob" || got[2] != "student2@coeus.education" {
+		t.Errorf("second student = %v", got)
+	}
+	if got := records[1][len(records[1])-1]; got != "100.0%" {
+		t.Errorf("attendance rate = %q, want 100.0%%", got)
+	}
+}
+
+func TestAttendanceAlertFlow(t *testing.T) {
+	server, _ := newWebsocketServer(t)
+	defer server.Close()
+	db := models.DB()
+
+	saved, err := new(models.AttendanceThresholds).GetThresholds(3)
+	if err != nil {
+		t.Fatalf("getThresholds failed with error: %v", err)
+	}
+	defer new(models.AttendanceThresholds).SetThresholds(saved)
+	defer db.Exec("DELETE FROM attendance_alert WHERE section_id = 3")
+
+	// Only the staff of the section set its thresholds, within bounds
+	for _, test := range []struct {
+		userID int
+		body   map[string]interface{}
+		want   int
+	}{
+		{1, map[string]interface{}{"absences": 1}, http.StatusForbidden},
+		{3, map[string]interface{}{"absences": -1}, http.StatusBadRequest},
+		{3, map[string]interface{}{"rate": 101}, http.StatusBadRequest},
+		{3, map[string]interface{}{"absences": 1, "email": true}, http.StatusOK},
+	} {
+		if status, data := apiRequest(t, server, test.userID, "PUT", "/api/attendance-thresholds/3", test.body); status != test.want {
+			t.Errorf("user %d setting %v got status %d, want %d: %v", test.userID, test.body, status, test.want, data)
+		}
+	}
+	if status, data := apiRequest(t, server, 3, "GET", "/api/attendance-thresholds/3", nil); status != http.StatusOK || data["thresholds"].(map[string]interface{})["Absences"] != float64(1) {
+		t.Errorf("thresholds = %d %v", status, data)
+	}
+
+	notified := make(chan models.AttendanceAlert, 100)
+	notifyAttendanceAlert = func(alert models.AttendanceAlert) { notified <- alert }
+	defer func() { notifyAttendanceAlert = sendAttendanceAlertEmails }()
+
+	attendanceID, err := new(models.Attendance).Add(3, 3, 3)
+	if err != nil {
+		t.Fatalf("add attendance failed with error: %v", err)
+	}
+	defer db.Exec("DELETE FROM attendance WHERE id = $1", attendanceID)
+	defer db.Exec("DELETE FROM user_attendance WHERE attendance_id = $1", attendanceID)
+	defer db.Exec("DELETE FROM attendance_status_change WHERE attendance_id = $1", attendanceID)
+	new(models.Attendance).SetUserAttendance(attendanceID, 22, models.AttendanceAbsent, 0, models.StatusSourceSessionEnd, "")
+
+	// The absent student crosses the threshold once, and is emailed about it once
+	checkAttendanceAlerts(3, attendanceID)
+	var alertID int
+	timeout := time.After(5 * time.Second)
+	for alertID == 0 {
+		select {
+		case alert := <-notified:
+			if alert.UserID == 22 && alert.Kind == models.AlertAbsences {
+				alertID = alert.ID
+			}
+		case <-timeout:
+			t.Fatalf("the absent student wasn't notified")
+		}
+	}
+	checkAttendanceAlerts(3, attendanceID)
+	select {
+	case alert := <-notified:
+		if alert.ID == alertID {
+			t.Errorf("the same alert was notified twice")
+		}
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	status, data := apiRequest(t, server, 3, "GET", "/api/attendance-alerts", nil)
+	found := 0
+	for _, alert := range data["alerts"].([]interface{}) {
+		if alert.(map[string]interface{})["ID"] == float64(alertID) {
+			found++
+		}
+	}
+	if status != http.StatusOK || found != 1 {
+		t.Errorf("the instructor sees the alert %d times, want once", found)
+	}
+
+	// Only the staff dismiss an alert, once
+	dismissPath := fmt.Sprintf("/api/attendance-alert/%d/dismiss", alertID)
+	for _, test := range []struct {
+		userID int
+		path   string
+		want   int
+	}{
+		{22, dismissPath, http.StatusForbidden},
+		{3, "/api/attendance-alert/-1/dismiss", http.StatusNotFound},
+		{3, dismissPath, http.StatusOK},
+		{3, dismissPath, http.StatusConflict},
+	} {
+		if status, _ := apiRequest(t, server, test.userID, "POST", test.path, nil); status != test.want {
+			t.Errorf("user %d dismissing %s got status %d, want %d", test.userID, test.path, status, test.want)
+		}
+	}
+}
+
+func TestAttendanceAlertReason(t *testing.T) {
+	for _, test := range []struct {
+		alert models.AttendanceAlert
+		want  string
+	}{
+		{models.AttendanceAlert{Kind: models.AlertAbsences, Threshold: 3, Observed: 3}, "reached 3 absences (alert at 3)"},
+		{models.AttendanceAlert{Kind: models.AlertRate, Threshold: 80, Observed: 66}, "fell to 66% attendance (alert below 80%)"},
+	} {
+		if got := attendanceAlertReason(test.alert); got != test.want {
+			t.Errorf("attendanceAlertReason(%+v) = %q, want %q", test.alert, got, test.want)
+		}
+	}
+}
+
+func TestMyAttendance(t *testing.T) {
+	server, _ := newWebsocketServer(t)
+	defer server.Close()
+	db := models.DB()
+
+	attendanceID, err := new(models.Attendance).Add(3, 3, 3)
+	if err != nil {
+		t.Fatalf("add attendance failed with error: %v", err)
+	}
+	defer db.Exec("DELETE FROM attendance WHERE id = $1", attendanceID)
+	defer db.Exec("DELETE FROM user_attendance WHERE attendance_id = $1", attendanceID)
+	defer db.Exec("DELETE FROM attendance_status_change WHERE attendance_id = $1", attendanceID)
+	new(models.Attendance).SetUserAttendance(attendanceID, 1, models.AttendancePresent, 1, models.StatusSourceCheckIn, "")
+	new(models.Attendance).SetUserAttendance(attendanceID, 22, models.AttendanceAbsent, 0, models.StatusSourceSessionEnd, "")
+
+	// status returns the status a user sees for the attendance
+	status := func(userID int) interface{} {
+		t.Helper()
+		code, data := apiRequest(t, server, userID, "GET", "/api/my-attendance", nil)
+		if code != http.StatusOK {
+			t.Fatalf("user %d got status %d: %v", userID, code, data)
+		}
+		for _, section := range data["sections"].([]interface{}) {
+			for _, session := range section.(map[string]interface{})["Sessions"].([]interface{}) {
+				if session.(map[string]interface{})["AttendanceID"] == float64(attendanceID) {
+					return session.(map[string]interface{})["Status"]
+				}
+			}
+		}
+		return nil
+	}
+
+	// Each student only sees their own status, and the instructor has nothing to see as a student
+	if got := status(1); got != models.AttendancePresent {
+		t.Errorf("student 1 sees %v, want present", got)
+	}
+	if got := status(22); got != models.AttendanceAbsent {
+		t.Errorf("student 22 sees %v, want absent", got)
+	}
+	if got := status(3); got != nil {
+		t.Errorf("the instructor sees %v, want nothing", got)
+	}
+}
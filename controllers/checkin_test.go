@@ -0,0 +1,190 @@
+package controllers
+
+import (
+	"bytes"
+	"coeus/models"
+	"fmt"
+	"image/png"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCheckIn(t *testing.T) {
+	server, dial := newWebsocketServer(t)
+	defer server.Close()
+	db := models.DB()
+
+	// Class session 3 belongs to section 3, where user 3 is the instructor and users 1 and 22 are students
+	attendanceID, err := new(models.Attendance).Add(3, 3, 3)
+	if err != nil {
+		t.Fatalf("add attendance failed with error: %v", err)
+	}
+	defer db.Exec("DELETE FROM attendance WHERE id = $1", attendanceID)
+	defer db.Exec("DELETE FROM user_attendance WHERE attendance_id = $1", attendanceID)
+	defer db.Exec("DELETE FROM check_in WHERE attendance_id = $1", attendanceID)
+	defer db.Exec("DELETE FROM check_in_attempt WHERE attendance_id = $1", attendanceID)
+
+	student, _, err := dial(1, "/ws/3")
+	if err != nil {
+		t.Fatalf("dial failed with error: %v", err)
+	}
+	defer student.Close()
+
+	// Only the staff open a check-in, and only during the class session
+	if status, _ := apiRequest(t, server, 3, "POST", "/api/check-in/3/open", nil); status != http.StatusConflict {
+		t.Errorf("opening a check-in outside the class session got status %d, want %d", status, http.StatusConflict)
+	}
+	db.Exec("UPDATE class_session SET in_progress = true WHERE id = 3")
+	defer db.Exec("UPDATE class_session SET in_progress = false WHERE id = 3")
+	if status, _ := apiRequest(t, server, 1, "POST", "/api/check-in/3/open", nil); status != http.StatusForbidden {
+		t.Errorf("student opening a check-in got status %d, want %d", status, http.StatusForbidden)
+	}
+	if status, _ := apiRequest(t, server, 3, "POST", "/api/check-in/3/open", map[string]int{"period": 5}); status != http.StatusBadRequest {
+		t.Errorf("opening a check-in with codes of 5 seconds got status %d, want %d", status, http.StatusBadRequest)
+	}
+
+	// Before it opens, codes are refused
+	if status, _ := apiRequest(t, server, 1, "POST", "/api/check-in/3", map[string]string{"code": "123456"}); status != http.StatusConflict {
+		t.Errorf("checking in before the check-in opened got status %d, want %d", status, http.StatusConflict)
+	}
+
+	status, reply := apiRequest(t, server, 3, "POST", "/api/check-in/3/open", map[string]int{"period": 60})
+	if status != http.StatusOK || reply["open"] != true {
+		t.Fatalf("opening a check-in = %d %v", status, reply)
+	}
+	code := reply["code"].(string)
+
+	// The class is told, but only the staff see the code
+	if opened := readEventOf(t, student, "check-in-opened"); int(opened["period"].(float64)) != 60 || len(opened) != 1 {
+		t.Errorf("check-in-opened = %v", opened)
+	}
+	_, reply = apiRequest(t, server, 1, "GET", "/api/check-in/3", nil)
+	if reply["open"] != true || reply["checkedIn"] != false || reply["code"] != nil {
+		t.Errorf("student sees the check-in %v", reply)
+	}
+	_, reply = apiRequest(t, server, 3, "GET", "/api/check-in/3", nil)
+	if reply["code"] != code || reply["expiresIn"].(float64) <= 0 {
+		t.Errorf("instructor sees the check-in %v, want the code %s", reply, code)
+	}
+
+	// The QR code links to the class session with the code, for the staff only
+	if status, _ := apiRequest(t, server, 1, "GET", "/api/check-in/3/qr", nil); status != http.StatusForbidden {
+		t.Errorf("student reading the QR code got status %d, want %d", status, http.StatusForbidden)
+	}
+	response, err := http.Get(fmt.Sprintf("%s/test-sign-in/3", server.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	cookie := response.Header.Get("Set-Cookie")
+	for _, format := range []string{"svg", "png"} {
+		request, _ := http.NewRequest("GET", server.URL+"/api/check-in/3/qr?format="+format, nil)
+		request.Header.Set("Cookie", cookie)
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(response.Body)
+		response.Body.Close()
+		if response.StatusCode != http.StatusOK || response.Header.Get("Cache-Control") != "no-store" {
+			t.Errorf("%s QR code = %d with Cache-Control %q", format, response.StatusCode, response.Header.Get("Cache-Control"))
+		}
+		switch format {
+		case "svg":
+			if response.Header.Get("Content-Type") != "image/svg+xml" || !strings.HasPrefix(string(body), "<svg") {
+				t.Errorf("SVG QR code has the content type %q", response.Header.Get("Content-Type"))
+			}
+		case "png":
+			if _, err := png.Decode(bytes.NewReader(body)); err != nil || response.Header.Get("Content-Type") != "image/png" {
+				t.Errorf("PNG QR code has the content type %q and doesn't decode: %v", response.Header.Get("Content-Type"), err)
+			}
+		}
+	}
+
+	// A wrong code is refused and logged, the shown one marks the student present over the websocket
+	wrong := "000000"
+	if code == wrong {
+		wrong = "111111"
+	}
+	if status, _ := apiRequest(t, server, 1, "POST", "/api/check-in/3", map[string]string{"code": wrong}); status != http.StatusBadRequest {
+		t.Errorf("checking in with a wrong code got status %d, want %d", status, http.StatusBadRequest)
+	}
+	reply = request(t, student, map[string]interface{}{"action": "check-in", "requestId": "1", "code": code})
+	if reply["type"] != "ack" {
+		t.Fatalf("check-in reply = %v", reply)
+	}
+	records, err := new(models.Attendance).GetByUser(1, attendanceID)
+	if err != nil || len(records) != 1 || records[0].Status != "present" {
+		t.Errorf("student attendance = %v %v, want present", records, err)
+	}
+
+	// The old endpoint needs the code too, and too many wrong codes lock the student out
+	path := fmt.Sprintf("/api/attendance/mark-present/%d", attendanceID)
+	if status, _ := apiRequest(t, server, 22, "POST", path, nil); status != http.StatusBadRequest {
+		t.Errorf("marking present without a code got status %d, want %d", status, http.StatusBadRequest)
+	}
+	for i := 0; i < models.CheckInAttemptLimit; i++ {
+		apiRequest(t, server, 22, "POST", path, map[string]string{"code": wrong})
+	}
+	if status, _ := apiRequest(t, server, 22, "POST", path, map[string]string{"code": code}); status != http.StatusTooManyRequests {
+		t.Errorf("checking in after %d wrong codes got status %d, want %d", models.CheckInAttemptLimit, status, http.StatusTooManyRequests)
+	}
+	if records, _ := new(models.Attendance).GetByUser(22, attendanceID); len(records) != 0 {
+		t.Errorf("locked out student attendance = %v, want none", records)
+	}
+
+	// The staff see every refused code: before the check-in opened, wrong, and locked out
+	_, reply = apiRequest(t, server, 3, "GET", "/api/check-in/3", nil)
+	if attempts, ok := reply["attempts"].([]interface{}); !ok || len(attempts) != 3+models.CheckInAttemptLimit {
+		t.Errorf("refused attempts = %v, want %d", reply["attempts"], 3+models.CheckInAttemptLimit)
+	}
+
+	// Once closed, the code is refused
+	if status, _ := apiRequest(t, server, 3, "POST", "/api/check-in/3/close", nil); status != http.StatusOK {
+		t.Fatalf("closing the check-in got status %d", status)
+	}
+	readEventOf(t, student, "check-in-closed")
+	if status, _ := apiRequest(t, server, 1, "POST", "/api/check-in/3", map[string]string{"code": code}); status != http.StatusConflict {
+		t.Errorf("checking in after the check-in closed got status %d, want %d", status, http.StatusConflict)
+	}
+}
+
+func TestStartSessionHidesAttendance(t *testing.T) {
+	server, dial := newWebsocketServer(t)
+	defer server.Close()
+	db := models.DB()
+
+	var lastID int
+	db.QueryRow("SELECT COALESCE(MAX(id), 0) FROM class_session").Scan(&lastID)
+	defer db.Exec("DELETE FROM class_session WHERE id > $1", lastID)
+	defer db.Exec("DELETE FROM attendance WHERE class_session_id > $1", lastID)
+
+	socket, _, err := dial(1, "/ws")
+	if err != nil {
+		t.Fatalf("dial failed with error: %v", err)
+	}
+	defer socket.Close()
+	waitFor(t, "the student to join", func() bool { return hub.Count(generalRoom) > 0 })
+
+	classSessionID, attendanceID, err := startClassSession(3, 3)
+	if err != nil {
+		t.Fatalf("start class session failed with error: %v", err)
+	}
+	defer db.Exec("UPDATE class_session SET in_progress = false WHERE id = $1", classSessionID)
+
+	// Every signed in user hears of the start, but not which attendance it takes
+	if started := readEventOf(t, socket, "start-session"); len(started) != 1 || int(started["sectionID"].(float64)) != 3 {
+		t.Errorf("start-session payload = %v, want only the section id", started)
+	}
+
+	// Section members get it from the check-in, others don't
+	path := fmt.Sprintf("/api/check-in/%d", classSessionID)
+	if status, reply := apiRequest(t, server, 1, "GET", path, nil); status != http.StatusOK || int(reply["attendanceID"].(float64)) != attendanceID {
+		t.Errorf("student check-in = %d %v, want attendance %d", status, reply, attendanceID)
+	}
+	if status, reply := apiRequest(t, server, 100000, "GET", path, nil); status != http.StatusForbidden || reply["attendanceID"] != nil {
+		t.Errorf("outsider check-in = %d %v, want status %d", status, reply, http.StatusForbidden)
+	}
+}
@@ -179,6 +179,11 @@ func LogoutGetHandler(c *gin.Context) {
 		return
 	}
 
+	// Close the user's websockets so they stop receiving class updates
+	if userIDInt, ok := userID.(int); ok {
+		revokeUserWebsockets(userIDInt)
+	}
+
 	// Clear the session
 	session.Clear()
 	err := session.Save()
@@ -315,15 +320,22 @@ func SettingsGetHandler(c *gin.Context) {
 		fmt.Println(err)
 	}
 
+	token, err := new(models.CalendarToken).GetByUserID(userIDint)
+	if err != nil {
+		fmt.Println(err)
+	}
+	calendarURL, _ := calendarFeedURLs(c, token, nil)
+
 	session.Set("timezone", s.TimezoneOffset)
 	session.Save()
 
 	RenderTemplate(c, http.StatusOK, "settings.html", gin.H{
-		"content":   "Settings page",
-		"checked":   checked,
-		"firstName": user.FirstName,
-		"lastName":  user.LastName,
-		"email":     user.Email,
+		"content":     "Settings page",
+		"checked":     checked,
+		"firstName":   user.FirstName,
+		"lastName":    user.LastName,
+		"email":       user.Email,
+		"calendarURL": calendarURL,
 	})
 }
 
@@ -402,6 +414,24 @@ func MyCoursesGetHandler(c *gin.Context) {
 	})
 }
 
+// MyAttendanceGetHandler shows the signed in user their own attendance in the sections they are enrolled in.
+func MyAttendanceGetHandler(c *gin.Context) {
+	session := sessions.Default(c)
+	userID := session.Get("userID").(int)
+	timezone, _ := session.Get("timezone").(string)
+	timezoneInt, _ := strconv.Atoi(timezone)
+
+	sections, err := new(models.StudentAttendance).GetByUser(userID, timezoneInt)
+	if err != nil {
+		log.Println("Failed to get attendance:", err)
+		return
+	}
+
+	RenderTemplate(c, http.StatusOK, "my-attendance.html", gin.H{
+		"sections": sections,
+	})
+}
+
 func MyCoursesDeleteHandler(c *gin.Context) {
 	sectionID := c.Param("sectionID")
 
@@ -419,6 +449,9 @@ func MyCoursesDeleteHandler(c *gin.Context) {
 		return
 	}
 
+	// Disconnect the former students from the section's class sessions
+	revokeWebsocketAccess(0, sectionIDInt)
+
 	c.Redirect(http.StatusSeeOther, "/")
 }
 
@@ -525,6 +558,9 @@ func SectionPostHandler(c *gin.Context) {
 		fmt.Println(err)
 	}
 
+	// Disconnect the user from the class sessions of the sections they left
+	revokeWebsocketAccess(userID, 0)
+
 	// Redirect to the my courses page
 	c.Redirect(http.StatusSeeOther, "/")
 }
@@ -563,11 +599,8 @@ func ClassSessionGetHandler(c *gin.Context) {
 		fmt.Println(err)
 	}
 
-	// Get the participant count for the class session
-	participantCount, err := new(models.ClassSession).GetParticipantCount(classSessionIDInt)
-	if err != nil {
-		fmt.Println(err)
-	}
+	// Count who is connected right now; the page's own websocket joins them once it opens
+	participantCount := relay.Present(classSessionIDInt)
 
 	// Get the course info from the database by the class session id
 	courseInfo, err := new(models.Course).GetBySectionId(sectionIDInt)
@@ -593,11 +626,19 @@ func ClassSessionGetHandler(c *gin.Context) {
 		fmt.Println(err)
 	}
 
+	// Get whether new questions wait in the moderation queue
+	premoderation, err := new(models.Section).GetPremoderation(sectionIDInt)
+	if err != nil {
+		fmt.Println(err)
+	}
+
 	session.Set("moderatorType", moderatorStatus.Type)
 	session.Save()
 
 	RenderTemplate(c, http.StatusOK, "class-session.html", gin.H{
 		"user":              userID,
+		"sectionID":         sectionIDInt,
+		"premoderation":     premoderation,
 		"moderatorStatus":   moderatorStatus,
 		"courseInfo":        courseInfo,
 		"scheduleInfo":      scheduleInfo,
@@ -605,95 +646,35 @@ func ClassSessionGetHandler(c *gin.Context) {
 		"timezone":          settings.TimezoneOffset,
 		"participantCount":  participantCount,
 	})
-
-	constructParticipantJoined(classSessionIDInt, participantCount)
 }
 func VoteUpPostHandler(c *gin.Context) {
 	session := sessions.Default(c)
 	userID := session.Get("userID").(int)
 
-	// Get the class section id from the session
-	classSessionID := session.Get("classSessionID").(string)
-
-	//parse the class section id to an int
-	classSessionIDInt, err := strconv.Atoi(classSessionID)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Get the question id from the form
-	questionID := c.Param("questionID")
-
 	// Parse the question id to an int
-	questionIDInt, err := strconv.Atoi(questionID)
-	if err != nil {
-		fmt.Println(err)
-	}
-	hasVoted, err := new(models.Question).HasVoted(questionIDInt, userID)
-	if err != nil {
-		fmt.Println(err)
-	}
-	if hasVoted {
-		c.JSON(http.StatusOK, gin.H{
-			"status": "already voted",
-		})
-		return
-	}
-
-	// Add the question to the database
-	err = new(models.Question).VoteQuestion(questionIDInt, userID)
-	if err != nil {
-		fmt.Println(err)
-	}
-	//send a 200 status code to the client if the vote was successful
-	c.JSON(http.StatusOK, gin.H{
-		"status": "success",
-	})
-
-	// Get the updated vote count for the question
-	updatedVoteCount, err := new(models.Question).GetVoteCount(questionIDInt)
+	questionIDInt, err := strconv.Atoi(c.Param("questionID"))
 	if err != nil {
-		log.Println("Error getting vote count:", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid question ID"})
 		return
 	}
 
-	// Broadcast the "vote-up" action to all WebSocket connections
-	constructVoteUp(classSessionIDInt, userID, questionIDInt, updatedVoteCount)
+	status, body := voteUpAction(userID, 0, questionIDInt)
+	c.JSON(status, body)
 }
 
 func MarkQuestionPostHandler(c *gin.Context) {
 	session := sessions.Default(c)
-
-	// Get the question id from the form
-	questionID := c.Param("questionID")
-
-	// Get the class section id from the session
-	classSessionID := session.Get("classSessionID").(string)
-
-	//parse the class section id to an int
-	classSessionIDInt, err := strconv.Atoi(classSessionID)
-	if err != nil {
-		fmt.Println(err)
-	}
+	userID := session.Get("userID").(int)
 
 	// Parse the question id to an int
-	questionIDInt, err := strconv.Atoi(questionID)
+	questionIDInt, err := strconv.Atoi(c.Param("questionID"))
 	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Add the question to the database
-	err = new(models.Question).MarkQuestion(questionIDInt)
-	if err != nil {
-		fmt.Println(err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid question ID"})
+		return
 	}
 
-	//send a 200 status code to the client if the vote was successful
-	c.JSON(http.StatusOK, gin.H{
-		"status": "success",
-	})
-
-	constructMarkQuestion(classSessionIDInt, questionIDInt)
+	status, body := markQuestionAction(userID, 0, questionIDInt)
+	c.JSON(status, body)
 }
 
 func AdminSettingsGetHandler(c *gin.Context) {
@@ -791,3 +772,97 @@ func InstructorAttendanceGetHandler(c *gin.Context) {
 		"courseInfo": courseInfo,
 	})
 }
+
+func InstructorSessionsGetHandler(c *gin.Context) {
+	session := sessions.Default(c)
+	timezone, _ := session.Get("timezone").(string)
+	timezoneInt, _ := strconv.Atoi(timezone)
+
+	sectionIDInt, err := strconv.Atoi(c.Param("sectionID"))
+	if err != nil || !isSectionStaff(c, sectionIDInt) {
+		RenderTemplate(c, http.StatusNotFound, "404.html", gin.H{})
+		return
+	}
+
+	// Get the course info for the section
+	courseInfo, err := new(models.Course).GetBySectionId(sectionIDInt)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Get every class session that has been started for the section
+	classSessions, err := new(models.ClassSession).GetBySectionID(sectionIDInt, timezoneInt)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	RenderTemplate(c, http.StatusOK, "session-history.html", gin.H{
+		"courseInfo":       courseInfo,
+		"historySectionID": sectionIDInt,
+		"classSessions":    classSessions,
+	})
+}
+
+func InstructorSessionGetHandler(c *gin.Context) {
+	session := sessions.Default(c)
+	timezone, _ := session.Get("timezone").(string)
+	timezoneInt, _ := strconv.Atoi(timezone)
+
+	sectionIDInt, err := strconv.Atoi(c.Param("sectionID"))
+	if err != nil || !isSectionStaff(c, sectionIDInt) {
+		RenderTemplate(c, http.StatusNotFound, "404.html", gin.H{})
+		return
+	}
+
+	// The class session must belong to the section
+	classSessionIDInt, err := strconv.Atoi(c.Param("classSessionID"))
+	if err != nil {
+		RenderTemplate(c, http.StatusNotFound, "404.html", gin.H{})
+		return
+	}
+	classSession, err := new(models.ClassSession).Get(classSessionIDInt, timezoneInt)
+	if err != nil || classSession.SectionID != sectionIDInt {
+		RenderTemplate(c, http.StatusNotFound, "404.html", gin.H{})
+		return
+	}
+
+	// Get the course info for the section
+	courseInfo, err := new(models.Course).GetBySectionId(sectionIDInt)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Get the questions asked during the class session
+	questions, err := new(models.Question).GetAllQuestions(classSessionIDInt, "created_at", timezoneInt)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Attach the answers given to them
+	answers, err := new(models.Answer).GetByClassSessionID(classSessionIDInt, timezoneInt)
+	if err != nil {
+		fmt.Println(err)
+	}
+	questions = new(models.Question).AnswersAppend(questions, answers)
+
+	// Get the polls run during the class session with their results
+	polls, err := new(models.Poll).GetByClassSessionID(classSessionIDInt, 0, timezoneInt)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Get the users who joined the class session
+	participants, err := new(models.Participant).GetParticipantDetails(classSessionIDInt)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	RenderTemplate(c, http.StatusOK, "session-archive.html", gin.H{
+		"courseInfo":       courseInfo,
+		"historySectionID": sectionIDInt,
+		"classSession":     classSession,
+		"questions":        questions,
+		"polls":            polls,
+		"participants":     participants,
+	})
+}
@@ -0,0 +1,370 @@
+package controllers
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+// generalRoom is the room of the connections that follow changes across the whole organization
+// rather than a single class session. Class session ids start at 1.
+const generalRoom = 0
+
+// sendBufferSize is how many messages may wait for a connection before it is treated as a slow consumer.
+const sendBufferSize = 64
+
+// eventLogSize is how many recent events of each class session are kept for clients that reconnect.
+const eventLogSize = 256
+
+// loggedEvent is an event published to a room with its sequence number.
+type loggedEvent struct {
+	seq     int64
+	message []byte
+}
+
+// eventLog numbers the events of a room and keeps the latest of them.
+type eventLog struct {
+	// seq is the sequence number of the last event published to the room
+	seq int64
+	// events holds the last published events, oldest first
+	events []loggedEvent
+}
+
+// Hub keeps track of the open websocket connections, grouped into rooms by class session,
+// and delivers broadcasts to them.
+//
+// Every connection has a buffered send queue that only its write pump reads. A broadcast never
+// blocks: a connection whose queue is full is a slow consumer and is evicted, which closes its
+// queue so the write pump closes the socket. Queues are only closed by the hub, with the lock
+// held and only while the connection is registered, so a queue is never closed twice or written
+// to after it is closed.
+//
+// Events published to a class session are numbered in the order they are queued, the same
+// for every client, and the last logSize of them are kept so a client that reconnects can
+// resume where it left off. Each hub numbers events on its own, so the numbers only mean
+// something to the hub's stream: a client that reconnects to another instance of the server
+// starts over from a snapshot.
+type Hub struct {
+	stream     string
+	mu         sync.RWMutex
+	rooms      map[int]map[*Connection]bool
+	logs       map[int]*eventLog
+	sendBuffer int
+	logSize    int
+	closed     bool
+}
+
+// NewHub returns an empty hub whose connections queue up to sendBuffer messages
+// and that keeps the last logSize events of each room.
+func NewHub(sendBuffer int, logSize int) *Hub {
+	id := make([]byte, 8)
+	rand.Read(id)
+
+	return &Hub{
+		stream:     hex.EncodeToString(id),
+		rooms:      make(map[int]map[*Connection]bool),
+		logs:       make(map[int]*eventLog),
+		sendBuffer: sendBuffer,
+		logSize:    logSize,
+	}
+}
+
+// hub holds every websocket connection of the server
+var hub = NewHub(sendBufferSize, eventLogSize)
+
+// Stream returns the id that sets the events numbered by this hub apart from those of other hubs.
+func (h *Hub) Stream() string {
+	return h.stream
+}
+
+// NewConnection returns a connection to a room with a send queue sized for the hub.
+func (h *Hub) NewConnection(conn *websocket.Conn, room int) *Connection {
+	return &Connection{
+		conn:       conn,
+		room:       room,
+		send:       make(chan []byte, h.sendBuffer),
+		done:       make(chan struct{}),
+		pingPeriod: websocketConfig.PingPeriod,
+		pongWait:   websocketConfig.PongWait,
+	}
+}
+
+// Register adds a connection to its room. It returns false once the hub is closed,
+// in which case the connection's queue is closed straight away.
+func (h *Hub) Register(c *Connection) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.add(c)
+}
+
+// add puts a connection in its room. The lock must be held.
+func (h *Hub) add(c *Connection) bool {
+	if h.closed {
+		close(c.send)
+		return false
+	}
+
+	if _, ok := h.rooms[c.room]; !ok {
+		h.rooms[c.room] = make(map[*Connection]bool)
+	}
+	h.rooms[c.room][c] = true
+	return true
+}
+
+// Resume adds a connection that last saw event lastSeq of its room in stream and queues the events it
+// missed, before any event published after it joined. When the events were numbered by another hub,
+// the missed events are no longer all logged, or they don't fit in its queue, none are queued and
+// the client needs a snapshot of the room instead. An empty stream is taken to be this hub's.
+// It returns the sequence number of the last event published to the room, whether the missed
+// events were queued, and false once the hub is closed.
+func (h *Hub) Resume(c *Connection, stream string, lastSeq int64) (int64, bool, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.add(c) {
+		return 0, false, false
+	}
+
+	log, ok := h.logs[c.room]
+	if !ok {
+		return 0, lastSeq == 0 && (stream == "" || stream == h.stream), true
+	}
+	if (stream != "" && stream != h.stream) || lastSeq > log.seq || log.seq-lastSeq > int64(cap(c.send)) {
+		return log.seq, false, true
+	}
+
+	missed := log.events
+	for len(missed) > 0 && missed[0].seq <= lastSeq {
+		missed = missed[1:]
+	}
+	if int64(len(missed)) != log.seq-lastSeq {
+		// Some of the missed events have already left the log
+		return log.seq, false, true
+	}
+	for _, event := range missed {
+		c.send <- event.message
+	}
+
+	return log.seq, true, true
+}
+
+// serve runs a connection until the client leaves or the hub drops it. join adds the connection
+// to the hub and returns false if it couldn't.
+func (h *Hub) serve(c *Connection, join func() bool) {
+	go c.writePump()
+	if join() {
+		c.readPump()
+		h.Unregister(c)
+	}
+	<-c.done
+}
+
+// Unregister removes a connection from its room and closes its send queue.
+// It is safe to call more than once and after the connection was evicted.
+func (h *Hub) Unregister(c *Connection) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.remove(c)
+}
+
+// remove drops a connection and closes its queue if it is still registered. The lock must be held.
+func (h *Hub) remove(c *Connection) {
+	conns, ok := h.rooms[c.room]
+	if !ok || !conns[c] {
+		return
+	}
+
+	delete(conns, c)
+	if len(conns) == 0 {
+		delete(h.rooms, c.room)
+	}
+	close(c.send)
+}
+
+// drop removes a connection that is closed for the given reason. The lock must be held.
+// It returns false if the connection was already gone.
+func (h *Hub) drop(c *Connection, code int, reason string) bool {
+	if !h.rooms[c.room][c] {
+		return false
+	}
+	c.closeCode, c.closeReason = code, reason
+	h.remove(c)
+	return true
+}
+
+// Broadcast queues a message for every connection in a room without waiting on any of them.
+// Connections whose queue is full are evicted.
+// It returns the number of connections the message was queued for.
+func (h *Hub) Broadcast(room int, message []byte) int {
+	var slow []*Connection
+	sent := 0
+
+	h.mu.RLock()
+	for c := range h.rooms[room] {
+		select {
+		case c.send <- message:
+			sent++
+		default:
+			slow = append(slow, c)
+		}
+	}
+	h.mu.RUnlock()
+
+	if len(slow) > 0 {
+		h.mu.Lock()
+		for _, c := range slow {
+			h.drop(c, websocket.CloseTryAgainLater, "client too slow")
+		}
+		h.mu.Unlock()
+	}
+
+	return sent
+}
+
+// Publish numbers the next event of a room, logs it and queues it for every connection in the room.
+// stamp builds the message of the event from its sequence number. Connections whose queue is full
+// are evicted.
+// It returns the sequence number of the event and any error returned by stamp.
+func (h *Hub) Publish(room int, stamp func(seq int64) ([]byte, error)) (int64, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	log, ok := h.logs[room]
+	if !ok {
+		log = &eventLog{}
+		h.logs[room] = log
+	}
+
+	message, err := stamp(log.seq + 1)
+	if err != nil {
+		return 0, err
+	}
+	log.seq++
+	log.events = append(log.events, loggedEvent{seq: log.seq, message: message})
+	if len(log.events) > h.logSize {
+		log.events = log.events[len(log.events)-h.logSize:]
+	}
+
+	for c := range h.rooms[room] {
+		select {
+		case c.send <- message:
+		default:
+			h.drop(c, websocket.CloseTryAgainLater, "client too slow")
+		}
+	}
+
+	return log.seq, nil
+}
+
+// Send queues a message for a single connection, evicting it if its queue is full.
+// It returns false if the connection is no longer registered or was evicted.
+func (h *Hub) Send(c *Connection, message []byte) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.rooms[c.room][c] {
+		return false
+	}
+
+	select {
+	case c.send <- message:
+		return true
+	default:
+		h.drop(c, websocket.CloseTryAgainLater, "client too slow")
+		return false
+	}
+}
+
+// SendTo queues a message for the connections of a room for which to returns true, without numbering
+// or logging it, for events only some of the room may see. to is called without the lock held, so it
+// may query the database. Connections whose queue is full are evicted.
+// It returns the number of connections the message was queued for.
+func (h *Hub) SendTo(room int, to func(c *Connection) bool, message []byte) int {
+	var conns []*Connection
+	h.mu.RLock()
+	for c := range h.rooms[room] {
+		conns = append(conns, c)
+	}
+	h.mu.RUnlock()
+
+	sent := 0
+	for _, c := range conns {
+		if to(c) && h.Send(c, message) {
+			sent++
+		}
+	}
+	return sent
+}
+
+// Forget drops the event log of a room, once its class session is over.
+func (h *Hub) Forget(room int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.logs, room)
+}
+
+// Revoke closes the connections for which revoked returns true with a close code and reason.
+// revoked is called without the lock held, so it may query the database.
+// It returns the number of connections closed.
+func (h *Hub) Revoke(revoked func(c *Connection) bool, code int, reason string) int {
+	var conns []*Connection
+	h.mu.RLock()
+	for _, room := range h.rooms {
+		for c := range room {
+			conns = append(conns, c)
+		}
+	}
+	h.mu.RUnlock()
+
+	var dropped []*Connection
+	for _, c := range conns {
+		if revoked(c) {
+			dropped = append(dropped, c)
+		}
+	}
+	if len(dropped) == 0 {
+		return 0
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	closed := 0
+	for _, c := range dropped {
+		if h.drop(c, code, reason) {
+			closed++
+		}
+	}
+	return closed
+}
+
+// Count returns the number of connections in a room.
+func (h *Hub) Count(room int) int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return len(h.rooms[room])
+}
+
+// Close unregisters every connection, which closes their sockets, and refuses new ones.
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for _, conns := range h.rooms {
+		for c := range conns {
+			h.drop(c, websocket.CloseGoingAway, "server shutting down")
+		}
+	}
+}
+
+// CloseWebsockets closes every open websocket connection, for use when the server shuts down.
+func CloseWebsockets() {
+	hub.Close()
+}
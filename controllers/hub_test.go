@@ -0,0 +1,802 @@
+package controllers
+
+import (
+	"coeus/globals"
+	"coeus/models"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-contrib/sessions/cookie"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+// drain reads a simulated client's queue until the hub closes it.
+// It returns the messages received.
+func drain(c *Connection) [][]byte {
+	var received [][]byte
+	for message := range c.send {
+		received = append(received, message)
+	}
+	return received
+}
+
+// waitFor polls cond until it holds or a few seconds have passed.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestHubBroadcast(t *testing.T) {
+	const clients = 300
+	const senders = 8
+	const messagesPerSender = 25
+
+	h := NewHub(senders*messagesPerSender, eventLogSize)
+
+	conns := make([]*Connection, clients)
+	results := make([][][]byte, clients)
+	var registered, readers sync.WaitGroup
+	for i := range conns {
+		conns[i] = h.NewConnection(nil, 1)
+		registered.Add(1)
+		readers.Add(1)
+		go func(i int) {
+			defer readers.Done()
+			h.Register(conns[i])
+			registered.Done()
+			results[i] = drain(conns[i])
+		}(i)
+	}
+	registered.Wait()
+
+	if count := h.Count(1); count != clients {
+		t.Fatalf("count = %d, want %d", count, clients)
+	}
+
+	var senderGroup sync.WaitGroup
+	for s := 0; s < senders; s++ {
+		senderGroup.Add(1)
+		go func(s int) {
+			defer senderGroup.Done()
+			for m := 0; m < messagesPerSender; m++ {
+				if sent := h.Broadcast(1, []byte(fmt.Sprintf("%d-%d", s, m))); sent != clients {
+					t.Errorf("broadcast reached %d clients, want %d", sent, clients)
+				}
+			}
+		}(s)
+	}
+	senderGroup.Wait()
+
+	// Other rooms don't see the class session's messages
+	if sent := h.Broadcast(2, []byte("elsewhere")); sent != 0 {
+		t.Errorf("broadcast to an empty room reached %d clients", sent)
+	}
+
+	// Unregistering twice must not close a queue twice
+	var leaving sync.WaitGroup
+	for _, c := range conns {
+		leaving.Add(2)
+		go func(c *Connection) {
+			defer leaving.Done()
+			h.Unregister(c)
+		}(c)
+		go func(c *Connection) {
+			defer leaving.Done()
+			h.Unregister(c)
+		}(c)
+	}
+	leaving.Wait()
+	readers.Wait()
+
+	for i, received := range results {
+		if len(received) != senders*messagesPerSender {
+			t.Fatalf("client %d received %d messages, want %d", i, len(received), senders*messagesPerSender)
+		}
+		// Messages of one sender arrive in the order they were sent
+		next := make([]int, senders)
+		for _, message := range received {
+			var s, m int
+			fmt.Sscanf(string(message), "%d-%d", &s, &m)
+			if m != next[s] {
+				t.Fatalf("client %d received message %d of sender %d, want %d", i, m, s, next[s])
+			}
+			next[s]++
+		}
+	}
+
+	if count := h.Count(1); count != 0 {
+		t.Errorf("count after everyone left = %d, want 0", count)
+	}
+}
+
+func TestHubEvictsSlowConsumer(t *testing.T) {
+	const clients = 200
+	h := NewHub(4, eventLogSize)
+
+	slow := h.NewConnection(nil, 7)
+	h.Register(slow)
+
+	conns := make([]*Connection, clients)
+	results := make([][][]byte, clients)
+	var readers sync.WaitGroup
+	for i := range conns {
+		conns[i] = h.NewConnection(nil, 7)
+		h.Register(conns[i])
+		readers.Add(1)
+		go func(i int) {
+			defer readers.Done()
+			results[i] = drain(conns[i])
+		}(i)
+	}
+
+	// The fast clients are given time to keep up; the slow one never reads
+	for m := 0; m < 20; m++ {
+		h.Broadcast(7, []byte("message"))
+		waitFor(t, "fast clients to read", func() bool {
+			for _, c := range conns {
+				if len(c.send) > 0 {
+					return false
+				}
+			}
+			return true
+		})
+	}
+
+	if slow.closeCode != websocket.CloseTryAgainLater {
+		t.Fatalf("slow client close code = %d, want it evicted with %d", slow.closeCode, websocket.CloseTryAgainLater)
+	}
+	if got := len(drain(slow)); got != 4 {
+		t.Errorf("slow client had %d queued messages, want 4", got)
+	}
+	if count := h.Count(7); count != clients {
+		t.Errorf("count = %d, want %d fast clients", count, clients)
+	}
+
+	// An evicted client leaving afterwards is harmless
+	h.Unregister(slow)
+
+	for _, c := range conns {
+		h.Unregister(c)
+	}
+	readers.Wait()
+	for i, received := range results {
+		if len(received) != 20 {
+			t.Fatalf("fast client %d received %d messages, want 20", i, len(received))
+		}
+	}
+}
+
+func TestHubChurn(t *testing.T) {
+	h := NewHub(8, eventLogSize)
+
+	// Clients join and leave several rooms while messages are broadcast to them
+	var wg sync.WaitGroup
+	for i := 0; i < 500; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			c := h.NewConnection(nil, i%5)
+			if !h.Register(c) {
+				t.Error("register failed on an open hub")
+				return
+			}
+			done := make(chan struct{})
+			go func() {
+				drain(c)
+				close(done)
+			}()
+			h.Broadcast(i%5, []byte("hello"))
+			h.Unregister(c)
+			<-done
+		}(i)
+	}
+	for s := 0; s < 10; s++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for m := 0; m < 100; m++ {
+				h.Broadcast(m%5, []byte("tick"))
+			}
+		}()
+	}
+	wg.Wait()
+
+	for room := 0; room < 5; room++ {
+		if count := h.Count(room); count != 0 {
+			t.Errorf("room %d has %d connections left", room, count)
+		}
+	}
+}
+
+func TestHubClose(t *testing.T) {
+	h := NewHub(1, eventLogSize)
+
+	conns := make([]*Connection, 100)
+	var readers sync.WaitGroup
+	for i := range conns {
+		conns[i] = h.NewConnection(nil, i%3)
+		h.Register(conns[i])
+		readers.Add(1)
+		go func(c *Connection) {
+			defer readers.Done()
+			drain(c)
+		}(conns[i])
+	}
+
+	h.Close()
+	readers.Wait()
+
+	for _, c := range conns {
+		h.Unregister(c)
+	}
+	late := h.NewConnection(nil, 1)
+	if h.Register(late) {
+		t.Error("register succeeded on a closed hub")
+	}
+	if _, ok := <-late.send; ok {
+		t.Error("queue of a connection refused by a closed hub is open")
+	}
+}
+
+func TestHubSendTo(t *testing.T) {
+	h := NewHub(4, eventLogSize)
+
+	conns := make([]*Connection, 4)
+	for i := range conns {
+		// Connections 0 to 2 are in room 1, connection 3 in room 2
+		room := 1
+		if i == 3 {
+			room = 2
+		}
+		conns[i] = h.NewConnection(nil, room)
+		conns[i].userID = i
+		h.Register(conns[i])
+	}
+
+	sent := h.SendTo(1, func(c *Connection) bool { return c.userID != 1 }, []byte("private"))
+	if sent != 2 {
+		t.Errorf("sent to %d connections, want 2", sent)
+	}
+	// The message isn't numbered or logged for the connections that resume
+	if seq, _, _ := h.Resume(h.NewConnection(nil, 1), "", 0); seq != 0 {
+		t.Errorf("room 1 is at event %d after a private message, want 0", seq)
+	}
+
+	h.Close()
+	for i, c := range conns {
+		received := drain(c)
+		want := i == 0 || i == 2
+		if got := len(received) == 1 && string(received[0]) == "private"; got != want {
+			t.Errorf("connection %d received %q", i, received)
+		}
+	}
+}
+
+func TestHubPublishOrder(t *testing.T) {
+	const clients = 200
+	const publishers = 10
+	const eventsPerPublisher = 20
+
+	h := NewHub(publishers*eventsPerPublisher, eventLogSize)
+
+	conns := make([]*Connection, clients)
+	results := make([][][]byte, clients)
+	var readers sync.WaitGroup
+	for i := range conns {
+		conns[i] = h.NewConnection(nil, 5)
+		h.Register(conns[i])
+		readers.Add(1)
+		go func(i int) {
+			defer readers.Done()
+			results[i] = drain(conns[i])
+		}(i)
+	}
+
+	var wg sync.WaitGroup
+	for p := 0; p < publishers; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for e := 0; e < eventsPerPublisher; e++ {
+				h.Publish(5, func(seq int64) ([]byte, error) {
+					return []byte(strconv.FormatInt(seq, 10)), nil
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, c := range conns {
+		h.Unregister(c)
+	}
+	readers.Wait()
+
+	// Every client sees every event once, in sequence order
+	for i, received := range results {
+		if len(received) != publishers*eventsPerPublisher {
+			t.Fatalf("client %d received %d events, want %d", i, len(received), publishers*eventsPerPublisher)
+		}
+		for j, message := range received {
+			if string(message) != strconv.Itoa(j+1) {
+				t.Fatalf("client %d received event %s at position %d, want %d", i, message, j, j+1)
+			}
+		}
+	}
+}
+
+func TestHubResume(t *testing.T) {
+	h := NewHub(8, 5)
+	publish := func(room int, n int) {
+		for i := 0; i < n; i++ {
+			h.Publish(room, func(seq int64) ([]byte, error) {
+				return []byte(strconv.FormatInt(seq, 10)), nil
+			})
+		}
+	}
+	resume := func(room int, lastSeq int64) (*Connection, int64, bool) {
+		c := h.NewConnection(nil, room)
+		seq, replayed, ok := h.Resume(c, "", lastSeq)
+		if !ok {
+			t.Fatal("resume failed on an open hub")
+		}
+		return c, seq, replayed
+	}
+	received := func(c *Connection) []string {
+		var messages []string
+		for len(c.send) > 0 {
+			messages = append(messages, string(<-c.send))
+		}
+		return messages
+	}
+
+	// Nothing was published yet, so a client that saw nothing missed nothing
+	c, seq, replayed := resume(1, 0)
+	if seq != 0 || !replayed || len(c.send) != 0 {
+		t.Errorf("resume of an empty room = %d, %v with %d queued", seq, replayed, len(c.send))
+	}
+	h.Unregister(c)
+
+	publish(1, 8)
+
+	// The missed events are still logged
+	c, seq, replayed = resume(1, 5)
+	if seq != 8 || !replayed {
+		t.Errorf("resume from 5 = %d, %v, want 8, true", seq, replayed)
+	}
+	publish(1, 1)
+	if got := strings.Join(received(c), ","); got != "6,7,8,9" {
+		t.Errorf("resumed client received %s, want 6,7,8,9", got)
+	}
+	h.Unregister(c)
+
+	// Up to date clients get nothing
+	c, seq, replayed = resume(1, 9)
+	if seq != 9 || !replayed || len(c.send) != 0 {
+		t.Errorf("resume from the last event = %d, %v with %d queued", seq, replayed, len(c.send))
+	}
+	h.Unregister(c)
+
+	// Only the last five events are logged
+	c, seq, replayed = resume(1, 3)
+	if seq != 9 || replayed || len(c.send) != 0 {
+		t.Errorf("resume from an evicted event = %d, %v with %d queued, want a snapshot", seq, replayed, len(c.send))
+	}
+	h.Unregister(c)
+
+	// A client ahead of the log saw events from before the log was forgotten
+	h.Forget(1)
+	c, seq, replayed = resume(1, 9)
+	if seq != 0 || replayed {
+		t.Errorf("resume after forget = %d, %v, want a snapshot", seq, replayed)
+	}
+	h.Unregister(c)
+
+	// Events numbered by another hub can't be replayed here
+	publish(1, 3)
+	c = h.NewConnection(nil, 1)
+	if seq, replayed, _ := h.Resume(c, "another-stream", 1); seq != 3 || replayed {
+		t.Errorf("resume from another stream = %d, %v, want a snapshot", seq, replayed)
+	}
+	h.Unregister(c)
+	c = h.NewConnection(nil, 1)
+	if _, replayed, _ := h.Resume(c, h.Stream(), 1); !replayed {
+		t.Error("resume from the hub's own stream wasn't replayed")
+	}
+	h.Unregister(c)
+
+	// A gap larger than the queue is sent as a snapshot as well
+	h = NewHub(2, 10)
+	publish(2, 6)
+	c, _, replayed = resume(2, 1)
+	if replayed {
+		t.Error("resume with more missed events than the queue holds replayed them")
+	}
+	h.Unregister(c)
+}
+
+// newWebsocketServer starts a server with the websocket and API routes and a /test-sign-in/:userID
+// route that signs the client in. It returns the server and a function that connects a client
+// signed in as userID (0 for none) to path.
+func newWebsocketServer(t *testing.T) (*httptest.Server, func(userID int, path string) (*websocket.Conn, *http.Response, error)) {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(sessions.Sessions("session", cookie.NewStore(globals.Secret)))
+	router.GET("/test-sign-in/:userID", func(c *gin.Context) {
+		userID, _ := strconv.Atoi(c.Param("userID"))
+		session := sessions.Default(c)
+		session.Set("userID", userID)
+		session.Save()
+	})
+	WSRoutes(router.Group("/"))
+	APIRoutes(router.Group("/"))
+	server := httptest.NewServer(router)
+
+	cookies := make(map[int]string)
+	dial := func(userID int, path string) (*websocket.Conn, *http.Response, error) {
+		header := http.Header{}
+		if userID != 0 {
+			if _, ok := cookies[userID]; !ok {
+				response, err := http.Get(fmt.Sprintf("%s/test-sign-in/%d", server.URL, userID))
+				if err != nil {
+					t.Fatalf("sign in failed with error: %v", err)
+				}
+				response.Body.Close()
+				cookies[userID] = response.Header.Get("Set-Cookie")
+			}
+			header.Set("Cookie", cookies[userID])
+		}
+		return websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+path, header)
+	}
+
+	return server, dial
+}
+
+// expectClose reads from a socket until it is closed and checks the close code.
+func expectClose(t *testing.T, socket *websocket.Conn, code int) {
+	t.Helper()
+	socket.SetReadDeadline(time.Now().Add(5 * time.Second))
+	for {
+		_, _, err := socket.ReadMessage()
+		if err == nil {
+			continue
+		}
+		if !websocket.IsCloseError(err, code) {
+			t.Errorf("socket closed with %v, want close code %d", err, code)
+		}
+		return
+	}
+}
+
+func TestWebsocketBroadcast(t *testing.T) {
+	const clients = 200
+	const classSessionID = 3
+
+	server, dial := newWebsocketServer(t)
+	defer server.Close()
+
+	sockets := make([]*websocket.Conn, clients)
+	for i := range sockets {
+		socket, _, err := dial(1, fmt.Sprintf("/ws/%d", classSessionID))
+		if err != nil {
+			t.Fatalf("dial failed with error: %v", err)
+		}
+		defer socket.Close()
+		sockets[i] = socket
+	}
+	waitFor(t, "clients to join", func() bool { return hub.Count(classSessionID) == clients })
+
+	constructVoteUp(classSessionID, 1, 1, clients)
+	constructMarkQuestion(classSessionID, 1)
+
+	var wg sync.WaitGroup
+	for i, socket := range sockets {
+		wg.Add(1)
+		go func(i int, socket *websocket.Conn) {
+			defer wg.Done()
+			socket.SetReadDeadline(time.Now().Add(5 * time.Second))
+			for _, action := range []string{"vote-up", "mark-question"} {
+				var message map[string]interface{}
+				if err := socket.ReadJSON(&message); err != nil {
+					t.Errorf("client %d read failed with error: %v", i, err)
+					return
+				}
+				// The first clients may see the user arrive
+				if message["type"] == "participant-joined" {
+					if err := socket.ReadJSON(&message); err != nil {
+						t.Errorf("client %d read failed with error: %v", i, err)
+						return
+					}
+				}
+				if message["type"] != action {
+					t.Errorf("client %d received %v, want %s", i, message["type"], action)
+				}
+			}
+		}(i, socket)
+	}
+	wg.Wait()
+
+	// Clients that hang up are dropped from the room
+	for _, socket := range sockets {
+		socket.Close()
+	}
+	waitFor(t, "clients to leave", func() bool { return hub.Count(classSessionID) == 0 })
+}
+
+func TestWebsocketAuthorization(t *testing.T) {
+	server, dial := newWebsocketServer(t)
+	defer server.Close()
+
+	tests := []struct {
+		name   string
+		userID int
+		path   string
+		status int
+	}{
+		{"general without a session", 0, "/ws", http.StatusUnauthorized},
+		{"class session without a session", 0, "/ws/3", http.StatusUnauthorized},
+		{"class session of another section", 2000, "/ws/3", http.StatusForbidden},
+		{"unknown class session", 1, "/ws/999999", http.StatusNotFound},
+		{"general when signed in", 2000, "/ws", http.StatusSwitchingProtocols},
+		{"student of the section", 1, "/ws/3", http.StatusSwitchingProtocols},
+		{"instructor of the section", 3, "/ws/3", http.StatusSwitchingProtocols},
+	}
+
+	for _, test := range tests {
+		socket, response, err := dial(test.userID, test.path)
+		if socket != nil {
+			socket.Close()
+		}
+		if response == nil {
+			t.Errorf("%s: dial failed with error: %v", test.name, err)
+			continue
+		}
+		if response.StatusCode != test.status {
+			t.Errorf("%s: status = %d, want %d", test.name, response.StatusCode, test.status)
+		}
+	}
+}
+
+func TestWebsocketRevoke(t *testing.T) {
+	server, dial := newWebsocketServer(t)
+	defer server.Close()
+
+	// User 2000 joins section 3 for the test and leaves it while connected
+	if err := new(models.Section).AddEnrollment(3, 2000); err != nil {
+		t.Fatalf("addEnrollment failed with error: %v", err)
+	}
+	defer new(models.Section).DeleteBySectionId(3, 2000)
+	defer models.DB().Exec("DELETE FROM participants WHERE session_id = 3 AND user_id = 2000")
+
+	student, _, err := dial(2000, "/ws/3")
+	if err != nil {
+		t.Fatalf("dial failed with error: %v", err)
+	}
+	defer student.Close()
+	instructor, _, err := dial(3, "/ws/3")
+	if err != nil {
+		t.Fatalf("dial failed with error: %v", err)
+	}
+	defer instructor.Close()
+	general, _, err := dial(2000, "/ws")
+	if err != nil {
+		t.Fatalf("dial failed with error: %v", err)
+	}
+	defer general.Close()
+	waitFor(t, "clients to join", func() bool { return hub.Count(3) == 2 && hub.Count(generalRoom) == 1 })
+
+	// Nothing is revoked while everyone still belongs to the section
+	revokeWebsocketAccess(0, 3)
+	if count := hub.Count(3); count != 2 {
+		t.Fatalf("count = %d after revoking nobody, want 2", count)
+	}
+
+	// A student who becomes a moderator is asked to reconnect with their new role
+	if _, err := new(models.Moderator).Add(2000, 3, "teacher assistant"); err != nil {
+		t.Fatalf("add moderator failed with error: %v", err)
+	}
+	defer new(models.Moderator).Delete(2000, 3)
+	revokeWebsocketAccess(2000, 3)
+	expectClose(t, student, websocket.CloseServiceRestart)
+	student, _, err = dial(2000, "/ws/3")
+	if err != nil {
+		t.Fatalf("dial failed with error: %v", err)
+	}
+	defer student.Close()
+	waitFor(t, "the moderator to join", func() bool { return hub.Count(3) == 2 })
+	revokeWebsocketAccess(2000, 3)
+	if count := hub.Count(3); count != 2 {
+		t.Fatalf("count = %d after revoking a moderator who still is one, want 2", count)
+	}
+	if err := new(models.Moderator).Delete(2000, 3); err != nil {
+		t.Fatalf("delete moderator failed with error: %v", err)
+	}
+
+	if err := new(models.Section).DeleteBySectionId(3, 2000); err != nil {
+		t.Fatalf("deleteBySectionId failed with error: %v", err)
+	}
+	revokeWebsocketAccess(2000, 0)
+	expectClose(t, student, websocket.ClosePolicyViolation)
+	if count := hub.Count(3); count != 1 {
+		t.Errorf("count = %d after the student left, want the instructor only", count)
+	}
+
+	// Signing out closes the general connection too
+	revokeUserWebsockets(2000)
+	expectClose(t, general, websocket.ClosePolicyViolation)
+}
+
+func TestCheckOrigin(t *testing.T) {
+	defer ConfigureWebsockets(websocketConfig)
+
+	request := func(host, origin string) *http.Request {
+		r := httptest.NewRequest("GET", "/ws", nil)
+		r.Host = host
+		if origin != "" {
+			r.Header.Set("Origin", origin)
+		}
+		return r
+	}
+
+	ConfigureWebsockets(WebsocketConfig{})
+	if !checkOrigin(request("coeus.example.edu", "https://coeus.example.edu")) {
+		t.Error("same origin was refused")
+	}
+	if !checkOrigin(request("coeus.example.edu", "")) {
+		t.Error("request without an origin was refused")
+	}
+	if checkOrigin(request("coeus.example.edu", "https://evil.example.com")) {
+		t.Error("foreign origin was accepted")
+	}
+
+	t.Setenv("WS_ALLOWED_ORIGINS", "https://lms.example.edu/, http://localhost:3000")
+	ConfigureWebsockets(DefaultWebsocketConfig())
+	if !checkOrigin(request("coeus.example.edu", "https://LMS.example.edu")) {
+		t.Error("allowed origin was refused")
+	}
+	if !checkOrigin(request("coeus.example.edu", "http://localhost:3000")) {
+		t.Error("allowed origin with a port was refused")
+	}
+	if checkOrigin(request("coeus.example.edu", "http://lms.example.edu")) {
+		t.Error("allowed host with another scheme was accepted")
+	}
+
+	ConfigureWebsockets(WebsocketConfig{AllowedOrigins: []string{"*"}})
+	if !checkOrigin(request("coeus.example.edu", "https://evil.example.com")) {
+		t.Error("wildcard did not allow every origin")
+	}
+}
+
+func TestWebsocketHeartbeat(t *testing.T) {
+	cfg := DefaultWebsocketConfig()
+	cfg.PingPeriod = 20 * time.Millisecond
+	cfg.PongWait = 100 * time.Millisecond
+	defer ConfigureWebsockets(websocketConfig)
+	ConfigureWebsockets(cfg)
+
+	server, dial := newWebsocketServer(t)
+	defer server.Close()
+
+	// A client that reads answers pings; one that never reads doesn't
+	alive, _, err := dial(1, "/ws/3")
+	if err != nil {
+		t.Fatalf("dial failed with error: %v", err)
+	}
+	defer alive.Close()
+	readErr := make(chan error, 1)
+	go func() {
+		for {
+			if _, _, err := alive.ReadMessage(); err != nil {
+				readErr <- err
+				return
+			}
+		}
+	}()
+
+	dead, _, err := dial(1, "/ws/3")
+	if err != nil {
+		t.Fatalf("dial failed with error: %v", err)
+	}
+	defer dead.Close()
+
+	waitFor(t, "clients to join", func() bool { return hub.Count(3) == 2 })
+	waitFor(t, "the silent client to be dropped", func() bool { return hub.Count(3) == 1 })
+
+	time.Sleep(5 * cfg.PongWait)
+	select {
+	case err := <-readErr:
+		t.Fatalf("client answering pings was disconnected: %v", err)
+	default:
+	}
+	if count := hub.Count(3); count != 1 {
+		t.Errorf("count = %d, want the client answering pings", count)
+	}
+
+	alive.Close()
+	waitFor(t, "clients to leave", func() bool { return hub.Count(3) == 0 })
+}
+
+func TestWebsocketResume(t *testing.T) {
+	server, dial := newWebsocketServer(t)
+	defer server.Close()
+	defer hub.Forget(3)
+
+	readEvent := func(socket *websocket.Conn) map[string]interface{} {
+		t.Helper()
+		socket.SetReadDeadline(time.Now().Add(5 * time.Second))
+		var event map[string]interface{}
+		if err := socket.ReadJSON(&event); err != nil {
+			t.Fatalf("read failed with error: %v", err)
+		}
+		return event
+	}
+
+	socket, _, err := dial(1, "/ws/3")
+	if err != nil {
+		t.Fatalf("dial failed with error: %v", err)
+	}
+
+	// The client sees itself arrive
+	event := readEvent(socket)
+	if event["type"] != "participant-joined" {
+		t.Fatalf("first event = %v, want participant-joined", event["type"])
+	}
+	lastSeq := int64(event["seq"].(float64))
+	socket.Close()
+	waitFor(t, "client to leave", func() bool { return relay.Present(3) == 0 })
+
+	// Events published while the client is away are replayed in order when it comes back,
+	// before it arrives again
+	constructVoteUp(3, 1, 6, 2)
+	constructMarkQuestion(3, 6)
+	socket, _, err = dial(1, fmt.Sprintf("/ws/3?lastSeq=%d", lastSeq))
+	if err != nil {
+		t.Fatalf("dial failed with error: %v", err)
+	}
+	defer socket.Close()
+	for i, action := range []string{"participant-left", "vote-up", "mark-question", "participant-joined"} {
+		event := readEvent(socket)
+		if event["type"] != action || int64(event["seq"].(float64)) != lastSeq+int64(i)+1 {
+			t.Errorf("replayed event %d = %v %v, want %s %d", i, event["type"], event["seq"], action, lastSeq+int64(i)+1)
+		}
+	}
+
+	// Once the log is gone the client is sent a snapshot
+	hub.Forget(3)
+	snapshotSocket, _, err := dial(1, fmt.Sprintf("/ws/3?lastSeq=%d", lastSeq))
+	if err != nil {
+		t.Fatalf("dial failed with error: %v", err)
+	}
+	defer snapshotSocket.Close()
+	snapshot := readEvent(snapshotSocket)
+	if snapshot["type"] != "snapshot" || snapshot["seq"].(float64) != 0 {
+		t.Errorf("event after the log was forgotten = %v %v, want a snapshot", snapshot["type"], snapshot["seq"])
+	}
+	questions, ok := payload(snapshot)["questionsByTime"].([]interface{})
+	if !ok || len(questions) == 0 {
+		t.Fatalf("snapshot has no questions: %v", payload(snapshot)["questionsByTime"])
+	}
+	// The questions of a snapshot are named like those of the other events
+	if question := questions[0].(map[string]interface{}); question["questionID"] == nil || question["text"] == nil || question["ID"] != nil {
+		t.Errorf("snapshot question = %v, want questionID and text", question)
+	}
+
+	if _, response, _ := dial(1, "/ws/3?lastSeq=abc"); response == nil || response.StatusCode != http.StatusBadRequest {
+		t.Error("invalid lastSeq was accepted")
+	}
+}
@@ -0,0 +1,60 @@
+package controllers
+
+import (
+	"coeus/models"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestQuestionRejectionPrivacy(t *testing.T) {
+	server, dial := newWebsocketServer(t)
+	defer server.Close()
+	db := models.DB()
+
+	// User 1 asks the questions, user 22 is another student of section 3 and user 3 its instructor
+	sockets := map[int]*websocket.Conn{}
+	for _, userID := range []int{1, 22, 3} {
+		socket, _, err := dial(userID, "/ws/3")
+		if err != nil {
+			t.Fatalf("dial failed with error: %v", err)
+		}
+		defer socket.Close()
+		sockets[userID] = socket
+	}
+
+	rejected, err := new(models.Question).PostPendingQuestion(1, 3, "Can I skip the midterm?")
+	if err != nil {
+		t.Fatalf("post pending question failed with error: %v", err)
+	}
+	defer db.Exec("DELETE FROM question WHERE id = $1", rejected)
+	approved, err := new(models.Question).PostPendingQuestion(1, 3, "Is the midterm open book?")
+	if err != nil {
+		t.Fatalf("post pending question failed with error: %v", err)
+	}
+	defer db.Exec("DELETE FROM question WHERE id = $1", approved)
+
+	reason := "Ask the instructor in private"
+	if status, data := apiRequest(t, server, 3, "POST", fmt.Sprintf("/api/moderation/%d/reject", rejected), map[string]interface{}{"reason": reason}); status != http.StatusOK {
+		t.Fatalf("reject got status %d: %v", status, data)
+	}
+	if status, data := apiRequest(t, server, 3, "POST", fmt.Sprintf("/api/moderation/%d/approve", approved), map[string]interface{}{}); status != http.StatusOK {
+		t.Fatalf("approve got status %d: %v", status, data)
+	}
+
+	// The author and the instructor learn why the question was rejected
+	for _, userID := range []int{1, 3} {
+		event := readEventOf(t, sockets[userID], "question-moderated")
+		if event["questionID"] != float64(rejected) || event["userID"] != float64(1) || event["reason"] != reason {
+			t.Errorf("user %d got %v, want the rejection", userID, event)
+		}
+	}
+
+	// The classmate only learns that the other question was approved, not who asked it
+	event := readEventOf(t, sockets[22], "question-moderated")
+	if event["questionID"] != float64(approved) || event["status"] != models.QuestionApproved || event["userID"] != float64(0) || event["reason"] != "" {
+		t.Errorf("classmate got %v, want the anonymous approval only", event)
+	}
+}
@@ -0,0 +1,178 @@
+package controllers
+
+import (
+	"bytes"
+	"coeus/models"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// apiRequest sends a JSON request to the test server as a signed in user and returns the status and body of the response.
+func apiRequest(t *testing.T, server *httptest.Server, userID int, method string, path string, body interface{}) (int, map[string]interface{}) {
+	t.Helper()
+
+	response, err := http.Get(fmt.Sprintf("%s/test-sign-in/%d", server.URL, userID))
+	if err != nil {
+		t.Fatalf("sign in failed with error: %v", err)
+	}
+	response.Body.Close()
+	cookie := response.Header.Get("Set-Cookie")
+
+	data, _ := json.Marshal(body)
+	request, _ := http.NewRequest(method, server.URL+path, bytes.NewReader(data))
+	request.Header.Set("Cookie", cookie)
+	request.Header.Set("Content-Type", "application/json")
+	response, err = http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("%s %s failed with error: %v", method, path, err)
+	}
+	defer response.Body.Close()
+
+	var reply map[string]interface{}
+	json.NewDecoder(response.Body).Decode(&reply)
+	return response.StatusCode, reply
+}
+
+// readEventOf reads from a class session socket until an event of a type arrives, and returns its payload.
+func readEventOf(t *testing.T, socket *websocket.Conn, eventType string) map[string]interface{} {
+	t.Helper()
+	socket.SetReadDeadline(time.Now().Add(5 * time.Second))
+	for {
+		var event map[string]interface{}
+		if err := socket.ReadJSON(&event); err != nil {
+			t.Fatalf("waiting for %s, read failed with error: %v", eventType, err)
+		}
+		if event["type"] == eventType {
+			return payload(event)
+		}
+	}
+}
+
+func TestPolls(t *testing.T) {
+	server, dial := newWebsocketServer(t)
+	defer server.Close()
+	db := models.DB()
+
+	// Class session 3 belongs to section 3, where user 3 is the instructor and users 1 and 22 are students
+	student, _, err := dial(1, "/ws/3")
+	if err != nil {
+		t.Fatalf("dial failed with error: %v", err)
+	}
+	defer student.Close()
+	instructor, _, err := dial(3, "/ws/3")
+	if err != nil {
+		t.Fatalf("dial failed with error: %v", err)
+	}
+	defer instructor.Close()
+
+	yesNo := map[string]interface{}{"question": "Did everyone follow that derivation?", "kind": "yes-no", "open": true}
+
+	// Only moderators create polls, and they can only be opened while the class session is in progress
+	if status, _ := apiRequest(t, server, 1, "POST", "/api/polls/3", yesNo); status != http.StatusForbidden {
+		t.Errorf("student creating a poll got status %d, want %d", status, http.StatusForbidden)
+	}
+	status, reply := apiRequest(t, server, 3, "POST", "/api/polls/3", yesNo)
+	if status != http.StatusConflict || reply["pollID"] == nil {
+		t.Fatalf("opening a poll in an ended class session = %d %v, want a draft and %d", status, reply, http.StatusConflict)
+	}
+	draftID := int(reply["pollID"].(float64))
+	defer db.Exec("DELETE FROM poll WHERE session_id = 3")
+	defer db.Exec("DELETE FROM poll_option WHERE poll_id IN (SELECT id FROM poll WHERE session_id = 3)")
+	defer db.Exec("DELETE FROM poll_response WHERE poll_id IN (SELECT id FROM poll WHERE session_id = 3)")
+
+	for _, invalid := range []map[string]interface{}{
+		{"question": " ", "kind": "yes-no"},
+		{"question": "Pick one", "kind": "choice", "options": []string{"only", " "}},
+		{"question": "Pick one", "kind": "ranking"},
+	} {
+		if status, _ := apiRequest(t, server, 3, "POST", "/api/polls/3", invalid); status != http.StatusBadRequest {
+			t.Errorf("creating poll %v got status %d, want %d", invalid, status, http.StatusBadRequest)
+		}
+	}
+
+	// Students don't see drafts
+	if _, reply := apiRequest(t, server, 1, "GET", "/api/polls/3", nil); len(reply["polls"].([]interface{})) != 0 {
+		t.Errorf("student sees polls %v before any was opened", reply["polls"])
+	}
+
+	db.Exec("UPDATE class_session SET in_progress = true WHERE id = 3")
+	defer db.Exec("UPDATE class_session SET in_progress = false WHERE id = 3")
+
+	status, reply = apiRequest(t, server, 3, "POST", "/api/polls/3", map[string]interface{}{
+		"question": "Which sort is stable?",
+		"kind":     "choice",
+		"options":  []string{"Merge sort", "", "Quicksort", "Heapsort"},
+		"open":     true,
+	})
+	if status != http.StatusOK || reply["status"] != models.PollOpen {
+		t.Fatalf("creating an open poll = %d %v", status, reply)
+	}
+	pollID := int(reply["pollID"].(float64))
+
+	// The class sees the poll open, without the empty option
+	opened := readEventOf(t, student, "poll-opened")
+	options := opened["options"].([]interface{})
+	if int(opened["pollID"].(float64)) != pollID || len(options) != 3 {
+		t.Fatalf("poll-opened = %v", opened)
+	}
+	mergeSort := int(options[0].(map[string]interface{})["optionID"].(float64))
+
+	// A student answers over the websocket, once, and the results stream to the class
+	reply = request(t, student, map[string]interface{}{"action": "poll-response", "requestId": "1", "pollID": pollID, "optionID": mergeSort})
+	if reply["type"] != "ack" {
+		t.Fatalf("poll-response reply = %v", reply)
+	}
+	results := readEventOf(t, instructor, "poll-results")
+	if int(results["responses"].(float64)) != 1 || int(results["options"].([]interface{})[0].(map[string]interface{})["votes"].(float64)) != 1 {
+		t.Errorf("poll-results = %v", results)
+	}
+	reply = request(t, student, map[string]interface{}{"action": "poll-response", "requestId": "2", "pollID": pollID, "optionID": mergeSort})
+	if reply["type"] != "error" || int(payload(reply)["status"].(float64)) != http.StatusConflict {
+		t.Errorf("second answer reply = %v, want a conflict", reply)
+	}
+
+	// Over HTTP, an option of another poll is refused
+	if status, _ := apiRequest(t, server, 22, "POST", fmt.Sprintf("/api/poll/%d/respond", pollID), map[string]int{"optionID": -1}); status != http.StatusBadRequest {
+		t.Errorf("answering with another poll's option got status %d, want %d", status, http.StatusBadRequest)
+	}
+
+	// Closing the poll sends the final results and stops the answers
+	if status, _ := apiRequest(t, server, 1, "POST", fmt.Sprintf("/api/poll/%d/close", pollID), nil); status != http.StatusForbidden {
+		t.Errorf("student closing a poll got status %d, want %d", status, http.StatusForbidden)
+	}
+	if status, reply := apiRequest(t, server, 3, "POST", fmt.Sprintf("/api/poll/%d/close", pollID), nil); status != http.StatusOK {
+		t.Fatalf("closing the poll = %d %v", status, reply)
+	}
+	if closed := readEventOf(t, student, "poll-closed"); int(closed["responses"].(float64)) != 1 {
+		t.Errorf("poll-closed = %v", closed)
+	}
+	if status, _ := apiRequest(t, server, 22, "POST", fmt.Sprintf("/api/poll/%d/respond", pollID), map[string]int{"optionID": mergeSort}); status != http.StatusConflict {
+		t.Errorf("answering a closed poll got status %d, want %d", status, http.StatusConflict)
+	}
+
+	// The results are kept with the class session, with the student's own answer
+	_, reply = apiRequest(t, server, 1, "GET", "/api/polls/3", nil)
+	polls := reply["polls"].([]interface{})
+	if len(polls) != 1 {
+		t.Fatalf("student sees %d polls, want the closed one", len(polls))
+	}
+	poll := polls[0].(map[string]interface{})
+	if poll["Status"] != models.PollClosed || int(poll["UserChoice"].(float64)) != mergeSort || int(poll["Responses"].(float64)) != 1 {
+		t.Errorf("stored poll = %v", poll)
+	}
+
+	// Ending the class session closes the polls left open
+	if status, _ := apiRequest(t, server, 3, "POST", fmt.Sprintf("/api/poll/%d/open", draftID), nil); status != http.StatusOK {
+		t.Fatalf("opening the draft got status %d", status)
+	}
+	closeClassSessionPolls(3)
+	if closed := readEventOf(t, student, "poll-closed"); int(closed["pollID"].(float64)) != draftID {
+		t.Errorf("poll-closed after the session ended = %v, want poll %d", closed, draftID)
+	}
+}
@@ -0,0 +1,204 @@
+package controllers
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Presence tracks who is connected to each class session right now, across every instance of the
+// server. A user is present while they have at least one websocket or event stream open to the
+// class session, whichever instance it is on, however many tabs they have open.
+//
+// Each instance counts its own connections per user, and tells the others through the relay when
+// a user arrives on it or leaves it. Every instance applies those changes in the same order, so
+// they agree on who is present. Instances also send heartbeats, and the users of an instance that
+// stopped sending them, such as one that crashed, are dropped once its heartbeats expire.
+type Presence struct {
+	mu sync.Mutex
+	// local counts the open connections of each user to each class session on this instance
+	local map[int]map[int]int
+	// present holds, for each class session, the streams of the instances each present user is connected to
+	present map[int]map[int]map[string]bool
+	// seen is when each stream was last heard from
+	seen map[string]time.Time
+}
+
+// departure is a user who became absent from a class session, and the number of users left in it.
+type departure struct {
+	room   int
+	userID int
+	count  int
+}
+
+// NewPresence returns a presence tracker with nobody connected.
+func NewPresence() *Presence {
+	return &Presence{
+		local:   make(map[int]map[int]int),
+		present: make(map[int]map[int]map[string]bool),
+		seen:    make(map[string]time.Time),
+	}
+}
+
+// Connect counts a new connection of a user to a class session on this instance.
+// It returns true if it is the user's first connection to it on this instance.
+func (p *Presence) Connect(room int, userID int) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.local[room]; !ok {
+		p.local[room] = make(map[int]int)
+	}
+	p.local[room][userID]++
+	return p.local[room][userID] == 1
+}
+
+// Disconnect counts a closed connection of a user to a class session on this instance.
+// It returns true if it was the user's last connection to it on this instance.
+func (p *Presence) Disconnect(room int, userID int) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.local[room][userID] == 0 {
+		return false
+	}
+	p.local[room][userID]--
+	if p.local[room][userID] > 0 {
+		return false
+	}
+	delete(p.local[room], userID)
+	if len(p.local[room]) == 0 {
+		delete(p.local, room)
+	}
+	return true
+}
+
+// Local returns the users with a connection to each class session on this instance.
+func (p *Presence) Local() map[int][]int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	local := make(map[int][]int)
+	for room, users := range p.local {
+		for userID := range users {
+			local[room] = append(local[room], userID)
+		}
+	}
+	return local
+}
+
+// Update records that a user arrived on or left the instance with a stream.
+// It returns whether the user became present or absent in the class session as a whole,
+// and the number of users present afterwards.
+func (p *Presence) Update(room int, userID int, stream string, present bool) (bool, int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	users, ok := p.present[room]
+	if !ok {
+		users = make(map[int]map[string]bool)
+		p.present[room] = users
+	}
+	streams := users[userID]
+	wasPresent := len(streams) > 0
+
+	if present {
+		if streams == nil {
+			streams = make(map[string]bool)
+			users[userID] = streams
+		}
+		streams[stream] = true
+	} else {
+		delete(streams, stream)
+		if len(streams) == 0 {
+			delete(users, userID)
+		}
+	}
+
+	count := len(users)
+	if count == 0 {
+		delete(p.present, room)
+	}
+	return wasPresent != (len(users[userID]) > 0), count
+}
+
+// Heartbeat records that the instance with a stream was running at a time.
+func (p *Presence) Heartbeat(stream string, at time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if at.After(p.seen[stream]) {
+		p.seen[stream] = at
+	}
+}
+
+// Expire forgets the streams last heard from before a time, and their users.
+// It returns the users who became absent, by class session and user.
+func (p *Presence) Expire(before time.Time) []departure {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var departures []departure
+	for stream, seen := range p.seen {
+		if !seen.Before(before) {
+			continue
+		}
+		delete(p.seen, stream)
+		for room, users := range p.present {
+			for userID, streams := range users {
+				if !streams[stream] {
+					continue
+				}
+				delete(streams, stream)
+				if len(streams) == 0 {
+					delete(users, userID)
+					departures = append(departures, departure{room: room, userID: userID})
+				}
+			}
+		}
+	}
+
+	// Users leave one after the other, so each departure leaves one user fewer than the one before
+	sort.Slice(departures, func(i, j int) bool {
+		if departures[i].room != departures[j].room {
+			return departures[i].room < departures[j].room
+		}
+		return departures[i].userID < departures[j].userID
+	})
+	counts := make(map[int]int)
+	for i := len(departures) - 1; i >= 0; i-- {
+		room := departures[i].room
+		if _, ok := counts[room]; !ok {
+			counts[room] = len(p.present[room])
+		}
+		departures[i].count = counts[room]
+		counts[room]++
+	}
+	for room, users := range p.present {
+		if len(users) == 0 {
+			delete(p.present, room)
+		}
+	}
+	return departures
+}
+
+// Count returns the number of users present in a class session.
+func (p *Presence) Count(room int) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return len(p.present[room])
+}
+
+// Users returns the ids of the users present in a class session, in increasing order.
+func (p *Presence) Users(room int) []int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	users := make([]int, 0, len(p.present[room]))
+	for userID := range p.present[room] {
+		users = append(users, userID)
+	}
+	sort.Ints(users)
+	return users
+}
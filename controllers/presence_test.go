@@ -0,0 +1,191 @@
+package controllers
+
+import (
+	"coeus/pubsub"
+	"coeus/pubsub/pubsubtest"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPresence(t *testing.T) {
+	p := NewPresence()
+
+	// Tabs of the same user on an instance count once
+	if !p.Connect(3, 1) || p.Connect(3, 1) {
+		t.Error("only the first connection of a user should be an arrival")
+	}
+	if p.Disconnect(3, 1) || !p.Disconnect(3, 1) {
+		t.Error("only the last connection of a user should be a departure")
+	}
+	if p.Disconnect(3, 1) {
+		t.Error("disconnecting a user with no connections was a departure")
+	}
+
+	// A user connected to two instances stays present until they leave both
+	if changed, count := p.Update(3, 1, "a", true); !changed || count != 1 {
+		t.Errorf("arrival on a = %v, %d, want a change to 1", changed, count)
+	}
+	if changed, count := p.Update(3, 1, "b", true); changed || count != 1 {
+		t.Errorf("arrival on b = %v, %d, want no change", changed, count)
+	}
+	if changed, count := p.Update(3, 2, "b", true); !changed || count != 2 {
+		t.Errorf("second user = %v, %d, want a change to 2", changed, count)
+	}
+	if changed, _ := p.Update(3, 1, "a", false); changed {
+		t.Error("leaving one of two instances made the user absent")
+	}
+	if changed, count := p.Update(3, 1, "b", false); !changed || count != 1 {
+		t.Errorf("leaving the last instance = %v, %d, want a change to 1", changed, count)
+	}
+	if users := p.Users(3); !reflect.DeepEqual(users, []int{2}) {
+		t.Errorf("present users = %v, want [2]", users)
+	}
+	if p.Count(4) != 0 {
+		t.Error("a class session nobody joined has users present")
+	}
+
+	// The users of an instance that stopped sending heartbeats are dropped, unless another instance has them
+	start := time.Now()
+	p.Heartbeat("a", start)
+	p.Heartbeat("b", start)
+	p.Update(3, 1, "a", true)
+	p.Update(3, 3, "b", true)
+	p.Update(4, 2, "b", true)
+	p.Heartbeat("a", start.Add(time.Minute))
+	departures := p.Expire(start.Add(time.Second))
+	want := []departure{{room: 3, userID: 2, count: 2}, {room: 3, userID: 3, count: 1}, {room: 4, userID: 2, count: 0}}
+	if !reflect.DeepEqual(departures, want) {
+		t.Errorf("departures = %+v, want %+v", departures, want)
+	}
+	if users := p.Users(3); !reflect.DeepEqual(users, []int{1}) || p.Count(4) != 0 {
+		t.Errorf("present users = %v and %d, want [1] and 0", users, p.Count(4))
+	}
+	if departures := p.Expire(start.Add(time.Second)); len(departures) != 0 {
+		t.Errorf("expiring again = %+v, want nothing", departures)
+	}
+}
+
+func TestRelayPresence(t *testing.T) {
+	server, err := pubsubtest.NewServer()
+	if err != nil {
+		t.Fatalf("starting the stand-in server failed with error: %v", err)
+	}
+	defer server.Close()
+
+	newInstance := func() (*Hub, *Relay, *Connection) {
+		broker, err := pubsub.DialRedis(server.URL())
+		if err != nil {
+			t.Fatalf("dial failed with error: %v", err)
+		}
+		t.Cleanup(func() { broker.Close() })
+		h := NewHub(sendBufferSize, eventLogSize)
+		r, err := NewRelay(h, broker)
+		if err != nil {
+			t.Fatalf("relay failed with error: %v", err)
+		}
+		watcher := h.NewConnection(nil, 3)
+		h.Register(watcher)
+		return h, r, watcher
+	}
+	expect := func(c *Connection, action string, count int) {
+		t.Helper()
+		event := receive(t, c)
+		if event["type"] != action || int(payload(event)["count"].(float64)) != count {
+			t.Errorf("received %v with count %v, want %s with count %d", event["type"], payload(event)["count"], action, count)
+		}
+	}
+
+	_, first, firstWatcher := newInstance()
+	_, second, secondWatcher := newInstance()
+
+	// User 1 opens a tab on each instance, user 2 a tab on the second
+	first.Arrive(3, 1)
+	second.Arrive(3, 1)
+	second.Arrive(3, 2)
+	for _, watcher := range []*Connection{firstWatcher, secondWatcher} {
+		expect(watcher, "participant-joined", 1)
+		expect(watcher, "participant-joined", 2)
+	}
+
+	// User 1 is still present until their last tab closes
+	first.Depart(3, 1)
+	second.Depart(3, 1)
+	for _, watcher := range []*Connection{firstWatcher, secondWatcher} {
+		expect(watcher, "participant-left", 1)
+	}
+
+	// An instance started later learns who is connected to the others
+	_, third, _ := newInstance()
+	waitFor(t, "the new instance to learn who is present", func() bool {
+		return reflect.DeepEqual(third.PresentUsers(3), []int{2})
+	})
+	if first.Present(3) != 1 || second.Present(3) != 1 {
+		t.Errorf("present counts = %d and %d, want 1", first.Present(3), second.Present(3))
+	}
+
+	// User 2 leaves once the second instance, which stopped without saying so, misses its heartbeats
+	first.Tick(time.Now())
+	if first.Present(3) != 1 {
+		t.Errorf("present count before the heartbeats expired = %d, want 1", first.Present(3))
+	}
+	first.Tick(time.Now().Add(presenceTTL + time.Second))
+	expect(firstWatcher, "participant-left", 0)
+	if first.Present(3) != 0 {
+		t.Errorf("present count after the heartbeats expired = %d, want 0", first.Present(3))
+	}
+}
+
+func TestPresenceEndpoint(t *testing.T) {
+	server, dial := newWebsocketServer(t)
+	defer server.Close()
+
+	get := func(userID int) (int, map[string]interface{}) {
+		t.Helper()
+		response, err := http.Get(fmt.Sprintf("%s/test-sign-in/%d", server.URL, userID))
+		if err != nil {
+			t.Fatalf("sign in failed with error: %v", err)
+		}
+		response.Body.Close()
+		request, _ := http.NewRequest("GET", server.URL+"/api/presence/3", nil)
+		request.Header.Set("Cookie", response.Header.Get("Set-Cookie"))
+		response, err = http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatalf("presence request failed with error: %v", err)
+		}
+		defer response.Body.Close()
+		var body map[string]interface{}
+		json.NewDecoder(response.Body).Decode(&body)
+		return response.StatusCode, body
+	}
+
+	// Two tabs of the same student
+	for i := 0; i < 2; i++ {
+		socket, _, err := dial(1, "/ws/3")
+		if err != nil {
+			t.Fatalf("dial failed with error: %v", err)
+		}
+		defer socket.Close()
+	}
+	waitFor(t, "the student to be present", func() bool { return relay.Present(3) == 1 })
+
+	status, body := get(3)
+	if status != http.StatusOK {
+		t.Fatalf("instructor got status %d", status)
+	}
+	present := body["present"].([]interface{})
+	if body["presentCount"].(float64) != 1 || len(present) != 1 || present[0].(map[string]interface{})["UserID"].(float64) != 1 {
+		t.Errorf("present = %v with count %v, want the student once", present, body["presentCount"])
+	}
+	joined := body["joined"].([]interface{})
+	if len(joined) <= len(present) || int(body["joinedCount"].(float64)) != len(joined) {
+		t.Errorf("joined %d participants, want everyone who ever joined, more than present", len(joined))
+	}
+
+	if status, _ := get(1); status != http.StatusForbidden {
+		t.Errorf("student got status %d, want %d", status, http.StatusForbidden)
+	}
+}
@@ -0,0 +1,191 @@
+package controllers
+
+import (
+	"coeus/models"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestQuizzes(t *testing.T) {
+	server, dial := newWebsocketServer(t)
+	defer server.Close()
+	db := models.DB()
+
+	// Class session 3 belongs to section 3, where user 3 is the instructor and users 1 and 22 are students
+	student, _, err := dial(1, "/ws/3")
+	if err != nil {
+		t.Fatalf("dial failed with error: %v", err)
+	}
+	defer student.Close()
+	instructor, _, err := dial(3, "/ws/3")
+	if err != nil {
+		t.Fatalf("dial failed with error: %v", err)
+	}
+	defer instructor.Close()
+
+	// Submissions mark students without attendance present
+	var lastUserAttendance int
+	db.QueryRow("SELECT COALESCE(MAX(id), 0) FROM user_attendance").Scan(&lastUserAttendance)
+	defer db.Exec("DELETE FROM user_attendance WHERE id > $1", lastUserAttendance)
+	defer db.Exec("DELETE FROM quiz WHERE session_id = 3")
+	defer db.Exec("DELETE FROM quiz_question WHERE quiz_id IN (SELECT id FROM quiz WHERE session_id = 3)")
+	defer db.Exec("DELETE FROM quiz_option WHERE question_id IN (SELECT quiz_question.id FROM quiz_question JOIN quiz ON quiz.id = quiz_question.quiz_id WHERE quiz.session_id = 3)")
+	defer db.Exec("DELETE FROM quiz_submission WHERE quiz_id IN (SELECT id FROM quiz WHERE session_id = 3)")
+	defer db.Exec("DELETE FROM quiz_answer WHERE submission_id IN (SELECT quiz_submission.id FROM quiz_submission JOIN quiz ON quiz.id = quiz_submission.quiz_id WHERE quiz.session_id = 3)")
+
+	sorting := map[string]interface{}{
+		"title":     "=Sorting",
+		"timeLimit": 300,
+		"open":      true,
+		"questions": []map[string]interface{}{
+			{"text": "Which sort is stable?", "points": 2, "options": []string{"Heapsort", "Merge sort"}, "correct": 1},
+			{"text": "Quicksort is O(n log n) in the worst case", "points": 1, "options": []string{"True", "False"}, "correct": 1},
+		},
+	}
+
+	// Only moderators create quizzes, and only valid ones
+	if status, _ := apiRequest(t, server, 1, "POST", "/api/quizzes/3", sorting); status != http.StatusForbidden {
+		t.Errorf("student creating a quiz got status %d, want %d", status, http.StatusForbidden)
+	}
+	for _, invalid := range []map[string]interface{}{
+		{"title": " ", "questions": sorting["questions"]},
+		{"title": "Empty"},
+		{"title": "No answer", "questions": []map[string]interface{}{{"text": "Pick", "points": 1, "options": []string{"a", "b"}, "correct": 2}}},
+		{"title": "No points", "questions": []map[string]interface{}{{"text": "Pick", "points": 0, "options": []string{"a", "b"}, "correct": 0}}},
+		{"title": "Too long", "timeLimit": -1, "questions": sorting["questions"]},
+	} {
+		if status, _ := apiRequest(t, server, 3, "POST", "/api/quizzes/3", invalid); status != http.StatusBadRequest {
+			t.Errorf("creating quiz %v got status %d, want %d", invalid["title"], status, http.StatusBadRequest)
+		}
+	}
+
+	db.Exec("UPDATE class_session SET in_progress = true WHERE id = 3")
+	defer db.Exec("UPDATE class_session SET in_progress = false WHERE id = 3")
+
+	status, reply := apiRequest(t, server, 3, "POST", "/api/quizzes/3", sorting)
+	if status != http.StatusOK || reply["status"] != models.QuizOpen {
+		t.Fatalf("creating an open quiz = %d %v", status, reply)
+	}
+	quizID := int(reply["quizID"].(float64))
+
+	// The class sees the questions and the time left, but not the answers
+	opened := readEventOf(t, student, "quiz-opened")
+	questions := opened["questions"].([]interface{})
+	if int(opened["quizID"].(float64)) != quizID || int(opened["timeLimit"].(float64)) != 300 || len(questions) != 2 {
+		t.Fatalf("quiz-opened = %v", opened)
+	}
+	optionID := func(question int, option int) int {
+		options := questions[question].(map[string]interface{})["options"].([]interface{})
+		return int(options[option].(map[string]interface{})["optionID"].(float64))
+	}
+	questionID := func(question int) string {
+		return fmt.Sprint(questions[question].(map[string]interface{})["questionID"])
+	}
+	_, reply = apiRequest(t, server, 1, "GET", "/api/quizzes/3", nil)
+	if quizzes := reply["quizzes"].([]interface{}); len(quizzes) != 1 {
+		t.Fatalf("student sees quizzes %v", quizzes)
+	}
+	for _, question := range reply["quizzes"].([]interface{})[0].(map[string]interface{})["Questions"].([]interface{}) {
+		for _, option := range question.(map[string]interface{})["Options"].([]interface{}) {
+			if option.(map[string]interface{})["Correct"] == true {
+				t.Errorf("student sees the answer %v of an open quiz", option)
+			}
+		}
+	}
+
+	// A student submits over the websocket, once, and is scored
+	reply = request(t, student, map[string]interface{}{
+		"action":    "quiz-submit",
+		"requestId": "1",
+		"quizID":    quizID,
+		"answers":   map[string]int{questionID(0): optionID(0, 1), questionID(1): optionID(1, 0)},
+	})
+	if reply["type"] != "ack" {
+		t.Fatalf("quiz-submit reply = %v", reply)
+	}
+	if data := payload(reply)["data"].(map[string]interface{}); int(data["score"].(float64)) != 2 || int(data["maxScore"].(float64)) != 3 {
+		t.Errorf("quiz-submit scored %v, want 2/3", data)
+	}
+	if submitted := readEventOf(t, instructor, "quiz-submitted"); int(submitted["submissions"].(float64)) != 1 {
+		t.Errorf("quiz-submitted = %v", submitted)
+	}
+	reply = request(t, student, map[string]interface{}{"action": "quiz-submit", "requestId": "2", "quizID": quizID, "answers": map[string]int{}})
+	if reply["type"] != "error" || int(payload(reply)["status"].(float64)) != http.StatusConflict {
+		t.Errorf("second submission reply = %v, want a conflict", reply)
+	}
+
+	// Over HTTP, an option of another question is refused
+	path := fmt.Sprintf("/api/quiz/%d/submit", quizID)
+	if status, _ := apiRequest(t, server, 22, "POST", path, map[string]interface{}{"answers": map[string]int{questionID(0): optionID(1, 1)}}); status != http.StatusBadRequest {
+		t.Errorf("answering with another question's option got status %d, want %d", status, http.StatusBadRequest)
+	}
+	if status, reply := apiRequest(t, server, 22, "POST", path, map[string]interface{}{"answers": map[string]int{questionID(1): optionID(1, 1)}}); status != http.StatusOK || int(reply["score"].(float64)) != 1 {
+		t.Errorf("submitting over HTTP = %d %v, want a score of 1", status, reply)
+	}
+
+	// Moderators see the scores of every student
+	if status, _ := apiRequest(t, server, 1, "GET", fmt.Sprintf("/api/quiz/%d/results", quizID), nil); status != http.StatusForbidden {
+		t.Errorf("student reading the scores got status %d, want %d", status, http.StatusForbidden)
+	}
+	_, reply = apiRequest(t, server, 3, "GET", fmt.Sprintf("/api/quiz/%d/results", quizID), nil)
+	if submissions, ok := reply["submissions"].([]interface{}); !ok || len(submissions) != 2 {
+		t.Errorf("results = %v, want 2 submissions", reply)
+	}
+
+	// Closing the quiz stops the submissions and shows the class the answers
+	if status, reply := apiRequest(t, server, 3, "POST", fmt.Sprintf("/api/quiz/%d/close", quizID), nil); status != http.StatusOK {
+		t.Fatalf("closing the quiz = %d %v", status, reply)
+	}
+	if closed := readEventOf(t, student, "quiz-closed"); int(closed["submissions"].(float64)) != 2 {
+		t.Errorf("quiz-closed = %v", closed)
+	}
+	if status, _ := apiRequest(t, server, 3, "POST", path, map[string]interface{}{"answers": map[string]int{}}); status != http.StatusConflict {
+		t.Errorf("submitting a closed quiz got status %d, want %d", status, http.StatusConflict)
+	}
+	_, reply = apiRequest(t, server, 1, "GET", "/api/quizzes/3", nil)
+	if !strings.Contains(fmt.Sprint(reply["quizzes"]), "Correct:true") {
+		t.Errorf("student doesn't see the answers of a closed quiz: %v", reply["quizzes"])
+	}
+	if submission := reply["submissions"].(map[string]interface{})[fmt.Sprint(quizID)]; submission == nil || int(submission.(map[string]interface{})["Score"].(float64)) != 2 {
+		t.Errorf("student's own submission = %v", submission)
+	}
+
+	// The scores of the section export as CSV, for its staff only
+	if status, _ := apiRequest(t, server, 1, "GET", "/api/quiz-scores/3", nil); status != http.StatusForbidden {
+		t.Errorf("student exporting the scores got status %d, want %d", status, http.StatusForbidden)
+	}
+	response, err := http.Get(fmt.Sprintf("%s/test-sign-in/3", server.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	request, _ := http.NewRequest("GET", server.URL+"/api/quiz-scores/3", nil)
+	request.Header.Set("Cookie", response.Header.Get("Set-Cookie"))
+	response, err = http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+	if response.Header.Get("Content-Type") != "text/csv; charset=utf-8" {
+		t.Errorf("export has the content type %q", response.Header.Get("Content-Type"))
+	}
+	body, _ := io.ReadAll(response.Body)
+	records, err := csv.NewReader(strings.NewReader(string(body))).ReadAll()
+	if err != nil {
+		t.Fatalf("export is not CSV: %v", err)
+	}
+	// The title starts like a formula, so it is exported as text
+	scores := map[string]string{}
+	for _, record := range records[1:] {
+		if record[2] == "'=Sorting" {
+			scores[record[3]] = record[6] + "/" + record[7]
+		}
+	}
+	if scores["student@coeus.education"] != "2/3" {
+		t.Errorf("export has the scores %v, want 2/3 for student@coeus.education", scores)
+	}
+}
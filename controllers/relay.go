@@ -0,0 +1,266 @@
+package controllers
+
+import (
+	"coeus/events"
+	"coeus/pubsub"
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+)
+
+// eventsChannel is the pub/sub channel that carries live events between the instances of the server.
+const eventsChannel = "coeus:events"
+
+// The kinds of relayed messages
+const (
+	relayBroadcast    = "broadcast"
+	relayModerators   = "moderators"
+	relayForget       = "forget"
+	relayRevokeUser   = "revoke-user"
+	relayRevoke       = "revoke"
+	relayPresence     = "presence"
+	relayPresenceSync = "presence-sync"
+	relayHeartbeat    = "heartbeat"
+)
+
+const (
+	// presenceHeartbeat is how often an instance tells the others it is still running.
+	presenceHeartbeat = 10 * time.Second
+	// presenceTTL is how long the users of an instance stay present after its last heartbeat.
+	presenceTTL = 3 * presenceHeartbeat
+)
+
+// relayedEvent is a message from one instance of the server to the hubs of all of them,
+// including its own. Broadcast events are numbered by each hub as they arrive, and the
+// broker delivers them to every instance in the same order.
+type relayedEvent struct {
+	Kind      string          `json:"kind"`
+	Room      int             `json:"room"`
+	Event     json.RawMessage `json:"event,omitempty"`
+	UserID    int             `json:"userID,omitempty"`
+	SectionID int             `json:"sectionID,omitempty"`
+	// Stream is the hub of the instance a presence change happened on, that asks for presence,
+	// or that sends a heartbeat
+	Stream  string `json:"stream,omitempty"`
+	Present bool   `json:"present,omitempty"`
+}
+
+// Relay delivers the events of every instance of the server to the connections of a hub
+// through a pub/sub broker, so a client receives the events of its room whichever instance
+// it is connected to.
+type Relay struct {
+	hub      *Hub
+	broker   pubsub.Broker
+	presence *Presence
+}
+
+// NewRelay subscribes a hub to the events published on a broker, and asks the instances
+// already running who is connected to them.
+func NewRelay(h *Hub, broker pubsub.Broker) (*Relay, error) {
+	r := &Relay{hub: h, broker: broker, presence: NewPresence()}
+	if err := broker.Subscribe(eventsChannel, r.deliver); err != nil {
+		return nil, err
+	}
+	r.publish(relayedEvent{Kind: relayPresenceSync, Stream: h.Stream()})
+	return r, nil
+}
+
+// relay publishes the events of this instance. It starts with a broker within the process.
+var relay, _ = NewRelay(hub, pubsub.NewLocal())
+
+// ConfigurePubSub publishes live events through broker, to share them with the other instances
+// of the server subscribed to it. It must be called before the server starts.
+func ConfigurePubSub(broker pubsub.Broker) error {
+	r, err := NewRelay(hub, broker)
+	if err != nil {
+		return err
+	}
+	relay = r
+	return nil
+}
+
+// Broadcast sends an event to the connections of a room on every instance.
+func (r *Relay) Broadcast(room int, event events.Envelope) {
+	message, err := json.Marshal(event)
+	if err != nil {
+		log.Println("Error marshalling JSON:", err)
+		return
+	}
+	r.publish(relayedEvent{Kind: relayBroadcast, Room: room, Event: message})
+}
+
+// SendModerators sends an event of a class session to the moderators of its section and to one user
+// on every instance, rather than to the whole room. The event isn't numbered, so a client that
+// reconnects doesn't get it again.
+func (r *Relay) SendModerators(room int, userID int, event events.Envelope) {
+	message, err := json.Marshal(event)
+	if err != nil {
+		log.Println("Error marshalling JSON:", err)
+		return
+	}
+	r.publish(relayedEvent{Kind: relayModerators, Room: room, Event: message, UserID: userID})
+}
+
+// Forget drops the event log of a room on every instance.
+func (r *Relay) Forget(room int) {
+	r.publish(relayedEvent{Kind: relayForget, Room: room})
+}
+
+// RevokeUser closes the websockets of a user on every instance.
+func (r *Relay) RevokeUser(userID int) {
+	r.publish(relayedEvent{Kind: relayRevokeUser, UserID: userID})
+}
+
+// RevokeAccess closes the class session websockets of users who may no longer join their section
+// on every instance, and reconnects those whose moderator role in it changed. A zero userID or sectionID checks the connections of every user or section.
+func (r *Relay) RevokeAccess(userID int, sectionID int) {
+	r.publish(relayedEvent{Kind: relayRevoke, UserID: userID, SectionID: sectionID})
+}
+
+// Arrive marks a user present in a class session for a connection that joined its room.
+// Other connections of the user, on any instance, make no difference to who is present.
+func (r *Relay) Arrive(room int, userID int) {
+	if r.presence.Connect(room, userID) {
+		r.publish(relayedEvent{Kind: relayPresence, Room: room, UserID: userID, Stream: r.hub.Stream(), Present: true})
+	}
+}
+
+// Depart marks a user absent from a class session once their connection left its room,
+// unless they have other connections to it.
+func (r *Relay) Depart(room int, userID int) {
+	if r.presence.Disconnect(room, userID) {
+		r.publish(relayedEvent{Kind: relayPresence, Room: room, UserID: userID, Stream: r.hub.Stream()})
+	}
+}
+
+// RunPresence sends the heartbeats of this instance and drops the users of the instances that stopped
+// sending theirs, until ctx is done.
+func RunPresence(ctx context.Context) {
+	ticker := time.NewTicker(presenceHeartbeat)
+	defer ticker.Stop()
+
+	relay.Tick(time.Now())
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			relay.Tick(now)
+		}
+	}
+}
+
+// Tick sends a heartbeat of this instance, and drops the users of every instance not heard from
+// for presenceTTL as of now, sending a participant-left event for those who are no longer present.
+func (r *Relay) Tick(now time.Time) {
+	r.presence.Heartbeat(r.hub.Stream(), now)
+	r.publish(relayedEvent{Kind: relayHeartbeat, Stream: r.hub.Stream()})
+
+	for _, d := range r.presence.Expire(now.Add(-presenceTTL)) {
+		r.sendPresence(d.room, d.userID, false, d.count)
+	}
+}
+
+// Present returns the number of users connected to a class session.
+func (r *Relay) Present(room int) int {
+	return r.presence.Count(room)
+}
+
+// PresentUsers returns the ids of the users connected to a class session.
+func (r *Relay) PresentUsers(room int) []int {
+	return r.presence.Users(room)
+}
+
+// publish sends a message to every instance. When the broker can't be reached the message is
+// still delivered to this instance's own connections.
+func (r *Relay) publish(event relayedEvent) {
+	message, err := json.Marshal(event)
+	if err != nil {
+		log.Println("Error marshalling JSON:", err)
+		return
+	}
+
+	if err := r.broker.Publish(eventsChannel, message); err != nil {
+		log.Println("Failed to publish event, delivering it locally:", err)
+		r.deliver(message)
+	}
+}
+
+// deliver applies a message from any instance to the hub.
+func (r *Relay) deliver(message []byte) {
+	var event relayedEvent
+	if err := json.Unmarshal(message, &event); err != nil {
+		log.Println("Invalid relayed event:", err)
+		return
+	}
+
+	switch event.Kind {
+	case relayBroadcast:
+		if event.Room == generalRoom {
+			r.hub.Broadcast(generalRoom, event.Event)
+			return
+		}
+		r.publishLocal(event.Room, event.Event)
+	case relayModerators:
+		r.hub.SendTo(event.Room, func(c *Connection) bool {
+			return c.userID == event.UserID || c.moderator
+		}, event.Event)
+	case relayPresence:
+		r.presence.Heartbeat(event.Stream, time.Now())
+		changed, count := r.presence.Update(event.Room, event.UserID, event.Stream, event.Present)
+		if changed {
+			r.sendPresence(event.Room, event.UserID, event.Present, count)
+		}
+	case relayHeartbeat:
+		r.presence.Heartbeat(event.Stream, time.Now())
+	case relayPresenceSync:
+		// A new instance doesn't know who is connected to the others yet
+		if event.Stream == r.hub.Stream() {
+			return
+		}
+		for room, users := range r.presence.Local() {
+			for _, userID := range users {
+				r.publish(relayedEvent{Kind: relayPresence, Room: room, UserID: userID, Stream: r.hub.Stream(), Present: true})
+			}
+		}
+	case relayForget:
+		r.hub.Forget(event.Room)
+	case relayRevokeUser:
+		revokeUser(r.hub, event.UserID)
+	case relayRevoke:
+		revokeAccess(r.hub, event.UserID, event.SectionID)
+	default:
+		log.Println("Unknown relayed event:", event.Kind)
+	}
+}
+
+// sendPresence sends the connections of a class session on this instance that a user joined or left it,
+// and the number of users present afterwards.
+func (r *Relay) sendPresence(room int, userID int, present bool, count int) {
+	var payload events.Payload = events.ParticipantLeft{UserID: userID, Count: count}
+	if present {
+		payload = events.ParticipantJoined{UserID: userID, Count: count}
+	}
+	message, err := json.Marshal(events.New(room, payload))
+	if err != nil {
+		log.Println("Error marshalling JSON:", err)
+		return
+	}
+	r.publishLocal(room, message)
+}
+
+// publishLocal numbers an event of a class session and sends it to the room's connections on this instance.
+func (r *Relay) publishLocal(room int, event []byte) {
+	_, err := r.hub.Publish(room, func(seq int64) ([]byte, error) {
+		stamped, err := events.Decode(event)
+		if err != nil {
+			return nil, err
+		}
+		stamped.Seq, stamped.Stream = seq, r.hub.Stream()
+		return json.Marshal(stamped)
+	})
+	if err != nil {
+		log.Println("Error stamping event:", err)
+	}
+}
@@ -0,0 +1,115 @@
+package controllers
+
+import (
+	"coeus/events"
+	"coeus/pubsub"
+	"coeus/pubsub/pubsubtest"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// receive returns the next message queued for a simulated client, failing the test after a few seconds.
+func receive(t *testing.T, c *Connection) map[string]interface{} {
+	t.Helper()
+	select {
+	case message, ok := <-c.send:
+		if !ok {
+			t.Fatal("connection closed while waiting for a message")
+		}
+		var event map[string]interface{}
+		if err := json.Unmarshal(message, &event); err != nil {
+			t.Fatalf("invalid message %s: %v", message, err)
+		}
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for a message")
+		return nil
+	}
+}
+
+func TestRelayAcrossInstances(t *testing.T) {
+	server, err := pubsubtest.NewServer()
+	if err != nil {
+		t.Fatalf("starting the stand-in server failed with error: %v", err)
+	}
+	defer server.Close()
+
+	// Two instances of the server behind a load balancer, sharing the broker
+	hubs := []*Hub{NewHub(sendBufferSize, eventLogSize), NewHub(sendBufferSize, eventLogSize)}
+	relays := make([]*Relay, len(hubs))
+	brokers := make([]*pubsub.Redis, len(hubs))
+	for i, h := range hubs {
+		brokers[i], err = pubsub.DialRedis(server.URL())
+		if err != nil {
+			t.Fatalf("dial failed with error: %v", err)
+		}
+		defer brokers[i].Close()
+		relays[i], err = NewRelay(h, brokers[i])
+		if err != nil {
+			t.Fatalf("relay failed with error: %v", err)
+		}
+	}
+
+	// A student in class session 3 on each instance, and a general connection on the second
+	students := []*Connection{hubs[0].NewConnection(nil, 3), hubs[1].NewConnection(nil, 3)}
+	for i, c := range students {
+		c.userID = i + 1
+		hubs[i].Register(c)
+	}
+	general := hubs[1].NewConnection(nil, generalRoom)
+	hubs[1].Register(general)
+
+	// A vote on each instance reaches the students of both, in the same order and numbered by their own hub
+	relays[0].Broadcast(3, events.New(3, events.VoteUp{Votes: 1}))
+	relays[1].Broadcast(3, events.New(3, events.VoteUp{Votes: 2}))
+	for i, c := range students {
+		var order []float64
+		for seq := 1; seq <= 2; seq++ {
+			event := receive(t, c)
+			if int(event["seq"].(float64)) != seq || event["stream"] != hubs[i].Stream() {
+				t.Errorf("student %d received event %v %v, want seq %d of stream %s", i, event["seq"], event["stream"], seq, hubs[i].Stream())
+			}
+			order = append(order, payload(event)["votes"].(float64))
+		}
+		if order[0] != 1 || order[1] != 2 {
+			t.Errorf("student %d received the votes in order %v", i, order)
+		}
+	}
+
+	relays[0].Broadcast(generalRoom, events.New(3, events.StartSession{SectionID: 3}))
+	if event := receive(t, general); event["type"] != "start-session" || event["seq"].(float64) != 0 || event["stream"] != nil {
+		t.Errorf("general connection received %v, want an unnumbered start-session", event)
+	}
+
+	// Ending the class session on one instance forgets its log on both
+	relays[1].Forget(3)
+	waitFor(t, "the event log to be forgotten", func() bool {
+		hubs[0].mu.RLock()
+		defer hubs[0].mu.RUnlock()
+		return hubs[0].logs[3] == nil
+	})
+
+	// Signing out on one instance closes the user's websockets on the other
+	relays[1].RevokeUser(1)
+	if received := drain(students[0]); len(received) != 0 {
+		t.Errorf("revoked student received %d more events", len(received))
+	}
+	if students[0].closeCode != websocket.ClosePolicyViolation {
+		t.Errorf("revoked student closed with code %d, want %d", students[0].closeCode, websocket.ClosePolicyViolation)
+	}
+	if hubs[1].Count(3) != 1 {
+		t.Error("revoking a user closed another user's websocket")
+	}
+
+	// Without the broker, events still reach the instance's own connections
+	brokers[0].Close()
+	local := hubs[0].NewConnection(nil, 4)
+	hubs[0].Register(local)
+	relays[0].Broadcast(4, events.New(4, events.VoteUp{}))
+	if event := receive(t, local); event["type"] != "vote-up" {
+		t.Errorf("received %v after the broker closed, want the vote", event)
+	}
+}
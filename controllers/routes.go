@@ -28,6 +28,7 @@ func PrivateRoutes(g *gin.RouterGroup) {
 		studentAndStaffRoutes.GET("/", MyCoursesGetHandler)
 		studentAndStaffRoutes.DELETE("/:sectionID", MyCoursesDeleteHandler)
 		studentAndStaffRoutes.GET("/session", SessionGetHandler)
+		studentAndStaffRoutes.GET("/my-attendance", MyAttendanceGetHandler)
 		studentAndStaffRoutes.GET("/settings", SettingsGetHandler)
 		studentAndStaffRoutes.POST("/settings", SettingsPostHandler)
 		studentAndStaffRoutes.GET("/course-section/:courseID", SectionGetHandler)
@@ -52,6 +53,8 @@ func PrivateRoutes(g *gin.RouterGroup) {
 		instructorRoutes.GET("", InstructorCoursesGetHandler)
 		instructorRoutes.GET("/attendance", InstructorAttendanceGetHandler)
 		instructorRoutes.GET("/attendance/:classSessionID", InstructorAttendanceGetHandler)
+		instructorRoutes.GET("/sessions/:sectionID", InstructorSessionsGetHandler)
+		instructorRoutes.GET("/sessions/:sectionID/:classSessionID", InstructorSessionGetHandler)
 	}
 
 }
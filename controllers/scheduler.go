@@ -0,0 +1,204 @@
+package controllers
+
+import (
+	"coeus/models"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// SchedulerConfig holds the settings of the session scheduler.
+type SchedulerConfig struct {
+	// Enabled turns automatic session start and stop on or off.
+	Enabled bool
+	// OpenBefore is how long before class a session is opened.
+	OpenBefore time.Duration
+	// CloseAfter is how long after the end of class a session is closed.
+	CloseAfter time.Duration
+	// Interval is how often the schedules are checked.
+	Interval time.Duration
+}
+
+// DefaultSchedulerConfig returns the scheduler settings.
+// SESSION_SCHEDULER ("off" disables it), SESSION_OPEN_MINUTES and SESSION_CLOSE_MINUTES
+// environment variables override the defaults when set.
+func DefaultSchedulerConfig() SchedulerConfig {
+	cfg := SchedulerConfig{
+		Enabled:    true,
+		OpenBefore: 5 * time.Minute,
+		CloseAfter: 0,
+		Interval:   time.Minute,
+	}
+
+	if os.Getenv("SESSION_SCHEDULER") == "off" {
+		cfg.Enabled = false
+	}
+	if n, err := strconv.Atoi(os.Getenv("SESSION_OPEN_MINUTES")); err == nil && n >= 0 {
+		cfg.OpenBefore = time.Duration(n) * time.Minute
+	}
+	if n, err := strconv.Atoi(os.Getenv("SESSION_CLOSE_MINUTES")); err == nil && n >= 0 {
+		cfg.CloseAfter = time.Duration(n) * time.Minute
+	}
+
+	return cfg
+}
+
+// Scheduler opens class sessions shortly before each scheduled meeting of a
+// section and closes them once the meeting is over.
+type Scheduler struct {
+	cfg SchedulerConfig
+}
+
+// NewScheduler returns a scheduler using the given settings.
+func NewScheduler(cfg SchedulerConfig) *Scheduler {
+	return &Scheduler{cfg: cfg}
+}
+
+// Run checks the schedules every interval until ctx is cancelled.
+func (s *Scheduler) Run(ctx context.Context) {
+	if !s.cfg.Enabled {
+		return
+	}
+
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	s.Tick(time.Now())
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.Tick(now)
+		}
+	}
+}
+
+// Tick starts the sessions that are due to open and ends the sessions that are due to close at now.
+func (s *Scheduler) Tick(now time.Time) {
+	loc := models.OrganizationLocation()
+	now = now.In(loc)
+
+	schedules, err := new(models.Schedule).GetAll()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	// Expand every schedule into the meetings around now, grouped by section
+	meetings := make(map[int][]models.Occurrence)
+	for _, schedule := range schedules {
+		occurrences := schedule.Occurrences(now.AddDate(0, 0, -1), now.AddDate(0, 0, 1), loc)
+		meetings[schedule.SectionID] = append(meetings[schedule.SectionID], occurrences...)
+	}
+
+	classSessions, err := new(models.ClassSession).GetAllInProgress()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	// A section with a session in progress is not opened again, however early it was started
+	inProgress := make(map[int]bool)
+	for _, classSession := range classSessions {
+		inProgress[classSession.SectionID] = true
+	}
+
+	for sectionID, occurrences := range meetings {
+		if inProgress[sectionID] {
+			continue
+		}
+		s.open(sectionID, occurrences, now)
+	}
+
+	s.close(classSessions, meetings, now)
+}
+
+// open starts a session for a section if one of its meetings is about to begin or is under way
+// and no session has been started for that meeting yet.
+func (s *Scheduler) open(sectionID int, occurrences []models.Occurrence, now time.Time) {
+	for _, occurrence := range occurrences {
+		opensAt := occurrence.Start.Add(-s.cfg.OpenBefore)
+		if now.Before(opensAt) || !now.Before(occurrence.End) {
+			continue
+		}
+
+		// Don't reopen a meeting the instructor already started or ended
+		started, err := new(models.ClassSession).StartedSince(sectionID, opensAt)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		if started {
+			return
+		}
+
+		instructorID, err := new(models.Moderator).GetInstructorID(sectionID)
+		if err != nil {
+			fmt.Println(err)
+		}
+
+		_, _, err = startClassSession(sectionID, instructorID)
+		if err != nil {
+			fmt.Println(err)
+		}
+		return
+	}
+}
+
+// close ends every session in progress whose meeting is over.
+func (s *Scheduler) close(classSessions []models.ClassSession, meetings map[int][]models.Occurrence, now time.Time) {
+	for _, classSession := range classSessions {
+		startedAt, err := time.ParseInLocation(models.TimestampLayout, classSession.StartedAt, time.UTC)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+
+		closesAt := s.closesAt(meetings[classSession.SectionID], startedAt.In(now.Location()))
+		if now.Before(closesAt) {
+			continue
+		}
+
+		err = endClassSession(classSession.ID)
+		if err != nil {
+			fmt.Println(err)
+		}
+	}
+}
+
+// closesAt returns when a session started at startedAt should be closed: after the first meeting of that
+// day still to end, so that a session started early by hand closes with its class, or at the end of the
+// day for a session started after the last meeting.
+func (s *Scheduler) closesAt(occurrences []models.Occurrence, startedAt time.Time) time.Time {
+	midnight := time.Date(startedAt.Year(), startedAt.Month(), startedAt.Day()+1, 0, 0, 0, 0, startedAt.Location())
+
+	var meeting *models.Occurrence
+	for i, occurrence := range occurrences {
+		if !occurrence.End.After(startedAt) || !occurrence.Start.Before(midnight) {
+			continue
+		}
+		if meeting == nil || occurrence.End.Before(meeting.End) {
+			meeting = &occurrences[i]
+		}
+	}
+	if meeting != nil {
+		return meeting.End.Add(s.cfg.CloseAfter)
+	}
+
+	return midnight
+}
+
+// startedMeeting returns the meeting a session started at startedAt was started for: the one under way
+// then, or about to begin within openBefore. ok is false for a session started outside the schedule.
+func startedMeeting(occurrences []models.Occurrence, startedAt time.Time, openBefore time.Duration) (meeting models.Occurrence, ok bool) {
+	for _, occurrence := range occurrences {
+		if !startedAt.Before(occurrence.Start.Add(-openBefore)) && startedAt.Before(occurrence.End) {
+			return occurrence, true
+		}
+	}
+
+	return models.Occurrence{}, false
+}
@@ -0,0 +1,159 @@
+package controllers
+
+import (
+	"coeus/models"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// nextSessionEvent reads from a general socket until a start-session or end-session event of a section,
+// or a demo-warning-banner event arrives, and returns its type. Sessions of other sections are skipped.
+func nextSessionEvent(t *testing.T, socket *websocket.Conn, sectionID int) string {
+	t.Helper()
+	socket.SetReadDeadline(time.Now().Add(5 * time.Second))
+	for {
+		var event map[string]interface{}
+		if err := socket.ReadJSON(&event); err != nil {
+			t.Fatalf("waiting for a session event, read failed with error: %v", err)
+		}
+		switch event["type"] {
+		case "start-session", "end-session":
+			if int(payload(event)["sectionID"].(float64)) == sectionID {
+				return event["type"].(string)
+			}
+		case "demo-warning-banner":
+			return event["type"].(string)
+		}
+	}
+}
+
+func TestSchedulerTick(t *testing.T) {
+	server, dial := newWebsocketServer(t)
+	defer server.Close()
+	db := models.DB()
+
+	// Section 3 meets on Sundays from 03:00 to 03:50, during its 2023 term, when no other section meets
+	scheduleID, err := new(models.Schedule).Add(models.Schedule{SectionID: 3, Weekdays: []time.Weekday{time.Sunday}, StartTime: "03:00", EndTime: "03:50"})
+	if err != nil {
+		t.Fatalf("add schedule failed with error: %v", err)
+	}
+	defer db.Exec("DELETE FROM schedule WHERE id = $1", scheduleID)
+
+	var lastID int
+	db.QueryRow("SELECT COALESCE(MAX(id), 0) FROM class_session").Scan(&lastID)
+	defer db.Exec("DELETE FROM class_session WHERE id > $1", lastID)
+	defer db.Exec("DELETE FROM attendance WHERE class_session_id > $1", lastID)
+	defer db.Exec("DELETE FROM user_attendance WHERE attendance_id IN (SELECT id FROM attendance WHERE class_session_id > $1)", lastID)
+	defer db.Exec("DELETE FROM attendance_status_change WHERE attendance_id IN (SELECT id FROM attendance WHERE class_session_id > $1)", lastID)
+
+	socket, _, err := dial(3, "/ws")
+	if err != nil {
+		t.Fatalf("dial failed with error: %v", err)
+	}
+	defer socket.Close()
+	waitFor(t, "the instructor to join", func() bool { return hub.Count(generalRoom) > 0 })
+
+	scheduler := NewScheduler(SchedulerConfig{Enabled: true, OpenBefore: 5 * time.Minute})
+	loc := models.OrganizationLocation()
+	at := func(clock string) time.Time {
+		t, _ := time.ParseInLocation("2006-01-02 15:04", "2023-02-05 "+clock, loc)
+		return t
+	}
+	// startedAt backdates the session in progress in section 3 to a time of the meeting day
+	startedAt := func(classSessionID int, clock string) {
+		db.Exec("UPDATE class_session SET started_at = $1 WHERE id = $2", at(clock).UTC().Format(models.TimestampLayout), classSessionID)
+	}
+	inProgress := func() int {
+		var classSessionID int
+		db.QueryRow("SELECT id FROM class_session WHERE section_id = 3 AND in_progress = true").Scan(&classSessionID)
+		return classSessionID
+	}
+	// expectQuiet checks that the ticks sent nothing, by sending an event after them
+	expectQuiet := func(when string) {
+		t.Helper()
+		TriggerDemoBannerWarning()
+		if event := nextSessionEvent(t, socket, 3); event != "demo-warning-banner" {
+			t.Errorf("%s the scheduler sent %s", when, event)
+		}
+	}
+
+	// A session the instructor started by hand before it opens is left alone, and closes with the class
+	classSessionID, _, err := startClassSession(3, 3)
+	if err != nil {
+		t.Fatalf("start class session failed with error: %v", err)
+	}
+	if event := nextSessionEvent(t, socket, 3); event != "start-session" {
+		t.Fatalf("starting the session sent %s, want start-session", event)
+	}
+	startedAt(classSessionID, "02:40")
+	for _, clock := range []string{"02:55", "03:00", "03:30", "03:49"} {
+		scheduler.Tick(at(clock))
+	}
+	expectQuiet("after an early start,")
+	if inProgress() != classSessionID {
+		t.Fatalf("the session started early is no longer the one in progress")
+	}
+	scheduler.Tick(at("03:50"))
+	if event := nextSessionEvent(t, socket, 3); event != "end-session" {
+		t.Errorf("the end of the class sent %s, want end-session", event)
+	}
+	if inProgress() != 0 {
+		t.Errorf("the session started early is still in progress after the class")
+	}
+
+	// A meeting is only opened once, even after the session was ended
+	scheduler.Tick(at("03:51"))
+	expectQuiet("after the class,")
+
+	// Had nobody started it, the scheduler opens the meeting late and closes it at the end of the class
+	db.Exec("UPDATE class_session SET started_at = $1, ended_at = $1 WHERE id = $2", at("02:00").AddDate(0, 0, -7).UTC().Format(models.TimestampLayout), classSessionID)
+	scheduler.Tick(at("03:20"))
+	if event := nextSessionEvent(t, socket, 3); event != "start-session" {
+		t.Fatalf("a meeting under way sent %s, want start-session", event)
+	}
+	classSessionID = inProgress()
+	if classSessionID == 0 {
+		t.Fatalf("the meeting under way was not started")
+	}
+	startedAt(classSessionID, "03:20")
+	scheduler.Tick(at("03:21"))
+	scheduler.Tick(at("03:49"))
+	expectQuiet("after a late start,")
+	scheduler.Tick(at("03:50"))
+	if event := nextSessionEvent(t, socket, 3); event != "end-session" {
+		t.Errorf("the end of the class sent %s, want end-session", event)
+	}
+	if inProgress() != 0 {
+		t.Errorf("the session started late is still in progress after the class")
+	}
+}
+
+func TestSchedulerClosesAt(t *testing.T) {
+	loc := time.UTC
+	day := func(hour, minute int) time.Time { return time.Date(2023, 2, 6, hour, minute, 0, 0, loc) }
+	occurrences := []models.Occurrence{
+		{Start: day(14, 0), End: day(14, 50)},
+		{Start: day(10, 10), End: day(11, 0)},
+		{Start: day(10, 10).AddDate(0, 0, 1), End: day(11, 0).AddDate(0, 0, 1)},
+	}
+	scheduler := NewScheduler(SchedulerConfig{OpenBefore: 5 * time.Minute, CloseAfter: 10 * time.Minute})
+
+	tests := []struct {
+		name      string
+		startedAt time.Time
+		want      time.Time
+	}{
+		{"early", day(9, 0), day(11, 10)},
+		{"on time", day(10, 5), day(11, 10)},
+		{"late", day(10, 40), day(11, 10)},
+		{"between meetings", day(12, 0), day(15, 0)},
+		{"after the last meeting", day(16, 0), day(24, 0)},
+	}
+	for _, test := range tests {
+		if got := scheduler.closesAt(occurrences, test.startedAt); !got.Equal(test.want) {
+			t.Errorf("%s: closesAt = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// eventStreamRetry is how long, in milliseconds, browsers wait before reopening a dropped event stream.
+const eventStreamRetry = 3000
+
+// HandleClassEventStream streams the live events of a class session as Server-Sent Events, for
+// clients whose network doesn't let websockets through. Every event carries the same JSON as on
+// the websocket, with the id "stream:seq", so a browser reopening the stream sends it back as
+// Last-Event-ID and resumes where it left off. When the hub drops the client, a "close" event
+// carries the code and reason a websocket would have been closed with.
+func HandleClassEventStream(c *gin.Context) {
+	follower, ok := followClassSession(c)
+	if !ok {
+		return
+	}
+
+	// The id of the last event seen takes precedence over the query of the first request
+	if lastEventID := c.GetHeader("Last-Event-ID"); lastEventID != "" {
+		stream, lastSeq, err := parseEventID(lastEventID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid Last-Event-ID"})
+			return
+		}
+		follower.stream, follower.lastSeq = stream, lastSeq
+	}
+
+	flusher, ok := c.Writer.(http.Flusher)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Streaming not supported"})
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	// Ask proxies such as nginx not to buffer the stream
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	fmt.Fprintf(c.Writer, "retry: %d\n\n", eventStreamRetry)
+	flusher.Flush()
+
+	connection := hub.NewConnection(nil, follower.classSessionID)
+	if !follower.join(connection) {
+		return
+	}
+	defer follower.leave()
+	defer hub.Unregister(connection)
+
+	// Comments keep proxies from timing out a quiet stream
+	ticker := time.NewTicker(connection.pingPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case message, ok := <-connection.send:
+			if !ok {
+				writeCloseEvent(c, connection)
+				flusher.Flush()
+				return
+			}
+			if err := writeEvent(c, message); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-ticker.C:
+			if _, err := fmt.Fprint(c.Writer, ": ping\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-c.Request.Context().Done():
+			return
+		}
+	}
+}
+
+// writeEvent writes a message of the hub as an event, with the id of its place in the stream.
+func writeEvent(c *gin.Context, message []byte) error {
+	var numbered struct {
+		Seq    *int64 `json:"seq"`
+		Stream string `json:"stream"`
+	}
+	json.Unmarshal(message, &numbered)
+
+	if numbered.Seq != nil && numbered.Stream != "" {
+		if _, err := fmt.Fprintf(c.Writer, "id: %s:%d\n", numbered.Stream, *numbered.Seq); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(c.Writer, "data: %s\n\n", message)
+	return err
+}
+
+// writeCloseEvent tells the client why the hub dropped it, if it was dropped rather than the request ending.
+func writeCloseEvent(c *gin.Context, connection *Connection) {
+	if connection.closeCode == 0 {
+		return
+	}
+	closing, _ := json.Marshal(gin.H{
+		"code":   connection.closeCode,
+		"reason": connection.closeReason,
+	})
+	fmt.Fprintf(c.Writer, "event: close\ndata: %s\n\n", closing)
+}
+
+// parseEventID splits an event id into the stream and the sequence number of the event.
+func parseEventID(id string) (string, int64, error) {
+	separator := strings.LastIndex(id, ":")
+	if separator < 0 {
+		return "", 0, fmt.Errorf("event id %q has no stream", id)
+	}
+	seq, err := strconv.ParseInt(id[separator+1:], 10, 64)
+	if err != nil || seq < 0 {
+		return "", 0, fmt.Errorf("invalid event id %q", id)
+	}
+	return id[:separator], seq, nil
+}
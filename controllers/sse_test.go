@@ -0,0 +1,180 @@
+package controllers
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// sseEvent is an event read from an event stream.
+type sseEvent struct {
+	id    string
+	event string
+	data  string
+}
+
+// openEventStream signs a user in and opens the event stream of a path, with a Last-Event-ID when set.
+// It returns the response and a function reading the next event, skipping comments and retry hints.
+func openEventStream(t *testing.T, serverURL string, userID int, path string, lastEventID string) (*http.Response, func() sseEvent) {
+	t.Helper()
+
+	request, _ := http.NewRequest("GET", serverURL+path, nil)
+	if userID != 0 {
+		response, err := http.Get(fmt.Sprintf("%s/test-sign-in/%d", serverURL, userID))
+		if err != nil {
+			t.Fatalf("sign in failed with error: %v", err)
+		}
+		response.Body.Close()
+		request.Header.Set("Cookie", response.Header.Get("Set-Cookie"))
+	}
+	if lastEventID != "" {
+		request.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("event stream request failed with error: %v", err)
+	}
+
+	reader := bufio.NewReader(response.Body)
+	next := func() sseEvent {
+		t.Helper()
+		events := make(chan sseEvent, 1)
+		go func() {
+			var event sseEvent
+			for {
+				line, err := reader.ReadString('\n')
+				if err != nil {
+					close(events)
+					return
+				}
+				line = strings.TrimSuffix(line, "\n")
+				switch {
+				case line == "":
+					if event.data != "" {
+						events <- event
+						return
+					}
+				case strings.HasPrefix(line, "id: "):
+					event.id = strings.TrimPrefix(line, "id: ")
+				case strings.HasPrefix(line, "event: "):
+					event.event = strings.TrimPrefix(line, "event: ")
+				case strings.HasPrefix(line, "data: "):
+					event.data = strings.TrimPrefix(line, "data: ")
+				}
+			}
+		}()
+
+		select {
+		case event, ok := <-events:
+			if !ok {
+				t.Fatal("event stream ended while waiting for an event")
+			}
+			return event
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for an event")
+			return sseEvent{}
+		}
+	}
+	return response, next
+}
+
+func TestEventStream(t *testing.T) {
+	server, dial := newWebsocketServer(t)
+	defer server.Close()
+
+	rooms := hub.Count(3)
+	response, next := openEventStream(t, server.URL, 1, "/sse/3", "")
+	if response.StatusCode != http.StatusOK || response.Header.Get("Content-Type") != "text/event-stream" {
+		t.Fatalf("event stream opened with %d %s", response.StatusCode, response.Header.Get("Content-Type"))
+	}
+	socket, _, err := dial(1, "/ws/3")
+	if err != nil {
+		t.Fatalf("dial failed with error: %v", err)
+	}
+	defer socket.Close()
+	waitFor(t, "the stream and socket to join", func() bool { return hub.Count(3) == rooms+2 })
+	if event := next(); !strings.Contains(event.data, `"type":"participant-joined"`) {
+		t.Errorf("first event = %s, want the user arriving", event.data)
+	}
+
+	// The stream carries exactly what the websocket does, with the place of the event as its id
+	constructVoteUp(3, 1, 6, 5)
+	event := next()
+	socket.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, message, err := socket.ReadMessage()
+	if err != nil {
+		t.Fatalf("websocket read failed with error: %v", err)
+	}
+	if event.data != string(message) {
+		t.Errorf("event stream sent %s, websocket %s", event.data, message)
+	}
+	if !strings.HasPrefix(event.id, hub.Stream()+":") {
+		t.Errorf("event id %q isn't in the hub's stream %s", event.id, hub.Stream())
+	}
+
+	// A browser reopening the stream gets the events it missed in between
+	response.Body.Close()
+	waitFor(t, "the stream to leave", func() bool { return hub.Count(3) == rooms+1 })
+	constructVoteUp(3, 1, 6, 7)
+	constructMarkQuestion(3, 6)
+	response, next = openEventStream(t, server.URL, 1, "/sse/3", event.id)
+	for _, action := range []string{"vote-up", "mark-question"} {
+		if event := next(); !strings.Contains(event.data, fmt.Sprintf(`"type":"%s"`, action)) {
+			t.Errorf("resumed stream sent %s, want %s", event.data, action)
+		}
+	}
+	response.Body.Close()
+
+	// Events numbered by another instance are replaced with a snapshot
+	response, next = openEventStream(t, server.URL, 1, "/sse/3", "another-stream:4")
+	if event := next(); !strings.Contains(event.data, `"type":"snapshot"`) {
+		t.Errorf("stream resumed from another instance sent %s, want a snapshot", event.data)
+	}
+	response.Body.Close()
+
+	// The same checks as the websocket
+	for _, test := range []struct {
+		name        string
+		userID      int
+		path        string
+		lastEventID string
+		status      int
+	}{
+		{"signed out", 0, "/sse/3", "", http.StatusUnauthorized},
+		{"not a member", 2000, "/sse/3", "", http.StatusForbidden},
+		{"unknown class session", 1, "/sse/999999", "", http.StatusNotFound},
+		{"invalid id", 1, "/sse/3", "seven", http.StatusBadRequest},
+	} {
+		response, _ := openEventStream(t, server.URL, test.userID, test.path, test.lastEventID)
+		response.Body.Close()
+		if response.StatusCode != test.status {
+			t.Errorf("%s: status %d, want %d", test.name, response.StatusCode, test.status)
+		}
+	}
+
+	// A revoked stream is told why before it ends
+	response, next = openEventStream(t, server.URL, 22, "/sse/3", "")
+	defer response.Body.Close()
+	waitFor(t, "the stream to join", func() bool { return hub.Count(3) == rooms+2 })
+	next() // participant-joined
+	revokeUserWebsockets(22)
+	if event := next(); event.event != "close" || !strings.Contains(event.data, `"code":1008`) {
+		t.Errorf("revoked stream ended with %+v, want a close event with code 1008", event)
+	}
+}
+
+func TestParseEventID(t *testing.T) {
+	stream, seq, err := parseEventID("0a1b2c:42")
+	if err != nil || stream != "0a1b2c" || seq != 42 {
+		t.Errorf("parseEventID = %q, %d, %v", stream, seq, err)
+	}
+	for _, id := range []string{"42", "0a1b2c:", "0a1b2c:-1", "0a1b2c:x"} {
+		if _, _, err := parseEventID(id); err == nil {
+			t.Errorf("parseEventID(%q) succeeded", id)
+		}
+	}
+}
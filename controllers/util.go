@@ -1,28 +1,124 @@
-package controllers
-
-import (
-	"net/http"
-
-	"github.com/gin-contrib/sessions"
-	"github.com/gin-gonic/gin"
-)
-
-func RenderTemplate(c *gin.Context, code int, templateName string, data gin.H) {
-	session := sessions.Default(c)
-
-	data["isInstructor"] = session.Get("isInstructor")
-	data["managementTemplates"] = session.Get("managementTemplates")
-	data["isAdmin"] = session.Get("isAdmin")
-	data["usingSendGrid"] = session.Get("usingSendGrid")
-	data["userInitials"] = session.Get("userInitials")
-	data["sectionID"] = session.Get("sectionID")
-	data["moderatorType"] = session.Get("moderatorType")
-	data["classSessionID"] = session.Get("classSessionID")
-	data["user"] = session.Get("userID")
-	data["cssStyle"] = session.Get("cssStyle")
-	data["timezone"] = session.Get("timezone")
-	data["organizationLogo"] = session.Get("organizationLogo")
-	data["organizationName"] = session.Get("organizationName")
-	data["isDemo"] = session.Get("isDemo")
-	c.HTML(http.StatusOK, templateName, data)
-}
+package controllers
+
+import (
+	"coeus/models"
+	"net/http"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+)
+
+func RenderTemplate(c *gin.Context, code int, templateName string, data gin.H) {
+	session := sessions.Default(c)
+
+	data["isInstructor"] = session.Get("isInstructor")
+	data["managementTemplates"] = session.Get("managementTemplates")
+	data["isAdmin"] = session.Get("isAdmin")
+	data["usingSendGrid"] = session.Get("usingSendGrid")
+	data["userInitials"] = session.Get("userInitials")
+	data["sectionID"] = session.Get("sectionID")
+	data["moderatorType"] = session.Get("moderatorType")
+	data["classSessionID"] = session.Get("classSessionID")
+	data["user"] = session.Get("userID")
+	data["cssStyle"] = session.Get("cssStyle")
+	data["timezone"] = session.Get("timezone")
+	data["organizationLogo"] = session.Get("organizationLogo")
+	data["organizationName"] = session.Get("organizationName")
+	data["isDemo"] = session.Get("isDemo")
+	c.HTML(http.StatusOK, templateName, data)
+}
+
+// isSectionStaff reports whether the signed in user is the instructor or a
+// teacher assistant of the section.
+func isSectionStaff(c *gin.Context, sectionID int) bool {
+	session := sessions.Default(c)
+	userID, ok := session.Get("userID").(int)
+	if !ok {
+		return false
+	}
+
+	moderator, err := new(models.Moderator).GetStatus(userID, sectionID)
+	if err != nil {
+		return false
+	}
+
+	return moderator.Type == "instructor" || moderator.Type == "teacher assistant"
+}
+
+// isSectionModerator reports whether the signed in user can moderate the questions of
+// the section, as its instructor, a teacher assistant or a moderator.
+func isSectionModerator(c *gin.Context, sectionID int) bool {
+	session := sessions.Default(c)
+	userID, ok := session.Get("userID").(int)
+	if !ok {
+		return false
+	}
+
+	return canModerateSection(userID, sectionID)
+}
+
+// canModerateSection reports whether a user is the instructor, a teacher assistant or a moderator of a section.
+func canModerateSection(userID int, sectionID int) bool {
+	moderator, err := new(models.Moderator).GetStatus(userID, sectionID)
+	if err != nil {
+		return false
+	}
+
+	return moderator.Type == "instructor" || moderator.Type == "teacher assistant" || moderator.Type == "moderator"
+}
+
+// isSectionMember reports whether the signed in user is enrolled in the section
+// or is one of its moderators.
+func isSectionMember(c *gin.Context, sectionID int) bool {
+	session := sessions.Default(c)
+	userID, ok := session.Get("userID").(int)
+	if !ok {
+		return false
+	}
+
+	return canJoinSection(userID, sectionID)
+}
+
+// canJoinSection reports whether a user may follow the class sessions of a section,
+// as a student enrolled in it or as its instructor, a teacher assistant or a moderator.
+func canJoinSection(userID int, sectionID int) bool {
+	if canModerateSection(userID, sectionID) {
+		return true
+	}
+
+	sectionIDs, err := new(models.Section).GetEnrolledSections(userID)
+	if err != nil {
+		return false
+	}
+
+	for _, id := range sectionIDs {
+		if id == sectionID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// requestBaseURL returns the scheme and host the client used to reach the server,
+// such as "https://coeus.example.edu", honouring a proxy's X-Forwarded-Proto header.
+func requestBaseURL(c *gin.Context) string {
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	if proto := c.GetHeader("X-Forwarded-Proto"); proto == "https" || proto == "http" {
+		scheme = proto
+	}
+	return scheme + "://" + c.Request.Host
+}
+
+// containsString reports whether s is in list.
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -1,68 +1,351 @@
-package controllers
-
-import (
-	"net/http"
-	"strconv"
-
-	"github.com/gin-gonic/gin"
-)
-
-// HandleGeneralWebsocketConnection handles the websocket connection for general changes to the database
-func HandleGeneralWebsocketConnection(c *gin.Context) {
-	w := c.Writer
-	r := c.Request
-	conn, err := upgrader.Upgrade(w, r, nil)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to upgrade to WebSocket"})
-		return
-	}
-
-	connection := &Connection{conn: conn, send: make(chan []byte), closed: make(chan bool, 2)}
-
-	generalConnections[connection] = true
-
-	go connection.writePump()
-	go connection.readPump()
-
-	<-connection.closed
-	<-connection.closed
-
-	delete(generalConnections, connection)
-	conn.Close()
-}
-
-// HandleClassWebsocketConnection handles the websocket connection for a specific class session
-func HandleClassWebsocketConnection(c *gin.Context) {
-	// Extract classSessionID from the request
-	classSessionID, err := strconv.Atoi(c.Param("classSessionID"))
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to extract class session ID"})
-		return
-	}
-
-	w := c.Writer
-	r := c.Request
-	conn, err := upgrader.Upgrade(w, r, nil)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to upgrade to WebSocket"})
-		return
-	}
-
-	connection := &Connection{conn: conn, send: make(chan []byte), closed: make(chan bool, 2)}
-
-	// Add the connection to the appropriate class session
-	if _, ok := connections[classSessionID]; !ok {
-		connections[classSessionID] = make(map[*Connection]bool)
-	}
-	connections[classSessionID][connection] = true
-
-	go connection.writePump()
-	go connection.readPump()
-
-	<-connection.closed
-	<-connection.closed
-
-	// Remove the connection from the class session
-	delete(connections[classSessionID], connection)
-	conn.Close()
-}
+package controllers
+
+import (
+	"coeus/events"
+	"coeus/models"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+// HandleGeneralWebsocketConnection handles the websocket connection for general changes to the database
+func HandleGeneralWebsocketConnection(c *gin.Context) {
+	session := sessions.Default(c)
+	userID, ok := session.Get("userID").(int)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Sign in required"})
+		return
+	}
+
+	// The upgrader replies to the client itself when the upgrade fails
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return
+	}
+
+	connection := hub.NewConnection(conn, generalRoom)
+	connection.userID = userID
+	hub.serve(connection, func() bool {
+		return hub.Register(connection)
+	})
+}
+
+// HandleClassWebsocketConnection handles the websocket connection for a specific class session
+func HandleClassWebsocketConnection(c *gin.Context) {
+	follower, ok := followClassSession(c)
+	if !ok {
+		return
+	}
+
+	// The upgrader replies to the client itself when the upgrade fails
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return
+	}
+
+	// Join the room of the class session, where the user is present until the websocket closes
+	connection := hub.NewConnection(conn, follower.classSessionID)
+	joined := false
+	hub.serve(connection, func() bool {
+		joined = follower.join(connection)
+		return joined
+	})
+	if joined {
+		follower.leave()
+	}
+}
+
+// classSessionFollower is a signed in user following the live events of a class session,
+// and where they left off when reconnecting.
+type classSessionFollower struct {
+	userID         int
+	classSessionID int
+	sectionID      int
+	// moderator is whether the user moderates the section
+	moderator     bool
+	timezone      int
+	moderatorType interface{}
+	// stream and lastSeq identify the last event a reconnecting client saw; lastSeq is -1 for a new client
+	stream  string
+	lastSeq int64
+}
+
+// followClassSession checks that the signed in user may follow the class session of the request,
+// which only the students and moderators of its section may, and reads where a reconnecting
+// client left off from the lastSeq and stream query parameters.
+// It replies with an error and returns false when the user may not follow it.
+func followClassSession(c *gin.Context) (classSessionFollower, bool) {
+	follower := classSessionFollower{lastSeq: -1}
+
+	session := sessions.Default(c)
+	userID, ok := session.Get("userID").(int)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Sign in required"})
+		return follower, false
+	}
+	follower.userID = userID
+
+	// Extract classSessionID from the request
+	classSessionID, err := strconv.Atoi(c.Param("classSessionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid class session ID"})
+		return follower, false
+	}
+	follower.classSessionID = classSessionID
+
+	// Only the students and moderators of the section may follow its class sessions
+	sectionID, err := new(models.ClassSession).GetSectionID(classSessionID)
+	if err == sql.ErrNoRows {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Class session not found"})
+		return follower, false
+	}
+	if err != nil {
+		fmt.Println(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get the class session"})
+		return follower, false
+	}
+	if !canJoinSection(userID, sectionID) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Not a member of this section"})
+		return follower, false
+	}
+	follower.sectionID = sectionID
+	follower.moderator = canModerateSection(userID, sectionID)
+
+	// A reconnecting client sends the sequence number and stream of the last event it saw
+	follower.stream = c.Query("stream")
+	if c.Query("lastSeq") != "" {
+		follower.lastSeq, err = strconv.ParseInt(c.Query("lastSeq"), 10, 64)
+		if err != nil || follower.lastSeq < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid lastSeq"})
+			return follower, false
+		}
+	}
+	timezoneString, _ := session.Get("timezone").(string)
+	follower.timezone, _ = strconv.Atoi(timezoneString)
+	follower.moderatorType = session.Get("moderatorType")
+
+	return follower, true
+}
+
+// join adds a connection of the follower to the room of the class session and marks them present.
+// A reconnecting client gets the events it missed replayed, or the whole class session if they are gone.
+// It returns false if the connection couldn't join; otherwise leave must be called once it closes.
+func (f classSessionFollower) join(connection *Connection) bool {
+	connection.userID = f.userID
+	connection.sectionID = f.sectionID
+	connection.moderator = f.moderator
+	if !f.register(connection) {
+		return false
+	}
+
+	f.arrive()
+	return true
+}
+
+// register adds a connection of the follower to the hub, replaying what it missed or sending a snapshot.
+func (f classSessionFollower) register(connection *Connection) bool {
+	if f.lastSeq < 0 {
+		return hub.Register(connection)
+	}
+
+	seq, replayed, ok := hub.Resume(connection, f.stream, f.lastSeq)
+	if ok && !replayed {
+		snapshot, err := classSessionSnapshot(f.classSessionID, f.userID, f.timezone, f.moderatorType, seq)
+		if err != nil {
+			fmt.Println(err)
+			hub.Unregister(connection)
+			return false
+		}
+		hub.Send(connection, snapshot)
+	}
+	return ok
+}
+
+// arrive records that the follower joined the class session and is present in it.
+func (f classSessionFollower) arrive() {
+	_, err := new(models.ClassSession).Join(f.classSessionID, f.userID)
+	if err != nil {
+		fmt.Println(err)
+	}
+	relay.Arrive(f.classSessionID, f.userID)
+}
+
+// leave records that a connection of the follower left the class session.
+func (f classSessionFollower) leave() {
+	relay.Depart(f.classSessionID, f.userID)
+}
+
+// classSessionSnapshot returns a snapshot event with the state of a class session as of event seq,
+// for a client that missed events that are no longer logged. Events after seq may already be part of it.
+func classSessionSnapshot(classSessionID int, userID int, timezone int, moderatorType interface{}, seq int64) ([]byte, error) {
+	questions := getSessionQuestions(classSessionID, userID, timezone)
+	snapshot := events.Snapshot{
+		QuestionsByTime:           snapshotQuestions(questions.byTime),
+		QuestionsByVote:           snapshotQuestions(questions.byVote),
+		QuestionsByVoteUnanswered: snapshotQuestions(questions.byVoteUnanswered),
+		QuestionsByTimeUnanswered: snapshotQuestions(questions.byTimeUnanswered),
+		Count:                     relay.Present(classSessionID),
+	}
+	snapshot.ModeratorType, _ = moderatorType.(string)
+
+	inProgress, err := new(models.ClassSession).GetInProgress(classSessionID)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.InProgress = inProgress
+
+	event := events.New(classSessionID, snapshot)
+	event.Seq, event.Stream = seq, hub.Stream()
+	return json.Marshal(event)
+}
+
+// snapshotQuestions converts questions and their answers to the questions of a snapshot event.
+func snapshotQuestions(questions []models.Question) []events.Question {
+	snapshot := make([]events.Question, 0, len(questions))
+	for _, question := range questions {
+		answers := make([]events.Answer, 0, len(question.Answers))
+		for _, answer := range question.Answers {
+			answers = append(answers, events.Answer{
+				AnswerID:      answer.ID,
+				QuestionID:    answer.QuestionID,
+				UserID:        answer.UserID,
+				Text:          answer.Text,
+				FirstName:     answer.FirstName,
+				LastName:      answer.LastName,
+				ModeratorType: answer.ModeratorType,
+				CreatedAt:     answer.CreatedAt,
+			})
+		}
+		snapshot = append(snapshot, events.Question{
+			QuestionID:   question.ID,
+			UserID:       question.UserID,
+			Text:         question.Text,
+			Votes:        question.Votes,
+			Answered:     question.Answered,
+			CreatedAt:    question.CreatedAt,
+			UserHasVoted: question.UserHasVoted,
+			Answers:      answers,
+		})
+	}
+	return snapshot
+}
+
+// clientAction is a message a client sends over the class session websocket, such as
+// {"action": "vote-up", "requestId": "7", "questionID": 12}. The fields used depend on the action.
+type clientAction struct {
+	Action       string `json:"action"`
+	RequestID    string `json:"requestId"`
+	QuestionText string `json:"questionText"`
+	Confirmed    bool   `json:"confirmed"`
+	QuestionID   int    `json:"questionID"`
+	PollID       int    `json:"pollID"`
+	OptionID     int    `json:"optionID"`
+	QuizID       int    `json:"quizID"`
+	// Answers maps the questions of a quiz to the options chosen
+	Answers map[int]int `json:"answers"`
+	// Code is the check-in code shown by the instructor
+	Code string `json:"code"`
+}
+
+// handleClientAction runs an action a client sent and replies to the client alone with an "ack",
+// or an "error" when the action failed, carrying the request id, status and body of the outcome.
+func (c *Connection) handleClientAction(message []byte) {
+	var action clientAction
+	status, body := http.StatusBadRequest, gin.H{"error": "Invalid message"}
+	if err := json.Unmarshal(message, &action); err == nil {
+		status, body = runClientAction(c, action)
+	}
+
+	var reply events.Payload = events.Ack{RequestID: action.RequestID, Status: status, Data: body}
+	if status >= http.StatusBadRequest {
+		message, ok := body["error"].(string)
+		if !ok {
+			message = http.StatusText(status)
+		}
+		reply = events.ActionError{RequestID: action.RequestID, Status: status, Data: body, Error: message}
+	}
+
+	replyBytes, err := json.Marshal(events.New(c.room, reply))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	hub.Send(c, replyBytes)
+}
+
+// runClientAction runs an action for the user of a class session connection, on the class session
+// it is connected to, with the same checks as the HTTP endpoint of the action.
+// It returns the status and body of the outcome.
+func runClientAction(c *Connection, action clientAction) (int, gin.H) {
+	if c.room == generalRoom {
+		return http.StatusBadRequest, gin.H{"error": "Actions are only accepted on class session websockets"}
+	}
+
+	switch action.Action {
+	case "post-question":
+		return postQuestionAction(c.userID, c.room, action.QuestionText, action.Confirmed)
+	case "vote-up":
+		return voteUpAction(c.userID, c.room, action.QuestionID)
+	case "mark-question":
+		return markQuestionAction(c.userID, c.room, action.QuestionID)
+	case "poll-response":
+		return respondPollAction(c.userID, c.room, action.PollID, action.OptionID)
+	case "quiz-submit":
+		return submitQuizAction(c.userID, c.room, action.QuizID, action.Answers)
+	case "check-in":
+		attendanceID, err := new(models.Attendance).GetAttendanceIDByClassSessionID(c.room)
+		if err == sql.ErrNoRows {
+			return http.StatusConflict, gin.H{"error": "Check-in is not open"}
+		}
+		if err != nil {
+			fmt.Println(err)
+			return http.StatusInternalServerError, gin.H{"error": "Failed to get the attendance"}
+		}
+		return checkInAction(c.userID, attendanceID, action.Code)
+	default:
+		return http.StatusBadRequest, gin.H{"error": "Unknown action"}
+	}
+}
+
+// revokeUserWebsockets closes every websocket of a user on every instance, once they sign out or are deleted.
+func revokeUserWebsockets(userID int) {
+	relay.RevokeUser(userID)
+}
+
+// revokeWebsocketAccess closes the class session websockets of users who may no longer join
+// the section of the class session on every instance, after enrollments or moderators changed,
+// and reconnects those whose moderator role changed.
+// A zero userID or sectionID checks the connections of every user or section.
+func revokeWebsocketAccess(userID int, sectionID int) {
+	relay.RevokeAccess(userID, sectionID)
+}
+
+// revokeUser closes the websockets of a user in a hub.
+func revokeUser(h *Hub, userID int) {
+	h.Revoke(func(connection *Connection) bool {
+		return connection.userID == userID
+	}, websocket.ClosePolicyViolation, "access revoked")
+}
+
+// revokeAccess closes the class session websockets in a hub of users who may no longer join their section,
+// and asks those who became or stopped being one of its moderators to reconnect with their new role.
+func revokeAccess(h *Hub, userID int, sectionID int) {
+	checked := func(connection *Connection) bool {
+		if connection.room == generalRoom {
+			return false
+		}
+		return (userID == 0 || connection.userID == userID) && (sectionID == 0 || connection.sectionID == sectionID)
+	}
+	h.Revoke(func(connection *Connection) bool {
+		return checked(connection) && !canJoinSection(connection.userID, connection.sectionID)
+	}, websocket.ClosePolicyViolation, "access revoked")
+	h.Revoke(func(connection *Connection) bool {
+		return checked(connection) && connection.moderator != canModerateSection(connection.userID, connection.sectionID)
+	}, websocket.CloseServiceRestart, "role changed")
+}
@@ -1,222 +1,400 @@
-package controllers
-
-import (
-	"coeus/models"
-	"encoding/json"
-	"log"
-	"net/http"
-
-	"github.com/gin-gonic/gin"
-	"github.com/gorilla/websocket"
-)
-
-type Connection struct {
-	conn   *websocket.Conn
-	send   chan []byte
-	closed chan bool
-}
-
-// Connections for all changes to the database
-var generalConnections = make(map[*Connection]bool)
-
-// A map of class session ids to a map of connections
-var connections = make(map[int]map[*Connection]bool)
-
-var upgrader = websocket.Upgrader{
-	ReadBufferSize:  1024,
-	WriteBufferSize: 1024,
-	CheckOrigin: func(r *http.Request) bool {
-		return true
-	},
-}
-
-// BROADCAST FUNCTIONS START
-
-// Broadcasts a message to all active connections
-func generalBroadcast(message []byte) {
-	for connection := range generalConnections {
-		select {
-		case connection.send <- message:
-		default:
-			delete(generalConnections, connection)
-			close(connection.send)
-		}
-	}
-}
-
-// Broadcasts a message to all active connections for a specific class session
-func classSessionBroadcast(classSessionID int, message []byte) {
-	if conns, ok := connections[classSessionID]; ok {
-		for connection := range conns {
-			select {
-			case connection.send <- message:
-			default:
-				delete(conns, connection)
-				close(connection.send)
-			}
-		}
-	}
-}
-
-func constructVoteUp(classSessionID, userID, questionID, updatedVoteCount int) {
-	// Construct a JSON object
-	voteUp := map[string]interface{}{
-		"action":     "vote-up",
-		"userID":     userID,
-		"questionID": questionID,
-		"votes":      updatedVoteCount,
-	}
-	// Marshal the voteUp message
-	voteUpBytes, err := json.Marshal(voteUp)
-	if err != nil {
-		log.Println("Error marshalling JSON:", err)
-		return
-	}
-	// Broadcast the "vote-up" action to all active connections
-	classSessionBroadcast(classSessionID, voteUpBytes)
-}
-
-func broadcastNewQuestion(classSessionID int, question models.Question) {
-	// Construct a JSON object
-	newQuestion := map[string]interface{}{
-		"action":     "new-question",
-		"userID":     question.UserID,
-		"questionID": question.ID,
-		"sessionID":  question.SessionID,
-		"text":       question.Text,
-		"votes":      question.Votes,
-		"answered":   question.Answered,
-		"createdAt":  question.CreatedAt,
-	}
-	// Marshal the newQuestion message
-	questionBytes, err := json.Marshal(newQuestion)
-	if err != nil {
-		log.Println("Error marshalling JSON:", err)
-		return
-	}
-	// Broadcast the newQuestion message to all active connections
-	classSessionBroadcast(classSessionID, questionBytes)
-}
-
-func constructMarkQuestion(classSessionID, questionID int) {
-	// Construct a JSON object
-	markQuestion := map[string]interface{}{
-		"action":     "mark-question",
-		"questionID": questionID,
-		"answered":   true,
-	}
-	// Marshal the markQuestion message
-	markQuestionBytes, err := json.Marshal(markQuestion)
-	if err != nil {
-		log.Println("Error marshalling JSON:", err)
-		return
-	}
-	// Broadcast the "mark-question" action to all active connections
-	classSessionBroadcast(classSessionID, markQuestionBytes)
-}
-
-func constructStartSession(sectionID, attendanceID int) {
-	// Construct a JSON object
-	startSession := map[string]interface{}{
-		"action":       "start-session",
-		"sectionID":    sectionID,
-		"attendanceID": attendanceID,
-	}
-	// Marshal the startSession message
-	startSessionBytes, err := json.Marshal(startSession)
-	if err != nil {
-		log.Println("Error marshalling JSON:", err)
-		return
-	}
-	// Broadcast the "start-session" action to all active connections
-	generalBroadcast(startSessionBytes)
-	classSessionBroadcast(sectionID, startSessionBytes)
-}
-
-func constructEndSession(classSessionID, sectionID int) {
-	// Construct a JSON object
-	endSession := map[string]interface{}{
-		"action":    "end-session",
-		"sectionID": sectionID,
-	}
-	// Marshal the endSession message
-	endSessionBytes, err := json.Marshal(endSession)
-	if err != nil {
-		log.Println("Error marshalling JSON:", err)
-		return
-	}
-	// Broadcast the "end-session" action to all active connections
-	generalBroadcast(endSessionBytes)
-	classSessionBroadcast(classSessionID, endSessionBytes)
-}
-
-func constructParticipantJoined(classSessionID, participantCount int) {
-	// Construct a JSON object
-	participantJoined := map[string]interface{}{
-		"action": "participant-joined",
-		"count":  participantCount,
-	}
-	// Marshal the participantJoined message
-	participantJoinedBytes, err := json.Marshal(participantJoined)
-	if err != nil {
-		log.Println("Error marshalling JSON:", err)
-		return
-	}
-	// Broadcast the "participant-joined" action to all active connections
-	classSessionBroadcast(classSessionID, participantJoinedBytes)
-}
-
-func TriggerDemoBannerWarning() {
-	// Construct a JSON object
-	banner := map[string]interface{}{
-		"action": "demo-warning-banner",
-	}
-	// Marshal the endSession message
-	bannerWarning, err := json.Marshal(banner)
-	if err != nil {
-		log.Println("Error marshalling JSON:", err)
-		return
-	}
-
-	// Broadcast the "end-session" action to all active connections
-	generalBroadcast(bannerWarning)
-}
-
-// BROADCAST FUNCTIONS END
-
-// DATA PUMPS START
-func (c *Connection) writePump() {
-	for {
-		select {
-		case message, ok := <-c.send:
-			if !ok {
-				c.closed <- true
-				return
-			}
-			c.conn.WriteMessage(websocket.TextMessage, message)
-		}
-	}
-}
-
-func (c *Connection) readPump() {
-	for {
-		_, messageBytes, err := c.conn.ReadMessage()
-		if err != nil {
-			break
-		}
-		// Unmarshal the received JSON message
-		var input map[string]interface{}
-		if err := json.Unmarshal(messageBytes, &input); err != nil {
-			log.Println("Error unmarshalling JSON:", err)
-			break
-		}
-	}
-	c.closed <- true
-}
-
-// DATA PUMPS END
-
-func WSRoutes(g *gin.RouterGroup) {
-	g.GET("/ws", HandleGeneralWebsocketConnection)
-	g.GET("/ws/:classSessionID", HandleClassWebsocketConnection)
-}
+package controllers
+
+import (
+	"coeus/events"
+	"coeus/models"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+// Connection is a websocket client in one room of the hub. Its write pump is the only goroutine
+// that writes to the socket and its read pump the only one that reads from it.
+// Event stream clients are connections without a socket, written to by their request handler.
+type Connection struct {
+	conn *websocket.Conn
+	room int
+	// userID is the signed in user the connection belongs to
+	userID int
+	// sectionID is the section of the class session room, 0 in the general room
+	sectionID int
+	// moderator is whether the user moderated the section when the connection was authorized
+	moderator bool
+	// send queues messages for the write pump; only the hub closes it
+	send chan []byte
+	// closeCode and closeReason are set by the hub before it closes send to say why the
+	// connection was dropped; a zero code is a normal closure
+	closeCode   int
+	closeReason string
+	// done is closed once the write pump has closed the socket
+	done chan struct{}
+	// pingPeriod and pongWait are the heartbeat settings the connection was opened with
+	pingPeriod time.Duration
+	pongWait   time.Duration
+}
+
+// writeWait is how long a write to a client may take before the connection is dropped
+const writeWait = 10 * time.Second
+
+// maxMessageSize is the largest message, in bytes, a client may send
+const maxMessageSize = 4096
+
+// WebsocketConfig holds the settings of the websocket endpoints.
+type WebsocketConfig struct {
+	// AllowedOrigins are the origins, such as "https://coeus.example.edu", that browsers may open
+	// websockets from besides the server's own. "*" allows every origin.
+	AllowedOrigins []string
+	// PingPeriod is how often clients are pinged to check they are still there.
+	PingPeriod time.Duration
+	// PongWait is how long a client may go without answering a ping, or sending anything,
+	// before its connection is closed. It must be longer than PingPeriod.
+	PongWait time.Duration
+}
+
+// DefaultWebsocketConfig returns the websocket settings.
+// The WS_ALLOWED_ORIGINS environment variable, a comma separated list of origins, sets the allowed origins.
+func DefaultWebsocketConfig() WebsocketConfig {
+	cfg := WebsocketConfig{
+		PingPeriod: 25 * time.Second,
+		PongWait:   60 * time.Second,
+	}
+
+	for _, origin := range strings.Split(os.Getenv("WS_ALLOWED_ORIGINS"), ",") {
+		origin = strings.TrimRight(strings.TrimSpace(origin), "/")
+		if origin != "" {
+			cfg.AllowedOrigins = append(cfg.AllowedOrigins, strings.ToLower(origin))
+		}
+	}
+
+	return cfg
+}
+
+// websocketConfig is the websocket settings in use
+var websocketConfig = DefaultWebsocketConfig()
+
+// ConfigureWebsockets sets the websocket settings. It must be called before the server starts.
+func ConfigureWebsockets(cfg WebsocketConfig) {
+	websocketConfig = cfg
+}
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	CheckOrigin:     checkOrigin,
+}
+
+// checkOrigin accepts websockets opened from the server's own origin or an allowed origin.
+// Requests without an Origin header don't come from a browser page and are accepted;
+// they still need a session cookie.
+func checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	if strings.EqualFold(u.Host, r.Host) {
+		return true
+	}
+
+	origin = strings.ToLower(u.Scheme + "://" + u.Host)
+	for _, allowed := range websocketConfig.AllowedOrigins {
+		if allowed == "*" || allowed == origin {
+			return true
+		}
+	}
+
+	return false
+}
+
+// BROADCAST FUNCTIONS START
+
+// Broadcasts an event to all active connections, on every instance of the server
+func generalBroadcast(event events.Envelope) {
+	relay.Broadcast(generalRoom, event)
+}
+
+// Broadcasts an event to all active connections for a specific class session, on every instance
+// of the server. The event is numbered with the next sequence number of the class session.
+func classSessionBroadcast(event events.Envelope) {
+	relay.Broadcast(event.ClassSessionID, event)
+}
+
+func constructVoteUp(classSessionID, userID, questionID, updatedVoteCount int) {
+	// Broadcast the "vote-up" event to all active connections
+	classSessionBroadcast(events.New(classSessionID, events.VoteUp{
+		UserID:     userID,
+		QuestionID: questionID,
+		Votes:      updatedVoteCount,
+	}))
+}
+
+func broadcastNewQuestion(classSessionID int, question models.Question) {
+	// Broadcast the "new-question" event to all active connections
+	classSessionBroadcast(events.New(classSessionID, events.NewQuestion{
+		UserID:     question.UserID,
+		QuestionID: question.ID,
+		Text:       question.Text,
+		Votes:      question.Votes,
+		Answered:   question.Answered,
+		CreatedAt:  question.CreatedAt,
+	}))
+}
+
+// broadcastPendingQuestion tells the class session that a question is waiting for a moderator.
+// Only the id is sent; moderators fetch the text from the moderation queue.
+func broadcastPendingQuestion(classSessionID, questionID int) {
+	// Broadcast the "question-pending" event to all active connections
+	classSessionBroadcast(events.New(classSessionID, events.QuestionPending{QuestionID: questionID}))
+}
+
+// broadcastQuestionModerated tells the class session that a pending question was approved, without saying
+// who asked it. A rejection and its reason only go to the moderators of the section and the author.
+func broadcastQuestionModerated(question models.Question) {
+	if question.Status == models.QuestionRejected {
+		// Send the "question-moderated" event to the moderators and the author
+		relay.SendModerators(question.SessionID, question.UserID, events.New(question.SessionID, events.QuestionModerated{
+			QuestionID: question.ID,
+			UserID:     question.UserID,
+			Status:     question.Status,
+			Reason:     question.RejectionReason,
+		}))
+		return
+	}
+
+	// Broadcast the "question-moderated" event to all active connections
+	classSessionBroadcast(events.New(question.SessionID, events.QuestionModerated{
+		QuestionID: question.ID,
+		Status:     question.Status,
+	}))
+}
+
+// broadcastMergeQuestion tells the class session that question sourceID was merged into target.
+func broadcastMergeQuestion(sourceID int, target models.Question) {
+	// Broadcast the "merge-question" event to all active connections
+	classSessionBroadcast(events.New(target.SessionID, events.MergeQuestion{
+		SourceID:   sourceID,
+		QuestionID: target.ID,
+		Text:       target.Text,
+		Votes:      target.Votes,
+		Answered:   target.Answered,
+	}))
+}
+
+func broadcastNewAnswer(classSessionID int, answer models.Answer) {
+	// Broadcast the "new-answer" event to all active connections
+	classSessionBroadcast(events.New(classSessionID, events.NewAnswer{
+		AnswerID:      answer.ID,
+		QuestionID:    answer.QuestionID,
+		UserID:        answer.UserID,
+		Text:          answer.Text,
+		FirstName:     answer.FirstName,
+		LastName:      answer.LastName,
+		ModeratorType: answer.ModeratorType,
+		CreatedAt:     answer.CreatedAt,
+	}))
+}
+
+func constructMarkQuestion(classSessionID, questionID int) {
+	// Broadcast the "mark-question" event to all active connections
+	classSessionBroadcast(events.New(classSessionID, events.MarkQuestion{
+		QuestionID: questionID,
+		Answered:   true,
+	}))
+}
+
+// pollOptions returns the options of a poll as sent in poll events.
+func pollOptions(poll models.Poll) []events.PollOption {
+	options := make([]events.PollOption, 0, len(poll.Options))
+	for _, option := range poll.Options {
+		options = append(options, events.PollOption{
+			OptionID: option.ID,
+			Text:     option.Text,
+			Votes:    option.Votes,
+		})
+	}
+	return options
+}
+
+// broadcastPollOpened shows a poll to the class session.
+func broadcastPollOpened(poll models.Poll) {
+	// Broadcast the "poll-opened" event to all active connections
+	classSessionBroadcast(events.New(poll.SessionID, events.PollOpened{
+		PollID:   poll.ID,
+		Question: poll.Question,
+		Kind:     poll.Kind,
+		Options:  pollOptions(poll),
+	}))
+}
+
+// broadcastPollResults streams the results of an open poll to the class session as answers come in.
+func broadcastPollResults(poll models.Poll) {
+	// Broadcast the "poll-results" event to all active connections
+	classSessionBroadcast(events.New(poll.SessionID, events.PollResults{
+		PollID:    poll.ID,
+		Responses: poll.Responses,
+		Options:   pollOptions(poll),
+	}))
+}
+
+// broadcastPollClosed tells the class session a poll stopped taking answers, with its final results.
+func broadcastPollClosed(poll models.Poll) {
+	// Broadcast the "poll-closed" event to all active connections
+	classSessionBroadcast(events.New(poll.SessionID, events.PollClosed{
+		PollID:    poll.ID,
+		Responses: poll.Responses,
+		Options:   pollOptions(poll),
+	}))
+}
+
+// quizQuestions returns the questions of a quiz as sent in quiz events, without the answers.
+func quizQuestions(quiz models.Quiz) []events.QuizQuestion {
+	questions := make([]events.QuizQuestion, 0, len(quiz.Questions))
+	for _, question := range quiz.Questions {
+		options := make([]events.QuizOption, 0, len(question.Options))
+		for _, option := range question.Options {
+			options = append(options, events.QuizOption{
+				OptionID: option.ID,
+				Text:     option.Text,
+			})
+		}
+		questions = append(questions, events.QuizQuestion{
+			QuestionID: question.ID,
+			Text:       question.Text,
+			Points:     question.Points,
+			Options:    options,
+		})
+	}
+	return questions
+}
+
+// broadcastQuizOpened shows a quiz to the class session.
+func broadcastQuizOpened(quiz models.Quiz) {
+	// Broadcast the "quiz-opened" event to all active connections
+	classSessionBroadcast(events.New(quiz.SessionID, events.QuizOpened{
+		QuizID:    quiz.ID,
+		Title:     quiz.Title,
+		TimeLimit: quiz.Remaining,
+		Questions: quizQuestions(quiz),
+	}))
+}
+
+// broadcastQuizSubmitted tells the class session how many students submitted a quiz.
+func broadcastQuizSubmitted(quiz models.Quiz) {
+	// Broadcast the "quiz-submitted" event to all active connections
+	classSessionBroadcast(events.New(quiz.SessionID, events.QuizSubmitted{
+		QuizID:      quiz.ID,
+		Submissions: quiz.Submissions,
+	}))
+}
+
+// broadcastQuizClosed tells the class session a quiz stopped taking submissions.
+func broadcastQuizClosed(quiz models.Quiz) {
+	// Broadcast the "quiz-closed" event to all active connections
+	classSessionBroadcast(events.New(quiz.SessionID, events.QuizClosed{
+		QuizID:      quiz.ID,
+		Submissions: quiz.Submissions,
+	}))
+}
+
+// broadcastCheckInOpened tells the class session it can check in, and how often the code changes.
+func broadcastCheckInOpened(classSessionID int, period int) {
+	// Broadcast the "check-in-opened" event to all active connections
+	classSessionBroadcast(events.New(classSessionID, events.CheckInOpened{Period: period}))
+}
+
+// broadcastCheckInClosed tells the class session it can no longer check in.
+func broadcastCheckInClosed(classSessionID int) {
+	// Broadcast the "check-in-closed" event to all active connections
+	classSessionBroadcast(events.New(classSessionID, events.CheckInClosed{}))
+}
+
+func constructStartSession(sectionID, classSessionID int) {
+	startSession := events.New(classSessionID, events.StartSession{SectionID: sectionID})
+	// Broadcast the "start-session" event to all active connections
+	generalBroadcast(startSession)
+	classSessionBroadcast(startSession)
+}
+
+func constructEndSession(classSessionID, sectionID int) {
+	endSession := events.New(classSessionID, events.EndSession{SectionID: sectionID})
+	// Broadcast the "end-session" event to all active connections
+	generalBroadcast(endSession)
+	classSessionBroadcast(endSession)
+}
+
+func TriggerDemoBannerWarning() {
+	// Broadcast the "demo-warning-banner" event to all active connections
+	generalBroadcast(events.New(0, events.DemoWarningBanner{}))
+}
+
+// BROADCAST FUNCTIONS END
+
+// DATA PUMPS START
+
+// writePump sends queued messages to the client, and pings it when there is nothing to send,
+// until the hub closes the queue. Then it says why the connection is closing and closes the socket.
+func (c *Connection) writePump() {
+	ticker := time.NewTicker(c.pingPeriod)
+	defer ticker.Stop()
+	defer close(c.done)
+	defer c.conn.Close()
+
+	for {
+		select {
+		case message, ok := <-c.send:
+			if !ok {
+				code, reason := websocket.CloseNormalClosure, ""
+				if c.closeCode != 0 {
+					code, reason = c.closeCode, c.closeReason
+				}
+				c.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(writeWait))
+				return
+			}
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.TextMessage, message); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeWait)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// readPump reads from the client until it disconnects, the socket is closed, or the client
+// stops answering pings.
+func (c *Connection) readPump() {
+	c.conn.SetReadLimit(maxMessageSize)
+	c.conn.SetReadDeadline(time.Now().Add(c.pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(c.pongWait))
+	})
+
+	for {
+		_, messageBytes, err := c.conn.ReadMessage()
+		if err != nil {
+			break
+		}
+		c.conn.SetReadDeadline(time.Now().Add(c.pongWait))
+
+		c.handleClientAction(messageBytes)
+	}
+}
+
+// DATA PUMPS END
+
+func WSRoutes(g *gin.RouterGroup) {
+	g.GET("/ws", HandleGeneralWebsocketConnection)
+	g.GET("/ws/:classSessionID", HandleClassWebsocketConnection)
+	g.GET("/sse/:classSessionID", HandleClassEventStream)
+}
@@ -0,0 +1,100 @@
+// Package events defines the live events the server sends to clients over websockets and
+// event streams. Every event is an Envelope whose payload is one of the payload types of this
+// package, and Schema describes them all as a JSON Schema for the clients.
+package events
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Version is the version of the event format. It goes up when a payload changes in a way
+// older clients can't read, such as a field being removed or changing type.
+const Version = 1
+
+// Envelope is an event as sent to clients.
+type Envelope struct {
+	// Type says which payload the event carries, such as "vote-up"
+	Type    string `json:"type"`
+	Version int    `json:"version"`
+	// ClassSessionID is the class session the event is about, 0 for events of no class session
+	ClassSessionID int `json:"classSessionID"`
+	// Seq numbers the events of a class session, and Stream identifies the server that numbered
+	// them, so a client reconnecting can say which was the last it saw. Replies and events of no
+	// class session are not numbered.
+	Seq    int64     `json:"seq"`
+	Stream string    `json:"stream,omitempty"`
+	SentAt time.Time `json:"sentAt"`
+	// Payload is one of the payload types, or the raw JSON of one for a decoded event
+	Payload interface{} `json:"payload"`
+}
+
+// Payload is the content of an event.
+type Payload interface {
+	// EventType returns the type of the events carrying the payload.
+	EventType() string
+}
+
+// New returns an event of a class session carrying payload, sent now.
+// A classSessionID of 0 makes an event of no class session.
+func New(classSessionID int, payload Payload) Envelope {
+	return Envelope{
+		Type:           payload.EventType(),
+		Version:        Version,
+		ClassSessionID: classSessionID,
+		SentAt:         time.Now().UTC(),
+		Payload:        payload,
+	}
+}
+
+// Decode reads an event, leaving its payload as raw JSON.
+func Decode(data []byte) (Envelope, error) {
+	payload := new(json.RawMessage)
+	event := Envelope{Payload: payload}
+	if err := json.Unmarshal(data, &event); err != nil {
+		return Envelope{}, err
+	}
+	event.Payload = *payload
+	return event, nil
+}
+
+// DecodePayload reads the payload of a decoded event into v.
+func (e Envelope) DecodePayload(v interface{}) error {
+	data, ok := e.Payload.(json.RawMessage)
+	if !ok {
+		var err error
+		if data, err = json.Marshal(e.Payload); err != nil {
+			return err
+		}
+	}
+	return json.Unmarshal(data, v)
+}
+
+// Payloads returns an empty value of every payload type.
+func Payloads() []Payload {
+	return []Payload{
+		VoteUp{},
+		NewQuestion{},
+		QuestionPending{},
+		QuestionModerated{},
+		MergeQuestion{},
+		NewAnswer{},
+		MarkQuestion{},
+		StartSession{},
+		EndSession{},
+		ParticipantJoined{},
+		ParticipantLeft{},
+		Snapshot{},
+		PollOpened{},
+		PollResults{},
+		PollClosed{},
+		QuizOpened{},
+		QuizSubmitted{},
+		QuizClosed{},
+		CheckInOpened{},
+		CheckInClosed{},
+		DemoWarningBanner{},
+		Ack{},
+		ActionError{},
+	}
+}
@@ -0,0 +1,151 @@
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "rewrite schema.json from the event types")
+
+func TestNewAndDecode(t *testing.T) {
+	before := time.Now().UTC()
+	event := New(3, VoteUp{UserID: 1, QuestionID: 6, Votes: 4})
+	if event.Type != "vote-up" || event.Version != Version || event.ClassSessionID != 3 {
+		t.Fatalf("New made %+v", event)
+	}
+	if event.SentAt.Before(before) || event.SentAt.Location() != time.UTC {
+		t.Errorf("SentAt is %v, want UTC after %v", event.SentAt, before)
+	}
+
+	event.Seq, event.Stream = 7, "abc"
+	data, err := json.Marshal(event)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded, err := Decode(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Type != "vote-up" || decoded.Seq != 7 || decoded.Stream != "abc" || !decoded.SentAt.Equal(event.SentAt) {
+		t.Errorf("Decode(%s) = %+v", data, decoded)
+	}
+	if _, ok := decoded.Payload.(json.RawMessage); !ok {
+		t.Fatalf("decoded payload is %T, want json.RawMessage", decoded.Payload)
+	}
+
+	var voteUp VoteUp
+	if err := decoded.DecodePayload(&voteUp); err != nil {
+		t.Fatal(err)
+	}
+	if voteUp != (VoteUp{UserID: 1, QuestionID: 6, Votes: 4}) {
+		t.Errorf("payload is %+v", voteUp)
+	}
+
+	// Re-encoding a decoded event leaves the payload as it was
+	again, err := json.Marshal(decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(again, data) {
+		t.Errorf("re-encoded %s, want %s", again, data)
+	}
+
+	if _, err := Decode([]byte("not json")); err == nil {
+		t.Error("Decode accepted invalid JSON")
+	}
+}
+
+func TestPayloadTypes(t *testing.T) {
+	seen := map[string]bool{}
+	for _, payload := range Payloads() {
+		eventType := payload.EventType()
+		if eventType == "" || seen[eventType] {
+			t.Errorf("%T has the type %q, which is empty or taken", payload, eventType)
+		}
+		seen[eventType] = true
+	}
+}
+
+func TestSchema(t *testing.T) {
+	schema, err := Schema()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The globals package moves the tests to the root of the repository, so find the file from here
+	_, file, _, _ := runtime.Caller(0)
+	path := filepath.Join(filepath.Dir(file), "schema.json")
+	if *update {
+		if err := os.WriteFile(path, append(schema, '\n'), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkedIn, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(bytes.TrimSpace(checkedIn), schema) {
+		t.Fatal("schema.json is out of date, run go test ./events -update")
+	}
+
+	var parsed struct {
+		OneOf []map[string]string `json:"oneOf"`
+		Defs  map[string]struct {
+			Properties map[string]json.RawMessage `json:"properties"`
+			Required   []string                   `json:"required"`
+		} `json:"$defs"`
+	}
+	if err := json.Unmarshal(schema, &parsed); err != nil {
+		t.Fatal(err)
+	}
+	if len(parsed.OneOf) != len(Payloads()) {
+		t.Errorf("schema has %d event types, want %d", len(parsed.OneOf), len(Payloads()))
+	}
+
+	// Every event sent has the properties the schema requires, and no others
+	for _, payload := range Payloads() {
+		data, err := json.Marshal(New(1, payload))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var event map[string]json.RawMessage
+		json.Unmarshal(data, &event)
+
+		def, ok := parsed.Defs[payload.EventType()]
+		if !ok {
+			t.Errorf("schema has no definition of %q", payload.EventType())
+			continue
+		}
+		for _, name := range def.Required {
+			if _, ok := event[name]; !ok {
+				t.Errorf("%s event has no %s", payload.EventType(), name)
+			}
+		}
+		for name := range event {
+			if _, ok := def.Properties[name]; !ok {
+				t.Errorf("%s event has %s, which the schema doesn't describe", payload.EventType(), name)
+			}
+		}
+		if got := compact(def.Properties["type"]); got != `{"const":"`+payload.EventType()+`"}` {
+			t.Errorf("%s definition has the type %s", payload.EventType(), got)
+		}
+	}
+
+	if compact(parsed.Defs["Question"].Properties["answers"]) != `{"items":{"$ref":"#/$defs/Answer"},"type":["array","null"]}` {
+		t.Errorf("Question.Answers is described as %s", parsed.Defs["Question"].Properties["answers"])
+	}
+}
+
+// compact returns JSON without the indentation of the schema.
+func compact(data []byte) string {
+	var b bytes.Buffer
+	json.Compact(&b, data)
+	return b.String()
+}
@@ -0,0 +1,249 @@
+package events
+
+// VoteUp is sent when a user votes a question up.
+type VoteUp struct {
+	UserID     int `json:"userID"`
+	QuestionID int `json:"questionID"`
+	// Votes is the question's vote count after the vote
+	Votes int `json:"votes"`
+}
+
+// NewQuestion is sent when a question is posted, or approved by a moderator.
+type NewQuestion struct {
+	UserID     int    `json:"userID"`
+	QuestionID int    `json:"questionID"`
+	Text       string `json:"text"`
+	Votes      int    `json:"votes"`
+	Answered   bool   `json:"answered"`
+	CreatedAt  string `json:"createdAt"`
+}
+
+// QuestionPending is sent when a question waits for a moderator. Only the id is sent;
+// moderators fetch the text from the moderation queue.
+type QuestionPending struct {
+	QuestionID int `json:"questionID"`
+}
+
+// QuestionModerated is sent to the class session when a pending question is approved, and only to the
+// moderators and the author when it is rejected.
+type QuestionModerated struct {
+	QuestionID int `json:"questionID"`
+	// UserID is the author of a rejected question, 0 for an approved one
+	UserID int `json:"userID"`
+	// Status is approved or rejected
+	Status string `json:"status"`
+	// Reason is why a question was rejected, empty for an approved one
+	Reason string `json:"reason"`
+}
+
+// MergeQuestion is sent when question SourceID is merged into question QuestionID.
+type MergeQuestion struct {
+	SourceID   int    `json:"sourceID"`
+	QuestionID int    `json:"questionID"`
+	Text       string `json:"text"`
+	Votes      int    `json:"votes"`
+	Answered   bool   `json:"answered"`
+}
+
+// NewAnswer is sent when a moderator answers a question.
+type NewAnswer struct {
+	AnswerID      int    `json:"answerID"`
+	QuestionID    int    `json:"questionID"`
+	UserID        int    `json:"userID"`
+	Text          string `json:"text"`
+	FirstName     string `json:"firstName"`
+	LastName      string `json:"lastName"`
+	ModeratorType string `json:"moderatorType"`
+	CreatedAt     string `json:"createdAt"`
+}
+
+// MarkQuestion is sent when a question is marked answered.
+type MarkQuestion struct {
+	QuestionID int  `json:"questionID"`
+	Answered   bool `json:"answered"`
+}
+
+// StartSession is sent to the class session and to every signed in user when a class session starts.
+// It doesn't carry the attendance id, which section members get from the check-in.
+type StartSession struct {
+	SectionID int `json:"sectionID"`
+}
+
+// EndSession is sent to the class session and to every signed in user when a class session ends.
+type EndSession struct {
+	SectionID int `json:"sectionID"`
+}
+
+// ParticipantJoined is sent when a user starts following a class session.
+type ParticipantJoined struct {
+	UserID int `json:"userID"`
+	// Count is the number of users following the class session
+	Count int `json:"count"`
+}
+
+// ParticipantLeft is sent when a user no longer follows a class session from any tab or device.
+type ParticipantLeft struct {
+	UserID int `json:"userID"`
+	// Count is the number of users following the class session
+	Count int `json:"count"`
+}
+
+// Question is a question of a class session as a user sees it in a snapshot.
+type Question struct {
+	QuestionID int    `json:"questionID"`
+	UserID     int    `json:"userID"`
+	Text       string `json:"text"`
+	Votes      int    `json:"votes"`
+	Answered   bool   `json:"answered"`
+	CreatedAt  string `json:"createdAt"`
+	// UserHasVoted is whether the user the snapshot is sent to voted the question up
+	UserHasVoted bool     `json:"userHasVoted"`
+	Answers      []Answer `json:"answers"`
+}
+
+// Answer is an answer to a question of a snapshot.
+type Answer struct {
+	AnswerID      int    `json:"answerID"`
+	QuestionID    int    `json:"questionID"`
+	UserID        int    `json:"userID"`
+	Text          string `json:"text"`
+	FirstName     string `json:"firstName"`
+	LastName      string `json:"lastName"`
+	ModeratorType string `json:"moderatorType"`
+	CreatedAt     string `json:"createdAt"`
+}
+
+// Snapshot is sent to a client that reconnected after missing events that are no longer logged,
+// with the state of the class session in place of them.
+type Snapshot struct {
+	QuestionsByTime           []Question `json:"questionsByTime"`
+	QuestionsByVote           []Question `json:"questionsByVote"`
+	QuestionsByVoteUnanswered []Question `json:"questionsByVoteUnanswered"`
+	QuestionsByTimeUnanswered []Question `json:"questionsByTimeUnanswered"`
+	// ModeratorType is the moderator type of the user in the section, such as "student"
+	ModeratorType string `json:"moderatorType"`
+	InProgress    bool   `json:"inProgress"`
+	// Count is the number of users following the class session
+	Count int `json:"count"`
+}
+
+// PollOption is an answer to a poll and the number of users who chose it.
+type PollOption struct {
+	OptionID int    `json:"optionID"`
+	Text     string `json:"text"`
+	Votes    int    `json:"votes"`
+}
+
+// PollOpened is sent when a moderator opens a poll to the class.
+type PollOpened struct {
+	PollID   int    `json:"pollID"`
+	Question string `json:"question"`
+	// Kind is choice or yes-no
+	Kind    string       `json:"kind"`
+	Options []PollOption `json:"options"`
+}
+
+// PollResults is sent when a user answers an open poll, with the results so far.
+type PollResults struct {
+	PollID int `json:"pollID"`
+	// Responses is the number of users who answered
+	Responses int          `json:"responses"`
+	Options   []PollOption `json:"options"`
+}
+
+// PollClosed is sent when a poll stops taking answers, with its final results.
+type PollClosed struct {
+	PollID    int          `json:"pollID"`
+	Responses int          `json:"responses"`
+	Options   []PollOption `json:"options"`
+}
+
+// QuizOption is an answer to a quiz question, without saying whether it is correct.
+type QuizOption struct {
+	OptionID int    `json:"optionID"`
+	Text     string `json:"text"`
+}
+
+// QuizQuestion is a question of a quiz and the points it is worth.
+type QuizQuestion struct {
+	QuestionID int          `json:"questionID"`
+	Text       string       `json:"text"`
+	Points     int          `json:"points"`
+	Options    []QuizOption `json:"options"`
+}
+
+// QuizOpened is sent when a moderator opens a quiz to the class.
+type QuizOpened struct {
+	QuizID int    `json:"quizID"`
+	Title  string `json:"title"`
+	// TimeLimit is the number of seconds left to submit, 0 for no limit
+	TimeLimit int            `json:"timeLimit"`
+	Questions []QuizQuestion `json:"questions"`
+}
+
+// QuizSubmitted is sent when a student submits a quiz.
+type QuizSubmitted struct {
+	QuizID int `json:"quizID"`
+	// Submissions is the number of students who submitted so far
+	Submissions int `json:"submissions"`
+}
+
+// QuizClosed is sent when a quiz stops taking submissions, by a moderator, its time limit
+// or the end of the class session.
+type QuizClosed struct {
+	QuizID      int `json:"quizID"`
+	Submissions int `json:"submissions"`
+}
+
+// CheckInOpened is sent when the instructor opens a check-in. The code itself is only shown in the room.
+type CheckInOpened struct {
+	// Period is the number of seconds each code is shown for
+	Period int `json:"period"`
+}
+
+// CheckInClosed is sent when a check-in stops taking codes, by the instructor or the end of the class session.
+type CheckInClosed struct{}
+
+// DemoWarningBanner is sent to every signed in user shortly before the demo database is reset.
+type DemoWarningBanner struct{}
+
+// Ack replies to the client alone when an action it sent over the websocket succeeded.
+type Ack struct {
+	// RequestID is the id the client gave the action
+	RequestID string `json:"requestId"`
+	// Status and Data are the status and body the same request over HTTP would get
+	Status int                    `json:"status"`
+	Data   map[string]interface{} `json:"data"`
+}
+
+// ActionError replies to the client alone when an action it sent over the websocket failed.
+type ActionError struct {
+	RequestID string                 `json:"requestId"`
+	Status    int                    `json:"status"`
+	Data      map[string]interface{} `json:"data"`
+	Error     string                 `json:"error"`
+}
+
+func (VoteUp) EventType() string            { return "vote-up" }
+func (NewQuestion) EventType() string       { return "new-question" }
+func (QuestionPending) EventType() string   { return "question-pending" }
+func (QuestionModerated) EventType() string { return "question-moderated" }
+func (MergeQuestion) EventType() string     { return "merge-question" }
+func (NewAnswer) EventType() string         { return "new-answer" }
+func (MarkQuestion) EventType() string      { return "mark-question" }
+func (StartSession) EventType() string      { return "start-session" }
+func (EndSession) EventType() string        { return "end-session" }
+func (ParticipantJoined) EventType() string { return "participant-joined" }
+func (ParticipantLeft) EventType() string   { return "participant-left" }
+func (Snapshot) EventType() string          { return "snapshot" }
+func (PollOpened) EventType() string        { return "poll-opened" }
+func (PollResults) EventType() string       { return "poll-results" }
+func (PollClosed) EventType() string        { return "poll-closed" }
+func (QuizOpened) EventType() string        { return "quiz-opened" }
+func (QuizSubmitted) EventType() string     { return "quiz-submitted" }
+func (QuizClosed) EventType() string        { return "quiz-closed" }
+func (CheckInOpened) EventType() string     { return "check-in-opened" }
+func (CheckInClosed) EventType() string     { return "check-in-closed" }
+func (DemoWarningBanner) EventType() string { return "demo-warning-banner" }
+func (Ack) EventType() string               { return "ack" }
+func (ActionError) EventType() string       { return "error" }
@@ -0,0 +1,184 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Schema returns a JSON Schema of the events, generated from the Envelope and payload types.
+// An event must match the definition of its type, named after it in $defs, and the definitions
+// of the payloads and the types they use are named after the Go types.
+func Schema() ([]byte, error) {
+	g := &schemaGenerator{defs: map[string]interface{}{}, types: map[string]reflect.Type{}}
+
+	var events []interface{}
+	for _, payload := range Payloads() {
+		payloadSchema, err := g.schema(reflect.TypeOf(payload))
+		if err != nil {
+			return nil, err
+		}
+
+		eventType := payload.EventType()
+		if _, ok := g.defs[eventType]; ok {
+			return nil, fmt.Errorf("events: event type %q is defined twice", eventType)
+		}
+		if g.defs[eventType], err = g.envelope(eventType, payloadSchema); err != nil {
+			return nil, err
+		}
+		events = append(events, ref(eventType))
+	}
+
+	return json.MarshalIndent(map[string]interface{}{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"title":   "Coeus live event",
+		"oneOf":   events,
+		"$defs":   g.defs,
+	}, "", "  ")
+}
+
+// schemaGenerator collects the definitions of the types of a schema as it describes them.
+type schemaGenerator struct {
+	defs  map[string]interface{}
+	types map[string]reflect.Type
+}
+
+// envelope returns the schema of the events of a type carrying a payload.
+func (g *schemaGenerator) envelope(eventType string, payload interface{}) (map[string]interface{}, error) {
+	envelope, err := g.object(reflect.TypeOf(Envelope{}))
+	if err != nil {
+		return nil, err
+	}
+
+	properties := envelope["properties"].(map[string]interface{})
+	properties["type"] = map[string]interface{}{"const": eventType}
+	properties["version"] = map[string]interface{}{"const": Version}
+	properties["payload"] = payload
+	return envelope, nil
+}
+
+// schema returns the schema of values of type t, adding named structs to the definitions.
+func (g *schemaGenerator) schema(t reflect.Type) (interface{}, error) {
+	if t == reflect.TypeOf(time.Time{}) {
+		return map[string]interface{}{"type": "string", "format": "date-time"}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}, nil
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}, nil
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}, nil
+	case reflect.Interface:
+		return map[string]interface{}{}, nil
+	case reflect.Ptr:
+		return g.schema(t.Elem())
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]interface{}{"type": "string", "contentEncoding": "base64"}, nil
+		}
+		items, err := g.schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		// A nil slice is sent as null
+		return map[string]interface{}{"type": []string{"array", "null"}, "items": items}, nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("events: map key of %s is not a string", t)
+		}
+		values, err := g.schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"type": []string{"object", "null"}, "additionalProperties": values}, nil
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.object(t)
+		}
+		if defined, ok := g.types[t.Name()]; ok {
+			if defined != t {
+				return nil, fmt.Errorf("events: %s and %s have the same name", defined, t)
+			}
+			return ref(t.Name()), nil
+		}
+		g.types[t.Name()] = t
+		object, err := g.object(t)
+		if err != nil {
+			return nil, err
+		}
+		g.defs[t.Name()] = object
+		return ref(t.Name()), nil
+	}
+
+	return nil, fmt.Errorf("events: can't describe %s", t)
+}
+
+// object returns the schema of the JSON object a struct is encoded as.
+func (g *schemaGenerator) object(t reflect.Type) (map[string]interface{}, error) {
+	properties := map[string]interface{}{}
+	required := []string{}
+	if err := g.fields(t, properties, &required); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"type":                 "object",
+		"properties":           properties,
+		"required":             required,
+		"additionalProperties": false,
+	}, nil
+}
+
+// fields adds the fields of a struct, and of the structs it embeds, to the properties of an object.
+func (g *schemaGenerator) fields(t reflect.Type, properties map[string]interface{}, required *[]string) error {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct && field.Tag.Get("json") == "" {
+			if err := g.fields(field.Type, properties, required); err != nil {
+				return err
+			}
+			continue
+		}
+		if field.PkgPath != "" || field.Tag.Get("json") == "-" {
+			continue
+		}
+
+		name, omitEmpty := jsonName(field)
+		schema, err := g.schema(field.Type)
+		if err != nil {
+			return err
+		}
+		properties[name] = schema
+		if !omitEmpty {
+			*required = append(*required, name)
+		}
+	}
+	return nil
+}
+
+// jsonName returns the name of a struct field in JSON and whether it is left out when empty.
+func jsonName(field reflect.StructField) (string, bool) {
+	name, options := field.Name, ""
+	if tag := field.Tag.Get("json"); tag != "" {
+		tagName := tag
+		if comma := strings.Index(tag, ","); comma >= 0 {
+			tagName, options = tag[:comma], tag[comma:]
+		}
+		if tagName != "" {
+			name = tagName
+		}
+	}
+	return name, strings.Contains(options, ",omitempty")
+}
+
+// ref returns a reference to a definition of the schema.
+func ref(name string) map[string]interface{} {
+	return map[string]interface{}{"$ref": "#/$defs/" + name}
+}
@@ -1,9 +1,9 @@
-package helpers
-
-import (
-	"strings"
-)
-
-func EmptyUserPass(username, password string) bool {
-	return strings.Trim(username, " ") == "" || strings.Trim(password, " ") == ""
-}
+package helpers
+
+import (
+	"strings"
+)
+
+func EmptyUserPass(username, password string) bool {
+	return strings.Trim(username, " ") == "" || strings.Trim(password, " ") == ""
+}
@@ -1,157 +1,243 @@
-/*
- * Copyright (C) 2023 Coeus
- *
- * This program is free software: you can redistribute it and/or modify
- * it under the terms of the GNU Affero General Public License version 3 as published by
- * the Free Software Foundation.
- *
- * This program is distributed in the hope that it will be useful,
- * but WITHOUT ANY WARRANTY; without even the implied warranty of
- * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
- * GNU Affero General Public License for more details.
- *
- * You should have received a copy of the GNU Affero General Public License
- * along with this program.  If not, see <https://www.gnu.org/licenses/agpl-3.0.txt>.
- */
-
-package main
-
-import (
-	"coeus/controllers"
-	"coeus/models"
-	"embed"
-	"fmt"
-	"html/template"
-	"io/fs"
-	"log"
-	"net/http"
-	"os"
-	"strconv"
-	"time"
-
-	"github.com/gin-contrib/sessions"
-	"github.com/gin-contrib/sessions/cookie"
-	"github.com/gin-gonic/gin"
-	"github.com/joho/godotenv"
-
-	globals "coeus/globals"
-)
-
-//go:embed views/templates/**/*
-var templatesEmbed embed.FS
-
-//go:embed views/static/*
-var staticEmbed embed.FS
-
-func checkDemoMode() bool {
-	if _, err := os.Stat("DEMO"); err == nil {
-		// If the file exists, return true
-		return true
-	} else if os.IsNotExist(err) {
-		// If the file does not exist, return false
-		return false
-	} else {
-		// In case of other errors (like permissions), log the error and return false
-		log.Println(err)
-		return false
-	}
-}
-
-func main() {
-
-	demoMode := checkDemoMode()
-
-	// Configure application based on demoMode
-	if demoMode {
-		fmt.Println("****************************************************\n  Running in demo mode. \n****************************************************")
-		// Point to sample.db
-		globals.DBNAME = "coeus-sample"
-
-		// Create a go channel that prints a message every 15 minutes
-		go func() {
-			ticker := time.NewTicker(14 * time.Minute)
-			for {
-				select {
-				case <-ticker.C:
-
-					// Delay the execution of the banner to keep the time interval at 15 minutes (14 + 0.5 + 0.5)
-					time.Sleep(30 * time.Second)
-
-					// Trigger the demo banner warning
-					controllers.TriggerDemoBannerWarning()
-
-					// Delay the reseed by 30 seconds to give the user time to read the warning
-					time.Sleep(30 * time.Second)
-
-					// Add your database reseeding function here
-					fmt.Println("Reseeding the sample database...")
-
-					// Delete the existing database and create a new one
-					models.ReseedSampleDB()
-				}
-			}
-		}()
-
-	} else {
-		fmt.Println("****************************************************\n  Running in production mode. \n****************************************************")
-		// Point to coeus.db
-		globals.DBNAME = "coeus"
-	}
-
-	// Load environment variables from .env file
-	err := godotenv.Load("globals/.env")
-	if err != nil {
-		log.Fatal("Error loading .env file")
-	}
-
-	fmt.Println("Running as a single binary: " + strconv.FormatBool(globals.IsBinary()))
-	_ = models.NewDB()
-
-	router := gin.Default()
-
-	// Serve static files before the organization is set up
-	staticFS, _ := fs.Sub(staticEmbed, "views/static")
-	router.StaticFS("/static", http.FS(staticFS))
-
-	templ := template.Must(template.New("").ParseFS(templatesEmbed, "views/templates/coeus/*.html", "views/templates/management/*.html", "views/templates/shared/*.html"))
-	router.SetHTMLTemplate(templ)
-
-	router.Use(sessions.Sessions("session", cookie.NewStore(globals.Secret)))
-
-	// Public
-	public := router.Group("/")
-	public.Use(controllers.OrganizationRequired)
-	controllers.PublicRoutes(public)
-
-	// Private
-	private := router.Group("/")
-	private.Use(controllers.AuthRequired)
-	controllers.PrivateRoutes(private)
-
-	// API
-	api := router.Group("/")
-	controllers.APIRoutes(api)
-
-	// Websockets
-	ws := router.Group("/")
-	controllers.WSRoutes(ws)
-
-	// Catch all
-	router.NoRoute(controllers.NotFoundHandler)
-
-	// Start server
-	port := os.Getenv("PORT")
-	runOnHeroku := os.Getenv("RUN_ON_HEROKU")
-
-	if runOnHeroku != "true" {
-		if port == "" {
-			port = "8080"
-		}
-	} else {
-		if port == "" {
-			log.Fatal("$PORT must be set")
-		}
-	}
-
-	router.Run(":" + port)
-}
+/*
+ * Copyright (C) 2023 Coeus
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3 as published by
+ * the Free Software Foundation.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/agpl-3.0.txt>.
+ */
+
+package main
+
+import (
+	"coeus/controllers"
+	"coeus/models"
+	"coeus/pubsub"
+	"context"
+	"embed"
+	"errors"
+	"flag"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-contrib/sessions/cookie"
+	"github.com/gin-gonic/gin"
+	"github.com/joho/godotenv"
+
+	globals "coeus/globals"
+)
+
+//go:embed views/templates/**/*
+var templatesEmbed embed.FS
+
+//go:embed views/static/*
+var staticEmbed embed.FS
+
+func checkDemoMode() bool {
+	if _, err := os.Stat("DEMO"); err == nil {
+		// If the file exists, return true
+		return true
+	} else if os.IsNotExist(err) {
+		// If the file does not exist, return false
+		return false
+	} else {
+		// In case of other errors (like permissions), log the error and return false
+		log.Println(err)
+		return false
+	}
+}
+
+func main() {
+	migrateDryRun := flag.Bool("migrate-dry-run", false, "list pending schema migrations and exit")
+	flag.Parse()
+
+	demoMode := checkDemoMode()
+
+	// Configure application based on demoMode
+	if demoMode {
+		fmt.Println("****************************************************\n  Running in demo mode. \n****************************************************")
+		// Point to sample.db
+		globals.DBNAME = "coeus-sample"
+
+		// Create a go channel that prints a message every 15 minutes
+		go func() {
+			ticker := time.NewTicker(14 * time.Minute)
+			for {
+				select {
+				case <-ticker.C:
+
+					// Delay the execution of the banner to keep the time interval at 15 minutes (14 + 0.5 + 0.5)
+					time.Sleep(30 * time.Second)
+
+					// Trigger the demo banner warning
+					controllers.TriggerDemoBannerWarning()
+
+					// Delay the reseed by 30 seconds to give the user time to read the warning
+					time.Sleep(30 * time.Second)
+
+					// Add your database reseeding function here
+					fmt.Println("Reseeding the sample database...")
+
+					// Delete the existing database and create a new one
+					models.ReseedSampleDB()
+				}
+			}
+		}()
+
+	} else {
+		fmt.Println("****************************************************\n  Running in production mode. \n****************************************************")
+		// Point to coeus.db
+		globals.DBNAME = "coeus"
+	}
+
+	// Load environment variables from .env file
+	err := godotenv.Load("globals/.env")
+	if err != nil {
+		log.Fatal("Error loading .env file")
+	}
+
+	fmt.Println("Running as a single binary: " + strconv.FormatBool(globals.IsBinary()))
+
+	// List the pending migrations without creating, seeding or changing the database
+	if *migrateDryRun {
+		pending, err := models.DryRunMigrations(models.DefaultDBConfig())
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, m := range pending {
+			fmt.Printf("Pending migration %d: %s\n", m.Version, m.Description)
+		}
+		fmt.Printf("%d pending migration(s), binary schema version %d\n", len(pending), models.LatestSchemaVersion())
+		return
+	}
+
+	// Open the shared database pool used by every model
+	db, err := models.OpenDB(models.DefaultDBConfig())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Bring the schema up to date, refusing to run against a newer schema
+	applied, err := models.Migrate(db, false)
+	if errors.Is(err, models.ErrSchemaTooNew) {
+		log.Fatal(err)
+	} else if err != nil {
+		log.Fatal("Failed to migrate database: ", err)
+	}
+	for _, m := range applied {
+		fmt.Printf("Applied migration %d: %s\n", m.Version, m.Description)
+	}
+
+	models.SetDB(db)
+	defer models.CloseDB()
+
+	router := gin.Default()
+
+	// Serve static files before the organization is set up
+	staticFS, _ := fs.Sub(staticEmbed, "views/static")
+	router.StaticFS("/static", http.FS(staticFS))
+
+	templ := template.Must(template.New("").ParseFS(templatesEmbed, "views/templates/coeus/*.html", "views/templates/management/*.html", "views/templates/shared/*.html"))
+	router.SetHTMLTemplate(templ)
+
+	router.Use(sessions.Sessions("session", cookie.NewStore(globals.Secret)))
+
+	// Public
+	public := router.Group("/")
+	public.Use(controllers.OrganizationRequired)
+	controllers.PublicRoutes(public)
+
+	// Private
+	private := router.Group("/")
+	private.Use(controllers.AuthRequired)
+	controllers.PrivateRoutes(private)
+
+	// API
+	api := router.Group("/")
+	controllers.APIRoutes(api)
+
+	// Share live events with the other instances of the server through the pub/sub broker
+	broker, err := pubsub.Open(pubsub.DefaultConfig())
+	if err != nil {
+		log.Fatal("Failed to connect to the pub/sub broker: ", err)
+	}
+	defer broker.Close()
+	if err := controllers.ConfigurePubSub(broker); err != nil {
+		log.Fatal("Failed to subscribe to live events: ", err)
+	}
+
+	// Websockets
+	controllers.ConfigureWebsockets(controllers.DefaultWebsocketConfig())
+	ws := router.Group("/")
+	controllers.WSRoutes(ws)
+
+	// Catch all
+	router.NoRoute(controllers.NotFoundHandler)
+
+	// Start server
+	port := os.Getenv("PORT")
+	runOnHeroku := os.Getenv("RUN_ON_HEROKU")
+
+	if runOnHeroku != "true" {
+		if port == "" {
+			port = "8080"
+		}
+	} else {
+		if port == "" {
+			log.Fatal("$PORT must be set")
+		}
+	}
+
+	server := &http.Server{
+		Addr:    ":" + port,
+		Handler: router,
+	}
+	// Websockets are hijacked connections that Shutdown doesn't wait for, so close them explicitly
+	server.RegisterOnShutdown(controllers.CloseWebsockets)
+
+	go func() {
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatal(err)
+		}
+	}()
+
+	// Open and close class sessions from the section schedules
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	defer stopScheduler()
+	go controllers.NewScheduler(controllers.DefaultSchedulerConfig()).Run(schedulerCtx)
+
+	// Send heartbeats to the other instances, and drop the users of instances that stopped
+	presenceCtx, stopPresence := context.WithCancel(context.Background())
+	defer stopPresence()
+	go controllers.RunPresence(presenceCtx)
+
+	// Wait for an interrupt, then drain open requests before the database is closed
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+	fmt.Println("Shutting down server...")
+	stopScheduler()
+	stopPresence()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := server.Shutdown(ctx); err != nil {
+		log.Println("Server forced to shutdown:", err)
+	}
+}
@@ -1,462 +1,779 @@
-package models
-
-import (
-	"database/sql"
-)
-
-type Attendance struct {
-	ID           int
-	Section      int
-	InstructorID int
-	ClassSession int
-	Date         string
-	CreatedAt    string
-	UpdatedAt    string
-	CourseSection
-}
-
-type UserAttendance struct {
-	ID         int
-	Attendance int
-	UserID     int
-	Status     string
-}
-
-type CourseSection struct {
-	SectionID    int
-	CourseID     int
-	CourseNumber string
-	CourseTitle  string
-	SectionName  string
-	AttendanceID int
-	Date         string
-}
-
-type AttendanceRecord struct {
-	ID        int
-	Email     string
-	FirstName string
-	LastName  string
-	Status    string
-}
-
-// ** CREATE **
-
-// Add a new attendance record to the database for a given class session.
-// It returns the ID of the new attendance record on success and any encountered error.
-func (a *Attendance) Add(classSessionID, sectionIDInt, instructorID int) (int, error) {
-	db := NewDB()
-	var result sql.Result
-	var ID int64
-	// Add attendance record
-	sqlStatement := `
-		INSERT INTO
-			attendance
-		VALUES
-			(NULL,
-			$1,	
-			$2,
-			$3,
-			datetime('now'),
-			datetime('now'),
-            datetime('now')
-			)`
-	result, err := db.Exec(sqlStatement, sectionIDInt, instructorID, classSessionID)
-	if err != nil {
-		return 0, err
-	}
-
-	// Get ID of newly created attendance record
-	ID, err = result.LastInsertId()
-	if err != nil {
-		return 0, err
-	}
-
-	return int(ID), nil
-}
-
-// Add a new user_attendance record to the database for a given user and attendance record.
-// It returns the ID of the new user_attendance record on success and any encountered error.
-func (a *Attendance) AddUserAttendance(attendanceID int, userID int, status string) (int64, error) {
-	db := NewDB()
-	var result sql.Result
-	var ID int64
-	// Add user_attendance record
-	sqlStatement := `
-		INSERT INTO
-			user_attendance
-		VALUES
-			(NULL,
-			$1,
-			$2,
-			$3)`
-	result, err := db.Exec(sqlStatement, attendanceID, userID, status)
-	if err != nil {
-		return 0, err
-	}
-
-	// Get ID of newly created user_attendance record
-	ID, err = result.LastInsertId()
-	if err != nil {
-		return 0, err
-	}
-
-	return ID, nil
-}
-
-// ** READ **
-
-// GetCoursesByInstructor gets all courses associated with a given instructor.
-// It returns a slice of CourseSection structs and any encountered error.
-func (c *CourseSection) GetCoursesByInstructor(instructorID int) ([]CourseSection, error) {
-	db := NewDB()
-	var courses []CourseSection
-
-	sqlStatement := `
-		SELECT DISTINCT
-			c.id as course_id,
-			c.number as course_number,
-			c.title as course_title
-		FROM
-			course c
-		JOIN
-			section s ON c.id = s.course_id
-		JOIN
-			attendance a ON s.id = a.section_id
-		WHERE
-			a.instructor_id = $1
-		`
-	rows, err := db.Query(sqlStatement, instructorID)
-	if err != nil {
-		return courses, err
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var cs CourseSection
-		err = rows.Scan(&cs.CourseID, &cs.CourseNumber, &cs.CourseTitle)
-		if err != nil {
-			return courses, err
-		}
-		courses = append(courses, cs)
-	}
-
-	return courses, nil
-}
-
-// GetSectionsByInstructorAndCourse gets all sections associated with a given course id and instructor id.
-// It returns a slice of CourseSection structs and any encountered error.
-func (c *CourseSection) GetSectionsByInstructorAndCourse(instructorID int, courseID int) ([]CourseSection, error) {
-	db := NewDB()
-	var sections []CourseSection
-
-	sqlStatement := `
-		SELECT DISTINCT
-			s.id as section_id,
-			c.number as course_number,
-			c.title as course_title,
-			s.name as section_name,
-			a.id as attendance_id,
-			strftime('%Y-%m-%d', a.attended_at) as date
-		FROM
-			section s
-		JOIN
-			course c ON s.course_id = c.id
-		JOIN
-			attendance a ON s.id = a.section_id
-		WHERE
-			a.instructor_id = $1
-		AND
-			c.id = $2
-		`
-	rows, err := db.Query(sqlStatement, instructorID, courseID)
-	if err != nil {
-		return sections, err
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var cs CourseSection
-		err = rows.Scan(&cs.SectionID, &cs.CourseNumber, &cs.CourseTitle, &cs.SectionName, &cs.AttendanceID, &cs.Date)
-		if err != nil {
-			return sections, err
-		}
-		sections = append(sections, cs)
-	}
-
-	return sections, nil
-}
-
-// GetByInstructor gets all attendance records for a given instructor along with the course title and section info for that record.
-// It returns a slice of Attendance structs and any encountered error.
-func (a *Attendance) GetByInstructor(instructorID int) ([]Attendance, error) {
-	db := NewDB()
-	var attendance []Attendance
-
-	sqlStatement := `
-		SELECT
-			a.id,
-			a.section_id,
-			a.instructor_id,
-			a.class_session_id,
-			strftime('%Y-%m-%d', a.attended_at) as attended_at,
-			a.created_at,
-			a.updated_at,
-			c.number as course_number,
-			c.title as course_title,
-			s.name as section_name
-		FROM
-			attendance a
-		JOIN
-			section s ON a.section_id = s.id
-		JOIN
-			course c ON s.course_id = c.id
-		WHERE
-			a.instructor_id = $1
-		`
-	rows, err := db.Query(sqlStatement, instructorID)
-	if err != nil {
-		return attendance, err
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var a Attendance
-		err = rows.Scan(&a.ID, &a.Section, &a.InstructorID, &a.ClassSession, &a.Date, &a.CreatedAt, &a.UpdatedAt, &a.CourseNumber, &a.CourseTitle, &a.SectionName)
-		if err != nil {
-			return attendance, err
-		}
-		attendance = append(attendance, a)
-	}
-
-	return attendance, nil
-}
-
-// Get all attendance records for a given class session.
-// It returns a slice of Attendance structs and any encountered error.
-func (a *Attendance) GetByClassSession(classSessionID int) ([]Attendance, error) {
-	db := NewDB()
-	var attendance []Attendance
-
-	sqlStatement := `
-		SELECT 
-			* 
-		FROM 
-			attendance 
-		WHERE 
-			class_session_id = $1
-		`
-	rows, err := db.Query(sqlStatement, classSessionID)
-	if err != nil {
-		return attendance, err
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var a Attendance
-		err = rows.Scan(&a.ID, &a.Section, &a.InstructorID, &a.ClassSession, &a.Date, &a.CreatedAt, &a.UpdatedAt)
-		if err != nil {
-			return attendance, err
-		}
-		attendance = append(attendance, a)
-	}
-
-	return attendance, nil
-}
-
-// GetStudentsByAttendance gets all students for a given attendance ID.
-// It returns a slice of User structs and any encountered error.
-func (ar *AttendanceRecord) GetStudentsByAttendance(attendanceID int) ([]AttendanceRecord, error) {
-	db := NewDB()
-	var attendanceRecords []AttendanceRecord
-
-	sqlStatement := `
-	SELECT
-		user.id,
-		user.email,
-		user.first_name,
-		user.last_name,
-		user_attendance.status
-	FROM
-		user
-	JOIN
-		user_attendance ON user.id = user_attendance.user_id
-	JOIN
-		attendance ON user_attendance.attendance_id = attendance.id
-	WHERE
-		attendance.id = $1
-		`
-
-	rows, err := db.Query(sqlStatement, attendanceID)
-	if err != nil {
-		return attendanceRecords, err
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var ar AttendanceRecord
-		err = rows.Scan(&ar.ID, &ar.Email, &ar.FirstName, &ar.LastName, &ar.Status)
-		if err != nil {
-			return attendanceRecords, err
-		}
-		attendanceRecords = append(attendanceRecords, ar)
-	}
-
-	return attendanceRecords, nil
-}
-
-// GetAttendanceIDBySectionID gets the attendance ID for a given section ID returning the most recent attendance record for that section.
-// It returns the attendance ID and any encountered error.
-func (a *Attendance) GetAttendanceIDBySectionID(sectionID int) (int, error) {
-	db := NewDB()
-	var attendanceID int
-
-	sqlStatement := `
-		SELECT
-			id
-		FROM
-			attendance
-		WHERE
-			section_id = $1
-		ORDER BY
-			created_at DESC
-		LIMIT 1
-		`
-	err := db.QueryRow(sqlStatement, sectionID).Scan(&attendanceID)
-	if err != nil {
-		return 0, err
-	}
-
-	return attendanceID, nil
-}
-
-// Get attendance for an individual user give the userID and attendance ID.
-// It returns a slice of UserAttendance structs and any encountered error.
-func (a *Attendance) GetByUser(userID int, attendanceID int) ([]UserAttendance, error) {
-	db := NewDB()
-	var attendance []UserAttendance
-
-	sqlStatement := `
-		SELECT 
-			* 
-		FROM 
-			user_attendance 
-		WHERE 
-			user_id = $1
-		AND
-			attendance_id = $2
-		`
-	rows, err := db.Query(sqlStatement, userID, attendanceID)
-	if err != nil {
-		return attendance, err
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var a UserAttendance
-		err = rows.Scan(&a.ID, &a.UserID, &a.Status)
-		if err != nil {
-			return attendance, err
-		}
-		attendance = append(attendance, a)
-	}
-
-	return attendance, nil
-}
-
-// ** UPDATE **
-// Update the status of a user_attendance record given the userID and attendanceID.
-// It returns any encountered error.
-func (a *Attendance) UpdateUserAttendance(userID int, attendanceID int, status string) error {
-	db := NewDB()
-
-	sqlStatement := `
-
-		UPDATE
-			user_attendance
-		SET
-			status = $1
-		WHERE
-			user_id = $2
-		AND
-			attendance_id = $3
-	`
-	_, err := db.Exec(sqlStatement, status, userID, attendanceID)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// ** DELETE **
-// Delete all attendance records for a given class session ID.
-func (a *Attendance) DeleteAll(classSessionID int) error {
-	db := NewDB()
-
-	// Get all attendanceIDs for the given classSessionID
-	var attendanceIDs []int
-	sqlSelect := `
-        SELECT
-            id
-        FROM
-            attendance
-        WHERE
-            class_session_id = $1
-    `
-	rows, err := db.Query(sqlSelect, classSessionID)
-	if err != nil {
-		return err
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var attendanceID int
-		err = rows.Scan(&attendanceID)
-		if err != nil {
-			return err
-		}
-		attendanceIDs = append(attendanceIDs, attendanceID)
-	}
-
-	// Delete all user_attendance records for the given attendanceIDs
-	for _, attendanceID := range attendanceIDs {
-		err = a.DeleteUserAttendance(attendanceID)
-		if err != nil {
-			return err
-		}
-	}
-
-	// Delete all attendance records for the given classSessionID
-	sqlDelete := `
-        DELETE FROM
-            attendance
-        WHERE
-            class_session_id = $1
-    `
-	_, err = db.Exec(sqlDelete, classSessionID)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// Delete a single user_attendance record given the attendanceID.
-// It returns any encountered error.
-func (a *Attendance) DeleteUserAttendance(attendanceID int) error {
-	db := NewDB()
-
-	sqlStatement := `
-		DELETE FROM
-			user_attendance
-		WHERE
-			attendance_id = $1
-	`
-	_, err := db.Exec(sqlStatement, attendanceID)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
+package models
+
+import "database/sql"
+
+type Attendance struct {
+	ID           int
+	Section      int
+	InstructorID int
+	ClassSession int
+	Date         string
+	CreatedAt    string
+	UpdatedAt    string
+	CourseSection
+}
+
+type UserAttendance struct {
+	ID         int
+	Attendance int
+	UserID     int
+	Status     string
+}
+
+type CourseSection struct {
+	SectionID    int
+	CourseID     int
+	CourseNumber string
+	CourseTitle  string
+	SectionName  string
+	AttendanceID int
+	Date         string
+}
+
+type AttendanceRecord struct {
+	ID        int
+	Email     string
+	FirstName string
+	LastName  string
+	Status    string
+}
+
+// StatusChange is an entry of the audit trail of attendance statuses: who changed the status
+// of a student for an attendance, when, from what to what and why.
+type StatusChange struct {
+	ID           int
+	AttendanceID int
+	UserID       int
+	FirstName    string
+	LastName     string
+	Email        string
+	// OldStatus is empty when the student had no status yet
+	OldStatus string
+	NewStatus string
+	// ChangedBy is 0 when the change was made by the system, such as at the end of a class session
+	ChangedBy          int
+	ChangedByFirstName string
+	ChangedByLastName  string
+	Source             string
+	Reason             string
+	CreatedAt          string
+}
+
+// Attendance statuses
+const (
+	AttendancePresent = "present"
+	AttendanceLate    = "late"
+	AttendanceExcused = "excused"
+	AttendanceAbsent  = "absent"
+)
+
+// AttendanceStatuses are the statuses a student can have for an attendance.
+var AttendanceStatuses = []string{AttendancePresent, AttendanceLate, AttendanceExcused, AttendanceAbsent}
+
+// Sources of attendance status changes
+const (
+	StatusSourceCheckIn    = "check-in"
+	StatusSourceSessionEnd = "session end"
+	StatusSourceManual     = "manual"
+	StatusSourceExcuse     = "excuse"
+)
+
+// ValidAttendanceStatus reports whether status is one of the AttendanceStatuses.
+func ValidAttendanceStatus(status string) bool {
+	for _, valid := range AttendanceStatuses {
+		if status == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// ** CREATE **
+
+// Add a new attendance record to the database for a given class session.
+// It returns the ID of the new attendance record on success and any encountered error.
+func (a *Attendance) Add(classSessionID, sectionIDInt, instructorID int) (int, error) {
+	db := DB()
+	var ID int64
+	// Add attendance record
+	sqlStatement := `
+		INSERT INTO
+			attendance
+		VALUES
+			(NULL,
+			$1,	
+			$2,
+			$3,
+			datetime('now'),
+			datetime('now'),
+            datetime('now')
+			)
+		RETURNING id`
+
+	// Get ID of newly created attendance record
+	err := db.QueryRow(sqlStatement, sectionIDInt, instructorID, classSessionID).Scan(&ID)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(ID), nil
+}
+
+// Add a new user_attendance record to the database for a given user and attendance record.
+// It returns the ID of the new user_attendance record on success and any encountered error.
+func (a *Attendance) AddUserAttendance(attendanceID int, userID int, status string) (int64, error) {
+	db := DB()
+	var ID int64
+	// Add user_attendance record
+	sqlStatement := `
+		INSERT INTO
+			user_attendance
+		VALUES
+			(NULL,
+			$1,
+			$2,
+			$3)
+		RETURNING id`
+
+	// Get ID of newly created user_attendance record
+	err := db.QueryRow(sqlStatement, attendanceID, userID, status).Scan(&ID)
+	if err != nil {
+		return 0, err
+	}
+
+	return ID, nil
+}
+
+// SetUserAttendance sets the status of a user for an attendance, adding their user_attendance record
+// if they have none, and records the change in the audit trail with who made it, 0 for the system,
+// where it came from and why.
+// It returns whether the status changed and any encountered error.
+func (a *Attendance) SetUserAttendance(attendanceID int, userID int, status string, changedBy int, source string, reason string) (bool, error) {
+	tx, err := DB().Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	changed, err := setUserAttendance(tx, attendanceID, userID, status, changedBy, source, reason)
+	if err != nil || !changed {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// setUserAttendance sets the status of a user for an attendance within a transaction, as SetUserAttendance does.
+// It returns whether the status changed and any encountered error.
+func setUserAttendance(tx *Tx, attendanceID int, userID int, status string, changedBy int, source string, reason string) (bool, error) {
+	var userAttendanceID int
+	var oldStatus sql.NullString
+	err := tx.QueryRow(`
+		SELECT
+			id,
+			status
+		FROM
+			user_attendance
+		WHERE
+			attendance_id = $1
+		AND
+			user_id = $2
+		ORDER BY
+			id DESC
+		LIMIT 1
+		`, attendanceID, userID).Scan(&userAttendanceID, &oldStatus)
+	switch {
+	case err == sql.ErrNoRows:
+		_, err = tx.Exec(`
+		INSERT INTO
+			user_attendance
+		VALUES
+			(NULL,
+			$1,
+			$2,
+			$3)
+		`, attendanceID, userID, status)
+	case err != nil:
+		return false, err
+	case oldStatus.String == status:
+		return false, nil
+	default:
+		_, err = tx.Exec(`
+		UPDATE
+			user_attendance
+		SET
+			status = $1
+		WHERE
+			id = $2
+		`, status, userAttendanceID)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	err = recordStatusChange(tx, attendanceID, userID, oldStatus, status, changedBy, source, reason)
+	return err == nil, err
+}
+
+// recordStatusChange adds an entry to the audit trail of attendance statuses.
+// It returns any encountered error.
+func recordStatusChange(tx *Tx, attendanceID int, userID int, oldStatus sql.NullString, newStatus string, changedBy int, source string, reason string) error {
+	_, err := tx.Exec(`
+		INSERT INTO
+			attendance_status_change
+			(attendance_id,
+			user_id,
+			old_status,
+			new_status,
+			changed_by,
+			source,
+			reason,
+			created_at)
+		VALUES
+			($1,
+			$2,
+			$3,
+			$4,
+			$5,
+			$6,
+			$7,
+			datetime('now'))
+		`, attendanceID, userID, oldStatus, newStatus, sql.NullInt64{Int64: int64(changedBy), Valid: changedBy != 0}, source, reason)
+	return err
+}
+
+// ** READ **
+
+// GetCoursesByInstructor gets all courses associated with a given instructor.
+// It returns a slice of CourseSection structs and any encountered error.
+func (c *CourseSection) GetCoursesByInstructor(instructorID int) ([]CourseSection, error) {
+	db := DB()
+	var courses []CourseSection
+
+	sqlStatement := `
+		SELECT DISTINCT
+			c.id as course_id,
+			c.number as course_number,
+			c.title as course_title
+		FROM
+			course c
+		JOIN
+			section s ON c.id = s.course_id
+		JOIN
+			attendance a ON s.id = a.section_id
+		WHERE
+			a.instructor_id = $1
+		`
+	rows, err := db.Query(sqlStatement, instructorID)
+	if err != nil {
+		return courses, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var cs CourseSection
+		err = rows.Scan(&cs.CourseID, &cs.CourseNumber, &cs.CourseTitle)
+		if err != nil {
+			return courses, err
+		}
+		courses = append(courses, cs)
+	}
+
+	return courses, nil
+}
+
+// GetSectionsByInstructorAndCourse gets all sections associated with a given course id and instructor id.
+// It returns a slice of CourseSection structs and any encountered error.
+func (c *CourseSection) GetSectionsByInstructorAndCourse(instructorID int, courseID int) ([]CourseSection, error) {
+	db := DB()
+	var sections []CourseSection
+
+	sqlStatement := `
+		SELECT DISTINCT
+			s.id as section_id,
+			c.number as course_number,
+			c.title as course_title,
+			s.name as section_name,
+			a.id as attendance_id,
+			strftime('%Y-%m-%d', a.attended_at) as date
+		FROM
+			section s
+		JOIN
+			course c ON s.course_id = c.id
+		JOIN
+			attendance a ON s.id = a.section_id
+		WHERE
+			a.instructor_id = $1
+		AND
+			c.id = $2
+		`
+	rows, err := db.Query(sqlStatement, instructorID, courseID)
+	if err != nil {
+		return sections, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var cs CourseSection
+		err = rows.Scan(&cs.SectionID, &cs.CourseNumber, &cs.CourseTitle, &cs.SectionName, &cs.AttendanceID, &cs.Date)
+		if err != nil {
+			return sections, err
+		}
+		sections = append(sections, cs)
+	}
+
+	return sections, nil
+}
+
+// GetByInstructor gets all attendance records for a given instructor along with the course title and section info for that record.
+// It returns a slice of Attendance structs and any encountered error.
+func (a *Attendance) GetByInstructor(instructorID int) ([]Attendance, error) {
+	db := DB()
+	var attendance []Attendance
+
+	sqlStatement := `
+		SELECT
+			a.id,
+			a.section_id,
+			a.instructor_id,
+			a.class_session_id,
+			strftime('%Y-%m-%d', a.attended_at) as attended_at,
+			a.created_at,
+			a.updated_at,
+			c.number as course_number,
+			c.title as course_title,
+			s.name as section_name
+		FROM
+			attendance a
+		JOIN
+			section s ON a.section_id = s.id
+		JOIN
+			course c ON s.course_id = c.id
+		WHERE
+			a.instructor_id = $1
+		`
+	rows, err := db.Query(sqlStatement, instructorID)
+	if err != nil {
+		return attendance, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var a Attendance
+		err = rows.Scan(&a.ID, &a.Section, &a.InstructorID, &a.ClassSession, &a.Date, &a.CreatedAt, &a.UpdatedAt, &a.CourseNumber, &a.CourseTitle, &a.SectionName)
+		if err != nil {
+			return attendance, err
+		}
+		attendance = append(attendance, a)
+	}
+
+	return attendance, nil
+}
+
+// Get all attendance records for a given class session.
+// It returns a slice of Attendance structs and any encountered error.
+func (a *Attendance) GetByClassSession(classSessionID int) ([]Attendance, error) {
+	db := DB()
+	var attendance []Attendance
+
+	sqlStatement := `
+		SELECT 
+			* 
+		FROM 
+			attendance 
+		WHERE 
+			class_session_id = $1
+		`
+	rows, err := db.Query(sqlStatement, classSessionID)
+	if err != nil {
+		return attendance, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var a Attendance
+		err = rows.Scan(&a.ID, &a.Section, &a.InstructorID, &a.ClassSession, &a.Date, &a.CreatedAt, &a.UpdatedAt)
+		if err != nil {
+			return attendance, err
+		}
+		attendance = append(attendance, a)
+	}
+
+	return attendance, nil
+}
+
+// GetStudentsByAttendance gets all students for a given attendance ID.
+// It returns a slice of User structs and any encountered error.
+func (ar *AttendanceRecord) GetStudentsByAttendance(attendanceID int) ([]AttendanceRecord, error) {
+	db := DB()
+	var attendanceRecords []AttendanceRecord
+
+	sqlStatement := `
+	SELECT
+		user.id,
+		user.email,
+		user.first_name,
+		user.last_name,
+		user_attendance.status
+	FROM
+		user
+	JOIN
+		user_attendance ON user.id = user_attendance.user_id
+	JOIN
+		attendance ON user_attendance.attendance_id = attendance.id
+	WHERE
+		attendance.id = $1
+		`
+
+	rows, err := db.Query(sqlStatement, attendanceID)
+	if err != nil {
+		return attendanceRecords, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var ar AttendanceRecord
+		err = rows.Scan(&ar.ID, &ar.Email, &ar.FirstName, &ar.LastName, &ar.Status)
+		if err != nil {
+			return attendanceRecords, err
+		}
+		attendanceRecords = append(attendanceRecords, ar)
+	}
+
+	return attendanceRecords, nil
+}
+
+// GetByID gets an attendance record given its id.
+// It returns the Attendance struct and any encountered error.
+func (a *Attendance) GetByID(attendanceID int) (Attendance, error) {
+	db := DB()
+	var attendance Attendance
+
+	sqlStatement := `
+		SELECT
+			id,
+			section_id,
+			instructor_id,
+			class_session_id
+		FROM
+			attendance
+		WHERE
+			id = $1
+		`
+	err := db.QueryRow(sqlStatement, attendanceID).Scan(&attendance.ID, &attendance.Section, &attendance.InstructorID, &attendance.ClassSession)
+	if err != nil {
+		return attendance, err
+	}
+
+	return attendance, nil
+}
+
+// GetRoster gets the students of the section an attendance was taken for, and anyone else with a status
+// for it, with their latest status or an empty one when they have none, by name.
+// It returns a slice of AttendanceRecord structs and any encountered error.
+func (ar *AttendanceRecord) GetRoster(attendanceID int) ([]AttendanceRecord, error) {
+	db := DB()
+	attendanceRecords := []AttendanceRecord{}
+
+	sqlStatement := `
+	SELECT
+		user.id,
+		user.email,
+		user.first_name,
+		user.last_name,
+		COALESCE((
+			SELECT
+				user_attendance.status
+			FROM
+				user_attendance
+			WHERE
+				user_attendance.attendance_id = attendance.id
+			AND
+				user_attendance.user_id = user.id
+			ORDER BY
+				user_attendance.id DESC
+			LIMIT 1), '')
+	FROM
+		attendance
+	JOIN
+		user ON user.id IN (
+			SELECT
+				enrollment.user_id
+			FROM
+				enrollment
+			WHERE
+				enrollment.section_id = attendance.section_id
+			AND
+				enrollment.user_id NOT IN (
+					SELECT
+						moderator.user_id
+					FROM
+						moderator
+					WHERE
+						moderator.section_id = attendance.section_id
+					AND
+						moderator.type IN ('instructor', 'teacher assistant'))
+			UNION
+			SELECT
+				user_attendance.user_id
+			FROM
+				user_attendance
+			WHERE
+				user_attendance.attendance_id = attendance.id)
+	WHERE
+		attendance.id = $1
+	ORDER BY
+		user.last_name,
+		user.first_name,
+		user.id
+		`
+
+	rows, err := db.Query(sqlStatement, attendanceID)
+	if err != nil {
+		return attendanceRecords, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var ar AttendanceRecord
+		err = rows.Scan(&ar.ID, &ar.Email, &ar.FirstName, &ar.LastName, &ar.Status)
+		if err != nil {
+			return attendanceRecords, err
+		}
+		attendanceRecords = append(attendanceRecords, ar)
+	}
+
+	return attendanceRecords, rows.Err()
+}
+
+// GetStatusChanges gets the audit trail of the statuses of an attendance, newest first,
+// with times shifted by the timezone offset in minutes.
+// It returns a slice of StatusChange structs and any encountered error.
+func (sc *StatusChange) GetStatusChanges(attendanceID int, timezone int) ([]StatusChange, error) {
+	db := DB()
+	changes := []StatusChange{}
+
+	sqlStatement := `
+	SELECT
+		attendance_status_change.id,
+		attendance_status_change.attendance_id,
+		attendance_status_change.user_id,
+		student.first_name,
+		student.last_name,
+		student.email,
+		COALESCE(attendance_status_change.old_status, ''),
+		attendance_status_change.new_status,
+		COALESCE(attendance_status_change.changed_by, 0),
+		COALESCE(changer.first_name, ''),
+		COALESCE(changer.last_name, ''),
+		attendance_status_change.source,
+		attendance_status_change.reason,
+		strftime('%Y-%m-%d %H:%M:%S', datetime(attendance_status_change.created_at, (? || ' minutes')))
+	FROM
+		attendance_status_change
+	JOIN
+		user student ON student.id = attendance_status_change.user_id
+	LEFT JOIN
+		user changer ON changer.id = attendance_status_change.changed_by
+	WHERE
+		attendance_status_change.attendance_id = ?
+	ORDER BY
+		attendance_status_change.id DESC
+		`
+
+	rows, err := db.Query(sqlStatement, timezone, attendanceID)
+	if err != nil {
+		return changes, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var c StatusChange
+		err = rows.Scan(&c.ID, &c.AttendanceID, &c.UserID, &c.FirstName, &c.LastName, &c.Email, &c.OldStatus, &c.NewStatus,
+			&c.ChangedBy, &c.ChangedByFirstName, &c.ChangedByLastName, &c.Source, &c.Reason, &c.CreatedAt)
+		if err != nil {
+			return changes, err
+		}
+		changes = append(changes, c)
+	}
+
+	return changes, rows.Err()
+}
+
+// GetAttendanceIDBySectionID gets the attendance ID for a given section ID returning the most recent attendance record for that section.
+// It returns the attendance ID and any encountered error.
+func (a *Attendance) GetAttendanceIDBySectionID(sectionID int) (int, error) {
+	db := DB()
+	var attendanceID int
+
+	sqlStatement := `
+		SELECT
+			id
+		FROM
+			attendance
+		WHERE
+			section_id = $1
+		ORDER BY
+			created_at DESC
+		LIMIT 1
+		`
+	err := db.QueryRow(sqlStatement, sectionID).Scan(&attendanceID)
+	if err != nil {
+		return 0, err
+	}
+
+	return attendanceID, nil
+}
+
+// GetAttendanceIDByClassSessionID gets the attendance ID taken for a given class session.
+// It returns the attendance ID and any encountered error.
+func (a *Attendance) GetAttendanceIDByClassSessionID(classSessionID int) (int, error) {
+	db := DB()
+	var attendanceID int
+
+	sqlStatement := `
+		SELECT
+			id
+		FROM
+			attendance
+		WHERE
+			class_session_id = $1
+		ORDER BY
+			id DESC
+		LIMIT 1
+		`
+	err := db.QueryRow(sqlStatement, classSessionID).Scan(&attendanceID)
+	if err != nil {
+		return 0, err
+	}
+
+	return attendanceID, nil
+}
+
+// Get attendance for an individual user give the userID and attendance ID.
+// It returns a slice of UserAttendance structs and any encountered error.
+func (a *Attendance) GetByUser(userID int, attendanceID int) ([]UserAttendance, error) {
+	db := DB()
+	var attendance []UserAttendance
+
+	sqlStatement := `
+		SELECT
+			id,
+			attendance_id,
+			user_id,
+			status
+		FROM
+			user_attendance
+		WHERE
+			user_id = $1
+		AND
+			attendance_id = $2
+		`
+	rows, err := db.Query(sqlStatement, userID, attendanceID)
+	if err != nil {
+		return attendance, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var a UserAttendance
+		err = rows.Scan(&a.ID, &a.Attendance, &a.UserID, &a.Status)
+		if err != nil {
+			return attendance, err
+		}
+		attendance = append(attendance, a)
+	}
+
+	return attendance, nil
+}
+
+// ** UPDATE **
+// UpdateUserAttendance sets the status of a user for an attendance as a manual change by changedBy,
+// and records it in the audit trail with the reason given.
+// It returns any encountered error.
+func (a *Attendance) UpdateUserAttendance(userID int, attendanceID int, status string, changedBy int, reason string) error {
+	tx, err := DB().Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	changed, err := setUserAttendance(tx, attendanceID, userID, status, changedBy, StatusSourceManual, reason)
+	if err != nil || !changed {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ** DELETE **
+// Delete all attendance records for a given class session ID.
+func (a *Attendance) DeleteAll(classSessionID int) error {
+	db := DB()
+
+	// Get all attendanceIDs for the given classSessionID
+	var attendanceIDs []int
+	sqlSelect := `
+        SELECT
+            id
+        FROM
+            attendance
+        WHERE
+            class_session_id = $1
+    `
+	rows, err := db.Query(sqlSelect, classSessionID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var attendanceID int
+		err = rows.Scan(&attendanceID)
+		if err != nil {
+			return err
+		}
+		attendanceIDs = append(attendanceIDs, attendanceID)
+	}
+
+	// Delete all user_attendance records for the given attendanceIDs
+	for _, attendanceID := range attendanceIDs {
+		err = a.DeleteUserAttendance(attendanceID)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Delete all attendance records for the given classSessionID
+	sqlDelete := `
+        DELETE FROM
+            attendance
+        WHERE
+            class_session_id = $1
+    `
+	_, err = db.Exec(sqlDelete, classSessionID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Delete a single user_attendance record given the attendanceID.
+// It returns any encountered error.
+func (a *Attendance) DeleteUserAttendance(attendanceID int) error {
+	db := DB()
+
+	sqlStatement := `
+		DELETE FROM
+			user_attendance
+		WHERE
+			attendance_id = $1
+	`
+	_, err := db.Exec(sqlStatement, attendanceID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,276 @@
+package models
+
+import (
+	"database/sql"
+	"math"
+)
+
+// AttendanceThresholds are the limits past which a student of a section is at risk and their instructor is alerted.
+type AttendanceThresholds struct {
+	SectionID int
+	// Absences is the number of absences that raises an alert, 0 for none
+	Absences int
+	// Rate is the attendance rate in percent below which an alert is raised, 0 for none
+	Rate int
+	// Email sends the alerts to the instructor and the student by email as well
+	Email bool
+}
+
+// AttendanceAlert is a student of a section who crossed one of its thresholds.
+type AttendanceAlert struct {
+	ID        int
+	SectionID int
+	UserID    int
+	FirstName string
+	LastName  string
+	Email     string
+	// Kind is the threshold crossed, AlertAbsences or AlertRate
+	Kind      string
+	Threshold int
+	// Observed is the number of absences or the attendance rate in percent of the student when they crossed it
+	Observed     int
+	AttendanceID int
+	CreatedAt    string
+	DismissedBy  int
+	DismissedAt  string
+	CourseNumber string
+	CourseTitle  string
+	SectionName  string
+}
+
+// Attendance alert kinds
+const (
+	AlertAbsences = "absences"
+	AlertRate     = "rate"
+)
+
+// AlertRateMinDays is the number of days a student must have attended or missed before their rate is checked,
+// so a single absence at the start of term doesn't put them below every threshold.
+const AlertRateMinDays = 3
+
+// Check returns the alerts raised by the statuses of a student, with the Kind, Threshold and Observed of each.
+func (t AttendanceThresholds) Check(statuses []string) []AttendanceAlert {
+	alerts := []AttendanceAlert{}
+
+	absences, counted := 0, 0
+	for _, status := range statuses {
+		switch status {
+		case AttendanceAbsent:
+			absences++
+			counted++
+		case AttendancePresent, AttendanceLate:
+			counted++
+		}
+	}
+	if t.Absences > 0 && absences >= t.Absences {
+		alerts = append(alerts, AttendanceAlert{SectionID: t.SectionID, Kind: AlertAbsences, Threshold: t.Absences, Observed: absences})
+	}
+
+	rate, ok := attendanceRate(statuses)
+	if t.Rate > 0 && ok && counted >= AlertRateMinDays && rate*100 < float64(t.Rate) {
+		alerts = append(alerts, AttendanceAlert{SectionID: t.SectionID, Kind: AlertRate, Threshold: t.Rate, Observed: int(math.Floor(rate * 100))})
+	}
+
+	return alerts
+}
+
+// ** CREATE **
+// Add raises an alert for a student who crossed a threshold of a section at an attendance, unless they
+// already crossed the same one.
+// It returns the id of the alert, whether it is new and any error encountered.
+func (a *AttendanceAlert) Add(sectionID int, userID int, kind string, threshold int, observed int, attendanceID int) (int, bool, error) {
+	var alertID int
+	err := DB().QueryRow(`
+	INSERT INTO
+		attendance_alert
+		(section_id,
+		user_id,
+		kind,
+		threshold,
+		observed,
+		attendance_id,
+		created_at)
+	VALUES
+		($1,
+		$2,
+		$3,
+		$4,
+		$5,
+		$6,
+		datetime('now'))
+	ON CONFLICT (section_id, user_id, kind, threshold) DO NOTHING
+	RETURNING id`,
+		sectionID, userID, kind, threshold, observed, sql.NullInt64{Int64: int64(attendanceID), Valid: attendanceID != 0}).Scan(&alertID)
+	if err == sql.ErrNoRows {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	return alertID, true, nil
+}
+
+// ** READ **
+// GetThresholds gets the attendance thresholds of a section.
+// It returns the AttendanceThresholds struct and any error encountered.
+func (t *AttendanceThresholds) GetThresholds(sectionID int) (AttendanceThresholds, error) {
+	thresholds := AttendanceThresholds{SectionID: sectionID}
+	err := DB().QueryRow(`
+	SELECT
+		alert_absences,
+		alert_rate,
+		alert_email
+	FROM
+		section
+	WHERE
+		id = $1`,
+		sectionID).Scan(&thresholds.Absences, &thresholds.Rate, &thresholds.Email)
+	return thresholds, err
+}
+
+// alertColumns are the columns scanned by queryAlerts, with created_at and dismissed_at
+// shifted by a timezone offset given twice.
+const alertColumns = `
+		attendance_alert.id,
+		attendance_alert.section_id,
+		attendance_alert.user_id,
+		user.first_name,
+		user.last_name,
+		user.email,
+		attendance_alert.kind,
+		attendance_alert.threshold,
+		attendance_alert.observed,
+		COALESCE(attendance_alert.attendance_id, 0),
+		strftime('%Y-%m-%d %H:%M', datetime(attendance_alert.created_at, (? || ' minutes'))),
+		COALESCE(attendance_alert.dismissed_by, 0),
+		COALESCE(strftime('%Y-%m-%d %H:%M', datetime(attendance_alert.dismissed_at, (? || ' minutes'))), ''),
+		course.number,
+		course.title,
+		section.name
+	FROM
+		attendance_alert
+	JOIN
+		user ON user.id = attendance_alert.user_id
+	JOIN
+		section ON section.id = attendance_alert.section_id
+	JOIN
+		course ON course.id = section.course_id`
+
+// queryAlerts returns the alerts selected by a query of alertColumns.
+func queryAlerts(sqlStatement string, args ...interface{}) ([]AttendanceAlert, error) {
+	rows, err := DB().Query(sqlStatement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	alerts := []AttendanceAlert{}
+	for rows.Next() {
+		var a AttendanceAlert
+		err = rows.Scan(&a.ID, &a.SectionID, &a.UserID, &a.FirstName, &a.LastName, &a.Email, &a.Kind, &a.Threshold, &a.Observed,
+			&a.AttendanceID, &a.CreatedAt, &a.DismissedBy, &a.DismissedAt, &a.CourseNumber, &a.CourseTitle, &a.SectionName)
+		if err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, a)
+	}
+
+	return alerts, rows.Err()
+}
+
+// GetByID gets an alert given its id, with times shifted by the timezone offset in minutes.
+// It returns the AttendanceAlert struct, sql.ErrNoRows when there is no such alert and any error encountered.
+func (a *AttendanceAlert) GetByID(alertID int, timezone int) (AttendanceAlert, error) {
+	alerts, err := queryAlerts(`SELECT`+alertColumns+`
+	WHERE
+		attendance_alert.id = ?`,
+		timezone, timezone, alertID)
+	if err != nil {
+		return AttendanceAlert{}, err
+	}
+	if len(alerts) == 0 {
+		return AttendanceAlert{}, sql.ErrNoRows
+	}
+	return alerts[0], nil
+}
+
+// GetOpenByTeacher gets the alerts not dismissed yet of the sections a user teaches, as their instructor or
+// a teacher assistant or having taken attendance in them, newest first.
+// It returns a slice of AttendanceAlert structs and any error encountered.
+func (a *AttendanceAlert) GetOpenByTeacher(userID int, timezone int) ([]AttendanceAlert, error) {
+	return queryAlerts(`SELECT`+alertColumns+`
+	WHERE
+		attendance_alert.dismissed_at IS NULL
+	AND
+		attendance_alert.section_id IN (
+			SELECT
+				moderator.section_id
+			FROM
+				moderator
+			WHERE
+				moderator.user_id = ?
+			AND
+				moderator.type IN ('instructor', 'teacher assistant')
+			UNION
+			SELECT
+				attendance.section_id
+			FROM
+				attendance
+			WHERE
+				attendance.instructor_id = ?)
+	ORDER BY
+		attendance_alert.id DESC`,
+		timezone, timezone, userID, userID)
+}
+
+// ** UPDATE **
+// SetThresholds sets the attendance thresholds of a section.
+// It returns sql.ErrNoRows when there is no such section and any error encountered.
+func (t *AttendanceThresholds) SetThresholds(thresholds AttendanceThresholds) error {
+	result, err := DB().Exec(`
+	UPDATE
+		section
+	SET
+		alert_absences = $1,
+		alert_rate = $2,
+		alert_email = $3,
+		updated_at = datetime('now')
+	WHERE
+		id = $4`,
+		thresholds.Absences, thresholds.Rate, thresholds.Email, thresholds.SectionID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// Dismiss marks an alert as followed up by a user, taking it off the dashboard.
+// It returns whether it was open and any error encountered.
+func (a *AttendanceAlert) Dismiss(alertID int, userID int) (bool, error) {
+	result, err := DB().Exec(`
+	UPDATE
+		attendance_alert
+	SET
+		dismissed_by = $1,
+		dismissed_at = datetime('now')
+	WHERE
+		id = $2
+	AND
+		dismissed_at IS NULL`,
+		userID, alertID)
+	if err != nil {
+		return false, err
+	}
+
+	dismissed, err := result.RowsAffected()
+	return dismissed > 0, err
+}
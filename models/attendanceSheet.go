@@ -0,0 +1,333 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AttendanceSheet is the attendance of a section as a roster by date: a row per student with their
+// status on each day attendance was taken, as registrars keep it.
+type AttendanceSheet struct {
+	SectionID    int
+	CourseNumber string
+	CourseTitle  string
+	SectionName  string
+	// Dates are the days attendance was taken, oldest first, one per column. A second attendance
+	// taken the same day is told apart by a number, as in "2026-03-02 (2)".
+	Dates    []string
+	Students []AttendanceSheetRow
+}
+
+// AttendanceSheetRow is a student of an AttendanceSheet.
+type AttendanceSheetRow struct {
+	UserID    int
+	Email     string
+	FirstName string
+	LastName  string
+	// Statuses are the statuses of the student on each of the Dates, empty when they have none
+	Statuses []string
+}
+
+// Total counts the Statuses of the student that are status.
+func (r AttendanceSheetRow) Total(status string) int {
+	total := 0
+	for _, s := range r.Statuses {
+		if s == status {
+			total++
+		}
+	}
+	return total
+}
+
+// attendanceRate is the share of the statuses that count as attended, present or late, leaving out
+// the excused ones and those missing.
+// It returns the rate, from 0 to 1, and false when no status counts.
+func attendanceRate(statuses []string) (float64, bool) {
+	attended, counted := 0, 0
+	for _, status := range statuses {
+		switch status {
+		case AttendancePresent, AttendanceLate:
+			attended++
+			counted++
+		case AttendanceAbsent:
+			counted++
+		}
+	}
+	if counted == 0 {
+		return 0, false
+	}
+	return float64(attended) / float64(counted), true
+}
+
+// Rate is the share of the days the student attended, present or late, out of those they weren't excused from.
+// It returns the rate, from 0 to 1, and false when they have no status that counts.
+func (r AttendanceSheetRow) Rate() (float64, bool) {
+	return attendanceRate(r.Statuses)
+}
+
+// DateTotal counts the students that are status on the date in the column given.
+func (s AttendanceSheet) DateTotal(column int, status string) int {
+	total := 0
+	for _, student := range s.Students {
+		if student.Statuses[column] == status {
+			total++
+		}
+	}
+	return total
+}
+
+// DateRate is the share of the students who attended, present or late, on the date in the column given,
+// out of those who weren't excused.
+// It returns the rate, from 0 to 1, and false when no student has a status that counts.
+func (s AttendanceSheet) DateRate(column int) (float64, bool) {
+	statuses := make([]string, len(s.Students))
+	for i, student := range s.Students {
+		statuses[i] = student.Statuses[column]
+	}
+	return attendanceRate(statuses)
+}
+
+// ** READ **
+// Get gets the attendance sheet of a section for the days from and to, as YYYY-MM-DD in the timezone
+// offset in minutes, both included. Either may be empty to leave the range open on that side.
+// Students enrolled in the section are on the sheet even without any status, and so is anyone else with one
+// but its instructor and teacher assistants.
+// It returns the AttendanceSheet struct and any error encountered.
+func (s *AttendanceSheet) Get(sectionID int, from string, to string, timezone int) (AttendanceSheet, error) {
+	db := DB()
+	sheet := AttendanceSheet{SectionID: sectionID, Dates: []string{}, Students: []AttendanceSheetRow{}}
+
+	err := db.QueryRow(`
+	SELECT
+		course.number,
+		course.title,
+		section.name
+	FROM
+		section
+	JOIN
+		course ON course.id = section.course_id
+	WHERE
+		section.id = ?`,
+		sectionID).Scan(&sheet.CourseNumber, &sheet.CourseTitle, &sheet.SectionName)
+	if err != nil {
+		return sheet, err
+	}
+
+	// The attendances taken in the range, one column each
+	filter := ""
+	args := []interface{}{timezone, sectionID}
+	if from != "" {
+		filter += `
+	AND
+		strftime('%Y-%m-%d', datetime(attended_at, (? || ' minutes'))) >= ?`
+		args = append(args, timezone, from)
+	}
+	if to != "" {
+		filter += `
+	AND
+		strftime('%Y-%m-%d', datetime(attended_at, (? || ' minutes'))) <= ?`
+		args = append(args, timezone, to)
+	}
+	rows, err := db.Query(`
+	SELECT
+		id,
+		strftime('%Y-%m-%d', datetime(attended_at, (? || ' minutes')))
+	FROM
+		attendance
+	WHERE
+		section_id = ?`+filter+`
+	ORDER BY
+		attended_at,
+		id`,
+		args...)
+	if err != nil {
+		return sheet, err
+	}
+	defer rows.Close()
+
+	columns := map[int]int{}
+	taken := map[string]int{}
+	for rows.Next() {
+		var attendanceID int
+		var date string
+		if err := rows.Scan(&attendanceID, &date); err != nil {
+			return sheet, err
+		}
+		taken[date]++
+		if taken[date] > 1 {
+			date = fmt.Sprintf("%s (%d)", date, taken[date])
+		}
+		columns[attendanceID] = len(sheet.Dates)
+		sheet.Dates = append(sheet.Dates, date)
+	}
+	if err := rows.Err(); err != nil {
+		return sheet, err
+	}
+
+	// The latest status of each student for each of those attendances
+	rows, err = db.Query(`
+	SELECT
+		user_attendance.attendance_id,
+		user_attendance.user_id,
+		user_attendance.status
+	FROM
+		user_attendance
+	JOIN
+		attendance ON attendance.id = user_attendance.attendance_id
+	WHERE
+		attendance.section_id = ?
+	ORDER BY
+		user_attendance.id`,
+		sectionID)
+	if err != nil {
+		return sheet, err
+	}
+	defer rows.Close()
+
+	statuses := map[int][]string{}
+	for rows.Next() {
+		var attendanceID, userID int
+		var status string
+		if err := rows.Scan(&attendanceID, &userID, &status); err != nil {
+			return sheet, err
+		}
+		column, ok := columns[attendanceID]
+		if !ok {
+			continue
+		}
+		if statuses[userID] == nil {
+			statuses[userID] = make([]string, len(sheet.Dates))
+		}
+		statuses[userID][column] = status
+	}
+	if err := rows.Err(); err != nil {
+		return sheet, err
+	}
+
+	// The students, enrolled or with a status, by name
+	rows, err = db.Query(`
+	SELECT
+		user.id,
+		user.email,
+		user.first_name,
+		user.last_name,
+		user.id IN (
+			SELECT
+				enrollment.user_id
+			FROM
+				enrollment
+			WHERE
+				enrollment.section_id = ?)
+	FROM
+		user
+	WHERE
+		user.id NOT IN (
+			SELECT
+				moderator.user_id
+			FROM
+				moderator
+			WHERE
+				moderator.section_id = ?
+			AND
+				moderator.type IN ('instructor', 'teacher assistant'))
+	AND
+		user.id IN (
+			SELECT
+				enrollment.user_id
+			FROM
+				enrollment
+			WHERE
+				enrollment.section_id = ?
+			UNION
+			SELECT
+				user_attendance.user_id
+			FROM
+				user_attendance
+			JOIN
+				attendance ON attendance.id = user_attendance.attendance_id
+			WHERE
+				attendance.section_id = ?)
+	ORDER BY
+		user.last_name,
+		user.first_name,
+		user.id`,
+		sectionID, sectionID, sectionID, sectionID)
+	if err != nil {
+		return sheet, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row AttendanceSheetRow
+		var enrolled bool
+		if err := rows.Scan(&row.UserID, &row.Email, &row.FirstName, &row.LastName, &enrolled); err != nil {
+			return sheet, err
+		}
+		row.Statuses = statuses[row.UserID]
+		if row.Statuses == nil {
+			// Students who left the section are only on the sheet for the days they have a status
+			if !enrolled {
+				continue
+			}
+			row.Statuses = make([]string, len(sheet.Dates))
+		}
+		sheet.Students = append(sheet.Students, row)
+	}
+
+	return sheet, rows.Err()
+}
+
+// Name returns the course number and section name of the sheet, as in "CS 101 - 1".
+func (s AttendanceSheet) Name() string {
+	return strings.TrimSpace(fmt.Sprintf("%s - %s", s.CourseNumber, s.SectionName))
+}
+
+// GetTaughtSectionIDs gets the sections a user teaches, as their instructor or a teacher assistant
+// or having taken attendance in them, by course number and section name.
+// It returns a slice of section ids and any error encountered.
+func (s *AttendanceSheet) GetTaughtSectionIDs(userID int) ([]int, error) {
+	rows, err := DB().Query(`
+	SELECT
+		section.id
+	FROM
+		section
+	JOIN
+		course ON course.id = section.course_id
+	WHERE
+		section.id IN (
+			SELECT
+				moderator.section_id
+			FROM
+				moderator
+			WHERE
+				moderator.user_id = $1
+			AND
+				moderator.type IN ('instructor', 'teacher assistant')
+			UNION
+			SELECT
+				attendance.section_id
+			FROM
+				attendance
+			WHERE
+				attendance.instructor_id = $1)
+	ORDER BY
+		course.number,
+		section.name,
+		section.id`,
+		userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sectionIDs := []int{}
+	for rows.Next() {
+		var sectionID int
+		if err := rows.Scan(&sectionID); err != nil {
+			return nil, err
+		}
+		sectionIDs = append(sectionIDs, sectionID)
+	}
+
+	return sectionIDs, rows.Err()
+}
@@ -0,0 +1,245 @@
+package models
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CalendarToken is the secret that lets a calendar app read a user's meeting feed without signing in.
+type CalendarToken struct {
+	ID        int
+	UserID    int
+	Token     string
+	CreatedAt string
+}
+
+// CalendarEvent is a recurring meeting of a section as it appears in a calendar feed.
+type CalendarEvent struct {
+	Summary  string
+	URL      string
+	Schedule Schedule
+}
+
+// calendarLineLength is the maximum length in octets of a content line before it is folded (RFC 5545 3.1).
+const calendarLineLength = 75
+
+// newCalendarToken returns a random, URL safe token.
+func newCalendarToken() (string, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// ** CREATE **
+// Reset replaces the calendar token of a user, which stops the old feed URLs from working.
+// It returns the new token and any encountered errors.
+func (t CalendarToken) Reset(userID int) (string, error) {
+	token, err := newCalendarToken()
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := DB().Begin()
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`
+	DELETE FROM
+		calendar_token
+	WHERE
+		user_id = $1`,
+		userID)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = tx.Exec(`
+	INSERT INTO
+		calendar_token
+		(user_id,
+		token,
+		created_at)
+	VALUES
+		($1,
+		$2,
+		datetime('now'))`,
+		userID, token)
+	if err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// ** READ **
+// GetByUserID returns the calendar token of a user, creating one the first time it is asked for.
+// It returns the token and any encountered errors.
+func (t CalendarToken) GetByUserID(userID int) (string, error) {
+	db := DB()
+
+	var token string
+	err := db.QueryRow(`
+	SELECT
+		token
+	FROM
+		calendar_token
+	WHERE
+		user_id = $1`,
+		userID).Scan(&token)
+	if err == sql.ErrNoRows {
+		return t.Reset(userID)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// GetUserID returns the user a calendar token belongs to.
+// It returns the user id and any encountered errors, sql.ErrNoRows for an unknown token.
+func (t CalendarToken) GetUserID(token string) (int, error) {
+	db := DB()
+
+	var userID int
+	err := db.QueryRow(`
+	SELECT
+		user_id
+	FROM
+		calendar_token
+	WHERE
+		token = $1`,
+		token).Scan(&userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return userID, nil
+}
+
+// Calendar renders events as an iCalendar (RFC 5545) document. Each event becomes one weekly
+// recurring VEVENT bounded by the course term, with the section's holidays as exception dates.
+// Times are written in the organization timezone, described by a fixed offset VTIMEZONE.
+func Calendar(name string, events []CalendarEvent, loc *time.Location, now time.Time) string {
+	tzid := calendarTimezoneID(loc)
+
+	var b strings.Builder
+	line := func(s string) {
+		b.WriteString(foldCalendarLine(s))
+	}
+
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//Coeus//Class Meetings//EN")
+	line("CALSCALE:GREGORIAN")
+	line("METHOD:PUBLISH")
+	line("X-WR-CALNAME:" + escapeCalendarText(name))
+
+	line("BEGIN:VTIMEZONE")
+	line("TZID:" + tzid)
+	line("BEGIN:STANDARD")
+	line("DTSTART:19700101T000000")
+	line("TZOFFSETFROM:" + calendarOffset(loc))
+	line("TZOFFSETTO:" + calendarOffset(loc))
+	line("END:STANDARD")
+	line("END:VTIMEZONE")
+
+	stamp := now.UTC().Format("20060102T150405Z")
+	for _, event := range events {
+		schedule := event.Schedule
+		first, last := schedule.term(loc)
+		if first.IsZero() || last.IsZero() {
+			continue
+		}
+
+		// The first meeting anchors the recurrence; a schedule that never meets in the term is left out
+		occurrences := schedule.Occurrences(first, first.AddDate(0, 0, 7), loc)
+		if len(occurrences) == 0 {
+			continue
+		}
+		start := occurrences[0]
+		clock, _ := parseClockTime(schedule.StartTime)
+		until := last.AddDate(0, 0, 1).Add(-time.Second)
+
+		line("BEGIN:VEVENT")
+		line(fmt.Sprintf("UID:schedule-%d-section-%d@coeus", schedule.ID, schedule.SectionID))
+		line("DTSTAMP:" + stamp)
+		line("DTSTART;TZID=" + tzid + ":" + start.Start.Format("20060102T150405"))
+		line("DTEND;TZID=" + tzid + ":" + start.End.Format("20060102T150405"))
+		line("RRULE:FREQ=WEEKLY;BYDAY=" + schedule.WeekdayCodes() + ";UNTIL=" + until.UTC().Format("20060102T150405Z"))
+		for _, holiday := range schedule.Holidays {
+			day, err := time.ParseInLocation(DateLayout, holiday, loc)
+			if err != nil || !schedule.MeetsOn(day.Weekday()) || day.Before(first) || day.After(last) {
+				continue
+			}
+			line("EXDATE;TZID=" + tzid + ":" + day.Add(clock).Format("20060102T150405"))
+		}
+		line("SUMMARY:" + escapeCalendarText(event.Summary))
+		if schedule.Room != "" {
+			line("LOCATION:" + escapeCalendarText(schedule.Room))
+		}
+		if event.URL != "" {
+			line("URL:" + event.URL)
+			line("DESCRIPTION:" + escapeCalendarText("Join the class session: "+event.URL))
+		}
+		line("END:VEVENT")
+	}
+
+	line("END:VCALENDAR")
+
+	return b.String()
+}
+
+// calendarOffset formats the UTC offset of loc as +hhmm or -hhmm.
+func calendarOffset(loc *time.Location) string {
+	_, offset := time.Now().In(loc).Zone()
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, offset/3600, offset%3600/60)
+}
+
+// calendarTimezoneID names the fixed offset timezone of a feed, such as "Coeus-0500".
+func calendarTimezoneID(loc *time.Location) string {
+	return "Coeus" + calendarOffset(loc)
+}
+
+// escapeCalendarText escapes a TEXT property value (RFC 5545 3.3.11).
+func escapeCalendarText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// foldCalendarLine ends a content line with CRLF, folding it onto continuation lines
+// that begin with a space so no line is longer than 75 octets. Folds never split a UTF-8 character.
+func foldCalendarLine(s string) string {
+	var b strings.Builder
+	limit := calendarLineLength
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && s[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(s[:cut])
+		b.WriteString("\r\n ")
+		s = s[cut:]
+		// The leading space of a continuation line counts towards its length
+		limit = calendarLineLength - 1
+	}
+	b.WriteString(s)
+	b.WriteString("\r\n")
+	return b.String()
+}
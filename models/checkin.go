@@ -0,0 +1,294 @@
+package models
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// CheckIn is a window during which students mark themselves present by submitting the code the instructor shows.
+// The code is derived from the secret of the check-in and changes every period seconds.
+type CheckIn struct {
+	ID           int
+	AttendanceID int
+	Secret       string
+	// Period is the number of seconds each code is shown for
+	Period   int
+	OpenedBy int
+	OpenedAt string
+	ClosedAt string
+}
+
+// CheckInAttempt is a code submitted by a student and whether it was accepted, kept to audit the check-ins.
+type CheckInAttempt struct {
+	ID           int
+	AttendanceID int
+	CheckInID    int
+	UserID       int
+	FirstName    string
+	LastName     string
+	Email        string
+	Code         string
+	Result       string
+	CreatedAt    string
+}
+
+// Check-in attempt results
+const (
+	CheckInAccepted = "accepted"
+	CheckInWrong    = "wrong"
+	CheckInExpired  = "expired"
+	CheckInClosed   = "closed"
+	CheckInLimited  = "limited"
+)
+
+// CheckInCodeLength is the number of digits of a check-in code.
+const CheckInCodeLength = 6
+
+// CheckInAttemptLimit is the number of rejected codes a student can submit to a check-in before they are refused.
+const CheckInAttemptLimit = 5
+
+// checkInExpiredPeriods is how many periods back a code is reported as expired rather than wrong.
+const checkInExpiredPeriods = 20
+
+// newCheckInSecret returns a random secret to derive the codes of a check-in from.
+func newCheckInSecret() (string, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// ** CREATE **
+// Open starts a check-in for an attendance, closing the one already open.
+// It returns the new check-in and any error encountered.
+func (ci *CheckIn) Open(attendanceID int, userID int, period int) (CheckIn, error) {
+	secret, err := newCheckInSecret()
+	if err != nil {
+		return CheckIn{}, err
+	}
+
+	tx, err := DB().Begin()
+	if err != nil {
+		return CheckIn{}, err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`
+	UPDATE
+		check_in
+	SET
+		closed_at = datetime('now')
+	WHERE
+		attendance_id = $1
+	AND
+		closed_at IS NULL`,
+		attendanceID)
+	if err != nil {
+		return CheckIn{}, err
+	}
+
+	checkIn := CheckIn{AttendanceID: attendanceID, Secret: secret, Period: period, OpenedBy: userID}
+	err = tx.QueryRow(`
+	INSERT INTO
+		check_in
+		(attendance_id,
+		secret,
+		period,
+		opened_by,
+		opened_at)
+	VALUES
+		($1,
+		$2,
+		$3,
+		$4,
+		datetime('now'))
+	RETURNING id, opened_at`,
+		attendanceID, secret, period, userID).Scan(&checkIn.ID, &checkIn.OpenedAt)
+	if err != nil {
+		return CheckIn{}, err
+	}
+
+	return checkIn, tx.Commit()
+}
+
+// LogAttempt records a code submitted to the check-in of an attendance, checkInID being 0 when none was open.
+// It returns any error encountered.
+func (a *CheckInAttempt) LogAttempt(attendanceID int, checkInID int, userID int, code string, result string) error {
+	_, err := DB().Exec(`
+	INSERT INTO
+		check_in_attempt
+		(attendance_id,
+		check_in_id,
+		user_id,
+		code,
+		result,
+		created_at)
+	VALUES
+		($1,
+		$2,
+		$3,
+		$4,
+		$5,
+		datetime('now'))`,
+		attendanceID, sql.NullInt64{Int64: int64(checkInID), Valid: checkInID != 0}, userID, code, result)
+	return err
+}
+
+// ** READ **
+// GetOpen gets the check-in open for an attendance.
+// It returns the check-in, sql.ErrNoRows when none is open, and any error encountered.
+func (ci *CheckIn) GetOpen(attendanceID int) (CheckIn, error) {
+	var checkIn CheckIn
+	err := DB().QueryRow(`
+	SELECT
+		id,
+		attendance_id,
+		secret,
+		period,
+		opened_by,
+		opened_at
+	FROM
+		check_in
+	WHERE
+		attendance_id = $1
+	AND
+		closed_at IS NULL
+	ORDER BY
+		id DESC
+	LIMIT 1`,
+		attendanceID).Scan(&checkIn.ID, &checkIn.AttendanceID, &checkIn.Secret, &checkIn.Period, &checkIn.OpenedBy, &checkIn.OpenedAt)
+	return checkIn, err
+}
+
+// counter is the number of the period a time falls in.
+func (ci CheckIn) counter(now time.Time) int64 {
+	return now.Unix() / int64(ci.Period)
+}
+
+// code derives the code shown during a period from the secret, the way one time passwords are (RFC 4226).
+func (ci CheckIn) code(counter int64) string {
+	mac := hmac.New(sha256.New, []byte(ci.Secret))
+	binary.Write(mac, binary.BigEndian, counter)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", CheckInCodeLength, value%1000000)
+}
+
+// CurrentCode gets the code to show at a time.
+// It returns the code and how long until it changes.
+func (ci CheckIn) CurrentCode(now time.Time) (string, time.Duration) {
+	counter := ci.counter(now)
+	next := time.Unix((counter+1)*int64(ci.Period), 0)
+	return ci.code(counter), next.Sub(now)
+}
+
+// Verify checks a code submitted at a time. The code of the previous period is still accepted,
+// for students who typed it as it changed.
+// It returns CheckInAccepted, CheckInExpired for an older code or CheckInWrong.
+func (ci CheckIn) Verify(code string, now time.Time) string {
+	counter := ci.counter(now)
+	for back := int64(0); back <= checkInExpiredPeriods; back++ {
+		if !hmac.Equal([]byte(ci.code(counter-back)), []byte(code)) {
+			continue
+		}
+		if back <= 1 {
+			return CheckInAccepted
+		}
+		return CheckInExpired
+	}
+	return CheckInWrong
+}
+
+// CountRejected counts the wrong and expired codes a user submitted to a check-in.
+// It returns the count and any error encountered.
+func (a *CheckInAttempt) CountRejected(checkInID int, userID int) (int, error) {
+	var count int
+	err := DB().QueryRow(`
+	SELECT
+		COUNT(*)
+	FROM
+		check_in_attempt
+	WHERE
+		check_in_id = $1
+	AND
+		user_id = $2
+	AND
+		result IN ('wrong', 'expired')`,
+		checkInID, userID).Scan(&count)
+	return count, err
+}
+
+// GetRejected gets the codes refused for an attendance, newest first, with who submitted them.
+// It returns a slice of CheckInAttempt structs and any error encountered.
+func (a *CheckInAttempt) GetRejected(attendanceID int, timezone int) ([]CheckInAttempt, error) {
+	rows, err := DB().Query(`
+	SELECT
+		check_in_attempt.id,
+		check_in_attempt.attendance_id,
+		COALESCE(check_in_attempt.check_in_id, 0),
+		check_in_attempt.user_id,
+		user.first_name,
+		user.last_name,
+		user.email,
+		check_in_attempt.code,
+		check_in_attempt.result,
+		strftime('%Y-%m-%d %H:%M:%S', datetime(check_in_attempt.created_at, (? || ' minutes')))
+	FROM
+		check_in_attempt
+	JOIN
+		user ON user.id = check_in_attempt.user_id
+	WHERE
+		check_in_attempt.attendance_id = ?
+	AND
+		check_in_attempt.result != 'accepted'
+	ORDER BY
+		check_in_attempt.id DESC`,
+		timezone, attendanceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	attempts := []CheckInAttempt{}
+	for rows.Next() {
+		var attempt CheckInAttempt
+		err = rows.Scan(&attempt.ID, &attempt.AttendanceID, &attempt.CheckInID, &attempt.UserID, &attempt.FirstName, &attempt.LastName, &attempt.Email, &attempt.Code, &attempt.Result, &attempt.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		attempts = append(attempts, attempt)
+	}
+
+	return attempts, rows.Err()
+}
+
+// ** UPDATE **
+// Close ends the check-in open for an attendance, after which no code is accepted.
+// It returns whether one was open and any error encountered.
+func (ci *CheckIn) Close(attendanceID int) (bool, error) {
+	result, err := DB().Exec(`
+	UPDATE
+		check_in
+	SET
+		closed_at = datetime('now')
+	WHERE
+		attendance_id = $1
+	AND
+		closed_at IS NULL`,
+		attendanceID)
+	if err != nil {
+		return false, err
+	}
+
+	closed, err := result.RowsAffected()
+	return closed > 0, err
+}
@@ -1,266 +1,532 @@
-package models
-
-import "fmt"
-
-type ClassSession struct {
-	ID         int
-	SectionID  int
-	ScheduleID int
-	InProgress bool
-	Data       string
-	CreatedAt  string
-	UpdatedAt  string
-}
-
-type Participant struct {
-	ID        int
-	SessionID int
-	UserID    int
-	JoinedAt  string
-}
-
-// ** CREATE **
-// Join adds a participant to a class session.
-// It returns any error encountered and the participant table id.
-func (s *ClassSession) Join(sessionID int, studentID int) (int, error) {
-	db := NewDB()
-
-	// Check if the student is already in the class session
-	var count int
-	err := db.QueryRow(`
-	SELECT
-		COUNT(*)
-	FROM
-		participants
-	WHERE
-		session_id = $1
-	AND
-		user_id = $2`,
-		sessionID, studentID).Scan(&count)
-	if err != nil {
-		return 0, err
-	}
-
-	// If the student is already in the class session, return
-	if count > 0 {
-		return 0, nil
-	}
-
-	// Insert participant into the database and return the participant ID
-	sqlStatement := `
-        INSERT INTO
-            participants
-        VALUES 
-            (NULL,
-            $1,
-            $2,
-            datetime('now')
-            )
-        RETURNING id`
-
-	var participantID int
-	err = db.QueryRow(sqlStatement, sessionID, studentID).Scan(&participantID)
-	if err != nil {
-		return 0, err
-	}
-
-	return participantID, nil
-}
-
-// Start sets the in_progress value for a given class session id to true.
-// It returns the class session id and any error encountered.
-func (s *ClassSession) Start(sectionID int) (int, error) {
-	db := NewDB()
-
-	// Update the in_progress field
-	res, err := db.Exec(`
-	UPDATE
-		class_session 
-	SET
-		in_progress = 1
-	WHERE
-		section_id = $1`,
-		sectionID)
-	if err != nil {
-		return 0, err
-	}
-
-	// Check if a row was affected
-	rowsAffected, err := res.RowsAffected()
-	if err != nil || rowsAffected == 0 {
-		return 0, fmt.Errorf("No rows updated")
-	}
-
-	// Select the class session id for the updated row
-	var classSessionID int
-	err = db.QueryRow("SELECT id FROM class_session WHERE section_id = $1", sectionID).Scan(&classSessionID)
-	if err != nil {
-		return 0, err
-	}
-
-	return classSessionID, nil
-}
-
-// ** READ **
-// GetParticipantCount returns a list of participants for a given class session id.
-// It returns the count of participants and any error encountered.
-func (s ClassSession) GetParticipantCount(classSessionID int) (int, error) {
-	db := NewDB()
-
-	var count int
-	err := db.QueryRow(`
-	SELECT
-		COUNT(*)
-	FROM 
-		participants 
-	WHERE
-		session_id = $1`,
-		classSessionID).Scan(&count)
-	if err != nil {
-		return 0, err
-	}
-
-	return count, nil
-}
-
-// GetParticipants given class session id.
-// It returns a slice of Participants structs and any error encountered.
-func (s Participant) GetParticipants(classSessionID int) ([]Participant, error) {
-	db := NewDB()
-
-	var participants []Participant
-	rows, err := db.Query(`
-	SELECT
-		id,
-		session_id,
-		user_id,
-		joined_at
-	FROM
-		participants
-	WHERE
-
-		session_id = $1`,
-		classSessionID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		p := Participant{}
-		err := rows.Scan(&p.ID, &p.SessionID, &p.UserID, &p.JoinedAt)
-		if err != nil {
-			return nil, err
-		}
-		participants = append(participants, p)
-	}
-
-	return participants, nil
-}
-
-// GetID returns the class session id for a given section id.
-// It returns the class session id and any error encountered.
-func (s ClassSession) GetID(sectionID int) (int, error) {
-	db := NewDB()
-
-	var classSessionID int
-	err := db.QueryRow(`
-	SELECT
-		id
-	FROM 
-		class_session 
-	WHERE
-		section_id = $1`,
-		sectionID).Scan(&classSessionID)
-	if err != nil {
-		return 0, err
-	}
-
-	return classSessionID, nil
-}
-
-// GetInProgress returns the in_progress value for a given class session id.
-// It returns the in_progress value and any error encountered.
-func (s ClassSession) GetInProgress(classSessionID int) (bool, error) {
-	db := NewDB()
-
-	var inProgress bool
-	err := db.QueryRow(`
-	SELECT
-		in_progress
-	FROM 
-		class_session 
-	WHERE
-		id = $1`,
-		classSessionID).Scan(&inProgress)
-	if err != nil {
-		return false, err
-	}
-
-	return inProgress, nil
-}
-
-// GetSectionID returns the section id for a given class session id.
-// It returns the section id and any error encountered.
-func (s ClassSession) GetSectionID(classSessionID int) (int, error) {
-	db := NewDB()
-
-	var sectionID int
-	err := db.QueryRow(`
-	SELECT
-		section_id
-	FROM 
-		class_session 
-	WHERE
-		id = $1`,
-		classSessionID).Scan(&sectionID)
-	if err != nil {
-		return 0, err
-	}
-
-	return sectionID, nil
-}
-
-// ** UPDATE **
-// End sets the in_progress value for a given class session id to false, removes all participants, and removes all questions.
-// It returns any error encountered.
-func (s *ClassSession) End(classSessionID int) error {
-	db := NewDB()
-
-	// Set the class session to not in progress
-	_, err := db.Exec(`
-	UPDATE
-		class_session 
-	SET
-		in_progress = 0
-	WHERE
-		id = $1`,
-		classSessionID)
-	if err != nil {
-		return err
-	}
-
-	// Remove all participants from the class session
-	_, err = db.Exec(`
-	DELETE FROM
-		participants
-	WHERE
-		session_id = $1`,
-		classSessionID)
-	if err != nil {
-		return err
-	}
-
-	// Remove all questions from the class session
-	_, err = db.Exec(`
-	DELETE FROM
-		question
-	WHERE
-		session_id = $1`,
-		classSessionID)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// TimestampLayout is the layout of the UTC timestamps stored by datetime('now').
+const TimestampLayout = "2006-01-02 15:04:05"
+
+type ClassSession struct {
+	ID         int
+	SectionID  int
+	ScheduleID int
+	InProgress bool
+	Data       string
+	CreatedAt  string
+	UpdatedAt  string
+	StartedAt  string
+	EndedAt    string
+}
+
+// ClassSessionSummary is a class session in a section's history.
+type ClassSessionSummary struct {
+	ClassSession
+	QuestionCount    int
+	ParticipantCount int
+}
+
+type Participant struct {
+	ID        int
+	SessionID int
+	UserID    int
+	JoinedAt  string
+}
+
+// ParticipantDetail is a participant of a class session with their name and email.
+type ParticipantDetail struct {
+	Participant
+	Email     string
+	FirstName string
+	LastName  string
+}
+
+// ** CREATE **
+// Join adds a participant to a class session.
+// It returns any error encountered and the participant table id.
+func (s *ClassSession) Join(sessionID int, studentID int) (int, error) {
+	db := DB()
+
+	// Check if the student is already in the class session
+	var count int
+	err := db.QueryRow(`
+	SELECT
+		COUNT(*)
+	FROM
+		participants
+	WHERE
+		session_id = $1
+	AND
+		user_id = $2`,
+		sessionID, studentID).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	// If the student is already in the class session, return
+	if count > 0 {
+		return 0, nil
+	}
+
+	// Insert participant into the database and return the participant ID
+	sqlStatement := `
+        INSERT INTO
+            participants
+        VALUES 
+            (NULL,
+            $1,
+            $2,
+            datetime('now')
+            )
+        RETURNING id`
+
+	var participantID int
+	err = db.QueryRow(sqlStatement, sessionID, studentID).Scan(&participantID)
+	if err != nil {
+		return 0, err
+	}
+
+	return participantID, nil
+}
+
+// Start starts a new dated class session for a section, linked to the section's schedule.
+// A session that is already in progress is returned as is, and a session created for the
+// section but never started is started instead of adding another.
+// Each write checks that no session is in progress in the same statement, so concurrent
+// starts don't wait on each other's read locks, and the class_session_in_progress index
+// refuses a second running session to whichever start comes last.
+// It returns the class session id and any error encountered.
+func (s *ClassSession) Start(sectionID int) (int, error) {
+	db := DB()
+
+	// Start a session that was created for the section but never started
+	var classSessionID int
+	err := db.QueryRow(`
+	UPDATE
+		class_session
+	SET
+		in_progress = true,
+		started_at = datetime('now'),
+		updated_at = datetime('now')
+	WHERE
+		id = (
+			SELECT
+				MIN(id)
+			FROM
+				class_session
+			WHERE
+				section_id = $1
+				AND started_at IS NULL)
+		AND NOT EXISTS (
+			SELECT
+				1
+			FROM
+				class_session
+			WHERE
+				section_id = $1
+				AND in_progress = true)
+	RETURNING id`,
+		sectionID).Scan(&classSessionID)
+
+	// Otherwise add a new session for the section and its schedule
+	if err == sql.ErrNoRows {
+		err = db.QueryRow(`
+		INSERT INTO
+			class_session
+			(section_id,
+			schedule_id,
+			in_progress,
+			data,
+			created_at,
+			updated_at,
+			started_at)
+		SELECT
+			section.id,
+			COALESCE((SELECT MIN(schedule.id) FROM schedule WHERE schedule.section_id = section.id), 0),
+			true,
+			'Session data',
+			datetime('now'),
+			datetime('now'),
+			datetime('now')
+		FROM
+			section
+		WHERE
+			section.id = $1
+			AND NOT EXISTS (
+				SELECT
+					1
+				FROM
+					class_session
+				WHERE
+					section_id = $1
+					AND in_progress = true)
+		RETURNING id`,
+			sectionID).Scan(&classSessionID)
+	}
+	if err == nil {
+		return classSessionID, nil
+	}
+
+	// Return the running session, whether it was already running or another start added it first
+	errRunning := db.QueryRow(`
+	SELECT
+		id
+	FROM
+		class_session
+	WHERE
+		section_id = $1
+		AND in_progress = true
+	ORDER BY
+		id DESC
+	LIMIT 1`,
+		sectionID).Scan(&classSessionID)
+	if errRunning != nil {
+		return 0, err
+	}
+
+	return classSessionID, nil
+}
+
+// ** READ **
+// GetParticipantCount returns a list of participants for a given class session id.
+// It returns the count of participants and any error encountered.
+func (s ClassSession) GetParticipantCount(classSessionID int) (int, error) {
+	db := DB()
+
+	var count int
+	err := db.QueryRow(`
+	SELECT
+		COUNT(*)
+	FROM 
+		participants 
+	WHERE
+		session_id = $1`,
+		classSessionID).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetParticipants given class session id.
+// It returns a slice of Participants structs and any error encountered.
+func (s Participant) GetParticipants(classSessionID int) ([]Participant, error) {
+	db := DB()
+
+	var participants []Participant
+	rows, err := db.Query(`
+	SELECT
+		id,
+		session_id,
+		user_id,
+		joined_at
+	FROM
+		participants
+	WHERE
+
+		session_id = $1`,
+		classSessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		p := Participant{}
+		err := rows.Scan(&p.ID, &p.SessionID, &p.UserID, &p.JoinedAt)
+		if err != nil {
+			return nil, err
+		}
+		participants = append(participants, p)
+	}
+
+	return participants, nil
+}
+
+// GetParticipantDetails returns the participants of a class session with their names, in the order they joined.
+// It returns a slice of ParticipantDetail structs and any error encountered.
+func (s Participant) GetParticipantDetails(classSessionID int) ([]ParticipantDetail, error) {
+	db := DB()
+
+	rows, err := db.Query(`
+	SELECT
+		participants.id,
+		participants.session_id,
+		participants.user_id,
+		participants.joined_at,
+		user.email,
+		user.first_name,
+		user.last_name
+	FROM
+		participants
+		JOIN
+		user
+		ON participants.user_id = user.id
+	WHERE
+		participants.session_id = $1
+	ORDER BY
+		participants.joined_at ASC,
+		participants.id ASC`,
+		classSessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var participants []ParticipantDetail
+	for rows.Next() {
+		p := ParticipantDetail{}
+		err := rows.Scan(&p.ID, &p.SessionID, &p.UserID, &p.JoinedAt, &p.Email, &p.FirstName, &p.LastName)
+		if err != nil {
+			return nil, err
+		}
+		participants = append(participants, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return participants, nil
+}
+
+// GetID returns the class session id for a given section id.
+// It returns the session in progress, or the most recent session if none is running, and any error encountered.
+func (s ClassSession) GetID(sectionID int) (int, error) {
+	db := DB()
+
+	var classSessionID int
+	err := db.QueryRow(`
+	SELECT
+		id
+	FROM 
+		class_session 
+	WHERE
+		section_id = $1
+	ORDER BY
+		in_progress DESC,
+		id DESC
+	LIMIT 1`,
+		sectionID).Scan(&classSessionID)
+	if err != nil {
+		return 0, err
+	}
+
+	return classSessionID, nil
+}
+
+// Get returns a class session given its id, with times shifted by the timezone offset in minutes.
+// It returns the ClassSession struct and any error encountered.
+func (s ClassSession) Get(classSessionID int, timezoneOffset int) (ClassSession, error) {
+	db := DB()
+
+	var session ClassSession
+	err := db.QueryRow(`
+	SELECT
+		id,
+		section_id,
+		schedule_id,
+		in_progress,
+		data,
+		created_at,
+		updated_at,
+		COALESCE(datetime(started_at, (? || ' minutes')), ''),
+		COALESCE(datetime(ended_at, (? || ' minutes')), '')
+	FROM
+		class_session
+	WHERE
+		id = ?`,
+		timezoneOffset, timezoneOffset, classSessionID).Scan(&session.ID, &session.SectionID, &session.ScheduleID, &session.InProgress,
+		&session.Data, &session.CreatedAt, &session.UpdatedAt, &session.StartedAt, &session.EndedAt)
+	if err != nil {
+		return ClassSession{}, err
+	}
+
+	return session, nil
+}
+
+// GetBySectionID returns the class sessions that have been started for a section, newest first,
+// with times shifted by the timezone offset in minutes.
+// It returns a slice of ClassSessionSummary structs and any error encountered.
+func (s ClassSession) GetBySectionID(sectionID int, timezoneOffset int) ([]ClassSessionSummary, error) {
+	db := DB()
+
+	rows, err := db.Query(`
+	SELECT
+		class_session.id,
+		class_session.section_id,
+		class_session.schedule_id,
+		class_session.in_progress,
+		class_session.data,
+		class_session.created_at,
+		class_session.updated_at,
+		COALESCE(datetime(class_session.started_at, (? || ' minutes')), ''),
+		COALESCE(datetime(class_session.ended_at, (? || ' minutes')), ''),
+		(SELECT COUNT(*) FROM question WHERE question.session_id = class_session.id AND question.status = 'approved'),
+		(SELECT COUNT(*) FROM participants WHERE participants.session_id = class_session.id)
+	FROM
+		class_session
+	WHERE
+		class_session.section_id = ?
+		AND class_session.started_at IS NOT NULL
+	ORDER BY
+		class_session.started_at DESC,
+		class_session.id DESC`,
+		timezoneOffset, timezoneOffset, sectionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var history []ClassSessionSummary
+	for rows.Next() {
+		h := ClassSessionSummary{}
+		err := rows.Scan(&h.ID, &h.SectionID, &h.ScheduleID, &h.InProgress, &h.Data, &h.CreatedAt, &h.UpdatedAt,
+			&h.StartedAt, &h.EndedAt, &h.QuestionCount, &h.ParticipantCount)
+		if err != nil {
+			return nil, err
+		}
+		history = append(history, h)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+// GetAllInProgress returns every class session that is in progress, with times in UTC.
+// It returns a slice of ClassSession structs and any error encountered.
+func (s ClassSession) GetAllInProgress() ([]ClassSession, error) {
+	db := DB()
+
+	rows, err := db.Query(`
+	SELECT
+		id,
+		section_id,
+		schedule_id,
+		in_progress,
+		data,
+		created_at,
+		updated_at,
+		COALESCE(started_at, created_at),
+		COALESCE(ended_at, '')
+	FROM
+		class_session
+	WHERE
+		in_progress = true
+	ORDER BY
+		id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var classSessions []ClassSession
+	for rows.Next() {
+		cs := ClassSession{}
+		err := rows.Scan(&cs.ID, &cs.SectionID, &cs.ScheduleID, &cs.InProgress, &cs.Data, &cs.CreatedAt, &cs.UpdatedAt, &cs.StartedAt, &cs.EndedAt)
+		if err != nil {
+			return nil, err
+		}
+		classSessions = append(classSessions, cs)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return classSessions, nil
+}
+
+// StartedSince reports whether a class session for the section was started at or after the given time.
+// It returns true if one was and any error encountered.
+func (s ClassSession) StartedSince(sectionID int, since time.Time) (bool, error) {
+	db := DB()
+
+	var count int
+	err := db.QueryRow(`
+	SELECT
+		COUNT(*)
+	FROM
+		class_session
+	WHERE
+		section_id = $1
+		AND started_at >= $2`,
+		sectionID, since.UTC().Format(TimestampLayout)).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// GetInProgress returns the in_progress value for a given class session id.
+// It returns the in_progress value and any error encountered.
+func (s ClassSession) GetInProgress(classSessionID int) (bool, error) {
+	db := DB()
+
+	var inProgress bool
+	err := db.QueryRow(`
+	SELECT
+		in_progress
+	FROM 
+		class_session 
+	WHERE
+		id = $1`,
+		classSessionID).Scan(&inProgress)
+	if err != nil {
+		return false, err
+	}
+
+	return inProgress, nil
+}
+
+// GetSectionID returns the section id for a given class session id.
+// It returns the section id and any error encountered.
+func (s ClassSession) GetSectionID(classSessionID int) (int, error) {
+	db := DB()
+
+	var sectionID int
+	err := db.QueryRow(`
+	SELECT
+		section_id
+	FROM 
+		class_session 
+	WHERE
+		id = $1`,
+		classSessionID).Scan(&sectionID)
+	if err != nil {
+		return 0, err
+	}
+
+	return sectionID, nil
+}
+
+// ** UPDATE **
+// End sets the in_progress value for a given class session id to false and records when it ended.
+// Participants and questions are kept so the session can be browsed afterward.
+// It returns whether the session was in progress and any error encountered.
+func (s *ClassSession) End(classSessionID int) (bool, error) {
+	db := DB()
+
+	// Set the class session to not in progress, unless it already ended
+	result, err := db.Exec(`
+	UPDATE
+		class_session 
+	SET
+		in_progress = false,
+		ended_at = datetime('now'),
+		updated_at = datetime('now')
+	WHERE
+		id = $1
+	AND
+		in_progress = true`,
+		classSessionID)
+	if err != nil {
+		return false, err
+	}
+
+	ended, err := result.RowsAffected()
+	return ended > 0, err
+}
@@ -1,85 +1,244 @@
-package models
-
-import (
-	globals "coeus/globals"
-	"context"
-	"database/sql"
-	"errors"
-	"fmt"
-	"log"
-	"os"
-
-	_ "github.com/mattn/go-sqlite3"
-)
-
-// NewDB opens the configured database and returns a connection to it.
-// If the database does not exist, it creates a new one with sample data
-func NewDB() *sql.DB {
-	var db *sql.DB
-
-	if db != nil {
-		db.Close()
-	}
-
-	// Check to see the file exists
-	var filename string = globals.DBNAME + ".db"
-
-	_, err := os.Stat(filename)
-	if errors.Is(err, os.ErrNotExist) {
-		fmt.Println("Creating database…")
-		file, _ := os.Create(filename)
-		fmt.Printf("Database %s created\n", filename)
-		file.Close()
-
-		db, err = sql.Open("sqlite3", filename)
-		if err != nil {
-			log.Fatal(err.Error())
-		}
-
-		if filename == "coeus-sample.db" {
-			transaction(db, ddl_sample)
-		} else {
-			transaction(db, ddl_blank)
-		}
-	} else {
-		// File/Database exists. Open it and return a connection
-		db, _ = sql.Open("sqlite3", filename)
-		if err != nil {
-			log.Fatal(err.Error())
-		}
-	}
-	return db
-}
-
-// ReseedSampleDB drops tables in the existing database and reseeds a new one with sample data
-func ReseedSampleDB() {
-	db := NewDB()
-
-	// Pass the database connection to the transaction function
-	transaction(db, ddl_sample)
-}
-
-// transaction executes an array of SQL statememts in a single transaction.
-func transaction(db *sql.DB, statements []string) {
-	var ctx = context.Background()
-	var tx, err = db.BeginTx(ctx, nil)
-	if err != nil {
-		fmt.Println("Couldn't begin transaction")
-		panic(err)
-	}
-	for _, statement := range statements {
-		_, err = tx.ExecContext(ctx, statement)
-		if err != nil {
-			tx.Rollback()
-			if err != nil {
-				fmt.Println(statement)
-				panic(err)
-			}
-		}
-	}
-	err = tx.Commit()
-	if err != nil {
-		fmt.Println("Failed to commit transaction")
-		panic(err)
-	}
-}
+package models
+
+import (
+	globals "coeus/globals"
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// DBConfig holds the settings used to open the shared database pool.
+type DBConfig struct {
+	// Filename is the SQLite database file.
+	Filename string
+	// Sample seeds a new database with sample data instead of a blank schema.
+	Sample bool
+	// ReadOnly opens an existing database without writing to it: it is never created or seeded.
+	ReadOnly        bool
+	JournalMode     string
+	BusyTimeout     time.Duration
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+}
+
+// Database is the SQLite pool shared by the models.
+type Database struct {
+	*sql.DB
+}
+
+// Tx is a transaction on a Database.
+type Tx struct {
+	*sql.Tx
+}
+
+// The shared database handle used by every model.
+var (
+	pool   *Database
+	poolMu sync.Mutex
+)
+
+// DefaultDBConfig returns the pool settings for the configured database.
+// DB_JOURNAL_MODE, DB_BUSY_TIMEOUT (milliseconds) and DB_MAX_OPEN_CONNS
+// environment variables override the defaults when set.
+func DefaultDBConfig() DBConfig {
+	cfg := DBConfig{
+		Filename:     globals.DBNAME + ".db",
+		Sample:       globals.DBNAME == "coeus-sample",
+		JournalMode:  "WAL",
+		BusyTimeout:  5 * time.Second,
+		MaxOpenConns: 10,
+		MaxIdleConns: 10,
+	}
+
+	if mode := os.Getenv("DB_JOURNAL_MODE"); mode != "" {
+		cfg.JournalMode = mode
+	}
+	if ms, err := strconv.Atoi(os.Getenv("DB_BUSY_TIMEOUT")); err == nil && ms >= 0 {
+		cfg.BusyTimeout = time.Duration(ms) * time.Millisecond
+	}
+	if n, err := strconv.Atoi(os.Getenv("DB_MAX_OPEN_CONNS")); err == nil && n > 0 {
+		cfg.MaxOpenConns = n
+		cfg.MaxIdleConns = n
+	}
+
+	return cfg
+}
+
+// OpenDB opens a database pool for the given config.
+// If the database is empty, it creates the blank or sample schema unless cfg.ReadOnly is set.
+func OpenDB(cfg DBConfig) (*Database, error) {
+	// A read only connection neither creates the file nor changes its journal mode
+	dsn := fmt.Sprintf("file:%s?_journal_mode=%s&_busy_timeout=%d",
+		cfg.Filename, cfg.JournalMode, cfg.BusyTimeout.Milliseconds())
+	if cfg.ReadOnly {
+		dsn = fmt.Sprintf("file:%s?mode=ro&_busy_timeout=%d", cfg.Filename, cfg.BusyTimeout.Milliseconds())
+	}
+
+	conn, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		return nil, err
+	}
+	conn.SetMaxOpenConns(cfg.MaxOpenConns)
+	conn.SetMaxIdleConns(cfg.MaxIdleConns)
+	conn.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+
+	if err = conn.Ping(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	db := &Database{DB: conn}
+	tables, err := db.tables()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	if len(tables) == 0 && !cfg.ReadOnly {
+		fmt.Println("Creating database…")
+		if cfg.Sample {
+			transaction(db, ddl_sample)
+		} else {
+			transaction(db, ddl_blank)
+		}
+		fmt.Println("Database created")
+	}
+
+	return db, nil
+}
+
+// SetDB installs db as the shared handle used by the models, closing any previous one.
+func SetDB(db *Database) {
+	poolMu.Lock()
+	defer poolMu.Unlock()
+
+	if pool != nil && pool != db {
+		pool.Close()
+	}
+	pool = db
+}
+
+// DB returns the shared database handle.
+// If none has been set, it opens one with DefaultDBConfig and applies pending migrations.
+func DB() *Database {
+	poolMu.Lock()
+	defer poolMu.Unlock()
+
+	if pool == nil {
+		db, err := OpenDB(DefaultDBConfig())
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+		_, err = Migrate(db, false)
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+		pool = db
+	}
+	return pool
+}
+
+// CloseDB closes the shared database handle.
+// It returns any error encountered.
+func CloseDB() error {
+	poolMu.Lock()
+	defer poolMu.Unlock()
+
+	if pool == nil {
+		return nil
+	}
+	err := pool.Close()
+	pool = nil
+	return err
+}
+
+// Begin starts a transaction.
+func (db *Database) Begin() (*Tx, error) {
+	return db.BeginTx(context.Background(), nil)
+}
+
+// BeginTx starts a transaction.
+func (db *Database) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	tx, err := db.DB.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx}, nil
+}
+
+// tables lists the tables of the database.
+func (db *Database) tables() ([]string, error) {
+	rows, err := db.Query(`SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%'`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tables []string
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+
+	return tables, rows.Err()
+}
+
+// ReseedSampleDB drops tables in the existing database and reseeds a new one with sample data
+func ReseedSampleDB() {
+	db := DB()
+
+	// Drop every table, including those added by migrations
+	tables, err := db.tables()
+	if err != nil {
+		panic(err)
+	}
+	var drops []string
+	for _, name := range tables {
+		drops = append(drops, fmt.Sprintf("DROP TABLE IF EXISTS %q", name))
+	}
+
+	// Pass the database connection to the transaction function
+	transaction(db, append(drops, ddl_sample...))
+
+	// Bring the fresh baseline up to the current schema
+	_, err = Migrate(db, false)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// transaction executes an array of SQL statememts in a single transaction.
+func transaction(db *Database, statements []string) {
+	var ctx = context.Background()
+	var tx, err = db.BeginTx(ctx, nil)
+	if err != nil {
+		fmt.Println("Couldn't begin transaction")
+		panic(err)
+	}
+	for _, statement := range statements {
+		_, err = tx.ExecContext(ctx, statement)
+		if err != nil {
+			tx.Rollback()
+			if err != nil {
+				fmt.Println(statement)
+				panic(err)
+			}
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		fmt.Println("Failed to commit transaction")
+		panic(err)
+	}
+}
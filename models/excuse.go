@@ -0,0 +1,259 @@
+package models
+
+import (
+	"database/sql"
+	"errors"
+)
+
+// ExcuseRequest is a student asking to be excused from a class session their attendance was taken for.
+// The staff of the section approve it, which marks the student excused, or deny it.
+type ExcuseRequest struct {
+	ID           int
+	AttendanceID int
+	UserID       int
+	FirstName    string
+	LastName     string
+	Email        string
+	// Date is the day the attendance was taken
+	Date   string
+	Reason string
+	// Status is pending until the request is approved or denied
+	Status string
+	// Response is what the staff answered, if anything
+	Response    string
+	DecidedBy   int
+	CreatedAt   string
+	DecidedAt   string
+	SectionID   int
+	SectionName string
+}
+
+// Excuse request statuses
+const (
+	ExcusePending  = "pending"
+	ExcuseApproved = "approved"
+	ExcuseDenied   = "denied"
+)
+
+var (
+	// ErrExcusePending is returned when a student asks to be excused while a request of theirs is pending.
+	ErrExcusePending = errors.New("an excuse request is already pending")
+	// ErrExcuseDecided is returned when deciding an excuse request that was already approved or denied.
+	ErrExcuseDecided = errors.New("excuse request was already decided")
+)
+
+// ** CREATE **
+// Add asks for a user to be excused from an attendance.
+// It returns the ID of the new request, ErrExcusePending when one is already pending and any error encountered.
+func (e *ExcuseRequest) Add(attendanceID int, userID int, reason string) (int, error) {
+	tx, err := DB().Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var pending int
+	err = tx.QueryRow(`
+	SELECT
+		COUNT(*)
+	FROM
+		excuse_request
+	WHERE
+		attendance_id = $1
+	AND
+		user_id = $2
+	AND
+		status = 'pending'`,
+		attendanceID, userID).Scan(&pending)
+	if err != nil {
+		return 0, err
+	}
+	if pending > 0 {
+		return 0, ErrExcusePending
+	}
+
+	var excuseID int
+	err = tx.QueryRow(`
+	INSERT INTO
+		excuse_request
+		(attendance_id,
+		user_id,
+		reason,
+		status,
+		created_at)
+	VALUES
+		($1,
+		$2,
+		$3,
+		'pending',
+		datetime('now'))
+	RETURNING id`,
+		attendanceID, userID, reason).Scan(&excuseID)
+	if err != nil {
+		return 0, err
+	}
+
+	return excuseID, tx.Commit()
+}
+
+// ** READ **
+// excuseColumns are the columns scanned by queryExcuses, with created_at and decided_at
+// shifted by a timezone offset given twice.
+const excuseColumns = `
+		excuse_request.id,
+		excuse_request.attendance_id,
+		excuse_request.user_id,
+		user.first_name,
+		user.last_name,
+		user.email,
+		strftime('%Y-%m-%d', attendance.attended_at),
+		excuse_request.reason,
+		excuse_request.status,
+		excuse_request.response,
+		COALESCE(excuse_request.decided_by, 0),
+		strftime('%Y-%m-%d %H:%M', datetime(excuse_request.created_at, (? || ' minutes'))),
+		COALESCE(strftime('%Y-%m-%d %H:%M', datetime(excuse_request.decided_at, (? || ' minutes'))), ''),
+		attendance.section_id,
+		section.name
+	FROM
+		excuse_request
+	JOIN
+		user ON user.id = excuse_request.user_id
+	JOIN
+		attendance ON attendance.id = excuse_request.attendance_id
+	JOIN
+		section ON section.id = attendance.section_id`
+
+// queryExcuses returns the excuse requests selected by a query of excuseColumns.
+func queryExcuses(sqlStatement string, args ...interface{}) ([]ExcuseRequest, error) {
+	rows, err := DB().Query(sqlStatement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	excuses := []ExcuseRequest{}
+	for rows.Next() {
+		var e ExcuseRequest
+		err = rows.Scan(&e.ID, &e.AttendanceID, &e.UserID, &e.FirstName, &e.LastName, &e.Email, &e.Date, &e.Reason,
+			&e.Status, &e.Response, &e.DecidedBy, &e.CreatedAt, &e.DecidedAt, &e.SectionID, &e.SectionName)
+		if err != nil {
+			return nil, err
+		}
+		excuses = append(excuses, e)
+	}
+
+	return excuses, rows.Err()
+}
+
+// GetByID gets an excuse request given its id, with times shifted by the timezone offset in minutes.
+// It returns the ExcuseRequest struct, sql.ErrNoRows when there is no such request and any error encountered.
+func (e *ExcuseRequest) GetByID(excuseID int, timezone int) (ExcuseRequest, error) {
+	excuses, err := queryExcuses(`SELECT`+excuseColumns+`
+	WHERE
+		excuse_request.id = ?`,
+		timezone, timezone, excuseID)
+	if err != nil {
+		return ExcuseRequest{}, err
+	}
+	if len(excuses) == 0 {
+		return ExcuseRequest{}, sql.ErrNoRows
+	}
+	return excuses[0], nil
+}
+
+// GetByAttendanceID gets the excuse requests for an attendance, pending first then newest first.
+// It returns a slice of ExcuseRequest structs and any error encountered.
+func (e *ExcuseRequest) GetByAttendanceID(attendanceID int, timezone int) ([]ExcuseRequest, error) {
+	return queryExcuses(`SELECT`+excuseColumns+`
+	WHERE
+		excuse_request.attendance_id = ?
+	ORDER BY
+		excuse_request.status = 'pending' DESC,
+		excuse_request.id DESC`,
+		timezone, timezone, attendanceID)
+}
+
+// GetByUser gets the excuse requests of a user for an attendance, newest first.
+// It returns a slice of ExcuseRequest structs and any error encountered.
+func (e *ExcuseRequest) GetByUser(attendanceID int, userID int, timezone int) ([]ExcuseRequest, error) {
+	return queryExcuses(`SELECT`+excuseColumns+`
+	WHERE
+		excuse_request.attendance_id = ?
+	AND
+		excuse_request.user_id = ?
+	ORDER BY
+		excuse_request.id DESC`,
+		timezone, timezone, attendanceID, userID)
+}
+
+// ** UPDATE **
+// Decide approves or denies a pending excuse request with a response. Approving it marks the student
+// excused, recording the change in the audit trail with the reason they gave.
+// It returns ErrExcuseDecided when the request isn't pending, sql.ErrNoRows when there is no such request
+// and any error encountered.
+func (e *ExcuseRequest) Decide(excuseID int, decidedBy int, approve bool, response string) error {
+	tx, err := DB().Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var attendanceID, userID int
+	var status, reason string
+	err = tx.QueryRow(`
+	SELECT
+		attendance_id,
+		user_id,
+		status,
+		reason
+	FROM
+		excuse_request
+	WHERE
+		id = $1`,
+		excuseID).Scan(&attendanceID, &userID, &status, &reason)
+	if err != nil {
+		return err
+	}
+	if status != ExcusePending {
+		return ErrExcuseDecided
+	}
+
+	status = ExcuseDenied
+	if approve {
+		status = ExcuseApproved
+	}
+	result, err := tx.Exec(`
+	UPDATE
+		excuse_request
+	SET
+		status = $1,
+		response = $2,
+		decided_by = $3,
+		decided_at = datetime('now')
+	WHERE
+		id = $4
+	AND
+		status = 'pending'`,
+		status, response, decidedBy, excuseID)
+	if err != nil {
+		return err
+	}
+	// Another moderator may have decided it in the meantime
+	decided, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if decided == 0 {
+		return ErrExcuseDecided
+	}
+
+	if approve {
+		_, err = setUserAttendance(tx, attendanceID, userID, AttendanceExcused, decidedBy, StatusSourceExcuse, reason)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,427 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// Migration is a single ordered change to the database schema.
+// Version 1 is the baseline schema created by ddl_blank and ddl_sample.
+type Migration struct {
+	Version     int
+	Description string
+	Statements  []string
+	// Apply, when set, runs after the statements in the same transaction
+	// for data changes that cannot be written in SQL.
+	Apply func(tx *Tx) error
+}
+
+// ErrSchemaTooNew is returned when the database was migrated by a newer binary.
+var ErrSchemaTooNew = errors.New("database schema is newer than this binary supports")
+
+// migrations must be kept in ascending version order. Never edit a released
+// migration; append a new one instead.
+var migrations = []Migration{
+	{
+		Version:     1,
+		Description: "baseline schema",
+	},
+	{
+		Version:     2,
+		Description: "class session history",
+		Statements: []string{
+			`ALTER TABLE class_session ADD COLUMN started_at TEXT`,
+			`ALTER TABLE class_session ADD COLUMN ended_at TEXT`,
+			// Sessions that are running or already have questions, participants
+			// or attendance were started before history was kept
+			`UPDATE class_session SET started_at = created_at
+			WHERE in_progress = true
+				OR id IN (SELECT session_id FROM question)
+				OR id IN (SELECT session_id FROM participants)
+				OR id IN (SELECT class_session_id FROM attendance)`,
+			`UPDATE class_session SET ended_at = updated_at
+			WHERE started_at IS NOT NULL AND in_progress = false`,
+		},
+	},
+	{
+		Version:     3,
+		Description: "structured section schedules and holidays",
+		Statements: []string{
+			`ALTER TABLE schedule ADD COLUMN weekdays TEXT`,
+			`ALTER TABLE schedule ADD COLUMN start_time TEXT`,
+			`ALTER TABLE schedule ADD COLUMN end_time TEXT`,
+			`ALTER TABLE schedule ADD COLUMN room TEXT`,
+			`CREATE TABLE holiday(
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				section_id INTEGER NOT NULL REFERENCES section(id),
+				date TEXT NOT NULL,
+				description TEXT NOT NULL
+			)`,
+		},
+		Apply: backfillSchedules,
+	},
+	{
+		Version:     4,
+		Description: "calendar feed tokens",
+		Statements: []string{
+			`CREATE TABLE calendar_token(
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				user_id INTEGER NOT NULL UNIQUE,
+				token TEXT NOT NULL UNIQUE,
+				created_at TEXT NOT NULL
+			)`,
+		},
+	},
+	{
+		Version:     5,
+		Description: "answers to questions",
+		Statements: []string{
+			`CREATE TABLE answer(
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				question_id INTEGER NOT NULL REFERENCES question(id),
+				user_id INTEGER NOT NULL REFERENCES user(id),
+				text TEXT NOT NULL,
+				created_at TEXT NOT NULL,
+				updated_at TEXT NOT NULL
+			)`,
+			`CREATE INDEX answer_question_id ON answer(question_id)`,
+		},
+	},
+	{
+		Version:     6,
+		Description: "question pre-moderation",
+		Statements: []string{
+			`ALTER TABLE section ADD COLUMN premoderation BOOLEAN NOT NULL DEFAULT false`,
+			`ALTER TABLE question ADD COLUMN status TEXT NOT NULL DEFAULT 'approved'`,
+			`ALTER TABLE question ADD COLUMN rejection_reason TEXT`,
+			`ALTER TABLE question ADD COLUMN moderated_by INTEGER`,
+			`ALTER TABLE question ADD COLUMN moderated_at TEXT`,
+		},
+	},
+	{
+		Version:     7,
+		Description: "merged duplicate questions",
+		Statements: []string{
+			`ALTER TABLE question ADD COLUMN merged_into INTEGER`,
+		},
+	},
+	{
+		Version:     8,
+		Description: "live polls",
+		Statements: []string{
+			`CREATE TABLE poll(
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				session_id INTEGER NOT NULL REFERENCES class_session(id),
+				user_id INTEGER NOT NULL REFERENCES user(id),
+				question TEXT NOT NULL,
+				kind TEXT NOT NULL,
+				status TEXT NOT NULL DEFAULT 'draft',
+				created_at TEXT NOT NULL,
+				opened_at TEXT,
+				closed_at TEXT
+			)`,
+			`CREATE INDEX poll_session_id ON poll(session_id)`,
+			`CREATE TABLE poll_option(
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				poll_id INTEGER NOT NULL REFERENCES poll(id),
+				position INTEGER NOT NULL,
+				text TEXT NOT NULL
+			)`,
+			`CREATE INDEX poll_option_poll_id ON poll_option(poll_id)`,
+			// Like votes, each user answers a poll once
+			`CREATE TABLE poll_response(
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				poll_id INTEGER NOT NULL REFERENCES poll(id),
+				option_id INTEGER NOT NULL REFERENCES poll_option(id),
+				user_id INTEGER NOT NULL REFERENCES user(id),
+				created_at TEXT NOT NULL,
+				UNIQUE(poll_id, user_id)
+			)`,
+		},
+	},
+	{
+		Version:     9,
+		Description: "graded quizzes",
+		Statements: []string{
+			`CREATE TABLE quiz(
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				session_id INTEGER NOT NULL REFERENCES class_session(id),
+				user_id INTEGER NOT NULL REFERENCES user(id),
+				title TEXT NOT NULL,
+				time_limit INTEGER NOT NULL DEFAULT 0,
+				status TEXT NOT NULL DEFAULT 'draft',
+				created_at TEXT NOT NULL,
+				opened_at TEXT,
+				closes_at TEXT,
+				closed_at TEXT
+			)`,
+			`CREATE INDEX quiz_session_id ON quiz(session_id)`,
+			`CREATE TABLE quiz_question(
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				quiz_id INTEGER NOT NULL REFERENCES quiz(id),
+				position INTEGER NOT NULL,
+				text TEXT NOT NULL,
+				points INTEGER NOT NULL
+			)`,
+			`CREATE INDEX quiz_question_quiz_id ON quiz_question(quiz_id)`,
+			`CREATE TABLE quiz_option(
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				question_id INTEGER NOT NULL REFERENCES quiz_question(id),
+				position INTEGER NOT NULL,
+				text TEXT NOT NULL,
+				correct BOOLEAN NOT NULL DEFAULT false
+			)`,
+			`CREATE INDEX quiz_option_question_id ON quiz_option(question_id)`,
+			// A submission is evidence the student took part in the class session, so it points
+			// at their attendance for it
+			`CREATE TABLE quiz_submission(
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				quiz_id INTEGER NOT NULL REFERENCES quiz(id),
+				user_id INTEGER NOT NULL REFERENCES user(id),
+				user_attendance_id INTEGER REFERENCES user_attendance(id),
+				score INTEGER NOT NULL,
+				max_score INTEGER NOT NULL,
+				submitted_at TEXT NOT NULL,
+				UNIQUE(quiz_id, user_id)
+			)`,
+			`CREATE TABLE quiz_answer(
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				submission_id INTEGER NOT NULL REFERENCES quiz_submission(id),
+				question_id INTEGER NOT NULL REFERENCES quiz_question(id),
+				option_id INTEGER NOT NULL REFERENCES quiz_option(id),
+				points INTEGER NOT NULL
+			)`,
+			`CREATE INDEX quiz_answer_submission_id ON quiz_answer(submission_id)`,
+		},
+	},
+	{
+		Version:     10,
+		Description: "attendance check-in codes",
+		Statements: []string{
+			// While a check-in is open, students mark themselves present with the code it shows,
+			// which is derived from its secret and changes every period seconds
+			`CREATE TABLE check_in(
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				attendance_id INTEGER NOT NULL REFERENCES attendance(id),
+				secret TEXT NOT NULL,
+				period INTEGER NOT NULL,
+				opened_by INTEGER NOT NULL REFERENCES user(id),
+				opened_at TEXT NOT NULL,
+				closed_at TEXT
+			)`,
+			`CREATE INDEX check_in_attendance_id ON check_in(attendance_id)`,
+			`CREATE TABLE check_in_attempt(
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				attendance_id INTEGER NOT NULL REFERENCES attendance(id),
+				check_in_id INTEGER REFERENCES check_in(id),
+				user_id INTEGER NOT NULL REFERENCES user(id),
+				code TEXT NOT NULL,
+				result TEXT NOT NULL,
+				created_at TEXT NOT NULL
+			)`,
+			`CREATE INDEX check_in_attempt_attendance_id ON check_in_attempt(attendance_id)`,
+		},
+	},
+	{
+		Version:     11,
+		Description: "attendance status history and excuse requests",
+		Statements: []string{
+			// Every change of a user_attendance status, with who made it (null for the system) and why
+			`CREATE TABLE attendance_status_change(
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				attendance_id INTEGER NOT NULL REFERENCES attendance(id),
+				user_id INTEGER NOT NULL REFERENCES user(id),
+				old_status TEXT,
+				new_status TEXT NOT NULL,
+				changed_by INTEGER REFERENCES user(id),
+				source TEXT NOT NULL,
+				reason TEXT NOT NULL DEFAULT '',
+				created_at TEXT NOT NULL
+			)`,
+			`CREATE INDEX attendance_status_change_attendance_id ON attendance_status_change(attendance_id)`,
+			`CREATE TABLE excuse_request(
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				attendance_id INTEGER NOT NULL REFERENCES attendance(id),
+				user_id INTEGER NOT NULL REFERENCES user(id),
+				reason TEXT NOT NULL,
+				status TEXT NOT NULL DEFAULT 'pending' CHECK(status IN ('pending', 'approved', 'denied')),
+				response TEXT NOT NULL DEFAULT '',
+				decided_by INTEGER REFERENCES user(id),
+				created_at TEXT NOT NULL,
+				decided_at TEXT
+			)`,
+			`CREATE INDEX excuse_request_attendance_id ON excuse_request(attendance_id)`,
+		},
+	},
+	{
+		Version:     12,
+		Description: "attendance alerts for at-risk students",
+		Statements: []string{
+			// The thresholds of a section, 0 when off: a number of absences and an attendance rate in percent
+			`ALTER TABLE section ADD COLUMN alert_absences INTEGER NOT NULL DEFAULT 0`,
+			`ALTER TABLE section ADD COLUMN alert_rate INTEGER NOT NULL DEFAULT 0`,
+			`ALTER TABLE section ADD COLUMN alert_email BOOLEAN NOT NULL DEFAULT false`,
+			// A student crossing a threshold, once per threshold: observed is their number of absences
+			// or attendance rate when they crossed it
+			`CREATE TABLE attendance_alert(
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				section_id INTEGER NOT NULL REFERENCES section(id),
+				user_id INTEGER NOT NULL REFERENCES user(id),
+				kind TEXT NOT NULL CHECK(kind IN ('absences', 'rate')),
+				threshold INTEGER NOT NULL,
+				observed INTEGER NOT NULL,
+				attendance_id INTEGER REFERENCES attendance(id),
+				created_at TEXT NOT NULL,
+				dismissed_by INTEGER REFERENCES user(id),
+				dismissed_at TEXT,
+				UNIQUE(section_id, user_id, kind, threshold)
+			)`,
+			`CREATE INDEX attendance_alert_section_id ON attendance_alert(section_id)`,
+		},
+	},
+	{
+		Version:     13,
+		Description: "one class session in progress per section",
+		Statements: []string{
+			// Sections left with more than one running session keep the newest
+			`UPDATE class_session SET in_progress = false, ended_at = COALESCE(ended_at, datetime('now')), updated_at = datetime('now')
+			WHERE in_progress = true
+				AND id NOT IN (SELECT MAX(id) FROM class_session WHERE in_progress = true GROUP BY section_id)`,
+			`CREATE UNIQUE INDEX class_session_in_progress ON class_session(section_id) WHERE in_progress = true`,
+		},
+	},
+}
+
+// LatestSchemaVersion returns the schema version this binary expects.
+func LatestSchemaVersion() int {
+	return migrations[len(migrations)-1].Version
+}
+
+// SchemaVersion returns the highest version recorded in the schema_version table.
+// It returns 0 for a database that has never been migrated and any error encountered.
+func SchemaVersion(db *Database) (int, error) {
+	var version sql.NullInt64
+
+	sqlStatement := `
+	SELECT
+		MAX(version)
+	FROM
+		schema_version`
+
+	err := db.QueryRow(sqlStatement).Scan(&version)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(version.Int64), nil
+}
+
+// PendingMigrations returns the migrations that have not been applied to the database.
+// It returns ErrSchemaTooNew if the database is ahead of this binary.
+func PendingMigrations(db *Database) ([]Migration, error) {
+	current, err := SchemaVersion(db)
+	if err != nil {
+		return nil, err
+	}
+
+	if current > LatestSchemaVersion() {
+		return nil, fmt.Errorf("%w: database is at version %d, binary supports %d", ErrSchemaTooNew, current, LatestSchemaVersion())
+	}
+
+	var pending []Migration
+	for _, m := range migrations {
+		if m.Version > current {
+			pending = append(pending, m)
+		}
+	}
+
+	return pending, nil
+}
+
+// DryRunMigrations returns the migrations that would be applied to the database of cfg,
+// opening it read only so that it is neither created, seeded nor written to.
+// Every migration is pending for a database that doesn't exist yet or is still empty.
+// It returns ErrSchemaTooNew if the database is ahead of this binary.
+func DryRunMigrations(cfg DBConfig) ([]Migration, error) {
+	if _, err := os.Stat(cfg.Filename); os.IsNotExist(err) {
+		return append([]Migration(nil), migrations...), nil
+	}
+
+	cfg.ReadOnly = true
+	db, err := OpenDB(cfg)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	tables, err := db.tables()
+	if err != nil {
+		return nil, err
+	}
+	if len(tables) == 0 {
+		return append([]Migration(nil), migrations...), nil
+	}
+
+	return PendingMigrations(db)
+}
+
+// Migrate applies all pending migrations in order, each in its own transaction.
+// When dryRun is true nothing is written and the pending migrations are only returned.
+// It returns the migrations that were (or would be) applied and any error encountered.
+func Migrate(db *Database, dryRun bool) ([]Migration, error) {
+	pending, err := PendingMigrations(db)
+	if err != nil || dryRun {
+		return pending, err
+	}
+
+	for i, m := range pending {
+		err = applyMigration(db, m)
+		if err != nil {
+			return pending[:i], fmt.Errorf("migration %d (%s): %w", m.Version, m.Description, err)
+		}
+	}
+
+	return pending, nil
+}
+
+// applyMigration runs the statements of a migration and records its version.
+func applyMigration(db *Database, m Migration) error {
+	ctx := context.Background()
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, statement := range m.Statements {
+		_, err = tx.ExecContext(ctx, statement)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if m.Apply != nil {
+		err = m.Apply(tx)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO
+		schema_version
+		(version)
+	VALUES
+		($1)`,
+		m.Version)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
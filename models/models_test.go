@@ -1,1191 +1,2827 @@
-package models
-
-import (
-	_ "coeus/globals"
-	"fmt"
-	"testing"
-)
-
-func TestUserAuthenticate(t *testing.T) {
-	var match int
-
-	user := new(User)
-	match = user.Authenticate("student@coeus.education", "coeus")
-
-	if match == 0 {
-		t.Fatalf("Authentication FAILED")
-	}
-}
-
-func TestUserGet(t *testing.T) {
-	user := new(User)
-
-	// Get Details for a user given an ID
-	_, error := user.Get(1)
-	if error != nil {
-		t.Fatal(error)
-	}
-}
-
-func TestGetAllUser(t *testing.T) {
-	user := new(User)
-
-	// Get all users
-	_, error := user.GetAll()
-	if error != nil {
-		t.Fatal(error)
-	}
-}
-
-func TestUserAdd(t *testing.T) {
-	u := new(User)
-
-	// Add a new user
-	id, err := u.Add("email@addexample.com", "password123", "Doe", "John")
-	if err != nil {
-		t.Fatal("Failed to add user")
-	}
-	u.Delete(id)
-
-}
-
-func TestUserUpdate(t *testing.T) {
-
-	u := new(User)
-
-	//Add a new user
-	id, err := u.Add("email@updateexample.com", "password123", "Doe", "John")
-	if err != nil {
-		t.Fatal("Failed to add user")
-	}
-
-	//	Update the user
-	err = u.Update(id, "email@updateexample.com", "Doe", "Jane", "new")
-	if err != nil {
-		t.Fatal("Failed to delete user")
-	}
-
-	// Delete the user
-	err = u.Delete(id)
-	if err != nil {
-		t.Fatal("Failed to delete user")
-	}
-
-}
-
-func TestUserDelete(t *testing.T) {
-	u := new(User)
-
-	// Add a new user
-	id, err := u.Add("email@deleteexample.com", "password123", "Doe", "John")
-	if err != nil {
-		t.Fatal("Failed to add user")
-	}
-
-	// Delete the user
-	err = u.Delete(id)
-	if err != nil {
-		t.Fatal("Failed to delete user")
-	}
-
-	// Check if user was deleted
-	_, err = u.Get(id)
-	if err == nil {
-		t.Fatal("User was not deleted")
-	}
-}
-
-func TestUserCount(t *testing.T) {
-	user := new(User)
-
-	// Get count of all users
-	_, error := user.Count()
-	if error != nil {
-		t.Fatal(error)
-	}
-}
-
-func TestSettingAdd(t *testing.T) {
-	s := new(Setting)
-
-	// Add a new setting
-	_, err := s.Add(999)
-	if err != nil {
-		t.Fatalf("Failed to add setting: %v", err)
-	}
-
-	// Delete the setting
-	err = s.Delete(999)
-	if err != nil {
-		t.Fatal("Failed to delete setting")
-	}
-}
-
-func TestSettingDelete(t *testing.T) {
-	s := new(Setting)
-
-	// Add a new setting
-	_, err := s.Add(999)
-	if err != nil {
-		t.Fatalf("Failed to add setting: %v", err)
-	}
-
-	// Delete the setting
-	err = s.Delete(999)
-	if err != nil {
-		t.Fatal("Failed to delete setting")
-	}
-}
-
-func TestSettingGet(t *testing.T) {
-	setting := new(Setting)
-
-	// Get Details for a user given an ID
-	_, error := setting.Get(1)
-	if error != nil {
-		t.Fatal(error)
-	}
-}
-
-func TestSettingUpdate(t *testing.T) {
-
-	s := new(Setting)
-
-	// Update settings for user id 1
-	error := s.Update(2, "theme", "false", -360)
-
-	if error != nil {
-		t.Fatal(error)
-	}
-}
-
-func TestCourseCount(t *testing.T) {
-	course := new(Course)
-
-	// Get count of all courses
-	_, error := course.Count()
-	if error != nil {
-		t.Fatal(error)
-	}
-}
-
-func TestCourseGet(t *testing.T) {
-	course := new(Course)
-
-	// Get a course with a known course number
-	result, err := course.Get("CSI 1113")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if result.Number != "CSI 1113" {
-		t.Fatalf("Expected course number CS101, but got %s", result.Number)
-	}
-}
-
-func TestCourseGetByUserId(t *testing.T) {
-	course := new(Course)
-
-	// Get courses by user ID
-	courses, err := course.GetByUserId(1)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if len(courses) == 0 {
-		t.Fatalf("Expected courses, but got %d", len(courses))
-	}
-}
-
-func TestCourseSearch(t *testing.T) {
-	course := new(Course)
-
-	// Search for courses using a known course identifier
-	results, err := course.Search("CSI 1113")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if len(results) == 0 {
-		t.Fatalf("Expected courses, but got %d", len(results))
-	}
-}
-
-func TestCourseGetBySectionId(t *testing.T) {
-	course := new(Course)
-
-	// Get a course with a known section ID
-	result, err := course.GetBySectionId(3)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if result.ID != 2 {
-		t.Fatalf("Expected course with ID 1, but got %d", result.ID)
-	}
-}
-
-func TestGetCourseSections(t *testing.T) {
-	course := new(Course)
-
-	// Get sections for a course with the course data
-	_, err := course.GetCourseSections()
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestCreateSchedule(t *testing.T) {
-	s := new(Section)
-
-	// Create a schedule for a user
-	_, err := s.CreateSchedule(23, "TEST | TEST")
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestAddCourseAndSections(t *testing.T) {
-	c := new(Course)
-
-	// Add a new course
-	_, err := c.AddCourseAndSections("CSI 9999", "Test Course", "Fall", "2023-05-14", "2023-05-14", "2023", 2)
-
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestUpdateCourseAndSection(t *testing.T) {
-	c := new(Course)
-	s := new(Section)
-
-	// Add a new course
-	ID, err := c.AddCourseAndSections("CSI 9999", "Test Course", "Fall", "2023-05-14", "2023-05-14", "2023", 2)
-
-	// THIS FUNC IS ONLY FOR TESTING PURPOSES
-	// Get the section ID of the new course based on the course ID and section number
-	sectionID, err := s.GetSectionIDByCourseIDAndSectionNumber(ID, 1)
-
-	// Update a course and its section
-	err = c.UpdateCourseAndSection(ID, sectionID, "CSI 1113", "Test Course", "Fall", "2023", "5", "2022 Feb", "2022 May", "M, F", "12:00:00 PM")
-
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestGetSectionIds(t *testing.T) {
-	c := new(Course)
-
-	// Get section IDs for a course
-	_, err := c.GetSectionIds(1)
-
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestAddClassSession(t *testing.T) {
-	s := new(Section)
-
-	// Add a class session
-	err := s.AddClassSession(1, 3)
-
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestDeleteByCourseIdAndSection(t *testing.T) {
-	c := new(Section)
-
-	// Delete a course and its sections
-	err := c.DeleteByCourseIdAndSection(1, 1)
-
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestSectionCount(t *testing.T) {
-	section := new(Section)
-
-	// Get count of all sections
-	_, error := section.Count()
-	if error != nil {
-		t.Fatal(error)
-	}
-}
-
-func TestSectionGetByCourse(t *testing.T) {
-	db := NewDB()
-	// Insert a test section with a known CourseId
-	_, err := db.Exec("INSERT INTO section (course_id, name, created_at, updated_at) VALUES ($1, 'Test Section', datetime('now'), datetime('now'))", 1)
-
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	s := new(Section)
-	sections, err := s.GetByCourse(1)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if len(sections) == 0 {
-		t.Fatalf("Expected sections, but got %d", len(sections))
-	}
-
-	// Clean up the test data
-	_, err = db.Exec("DELETE FROM section WHERE course_id = $1", 1)
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestAddEnrollment(t *testing.T) {
-
-	db := NewDB()
-	s := new(Section)
-
-	// Add enrollment for the section CS50 1 and user Asim
-	err := s.AddEnrollment(1, 1)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	// Clean up the test data
-	_, err = db.Exec("DELETE FROM enrollment WHERE section_id=$1 AND user_id=$2", 1, 1)
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestGetEnrolledUsersBySectionID(t *testing.T) {
-
-	s := new(Enrollment)
-
-	// Get enrolled users for a section
-	_, err := s.GetEnrolledUsersBySectionID(3)
-
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestPostQuestion(t *testing.T) {
-
-	s := new(Question)
-
-	_, err := s.PostQuestion(1, 2, "This is a test question")
-
-	if err != nil {
-		t.Errorf("postQuestion failed with error: %v", err)
-	}
-}
-
-func TestQuestionGetByID(t *testing.T) {
-
-	s := new(Question)
-
-	// Insert a test question
-	s.PostQuestion(1, 2, "test")
-
-	_, err := s.GetByID(1, 100)
-	if err != nil {
-		t.Errorf("getByID failed with error: %v", err)
-	}
-
-	// Clean up the test data
-	db := NewDB()
-	_, err = db.Exec("DELETE FROM question WHERE text = $1", "test")
-
-}
-
-func TestGetAllQuestions(t *testing.T) {
-
-	s := new(Question)
-
-	questions, err := s.GetAllQuestions(10, "votes", 300)
-
-	if err != nil {
-		t.Errorf("getAllQuestions failed with error: %v", err)
-	}
-
-	if len(questions) == 0 {
-		t.Fatalf("Expected questions, but got %d", len(questions))
-	}
-}
-
-func TestGetUnansweredQuestions(t *testing.T) {
-
-	_, err := new(Question).GetUnansweredQuestions(3, 300, "votes")
-	if err != nil {
-		t.Errorf("getUnansweredQuestions failed with error: %v", err)
-	}
-
-}
-
-func TestJoin(t *testing.T) {
-
-	_, err := new(ClassSession).Join(1, 1)
-
-	if err != nil {
-		t.Errorf("join failed with error: %v", err)
-	}
-}
-
-func TestGetParticipantCount(t *testing.T) {
-
-	_, err := new(ClassSession).GetParticipantCount(1)
-
-	if err != nil {
-		t.Errorf("getParticipants failed with error: %v", err)
-	}
-}
-
-func TestGetParticipants(t *testing.T) {
-
-	_, err := new(Participant).GetParticipants(1)
-
-	if err != nil {
-		t.Errorf("getParticipants failed with error: %v", err)
-	}
-}
-
-func TestGetSectionID(t *testing.T) {
-
-	_, err := new(ClassSession).GetSectionID(1)
-
-	if err != nil {
-		t.Errorf("getSectionID failed with error: %v", err)
-	}
-}
-
-func TestVoteQuestion(t *testing.T) {
-
-	err := new(Question).VoteQuestion(1, 1)
-
-	if err != nil {
-		t.Errorf("voteQuestion failed with error: %v", err)
-	}
-}
-
-func TestGetVoteCount(t *testing.T) {
-
-	_, err := new(Question).GetVoteCount(1)
-
-	if err != nil {
-		t.Errorf("getVoteCount failed with error: %v", err)
-	}
-}
-
-func TestGetBySectionId(t *testing.T) {
-
-	_, err := new(Course).GetBySectionId(3)
-
-	if err != nil {
-		t.Errorf("getBySectionId failed with error: %v", err)
-	}
-}
-
-func TestGetUserInitials(t *testing.T) {
-
-	_, err := new(User).GetUserInitials(1)
-
-	if err != nil {
-		t.Errorf("getUserInitials failed with error: %v", err)
-	}
-}
-
-func TestQuestionVoteQuestion(t *testing.T) {
-	q := new(Question)
-
-	// Add a vote to a question with a known question ID and user ID
-	err := q.VoteQuestion(1, 1)
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestQuestionHasVoted(t *testing.T) {
-	q := new(Question)
-	// Check if a user has voted for a question with a known question ID and user ID
-	result, err := q.HasVoted(1, 1)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if !result {
-		t.Fatalf("Expected user to have voted, but got %v", result)
-
-	}
-
-}
-func TestAddModerator(t *testing.T) {
-
-	err := new(Moderator).Delete(999, 3)
-	if err != nil {
-		t.Errorf("deleteModerator failed with error: %v", err)
-	}
-
-	_, err = new(Moderator).Add(999, 3, "student")
-	if err != nil {
-		t.Errorf("addModerator failed with error: %v", err)
-	}
-
-	err = new(Moderator).Delete(999, 3)
-	if err != nil {
-		t.Errorf("deleteModerator failed with error: %v", err)
-	}
-}
-
-func TestUpdateModerator(t *testing.T) {
-
-	_, err := new(Moderator).Add(999, 3, "student")
-	if err != nil {
-		t.Errorf("addModerator failed with error: %v", err)
-	}
-
-	_, err = new(Moderator).Update(999, 3, "teacher assistant")
-	if err != nil {
-		t.Errorf("updateModerator failed with error: %v", err)
-	}
-
-	err = new(Moderator).Delete(999, 3)
-	if err != nil {
-		t.Errorf("deleteModerator failed with error: %v", err)
-	}
-
-}
-
-func TestGetModerator(t *testing.T) {
-
-	_, err := new(Moderator).Add(999, 3, "student")
-	if err != nil {
-		t.Errorf("addModerator failed with error: %v", err)
-	}
-
-	_, err = new(Moderator).Get(999)
-	if err != nil {
-		t.Errorf("getModerator failed with error: %v", err)
-	}
-
-}
-
-func TestGetStatusModerator(t *testing.T) {
-
-	err := new(Moderator).Delete(999, 3)
-	if err != nil {
-		t.Errorf("deleteModerator failed with error: %v", err)
-	}
-
-	_, err = new(Moderator).Add(999, 3, "student")
-	if err != nil {
-		t.Errorf("addModerator failed with error: %v", err)
-	}
-
-	_, err = new(Moderator).GetStatus(999, 3)
-	if err != nil {
-		t.Errorf("getStatusModerator failed with error: %v", err)
-	}
-
-	err = new(Moderator).Delete(999, 3)
-	if err != nil {
-		t.Errorf("deleteModerator failed with error: %v", err)
-	}
-}
-
-func TestGetInProgress(t *testing.T) {
-
-	_, err := new(ClassSession).GetInProgress(1)
-	if err != nil {
-		t.Errorf("getInProgress failed with error: %v", err)
-	}
-}
-func TestStart(t *testing.T) {
-
-	_, err := new(ClassSession).Start(1)
-	if err != nil {
-		t.Errorf("start failed with error: %v", err)
-	}
-}
-
-func TestEnd(t *testing.T) {
-
-	_, err := new(ClassSession).Start(1)
-	if err != nil {
-		t.Errorf("start failed with error: %v", err)
-	}
-
-	err = new(ClassSession).End(1)
-	if err != nil {
-		t.Errorf("end failed with error: %v", err)
-	}
-}
-
-func TestDeleteModerator(t *testing.T) {
-
-	// First delete any existing moderator
-	err := new(Moderator).Delete(999, 3)
-	if err != nil {
-		t.Errorf("deleteModerator failed with error: %v", err)
-	}
-
-	// Add a moderator with a known user ID and section ID
-	_, err = new(Moderator).Add(999, 3, "student")
-	if err != nil {
-		t.Errorf("addModerator failed with error: %v", err)
-	}
-
-	// Than actually delete the added moderator
-	err = new(Moderator).Delete(999, 3)
-	if err != nil {
-		t.Errorf("deleteModerator failed with error: %v", err)
-	}
-}
-
-func TestIsInstructor(t *testing.T) {
-
-	// First delete any existing moderator
-	err := new(Moderator).Delete(999, 3)
-	if err != nil {
-		t.Errorf("deleteModerator failed with error: %v", err)
-	}
-
-	// Add a moderator with a known user ID and section ID
-	_, err = new(Moderator).Add(999, 3, "instructor")
-	if err != nil {
-		t.Errorf("addModerator failed with error: %v", err)
-	}
-
-	// Check if a user with a known user ID is an instructor
-	result, err := new(Moderator).IsInstructor(999)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if !result {
-		t.Fatalf("Expected user to be an instructor, but got %v", result)
-	}
-
-	// Than actually delete the added moderator
-	err = new(Moderator).Delete(999, 3)
-	if err != nil {
-		t.Errorf("deleteModerator failed with error: %v", err)
-	}
-}
-
-func TestCountByInstructor(t *testing.T) {
-	// Count the number of courses and sections taught by an instructor with a known user ID
-	_, err := new(Course).CountByInstructor(3)
-	if err != nil {
-		fmt.Println(err)
-	}
-	_, err = new(Section).CountByInstructor(3)
-	if err != nil {
-		fmt.Println(err)
-	}
-}
-
-func TestGetCourseSectionsByIntructor(t *testing.T) {
-	// Get the sections taught by an instructor with a known user ID
-	_, err := new(Course).GetCourseSectionsByIntructor(3)
-	if err != nil {
-		fmt.Println(err)
-	}
-}
-
-func TestHasVoted(t *testing.T) {
-
-	// Check if a user has voted for a question with a known question ID and user ID
-	result, err := new(Question).HasVoted(1, 1)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if !result {
-		t.Fatalf("Expected user to have voted, but got %v", result)
-	}
-}
-
-func TestHasVotedAll(t *testing.T) {
-
-	// Get the userHasVoted slice from the database
-	result, err := new(Question).HasVotedAll(3, 1)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if result == nil {
-		t.Fatalf("Expected slice of structs with userHasVoted and a question id, but got %v", result)
-	}
-}
-
-func TestHasVotedAppend(t *testing.T) {
-
-	// Get the questions from the database by the created at time
-	questionsByTimeSlice, err := new(Question).GetAllQuestions(3, "created_at", 300)
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	// Get the userHasVoted slice from the database
-	userHasVotedSlice, err := new(Question).HasVotedAll(3, 1)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	// Append the userHasVoted slice to the questionsByTime slice
-	result := new(Question).HasVotedAppend(questionsByTimeSlice, userHasVotedSlice)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if result == nil {
-		t.Fatalf("Expected questions and voted slice to be combined, but got %v", result)
-	}
-}
-
-func TestGetStatus(t *testing.T) {
-
-	_, err := new(Organization).GetStatus()
-	if err != nil {
-		t.Fatal(err)
-	}
-
-}
-
-func TestGetOrganizationID(t *testing.T) {
-
-	// Get the organization ID from the database
-	_, err := new(User).GetOrganizationID()
-	if err != nil {
-		t.Fatal(err)
-	}
-
-}
-
-func TestUpdateOrganizationName(t *testing.T) {
-
-	// Get the organization ID from the database
-	ID, err := new(User).GetOrganizationID()
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	// Update the organization name in the database
-	err = new(Organization).UpdateName(ID, "Test Organization Name")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-}
-
-func TestUpdateOrganizationTime(t *testing.T) {
-
-	// Get the organization ID from the database
-	ID, err := new(User).GetOrganizationID()
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	// Update the organization timezone in the database
-	err = new(Organization).UpdateTimeZone(ID, "0")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-}
-
-func TestUpdateOrganizationUpdateAPIKeyAndEmail(t *testing.T) {
-
-	// Get the organization ID from the database
-	ID, err := new(User).GetOrganizationID()
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	// Update the organization timezone in the database
-	err = new(Organization).UpdateAPIKeyAndEmail(ID, "Test API Key", "Test Email")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-}
-
-func TestAddUserToOrganization(t *testing.T) {
-
-	// Add a user to an organization with a known user ID and organization ID
-	err := new(User).AddUserToOrganization(1, 1)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-}
-
-func TestGetOrganization(t *testing.T) {
-
-	// Add an organization to the database
-	ID, err := new(Organization).Add("Test Organization", "0", "logo.svg", "1234", "user@email")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	// Get the organization from the database
-	organization, err := new(Organization).Get(ID)
-	if err != nil {
-		t.Fatal(err)
-		t.Fatalf("Expected organization, but got %v", organization)
-	}
-}
-
-func TestAddOrganization(t *testing.T) {
-
-	// Add an organization to the database
-	_, err := new(Organization).Add("Test Organization", "0", "logo.svg", "1234", "user@email")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-}
-
-func TestSetAdmin(t *testing.T) {
-
-	// Set a user as an admin with a known user ID
-	err := new(Organization).SetAdmin(999)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	// Remove a user as an admin with a known user ID
-	err = new(Organization).DeleteAdmin(999)
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestGetAdminID(t *testing.T) {
-
-	// Set a user as an admin with a known user ID
-	err := new(Organization).SetAdmin(999)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	// Get the admin ID from the database
-	_, err = new(Organization).GetAdminID()
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	// Remove a user as an admin with a known user ID
-	err = new(Organization).DeleteAdmin(999)
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestAdminDelete(t *testing.T) {
-
-	// Delete the user with a known user ID
-	err := new(Moderator).AdminDelete(999)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	// Add a user with a known user ID
-	_, err = new(Moderator).AdminAdd(999, "teacher assistant")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	// Delete the user with a known user ID
-	err = new(Moderator).AdminDelete(999)
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestAdminAdd(t *testing.T) {
-
-	err := new(Moderator).AdminDelete(999)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	_, err = new(Moderator).AdminAdd(999, "teacher assistant")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	err = new(Moderator).AdminDelete(999)
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestAdminUpdate(t *testing.T) {
-
-	err := new(Moderator).AdminDelete(999)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	// Add a user with a known user ID
-	_, err = new(Moderator).AdminAdd(999, "teacher assistant")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	// Update the user with a known user ID
-	_, err = new(Moderator).AdminUpdate(999, "instructor")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	err = new(Moderator).AdminDelete(999)
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestCreateToken(t *testing.T) {
-
-	_, err := new(VerifyUser).CreateToken("whalencollin@gmail.com")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-}
-
-func TestMatchToken(t *testing.T) {
-
-	ID, err := new(VerifyUser).CreateToken("whalencollin@gmail.com")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	token, err := new(VerifyUser).GetToken(ID)
-
-	bool, _, err := new(VerifyUser).MatchToken(token)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if bool == false {
-		t.Fatal("Expected true, but got false")
-	}
-}
-
-func TestDeleteToken(t *testing.T) {
-
-	// Create a token
-	ID, err := new(VerifyUser).CreateToken("whalencollin@gmail.com")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	// Delete the token
-	err = new(VerifyUser).DeleteToken(ID)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-}
-
-func TestCheckAPIKey(t *testing.T) {
-
-	// CheckAPIKey function to check if the API key exists true or false is considered a pass
-	_, err := new(Organization).CheckAPIKey()
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestUpdatePassword(t *testing.T) {
-
-	// Update the user's password
-	err := new(User).UpdatePassword(999, "Ch0ngeme!")
-	if err != nil {
-		fmt.Println(err)
-	}
-
-}
-
-func TestAttendanceAdd(t *testing.T) {
-
-	// Add a user to the attendance table
-	_, err := new(Attendance).Add(3, 1, 3)
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestAttendanceAddUserAttendance(t *testing.T) {
-
-	// Add a user to the attendance table
-	ID, err := new(Attendance).Add(3, 1, 3)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	//convert ID from int64 to int
-	IDint := int(ID)
-
-	// Add a user to the user attendance table
-	_, err = new(Attendance).AddUserAttendance(999, IDint, "present")
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestAttendanceGetByClassSession(t *testing.T) {
-
-	// Get the attendance for a class session
-	_, err := new(Attendance).GetByClassSession(3)
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestGetAttendanceIDBySectionID(t *testing.T) {
-
-	_, err := new(Attendance).Add(3, 3, 3)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	// Get the attendance ID for a section
-	_, err = new(Attendance).GetAttendanceIDBySectionID(3)
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestAttendanceGetByUser(t *testing.T) {
-
-	// Add a user to the attendance table
-	ID, err := new(Attendance).Add(3, 1, 3)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	//convert ID from int64 to int
-	IDint := int(ID)
-
-	// Add a user to the user attendance table
-	_, err = new(Attendance).AddUserAttendance(999, IDint, "present")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	// Get the attendance for a user
-	_, err = new(Attendance).GetByUser(999, IDint)
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestUpdateUserAttendance(t *testing.T) {
-
-	// Add a user to the attendance table
-	ID, err := new(Attendance).Add(3, 1, 3)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	//convert ID from int64 to int
-	IDint := int(ID)
-
-	// Add a user to the user attendance table
-	_, err = new(Attendance).AddUserAttendance(999, IDint, "absent")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	// Update a user's attendance
-	err = new(Attendance).UpdateUserAttendance(999, IDint, "present")
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestAttendanceDeleteAll(t *testing.T) {
-
-	// Add a user to the attendance table
-	ID, err := new(Attendance).Add(3, 1, 3)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	//convert ID from int64 to int
-	IDint := int(ID)
-
-	// Add a user to the user attendance table
-	_, err = new(Attendance).AddUserAttendance(999, IDint, "absent")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	// Delete all attendance
-	err = new(Attendance).DeleteAll(3)
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestAttendanceDeleteUserAttendance(t *testing.T) {
-
-	// Add a user to the attendance table
-	ID, err := new(Attendance).Add(3, 1, 3)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	//convert ID from int64 to int
-	IDint := int(ID)
-
-	// Add a user to the user attendance table
-	_, err = new(Attendance).AddUserAttendance(999, IDint, "absent")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	// Delete a user's attendance
-	err = new(Attendance).DeleteUserAttendance(999)
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestGetByInstructor(t *testing.T) {
-
-	// Get the attendance for an instructor
-	_, err := new(Attendance).GetByInstructor(3)
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestGetCoursesByInstructor(t *testing.T) {
-
-	// Get the courses for an instructor
-	_, err := new(CourseSection).GetCoursesByInstructor(3)
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestGetSectionsByInstructorAndCourse(t *testing.T) {
-
-	// Get the sections for an instructor and course
-	_, err := new(CourseSection).GetSectionsByInstructorAndCourse(3, 1)
-	if err != nil {
-		t.Fatal(err)
-	}
-}
-
-func TestGetStudentsByAttendance(t *testing.T) {
-
-	// Get the students by attendance
-	_, err := new(AttendanceRecord).GetStudentsByAttendance(3)
-	if err != nil {
-		t.Fatal(err)
-	}
-}
+package models
+
+import (
+	_ "coeus/globals"
+	"database/sql"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestUserAuthenticate(t *testing.T) {
+	var match int
+
+	user := new(User)
+	match = user.Authenticate("student@coeus.education", "coeus")
+
+	if match == 0 {
+		t.Fatalf("Authentication FAILED")
+	}
+}
+
+func TestUserGet(t *testing.T) {
+	user := new(User)
+
+	// Get Details for a user given an ID
+	_, error := user.Get(1)
+	if error != nil {
+		t.Fatal(error)
+	}
+}
+
+func TestGetAllUser(t *testing.T) {
+	user := new(User)
+
+	// Get all users
+	_, error := user.GetAll()
+	if error != nil {
+		t.Fatal(error)
+	}
+}
+
+func TestUserAdd(t *testing.T) {
+	u := new(User)
+
+	// Add a new user
+	id, err := u.Add("email@addexample.com", "password123", "Doe", "John")
+	if err != nil {
+		t.Fatal("Failed to add user")
+	}
+	u.Delete(id)
+
+}
+
+func TestUserUpdate(t *testing.T) {
+
+	u := new(User)
+
+	//Add a new user
+	id, err := u.Add("email@updateexample.com", "password123", "Doe", "John")
+	if err != nil {
+		t.Fatal("Failed to add user")
+	}
+
+	//	Update the user
+	err = u.Update(id, "email@updateexample.com", "Doe", "Jane", "new")
+	if err != nil {
+		t.Fatal("Failed to delete user")
+	}
+
+	// Delete the user
+	err = u.Delete(id)
+	if err != nil {
+		t.Fatal("Failed to delete user")
+	}
+
+}
+
+func TestUserDelete(t *testing.T) {
+	u := new(User)
+
+	// Add a new user
+	id, err := u.Add("email@deleteexample.com", "password123", "Doe", "John")
+	if err != nil {
+		t.Fatal("Failed to add user")
+	}
+
+	// Delete the user
+	err = u.Delete(id)
+	if err != nil {
+		t.Fatal("Failed to delete user")
+	}
+
+	// Check if user was deleted
+	_, err = u.Get(id)
+	if err == nil {
+		t.Fatal("User was not deleted")
+	}
+}
+
+func TestUserCount(t *testing.T) {
+	user := new(User)
+
+	// Get count of all users
+	_, error := user.Count()
+	if error != nil {
+		t.Fatal(error)
+	}
+}
+
+func TestSettingAdd(t *testing.T) {
+	s := new(Setting)
+
+	// Add a new setting
+	_, err := s.Add(999)
+	if err != nil {
+		t.Fatalf("Failed to add setting: %v", err)
+	}
+
+	// Delete the setting
+	err = s.Delete(999)
+	if err != nil {
+		t.Fatal("Failed to delete setting")
+	}
+}
+
+func TestSettingDelete(t *testing.T) {
+	s := new(Setting)
+
+	// Add a new setting
+	_, err := s.Add(999)
+	if err != nil {
+		t.Fatalf("Failed to add setting: %v", err)
+	}
+
+	// Delete the setting
+	err = s.Delete(999)
+	if err != nil {
+		t.Fatal("Failed to delete setting")
+	}
+}
+
+func TestSettingGet(t *testing.T) {
+	setting := new(Setting)
+
+	// Get Details for a user given an ID
+	_, error := setting.Get(1)
+	if error != nil {
+		t.Fatal(error)
+	}
+}
+
+func TestSettingUpdate(t *testing.T) {
+
+	s := new(Setting)
+
+	// Update settings for user id 1
+	error := s.Update(2, "theme", "false", -360)
+
+	if error != nil {
+		t.Fatal(error)
+	}
+}
+
+func TestCourseCount(t *testing.T) {
+	course := new(Course)
+
+	// Get count of all courses
+	_, error := course.Count()
+	if error != nil {
+		t.Fatal(error)
+	}
+}
+
+func TestCourseGet(t *testing.T) {
+	course := new(Course)
+
+	// Get a course with a known course number
+	result, err := course.Get("CSI 1113")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Number != "CSI 1113" {
+		t.Fatalf("Expected course number CS101, but got %s", result.Number)
+	}
+}
+
+func TestCourseGetByUserId(t *testing.T) {
+	course := new(Course)
+
+	// Get courses by user ID
+	courses, err := course.GetByUserId(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(courses) == 0 {
+		t.Fatalf("Expected courses, but got %d", len(courses))
+	}
+}
+
+func TestCourseSearch(t *testing.T) {
+	course := new(Course)
+
+	// Search for courses using a known course identifier
+	results, err := course.Search("CSI 1113")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) == 0 {
+		t.Fatalf("Expected courses, but got %d", len(results))
+	}
+}
+
+func TestCourseGetBySectionId(t *testing.T) {
+	course := new(Course)
+
+	// Get a course with a known section ID
+	result, err := course.GetBySectionId(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.ID != 2 {
+		t.Fatalf("Expected course with ID 1, but got %d", result.ID)
+	}
+}
+
+func TestGetCourseSections(t *testing.T) {
+	course := new(Course)
+
+	// Get sections for a course with the course data
+	_, err := course.GetCourseSections()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCreateSchedule(t *testing.T) {
+	s := new(Section)
+
+	// Create a schedule for a user
+	scheduleID, err := s.CreateSchedule(23, "M W F | 11:15 AM-12:05 PM")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer DB().Exec("DELETE FROM schedule WHERE id = $1", scheduleID)
+
+	// Schedules that don't parse are refused
+	if _, err := s.CreateSchedule(23, "TEST | TEST"); err == nil {
+		t.Error("creating a schedule that doesn't parse succeeded")
+	}
+}
+
+func TestAddCourseAndSections(t *testing.T) {
+	c := new(Course)
+
+	// Add a new course
+	_, err := c.AddCourseAndSections("CSI 9999", "Test Course", "Fall", "2023-05-14", "2023-05-14", "2023", 2)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUpdateCourseAndSection(t *testing.T) {
+	c := new(Course)
+	s := new(Section)
+
+	// Add a new course
+	ID, err := c.AddCourseAndSections("CSI 9999", "Test Course", "Fall", "2023-05-14", "2023-05-14", "2023", 2)
+
+	// THIS FUNC IS ONLY FOR TESTING PURPOSES
+	// Get the section ID of the new course based on the course ID and section number
+	sectionID, err := s.GetSectionIDByCourseIDAndSectionNumber(ID, 1)
+
+	// Update a course and its section, giving the section its first schedule
+	err = c.UpdateCourseAndSection(ID, sectionID, 0, "CSI 1113", "Test Course", "Fall", "2023", "5", "2022 Feb", "2022 May", "M, F", "12:00 PM-12:50 PM")
+	if err != nil {
+		t.Fatal(err)
+	}
+	schedules, err := new(Schedule).GetBySectionID(sectionID)
+	if err != nil || len(schedules) != 1 {
+		t.Fatalf("schedules = %+v %v, want one", schedules, err)
+	}
+	first := schedules[0].ID
+	second, err := new(Schedule).Add(Schedule{SectionID: sectionID, Weekdays: []time.Weekday{time.Tuesday}, StartTime: "09:00", EndTime: "09:50"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the schedule edited changes
+	err = c.UpdateCourseAndSection(ID, sectionID, first, "CSI 1113", "Test Course", "Fall", "2023", "5", "2022 Feb", "2022 May", "W", "1:00 PM-1:50 PM")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if schedule, _ := new(Schedule).Get(first); schedule.StartTime != "13:00" || !schedule.MeetsOn(time.Wednesday) || schedule.MeetsOn(time.Monday) {
+		t.Errorf("edited schedule = %+v", schedule)
+	}
+	if schedule, _ := new(Schedule).Get(second); schedule.StartTime != "09:00" || !schedule.MeetsOn(time.Tuesday) {
+		t.Errorf("other schedule of the section = %+v, want it unchanged", schedule)
+	}
+
+	// Text that doesn't parse is refused, and so is a schedule of another section
+	if err := c.UpdateCourseAndSection(ID, sectionID, first, "CSI 1113", "Test Course", "Fall", "2023", "5", "2022 Feb", "2022 May", "M, F", "12:00:00 PM"); err == nil {
+		t.Error("updating with a schedule that doesn't parse succeeded")
+	}
+	if schedule, _ := new(Schedule).Get(first); schedule.StartTime != "13:00" {
+		t.Errorf("refused update changed the schedule to %+v", schedule)
+	}
+	if err := c.UpdateCourseAndSection(ID, 3, first, "CSI 1113", "Test Course", "Fall", "2023", "5", "2022 Feb", "2022 May", "W", "1:00 PM-1:50 PM"); err != sql.ErrNoRows {
+		t.Errorf("updating a schedule of another section returned %v, want %v", err, sql.ErrNoRows)
+	}
+}
+
+func TestGetSectionIds(t *testing.T) {
+	c := new(Course)
+
+	// Get section IDs for a course
+	_, err := c.GetSectionIds(1)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAddClassSession(t *testing.T) {
+	s := new(Section)
+
+	// Add a class session
+	err := s.AddClassSession(1, 3)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDeleteByCourseIdAndSection(t *testing.T) {
+	c := new(Section)
+
+	// Delete a course and its sections
+	err := c.DeleteByCourseIdAndSection(1, 1)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSectionCount(t *testing.T) {
+	section := new(Section)
+
+	// Get count of all sections
+	_, error := section.Count()
+	if error != nil {
+		t.Fatal(error)
+	}
+}
+
+func TestSectionGetByCourse(t *testing.T) {
+	db := DB()
+	// Insert a test section with a known CourseId
+	_, err := db.Exec("INSERT INTO section (course_id, name, created_at, updated_at) VALUES ($1, 'Test Section', datetime('now'), datetime('now'))", 1)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := new(Section)
+	sections, err := s.GetByCourse(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(sections) == 0 {
+		t.Fatalf("Expected sections, but got %d", len(sections))
+	}
+
+	// Clean up the test data
+	_, err = db.Exec("DELETE FROM section WHERE course_id = $1", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAddEnrollment(t *testing.T) {
+
+	db := DB()
+	s := new(Section)
+
+	// Add enrollment for the section CS50 1 and user Asim
+	err := s.AddEnrollment(1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the test data
+	_, err = db.Exec("DELETE FROM enrollment WHERE section_id=$1 AND user_id=$2", 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetEnrolledUsersBySectionID(t *testing.T) {
+
+	s := new(Enrollment)
+
+	// Get enrolled users for a section
+	_, err := s.GetEnrolledUsersBySectionID(3)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPostQuestion(t *testing.T) {
+
+	s := new(Question)
+
+	_, err := s.PostQuestion(1, 2, "This is a test question")
+
+	if err != nil {
+		t.Errorf("postQuestion failed with error: %v", err)
+	}
+}
+
+func TestQuestionGetByID(t *testing.T) {
+
+	s := new(Question)
+
+	// Insert a test question
+	s.PostQuestion(1, 2, "test")
+
+	_, err := s.GetByID(1, 100)
+	if err != nil {
+		t.Errorf("getByID failed with error: %v", err)
+	}
+
+	// Clean up the test data
+	db := DB()
+	_, err = db.Exec("DELETE FROM question WHERE text = $1", "test")
+
+}
+
+func TestGetAllQuestions(t *testing.T) {
+
+	s := new(Question)
+
+	questions, err := s.GetAllQuestions(10, "votes", 300)
+
+	if err != nil {
+		t.Errorf("getAllQuestions failed with error: %v", err)
+	}
+
+	if len(questions) == 0 {
+		t.Fatalf("Expected questions, but got %d", len(questions))
+	}
+}
+
+func TestGetUnansweredQuestions(t *testing.T) {
+
+	_, err := new(Question).GetUnansweredQuestions(3, 300, "votes")
+	if err != nil {
+		t.Errorf("getUnansweredQuestions failed with error: %v", err)
+	}
+
+}
+
+func TestJoin(t *testing.T) {
+
+	_, err := new(ClassSession).Join(1, 1)
+
+	if err != nil {
+		t.Errorf("join failed with error: %v", err)
+	}
+}
+
+func TestGetParticipantCount(t *testing.T) {
+
+	_, err := new(ClassSession).GetParticipantCount(1)
+
+	if err != nil {
+		t.Errorf("getParticipants failed with error: %v", err)
+	}
+}
+
+func TestGetParticipants(t *testing.T) {
+
+	_, err := new(Participant).GetParticipants(1)
+
+	if err != nil {
+		t.Errorf("getParticipants failed with error: %v", err)
+	}
+}
+
+func TestGetSectionID(t *testing.T) {
+
+	_, err := new(ClassSession).GetSectionID(1)
+
+	if err != nil {
+		t.Errorf("getSectionID failed with error: %v", err)
+	}
+}
+
+func TestVoteQuestion(t *testing.T) {
+
+	err := new(Question).VoteQuestion(1, 1)
+
+	if err != nil {
+		t.Errorf("voteQuestion failed with error: %v", err)
+	}
+}
+
+func TestGetVoteCount(t *testing.T) {
+
+	_, err := new(Question).GetVoteCount(1)
+
+	if err != nil {
+		t.Errorf("getVoteCount failed with error: %v", err)
+	}
+}
+
+func TestGetBySectionId(t *testing.T) {
+
+	_, err := new(Course).GetBySectionId(3)
+
+	if err != nil {
+		t.Errorf("getBySectionId failed with error: %v", err)
+	}
+}
+
+func TestGetUserInitials(t *testing.T) {
+
+	_, err := new(User).GetUserInitials(1)
+
+	if err != nil {
+		t.Errorf("getUserInitials failed with error: %v", err)
+	}
+}
+
+func TestQuestionVoteQuestion(t *testing.T) {
+	q := new(Question)
+
+	// Add a vote to a question with a known question ID and user ID
+	err := q.VoteQuestion(1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestQuestionHasVoted(t *testing.T) {
+	q := new(Question)
+	// Check if a user has voted for a question with a known question ID and user ID
+	result, err := q.HasVoted(1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result {
+		t.Fatalf("Expected user to have voted, but got %v", result)
+
+	}
+
+}
+func TestAddModerator(t *testing.T) {
+
+	err := new(Moderator).Delete(999, 3)
+	if err != nil {
+		t.Errorf("deleteModerator failed with error: %v", err)
+	}
+
+	_, err = new(Moderator).Add(999, 3, "student")
+	if err != nil {
+		t.Errorf("addModerator failed with error: %v", err)
+	}
+
+	err = new(Moderator).Delete(999, 3)
+	if err != nil {
+		t.Errorf("deleteModerator failed with error: %v", err)
+	}
+}
+
+func TestUpdateModerator(t *testing.T) {
+
+	_, err := new(Moderator).Add(999, 3, "student")
+	if err != nil {
+		t.Errorf("addModerator failed with error: %v", err)
+	}
+
+	_, err = new(Moderator).Update(999, 3, "teacher assistant")
+	if err != nil {
+		t.Errorf("updateModerator failed with error: %v", err)
+	}
+
+	err = new(Moderator).Delete(999, 3)
+	if err != nil {
+		t.Errorf("deleteModerator failed with error: %v", err)
+	}
+
+}
+
+func TestGetModerator(t *testing.T) {
+
+	_, err := new(Moderator).Add(999, 3, "student")
+	if err != nil {
+		t.Errorf("addModerator failed with error: %v", err)
+	}
+
+	_, err = new(Moderator).Get(999)
+	if err != nil {
+		t.Errorf("getModerator failed with error: %v", err)
+	}
+
+}
+
+func TestGetStatusModerator(t *testing.T) {
+
+	err := new(Moderator).Delete(999, 3)
+	if err != nil {
+		t.Errorf("deleteModerator failed with error: %v", err)
+	}
+
+	_, err = new(Moderator).Add(999, 3, "student")
+	if err != nil {
+		t.Errorf("addModerator failed with error: %v", err)
+	}
+
+	_, err = new(Moderator).GetStatus(999, 3)
+	if err != nil {
+		t.Errorf("getStatusModerator failed with error: %v", err)
+	}
+
+	err = new(Moderator).Delete(999, 3)
+	if err != nil {
+		t.Errorf("deleteModerator failed with error: %v", err)
+	}
+}
+
+func TestAdminModerator(t *testing.T) {
+	// A moderator of no section can be updated and deleted
+	err := new(Moderator).AdminDelete(999)
+	if err != nil {
+		t.Fatalf("adminDelete failed with error: %v", err)
+	}
+
+	_, err = new(Moderator).AdminAdd(999, "instructor")
+	if err != nil {
+		t.Fatalf("adminAdd failed with error: %v", err)
+	}
+	defer DB().Exec("DELETE FROM moderator WHERE section_id IS NULL AND user_id = 999")
+
+	_, err = new(Moderator).AdminUpdate(999, "teacher assistant")
+	if err != nil {
+		t.Fatalf("adminUpdate failed with error: %v", err)
+	}
+	var moderatorType string
+	DB().QueryRow("SELECT type FROM moderator WHERE section_id IS NULL AND user_id = 999").Scan(&moderatorType)
+	if moderatorType != "teacher assistant" {
+		t.Errorf("Expected the admin update to set the type to teacher assistant, but got %q", moderatorType)
+	}
+
+	err = new(Moderator).AdminDelete(999)
+	if err != nil {
+		t.Fatalf("adminDelete failed with error: %v", err)
+	}
+	var count int
+	DB().QueryRow("SELECT COUNT(*) FROM moderator WHERE section_id IS NULL AND user_id = 999").Scan(&count)
+	if count != 0 {
+		t.Errorf("Expected the admin delete to remove the moderator, but %d remain", count)
+	}
+}
+
+func TestGetInProgress(t *testing.T) {
+
+	_, err := new(ClassSession).GetInProgress(1)
+	if err != nil {
+		t.Errorf("getInProgress failed with error: %v", err)
+	}
+}
+func TestStart(t *testing.T) {
+
+	_, err := new(ClassSession).Start(1)
+	if err != nil {
+		t.Errorf("start failed with error: %v", err)
+	}
+}
+
+func TestEnd(t *testing.T) {
+
+	classSessionID, err := new(ClassSession).Start(1)
+	if err != nil {
+		t.Errorf("start failed with error: %v", err)
+	}
+
+	ended, err := new(ClassSession).End(classSessionID)
+	if err != nil || !ended {
+		t.Errorf("end returned %v, %v; want true", ended, err)
+	}
+
+	// Ending it again changes nothing
+	ended, err = new(ClassSession).End(classSessionID)
+	if err != nil || ended {
+		t.Errorf("second end returned %v, %v; want false", ended, err)
+	}
+}
+
+func TestStartConcurrently(t *testing.T) {
+	const starts = 50
+
+	var lastID int
+	DB().QueryRow("SELECT COALESCE(MAX(id), 0) FROM class_session").Scan(&lastID)
+	defer DB().Exec("DELETE FROM class_session WHERE section_id = 8 AND id > $1", lastID)
+
+	// Every start of a section that isn't running gets the same new session
+	var wg sync.WaitGroup
+	ids := make(chan int, starts)
+	errs := make(chan error, starts)
+	ready := make(chan struct{})
+	for i := 0; i < starts; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-ready
+			classSessionID, err := new(ClassSession).Start(8)
+			if err != nil {
+				errs <- err
+				return
+			}
+			ids <- classSessionID
+		}()
+	}
+	close(ready)
+	wg.Wait()
+	close(ids)
+	close(errs)
+
+	for err := range errs {
+		t.Errorf("start failed with error: %v", err)
+	}
+	first := <-ids
+	for classSessionID := range ids {
+		if classSessionID != first {
+			t.Errorf("concurrent starts returned class sessions %d and %d", first, classSessionID)
+		}
+	}
+
+	var running int
+	DB().QueryRow("SELECT COUNT(*) FROM class_session WHERE section_id = 8 AND in_progress = true").Scan(&running)
+	if running != 1 {
+		t.Errorf("section has %d sessions in progress, want 1", running)
+	}
+
+	// The database refuses a second running session
+	_, err := DB().Exec("INSERT INTO class_session (section_id, schedule_id, in_progress, data, created_at, updated_at) VALUES (8, 0, true, '', datetime('now'), datetime('now'))")
+	if err == nil {
+		t.Errorf("Expected a second session in progress to be refused")
+	}
+}
+
+func TestClassSessionHistory(t *testing.T) {
+
+	first, err := new(ClassSession).Start(5)
+	if err != nil {
+		t.Fatalf("start failed with error: %v", err)
+	}
+
+	// Starting a running session returns it
+	again, err := new(ClassSession).Start(5)
+	if err != nil || again != first {
+		t.Fatalf("restart returned %d, %v; want %d", again, err, first)
+	}
+
+	_, err = new(ClassSession).Join(first, 1)
+	if err != nil {
+		t.Fatalf("join failed with error: %v", err)
+	}
+	_, err = new(ClassSession).End(first)
+	if err != nil {
+		t.Fatalf("end failed with error: %v", err)
+	}
+
+	// Each start after an end is a new dated session
+	second, err := new(ClassSession).Start(5)
+	if err != nil {
+		t.Fatalf("start failed with error: %v", err)
+	}
+	if second == first {
+		t.Fatalf("start reused class session %d", first)
+	}
+	_, err = new(ClassSession).End(second)
+	if err != nil {
+		t.Fatalf("end failed with error: %v", err)
+	}
+
+	history, err := new(ClassSession).GetBySectionID(5, 0)
+	if err != nil {
+		t.Fatalf("getBySectionID failed with error: %v", err)
+	}
+	found := map[int]ClassSessionSummary{}
+	for _, h := range history {
+		found[h.ID] = h
+	}
+	if _, ok := found[second]; !ok {
+		t.Errorf("class session %d missing from history", second)
+	}
+	if h, ok := found[first]; !ok || h.StartedAt == "" || h.EndedAt == "" || h.ParticipantCount != 1 {
+		t.Errorf("class session %d history = %+v", first, h)
+	}
+
+	// Participants are kept after the session ends
+	participants, err := new(Participant).GetParticipantDetails(first)
+	if err != nil || len(participants) != 1 {
+		t.Errorf("getParticipantDetails returned %v, %v", participants, err)
+	}
+
+	session, err := new(ClassSession).Get(first, 0)
+	if err != nil || session.SectionID != 5 || session.InProgress {
+		t.Errorf("get returned %+v, %v", session, err)
+	}
+}
+
+func TestDeleteModerator(t *testing.T) {
+
+	// First delete any existing moderator
+	err := new(Moderator).Delete(999, 3)
+	if err != nil {
+		t.Errorf("deleteModerator failed with error: %v", err)
+	}
+
+	// Add a moderator with a known user ID and section ID
+	_, err = new(Moderator).Add(999, 3, "student")
+	if err != nil {
+		t.Errorf("addModerator failed with error: %v", err)
+	}
+
+	// Than actually delete the added moderator
+	err = new(Moderator).Delete(999, 3)
+	if err != nil {
+		t.Errorf("deleteModerator failed with error: %v", err)
+	}
+}
+
+func TestIsInstructor(t *testing.T) {
+
+	// First delete any existing moderator
+	err := new(Moderator).Delete(999, 3)
+	if err != nil {
+		t.Errorf("deleteModerator failed with error: %v", err)
+	}
+
+	// Add a moderator with a known user ID and section ID
+	_, err = new(Moderator).Add(999, 3, "instructor")
+	if err != nil {
+		t.Errorf("addModerator failed with error: %v", err)
+	}
+
+	// Check if a user with a known user ID is an instructor
+	result, err := new(Moderator).IsInstructor(999)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result {
+		t.Fatalf("Expected user to be an instructor, but got %v", result)
+	}
+
+	// Than actually delete the added moderator
+	err = new(Moderator).Delete(999, 3)
+	if err != nil {
+		t.Errorf("deleteModerator failed with error: %v", err)
+	}
+}
+
+func TestCountByInstructor(t *testing.T) {
+	// Count the number of courses and sections taught by an instructor with a known user ID
+	_, err := new(Course).CountByInstructor(3)
+	if err != nil {
+		fmt.Println(err)
+	}
+	_, err = new(Section).CountByInstructor(3)
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+func TestGetCourseSectionsByIntructor(t *testing.T) {
+	// Get the sections taught by an instructor with a known user ID
+	_, err := new(Course).GetCourseSectionsByIntructor(3)
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+func TestHasVoted(t *testing.T) {
+
+	// Check if a user has voted for a question with a known question ID and user ID
+	result, err := new(Question).HasVoted(1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result {
+		t.Fatalf("Expected user to have voted, but got %v", result)
+	}
+}
+
+func TestHasVotedAll(t *testing.T) {
+
+	// Get the userHasVoted slice from the database
+	result, err := new(Question).HasVotedAll(3, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result == nil {
+		t.Fatalf("Expected slice of structs with userHasVoted and a question id, but got %v", result)
+	}
+}
+
+func TestHasVotedAppend(t *testing.T) {
+
+	// Get the questions from the database by the created at time
+	questionsByTimeSlice, err := new(Question).GetAllQuestions(3, "created_at", 300)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Get the userHasVoted slice from the database
+	userHasVotedSlice, err := new(Question).HasVotedAll(3, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Append the userHasVoted slice to the questionsByTime slice
+	result := new(Question).HasVotedAppend(questionsByTimeSlice, userHasVotedSlice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result == nil {
+		t.Fatalf("Expected questions and voted slice to be combined, but got %v", result)
+	}
+}
+
+func TestGetStatus(t *testing.T) {
+
+	_, err := new(Organization).GetStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+}
+
+func TestGetOrganizationID(t *testing.T) {
+
+	// Get the organization ID from the database
+	_, err := new(User).GetOrganizationID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+}
+
+func TestUpdateOrganizationName(t *testing.T) {
+
+	// Get the organization ID from the database
+	ID, err := new(User).GetOrganizationID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Update the organization name in the database
+	err = new(Organization).UpdateName(ID, "Test Organization Name")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+}
+
+func TestUpdateOrganizationTime(t *testing.T) {
+
+	// Get the organization ID from the database
+	ID, err := new(User).GetOrganizationID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Update the organization timezone in the database
+	err = new(Organization).UpdateTimeZone(ID, "0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+}
+
+func TestUpdateOrganizationUpdateAPIKeyAndEmail(t *testing.T) {
+
+	// Get the organization ID from the database
+	ID, err := new(User).GetOrganizationID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Update the organization timezone in the database
+	err = new(Organization).UpdateAPIKeyAndEmail(ID, "Test API Key", "Test Email")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+}
+
+func TestAddUserToOrganization(t *testing.T) {
+
+	// Add a user to an organization with a known user ID and organization ID
+	err := new(User).AddUserToOrganization(1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+}
+
+func TestGetOrganization(t *testing.T) {
+
+	// Add an organization to the database
+	ID, err := new(Organization).Add("Test Organization", "0", "logo.svg", "1234", "user@email")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Get the organization from the database
+	organization, err := new(Organization).Get(ID)
+	if err != nil {
+		t.Fatal(err)
+		t.Fatalf("Expected organization, but got %v", organization)
+	}
+}
+
+func TestAddOrganization(t *testing.T) {
+
+	// Add an organization to the database
+	_, err := new(Organization).Add("Test Organization", "0", "logo.svg", "1234", "user@email")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+}
+
+func TestSetAdmin(t *testing.T) {
+
+	// Set a user as an admin with a known user ID
+	err := new(Organization).SetAdmin(999)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Remove a user as an admin with a known user ID
+	err = new(Organization).DeleteAdmin(999)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetAdminID(t *testing.T) {
+
+	// Set a user as an admin with a known user ID
+	err := new(Organization).SetAdmin(999)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Get the admin ID from the database
+	_, err = new(Organization).GetAdminID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Remove a user as an admin with a known user ID
+	err = new(Organization).DeleteAdmin(999)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAdminDelete(t *testing.T) {
+
+	// Delete the user with a known user ID
+	err := new(Moderator).AdminDelete(999)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Add a user with a known user ID
+	_, err = new(Moderator).AdminAdd(999, "teacher assistant")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Delete the user with a known user ID
+	err = new(Moderator).AdminDelete(999)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAdminAdd(t *testing.T) {
+
+	err := new(Moderator).AdminDelete(999)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = new(Moderator).AdminAdd(999, "teacher assistant")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = new(Moderator).AdminDelete(999)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAdminUpdate(t *testing.T) {
+
+	err := new(Moderator).AdminDelete(999)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Add a user with a known user ID
+	_, err = new(Moderator).AdminAdd(999, "teacher assistant")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Update the user with a known user ID
+	_, err = new(Moderator).AdminUpdate(999, "instructor")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = new(Moderator).AdminDelete(999)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCreateToken(t *testing.T) {
+
+	_, err := new(VerifyUser).CreateToken("whalencollin@gmail.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+}
+
+func TestMatchToken(t *testing.T) {
+
+	ID, err := new(VerifyUser).CreateToken("whalencollin@gmail.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := new(VerifyUser).GetToken(ID)
+
+	bool, _, err := new(VerifyUser).MatchToken(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bool == false {
+		t.Fatal("Expected true, but got false")
+	}
+}
+
+func TestDeleteToken(t *testing.T) {
+
+	// Create a token
+	ID, err := new(VerifyUser).CreateToken("whalencollin@gmail.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Delete the token
+	err = new(VerifyUser).DeleteToken(ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+}
+
+func TestCheckAPIKey(t *testing.T) {
+
+	// CheckAPIKey function to check if the API key exists true or false is considered a pass
+	_, err := new(Organization).CheckAPIKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUpdatePassword(t *testing.T) {
+
+	// Update the user's password
+	err := new(User).UpdatePassword(999, "Ch0ngeme!")
+	if err != nil {
+		fmt.Println(err)
+	}
+
+}
+
+func TestAttendanceAdd(t *testing.T) {
+
+	// Add a user to the attendance table
+	_, err := new(Attendance).Add(3, 1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAttendanceAddUserAttendance(t *testing.T) {
+
+	// Add a user to the attendance table
+	ID, err := new(Attendance).Add(3, 1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//convert ID from int64 to int
+	IDint := int(ID)
+
+	// Add a user to the user attendance table
+	_, err = new(Attendance).AddUserAttendance(999, IDint, "present")
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAttendanceGetByClassSession(t *testing.T) {
+
+	// Get the attendance for a class session
+	_, err := new(Attendance).GetByClassSession(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetAttendanceIDBySectionID(t *testing.T) {
+
+	_, err := new(Attendance).Add(3, 3, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Get the attendance ID for a section
+	_, err = new(Attendance).GetAttendanceIDBySectionID(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAttendanceGetByUser(t *testing.T) {
+
+	// Add a user to the attendance table
+	ID, err := new(Attendance).Add(3, 1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//convert ID from int64 to int
+	IDint := int(ID)
+
+	// Add a user to the user attendance table
+	_, err = new(Attendance).AddUserAttendance(999, IDint, "present")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Get the attendance for a user
+	_, err = new(Attendance).GetByUser(999, IDint)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUpdateUserAttendance(t *testing.T) {
+	db := DB()
+
+	// Add a user to the attendance table
+	ID, err := new(Attendance).Add(3, 1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Exec("DELETE FROM attendance WHERE id = $1", ID)
+	defer db.Exec("DELETE FROM user_attendance WHERE attendance_id = $1", ID)
+	defer db.Exec("DELETE FROM attendance_status_change WHERE attendance_id = $1", ID)
+
+	// Add a user to the user attendance table
+	_, err = new(Attendance).AddUserAttendance(ID, 999, "absent")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Update a user's attendance
+	err = new(Attendance).UpdateUserAttendance(999, ID, "present", 3, "signed the sheet")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The change is in the audit trail
+	changes, err := new(StatusChange).GetStatusChanges(ID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].UserID != 999 || changes[0].OldStatus != "absent" || changes[0].NewStatus != "present" ||
+		changes[0].ChangedBy != 3 || changes[0].Source != StatusSourceManual || changes[0].Reason != "signed the sheet" {
+		t.Errorf("status changes = %+v", changes)
+	}
+}
+
+func TestAttendanceDeleteAll(t *testing.T) {
+
+	// Add a user to the attendance table
+	ID, err := new(Attendance).Add(3, 1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//convert ID from int64 to int
+	IDint := int(ID)
+
+	// Add a user to the user attendance table
+	_, err = new(Attendance).AddUserAttendance(999, IDint, "absent")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Delete all attendance
+	err = new(Attendance).DeleteAll(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAttendanceDeleteUserAttendance(t *testing.T) {
+
+	// Add a user to the attendance table
+	ID, err := new(Attendance).Add(3, 1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//convert ID from int64 to int
+	IDint := int(ID)
+
+	// Add a user to the user attendance table
+	_, err = new(Attendance).AddUserAttendance(999, IDint, "absent")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Delete a user's attendance
+	err = new(Attendance).DeleteUserAttendance(999)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetByInstructor(t *testing.T) {
+
+	// Get the attendance for an instructor
+	_, err := new(Attendance).GetByInstructor(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetCoursesByInstructor(t *testing.T) {
+
+	// Get the courses for an instructor
+	_, err := new(CourseSection).GetCoursesByInstructor(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetSectionsByInstructorAndCourse(t *testing.T) {
+
+	// Get the sections for an instructor and course
+	_, err := new(CourseSection).GetSectionsByInstructorAndCourse(3, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetStudentsByAttendance(t *testing.T) {
+
+	// Get the students by attendance
+	_, err := new(AttendanceRecord).GetStudentsByAttendance(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDBShared(t *testing.T) {
+
+	// Every model call should reuse the same pool
+	if DB() != DB() {
+		t.Fatal("Expected DB to return the shared handle")
+	}
+}
+
+func TestDBConcurrentVotes(t *testing.T) {
+	q := new(Question)
+
+	questionID, err := q.PostQuestion(1, 1, "concurrent votes")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Vote from many goroutines at once to exercise the busy timeout
+	var wg sync.WaitGroup
+	errs := make(chan error, 50)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(userID int) {
+			defer wg.Done()
+			if _, err := new(User).Get(1); err != nil {
+				errs <- err
+				return
+			}
+			_, err := DB().Exec("INSERT INTO vote VALUES (NULL, $1, $2)", questionID, userID)
+			if err != nil {
+				errs <- err
+			}
+		}(1000 + i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Fatal(err)
+	}
+
+	// Clean up the test data
+	DB().Exec("DELETE FROM vote WHERE question_id = $1", questionID)
+	DB().Exec("DELETE FROM question WHERE id = $1", questionID)
+}
+
+func TestMigrate(t *testing.T) {
+	db := DB()
+
+	// The shared handle is migrated when it is opened
+	version, err := SchemaVersion(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != LatestSchemaVersion() {
+		t.Fatalf("Expected schema version %d, but got %d", LatestSchemaVersion(), version)
+	}
+
+	// A dry run on an up to date database has nothing to apply
+	pending, err := Migrate(db, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 0 {
+		t.Fatalf("Expected no pending migrations, but got %d", len(pending))
+	}
+}
+
+func TestDryRunMigrations(t *testing.T) {
+	// A database that doesn't exist has every migration pending, and is not created
+	cfg := DefaultDBConfig()
+	cfg.Filename = filepath.Join(t.TempDir(), "missing.db")
+	pending, err := DryRunMigrations(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != len(migrations) {
+		t.Fatalf("Expected %d pending migrations, but got %d", len(migrations), len(pending))
+	}
+	if _, err := os.Stat(cfg.Filename); !os.IsNotExist(err) {
+		t.Fatalf("Expected the dry run not to create %s, but got %v", cfg.Filename, err)
+	}
+
+	// An empty file is not seeded
+	if err := os.WriteFile(cfg.Filename, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	pending, err = DryRunMigrations(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != len(migrations) {
+		t.Fatalf("Expected %d pending migrations, but got %d", len(migrations), len(pending))
+	}
+	if info, err := os.Stat(cfg.Filename); err != nil || info.Size() != 0 {
+		t.Fatalf("Expected the dry run to leave the empty file alone, but got %v %v", info, err)
+	}
+
+	// The shared database is up to date, and is opened read only
+	pending, err = DryRunMigrations(DefaultDBConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 0 {
+		t.Fatalf("Expected no pending migrations, but got %d", len(pending))
+	}
+	cfg = DefaultDBConfig()
+	cfg.ReadOnly = true
+	db, err := OpenDB(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := db.Exec("INSERT INTO schema_version (version) VALUES ($1)", LatestSchemaVersion()+1); err == nil {
+		t.Fatal("Expected a read only database to refuse writes")
+	}
+}
+
+func TestMigrateSchemaTooNew(t *testing.T) {
+	db := DB()
+
+	// Pretend a newer binary migrated the database
+	newer := LatestSchemaVersion() + 1
+	_, err := db.Exec("INSERT INTO schema_version (version) VALUES ($1)", newer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Exec("DELETE FROM schema_version WHERE version = $1", newer)
+
+	_, err = Migrate(db, false)
+	if !errors.Is(err, ErrSchemaTooNew) {
+		t.Fatalf("Expected ErrSchemaTooNew, but got %v", err)
+	}
+}
+
+func TestPlaceholderNumbers(t *testing.T) {
+	// SQLite treats $n as a name and numbers the names in the order they first appear,
+	// so the arguments only line up when a query uses $1, $2, ... in that order.
+	// Tests run from the repository root.
+	fset := token.NewFileSet()
+	packages, err := parser.ParseDir(fset, "models", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Placeholders, but not the $2a$10$ of password hashes
+	placeholder := regexp.MustCompile(`(?:^|[^\w$])\$(\d+)\b`)
+	for _, pkg := range packages {
+		for _, file := range pkg.Files {
+			ast.Inspect(file, func(n ast.Node) bool {
+				// A query concatenated from several literals is checked as a whole
+				var query strings.Builder
+				switch n := n.(type) {
+				case *ast.BinaryExpr:
+					if n.Op != token.ADD {
+						return true
+					}
+					ast.Inspect(n, func(n ast.Node) bool {
+						if literal, ok := n.(*ast.BasicLit); ok && literal.Kind == token.STRING {
+							query.WriteString(literal.Value)
+						}
+						return true
+					})
+				case *ast.BasicLit:
+					if n.Kind != token.STRING {
+						return true
+					}
+					query.WriteString(n.Value)
+				default:
+					return true
+				}
+
+				var order []int
+				seen := map[int]bool{}
+				for _, match := range placeholder.FindAllStringSubmatch(query.String(), -1) {
+					number, _ := strconv.Atoi(match[1])
+					if !seen[number] {
+						seen[number] = true
+						order = append(order, number)
+					}
+				}
+				for i, number := range order {
+					if number != i+1 {
+						t.Errorf("%s: placeholders first appear as %v, want $1 to $%d in order", fset.Position(n.Pos()), order, len(order))
+						break
+					}
+				}
+				return false
+			})
+		}
+	}
+}
+
+func TestParseSchedule(t *testing.T) {
+	tests := []struct {
+		day, timeslot string
+		days          []time.Weekday
+		start, end    string
+	}{
+		{"M W F", "11:15 AM-12:05 PM", []time.Weekday{time.Monday, time.Wednesday, time.Friday}, "11:15", "12:05"},
+		{"Tu", "06:00 PM-08:00 PM", []time.Weekday{time.Tuesday}, "18:00", "20:00"},
+		{"TTh", "10:10 AM-11:00 AM ", []time.Weekday{time.Tuesday, time.Thursday}, "10:10", "11:00"},
+		{"Monday, Wednesday", "11 - 12pm", []time.Weekday{time.Monday, time.Wednesday}, "11:00", "12:00"},
+		{"MWF", "18:00-20:00", []time.Weekday{time.Monday, time.Wednesday, time.Friday}, "18:00", "20:00"},
+	}
+
+	for _, tt := range tests {
+		schedule, err := ParseSchedule(tt.day, tt.timeslot)
+		if err != nil {
+			t.Errorf("ParseSchedule(%q, %q) failed with error: %v", tt.day, tt.timeslot, err)
+			continue
+		}
+		if fmt.Sprint(schedule.Weekdays) != fmt.Sprint(tt.days) || schedule.StartTime != tt.start || schedule.EndTime != tt.end {
+			t.Errorf("ParseSchedule(%q, %q) = %v %s-%s, want %v %s-%s", tt.day, tt.timeslot, schedule.Weekdays, schedule.StartTime, schedule.EndTime, tt.days, tt.start, tt.end)
+		}
+	}
+
+	for _, bad := range [][2]string{{"Xy", "1pm-2pm"}, {"M", "2pm-1pm"}, {"M", "noon"}} {
+		if _, err := ParseSchedule(bad[0], bad[1]); err == nil {
+			t.Errorf("ParseSchedule(%q, %q) succeeded, want error", bad[0], bad[1])
+		}
+	}
+}
+
+func TestScheduleValidate(t *testing.T) {
+	valid := Schedule{Weekdays: []time.Weekday{time.Monday}, StartTime: "09:00", EndTime: "10:15", Room: "Keller 3-210"}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Validate(%+v) failed with error: %v", valid, err)
+	}
+	if valid.Days() != "M" || valid.Timeslot() != "09:00 AM-10:15 AM" || valid.WeekdayCodes() != "MO" {
+		t.Errorf("display text = %q %q %q", valid.Days(), valid.Timeslot(), valid.WeekdayCodes())
+	}
+
+	invalid := []Schedule{
+		{StartTime: "09:00", EndTime: "10:00"},
+		{Weekdays: []time.Weekday{time.Monday}, StartTime: "9am", EndTime: "10:00"},
+		{Weekdays: []time.Weekday{time.Monday}, StartTime: "10:00", EndTime: "09:00"},
+		{Weekdays: []time.Weekday{7}, StartTime: "09:00", EndTime: "10:00"},
+	}
+	for _, schedule := range invalid {
+		if err := schedule.Validate(); err == nil {
+			t.Errorf("Validate(%+v) succeeded, want error", schedule)
+		}
+	}
+}
+
+func TestScheduleOccurrences(t *testing.T) {
+	loc := time.FixedZone("", -300*60)
+	schedule := Schedule{
+		ID:        1,
+		SectionID: 2,
+		Weekdays:  []time.Weekday{time.Monday, time.Wednesday},
+		StartTime: "18:30",
+		EndTime:   "21:00",
+		Room:      "Lind 229",
+		TermStart: "2023-03-01",
+		TermEnd:   "2023-03-15",
+		Holidays:  []string{"2023-03-08"},
+	}
+
+	occurrences := schedule.Occurrences(time.Date(2023, 2, 1, 0, 0, 0, 0, loc), time.Date(2023, 4, 1, 0, 0, 0, 0, loc), loc)
+
+	// Wednesday 1, Monday 6, Monday 13 and Wednesday 15, skipping the holiday on the 8th
+	var starts []string
+	for _, o := range occurrences {
+		starts = append(starts, o.Start.Format("Jan 2 15:04"))
+		if o.End.Sub(o.Start) != 150*time.Minute || o.Room != "Lind 229" || o.SectionID != 2 {
+			t.Errorf("occurrence = %+v", o)
+		}
+	}
+	want := "[Mar 1 18:30 Mar 6 18:30 Mar 13 18:30 Mar 15 18:30]"
+	if fmt.Sprint(starts) != want {
+		t.Errorf("Occurrences = %v, want %v", starts, want)
+	}
+
+	// Term dates entered in the wrong order still bound the meetings
+	schedule.TermStart, schedule.TermEnd = schedule.TermEnd, schedule.TermStart
+	if got := len(schedule.Occurrences(time.Date(2023, 2, 1, 0, 0, 0, 0, loc), time.Date(2023, 4, 1, 0, 0, 0, 0, loc), loc)); got != 4 {
+		t.Errorf("Occurrences with swapped term = %d, want 4", got)
+	}
+}
+
+func TestScheduleCRUD(t *testing.T) {
+	scheduleID, err := new(Schedule).Add(Schedule{SectionID: 3, Weekdays: []time.Weekday{time.Tuesday, time.Thursday}, StartTime: "13:00", EndTime: "14:15", Room: "Room 1"})
+	if err != nil {
+		t.Fatalf("add failed with error: %v", err)
+	}
+	defer new(Schedule).Delete(scheduleID)
+
+	_, err = new(Schedule).Add(Schedule{SectionID: 3, StartTime: "13:00", EndTime: "14:15"})
+	if err == nil {
+		t.Errorf("add without weekdays succeeded")
+	}
+
+	schedule, err := new(Schedule).Get(scheduleID)
+	if err != nil || schedule.WeekdayCodes() != "TU,TH" || schedule.Room != "Room 1" || schedule.TermStart == "" {
+		t.Fatalf("get returned %+v, %v", schedule, err)
+	}
+
+	schedule.Room = "Room 2"
+	schedule.EndTime = "14:30"
+	err = new(Schedule).Update(schedule)
+	if err != nil {
+		t.Fatalf("update failed with error: %v", err)
+	}
+
+	holidayID, err := new(Holiday).Add(3, "2023-03-07", "Spring break")
+	if err != nil {
+		t.Fatalf("add holiday failed with error: %v", err)
+	}
+	defer new(Holiday).Delete(3, holidayID)
+
+	if _, err := new(Holiday).Add(3, "March 7", ""); err == nil {
+		t.Errorf("add holiday with an invalid date succeeded")
+	}
+
+	schedules, err := new(Schedule).GetBySectionID(3)
+	if err != nil {
+		t.Fatalf("getBySectionID failed with error: %v", err)
+	}
+	found := false
+	for _, s := range schedules {
+		if s.ID == scheduleID {
+			found = s.Room == "Room 2" && s.EndTime == "14:30" && fmt.Sprint(s.Holidays) == "[2023-03-07]"
+		}
+	}
+	if !found {
+		t.Errorf("updated schedule %d missing from %+v", scheduleID, schedules)
+	}
+
+	_, err = new(Schedule).GetOccurrences(3, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Errorf("getOccurrences failed with error: %v", err)
+	}
+}
+
+func TestOrganizationLocation(t *testing.T) {
+	_, offset := time.Date(2023, 1, 1, 0, 0, 0, 0, Organization{OrganizationTimezone: "-300"}.Location()).Zone()
+	if offset != -300*60 {
+		t.Errorf("Location offset = %d, want %d", offset, -300*60)
+	}
+
+	if (Organization{}).Location() != time.UTC {
+		t.Errorf("Location without a timezone is not UTC")
+	}
+}
+
+func TestStartedSince(t *testing.T) {
+	before := time.Now().Add(-time.Minute)
+
+	classSessionID, err := new(ClassSession).Start(6)
+	if err != nil {
+		t.Fatalf("start failed with error: %v", err)
+	}
+	defer new(ClassSession).End(classSessionID)
+
+	started, err := new(ClassSession).StartedSince(6, before)
+	if err != nil || !started {
+		t.Errorf("StartedSince = %v, %v; want true", started, err)
+	}
+
+	started, err = new(ClassSession).StartedSince(6, time.Now().Add(time.Hour))
+	if err != nil || started {
+		t.Errorf("StartedSince in the future = %v, %v; want false", started, err)
+	}
+}
+
+func TestCalendarToken(t *testing.T) {
+	token, err := new(CalendarToken).GetByUserID(22)
+	if err != nil || len(token) != 40 {
+		t.Fatalf("getByUserID returned %q, %v", token, err)
+	}
+
+	again, err := new(CalendarToken).GetByUserID(22)
+	if err != nil || again != token {
+		t.Errorf("getByUserID returned %q, want the existing token %q", again, token)
+	}
+
+	userID, err := new(CalendarToken).GetUserID(token)
+	if err != nil || userID != 22 {
+		t.Errorf("getUserID returned %d, %v", userID, err)
+	}
+
+	reset, err := new(CalendarToken).Reset(22)
+	if err != nil || reset == token {
+		t.Fatalf("reset returned %q, %v", reset, err)
+	}
+
+	_, err = new(CalendarToken).GetUserID(token)
+	if err != sql.ErrNoRows {
+		t.Errorf("old token still valid, err = %v", err)
+	}
+}
+
+func TestCalendar(t *testing.T) {
+	loc := time.FixedZone("", -300*60)
+	events := []CalendarEvent{{
+		Summary: "CS 101 Intro, Programming - Section 1",
+		URL:     "https://coeus.example.edu/class-session/2/7",
+		Schedule: Schedule{
+			ID:        1,
+			SectionID: 2,
+			Weekdays:  []time.Weekday{time.Monday, time.Wednesday},
+			StartTime: "18:30",
+			EndTime:   "21:00",
+			Room:      "Lind 229",
+			TermStart: "2023-03-01",
+			TermEnd:   "2023-03-15",
+			Holidays:  []string{"2023-03-08", "2023-03-09"},
+		},
+	}}
+
+	ics := Calendar("Coeus classes", events, loc, time.Date(2023, 2, 1, 12, 0, 0, 0, time.UTC))
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"TZID:Coeus-0500\r\n",
+		"TZOFFSETTO:-0500\r\n",
+		"DTSTAMP:20230201T120000Z\r\n",
+		"DTSTART;TZID=Coeus-0500:20230301T183000\r\n",
+		"DTEND;TZID=Coeus-0500:20230301T210000\r\n",
+		"RRULE:FREQ=WEEKLY;BYDAY=MO,WE;UNTIL=20230316T045959Z\r\n",
+		"EXDATE;TZID=Coeus-0500:20230308T183000\r\n",
+		"SUMMARY:CS 101 Intro\\, Programming - Section 1\r\n",
+		"LOCATION:Lind 229\r\n",
+		"URL:https://coeus.example.edu/class-session/2/7\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(ics, want) {
+			t.Errorf("calendar is missing %q:\n%s", want, ics)
+		}
+	}
+
+	// The holiday on a Thursday is not a meeting day
+	if strings.Contains(ics, "20230309") {
+		t.Errorf("calendar excludes a date the section doesn't meet on:\n%s", ics)
+	}
+
+	for _, line := range strings.Split(ics, "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("line longer than 75 octets: %q", line)
+		}
+	}
+}
+
+func TestFoldCalendarLine(t *testing.T) {
+	line := "DESCRIPTION:" + strings.Repeat("é", 60)
+	folded := foldCalendarLine(line)
+
+	if strings.ReplaceAll(folded, "\r\n ", "") != line+"\r\n" {
+		t.Errorf("unfolding %q does not give back the line", folded)
+	}
+	for _, part := range strings.Split(strings.TrimSuffix(folded, "\r\n"), "\r\n") {
+		if len(part) > 75 || !utf8.ValidString(part) {
+			t.Errorf("bad folded line %q", part)
+		}
+	}
+}
+
+func TestPostAnswer(t *testing.T) {
+	db := DB()
+
+	// Class session 3 belongs to section 3, where user 3 is the instructor
+	questionID, err := new(Question).PostQuestion(1, 3, "What is a pointer?")
+	if err != nil {
+		t.Fatalf("postQuestion failed with error: %v", err)
+	}
+	defer db.Exec("DELETE FROM question WHERE id = $1", questionID)
+	defer db.Exec("DELETE FROM answer WHERE question_id = $1", questionID)
+
+	answerID, err := new(Answer).PostAnswer(questionID, 3, "A variable that holds an address.")
+	if err != nil {
+		t.Fatalf("postAnswer failed with error: %v", err)
+	}
+
+	question, err := new(Question).GetByID(questionID, 0)
+	if err != nil || !question.Answered {
+		t.Errorf("question %d not marked answered: %+v, %v", questionID, question, err)
+	}
+
+	answer, err := new(Answer).GetByID(answerID, 0)
+	if err != nil {
+		t.Fatalf("getByID failed with error: %v", err)
+	}
+	if answer.QuestionID != questionID || answer.UserID != 3 || answer.ModeratorType != "instructor" || answer.FirstName == "" {
+		t.Errorf("getByID returned %+v", answer)
+	}
+
+	answers, err := new(Answer).GetByClassSessionID(3, 0)
+	if err != nil {
+		t.Fatalf("getByClassSessionID failed with error: %v", err)
+	}
+	questions := new(Question).AnswersAppend([]Question{{ID: questionID}, {ID: -1}}, answers)
+	if len(questions[0].Answers) != 1 || questions[0].Answers[0].ID != answerID || len(questions[1].Answers) != 0 {
+		t.Errorf("answersAppend returned %+v", questions)
+	}
+}
+
+func TestQuestionModeration(t *testing.T) {
+	db := DB()
+
+	err := new(Section).SetPremoderation(3, true)
+	if err != nil {
+		t.Fatalf("setPremoderation failed with error: %v", err)
+	}
+	defer new(Section).SetPremoderation(3, false)
+
+	premoderation, err := new(Section).GetPremoderation(3)
+	if err != nil || !premoderation {
+		t.Errorf("getPremoderation returned %v, %v", premoderation, err)
+	}
+
+	approvedID, err := new(Question).PostPendingQuestion(1, 3, "Is the exam open book?")
+	if err != nil {
+		t.Fatalf("postPendingQuestion failed with error: %v", err)
+	}
+	defer db.Exec("DELETE FROM question WHERE id = $1", approvedID)
+	rejectedID, err := new(Question).PostPendingQuestion(1, 3, "Off topic")
+	if err != nil {
+		t.Fatalf("postPendingQuestion failed with error: %v", err)
+	}
+	defer db.Exec("DELETE FROM question WHERE id = $1", rejectedID)
+
+	visible := func(questionID int) bool {
+		questions, err := new(Question).GetAllQuestions(3, "created_at", 0)
+		if err != nil {
+			t.Fatalf("getAllQuestions failed with error: %v", err)
+		}
+		for _, q := range questions {
+			if q.ID == questionID {
+				return true
+			}
+		}
+		return false
+	}
+
+	if visible(approvedID) {
+		t.Errorf("pending question %d is visible to the class", approvedID)
+	}
+	pending, err := new(Question).GetPendingQuestions(3, 0)
+	if err != nil || len(pending) < 2 {
+		t.Errorf("getPendingQuestions returned %d questions, %v", len(pending), err)
+	}
+
+	err = new(Question).EditPending(approvedID, "Is the final exam open book?")
+	if err != nil {
+		t.Errorf("editPending failed with error: %v", err)
+	}
+	// The question was asked long ago, and approving it keeps that time
+	DB().Exec("UPDATE question SET created_at = '2000-01-01 09:30:00' WHERE id = $1", approvedID)
+	err = new(Question).Approve(approvedID, 3)
+	if err != nil {
+		t.Fatalf("approve failed with error: %v", err)
+	}
+	if !visible(approvedID) {
+		t.Errorf("approved question %d is not visible to the class", approvedID)
+	}
+	var createdAt string
+	DB().QueryRow("SELECT created_at FROM question WHERE id = $1", approvedID).Scan(&createdAt)
+	if createdAt != "2000-01-01 09:30:00" {
+		t.Errorf("approving moved the time the question was asked to %s", createdAt)
+	}
+	// It still comes first in the feed by time, as the latest to reach the class
+	if questions, _ := new(Question).GetAllQuestions(3, "created_at", 0); len(questions) == 0 || questions[0].ID != approvedID || questions[0].CreatedAt != "09:30" {
+		t.Errorf("the approved question isn't first in the feed by time")
+	}
+	if questions, _ := new(Question).GetUnansweredQuestions(3, 0, "time"); len(questions) == 0 || questions[0].ID != approvedID {
+		t.Errorf("the approved question isn't first in the unanswered feed by time")
+	}
+	question, _ := new(Question).GetByID(approvedID, 0)
+	if question.Text != "Is the final exam open book?" || question.Status != QuestionApproved {
+		t.Errorf("approved question = %+v", question)
+	}
+
+	err = new(Question).Reject(rejectedID, 3, "Please ask about the course")
+	if err != nil {
+		t.Fatalf("reject failed with error: %v", err)
+	}
+	question, _ = new(Question).GetByID(rejectedID, 0)
+	if visible(rejectedID) || question.Status != QuestionRejected || question.RejectionReason != "Please ask about the course" {
+		t.Errorf("rejected question = %+v", question)
+	}
+
+	// A question can only leave the queue once
+	if err := new(Question).Approve(rejectedID, 3); err != ErrQuestionNotPending {
+		t.Errorf("approving a rejected question returned %v, want ErrQuestionNotPending", err)
+	}
+	if err := new(Question).EditPending(approvedID, "changed"); err != ErrQuestionNotPending {
+		t.Errorf("editing an approved question returned %v, want ErrQuestionNotPending", err)
+	}
+}
+
+func TestQuestionSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b    string
+		similar bool
+	}{
+		{"When is the midterm?", "When is the midterm?", true},
+		{"When is the midterm exam?", "what day is the midterm exam", true},
+		{"Can you explain pointers again?", "Could you explain pointer again", true},
+		{"Is the homework due friday?", "Is homwork due Friday?", true},
+		{"When is the midterm?", "How do I install the compiler?", false},
+		{"What is recursion?", "Where are the slides posted?", false},
+	}
+
+	for _, test := range tests {
+		score := QuestionSimilarity(test.a, test.b)
+		if (score >= DuplicateThreshold) != test.similar {
+			t.Errorf("questionSimilarity(%q, %q) = %.2f, want similar %v", test.a, test.b, score, test.similar)
+		}
+	}
+
+	if score := QuestionSimilarity("When is the midterm?", "when is the MIDTERM"); score != 1 {
+		t.Errorf("identical questions scored %.2f, want 1", score)
+	}
+}
+
+func TestFindSimilar(t *testing.T) {
+	db := DB()
+
+	questionID, err := new(Question).PostQuestion(1, 3, "Will the quiz cover chapter seven?")
+	if err != nil {
+		t.Fatalf("postQuestion failed with error: %v", err)
+	}
+	defer db.Exec("DELETE FROM question WHERE id = $1", questionID)
+
+	similar, err := new(Question).FindSimilar(3, "does the quiz cover chapter 7 or chapter seven", 0)
+	if err != nil {
+		t.Fatalf("findSimilar failed with error: %v", err)
+	}
+	if len(similar) == 0 || similar[0].ID != questionID {
+		t.Errorf("findSimilar returned %+v, want question %d first", similar, questionID)
+	}
+
+	similar, err = new(Question).FindSimilar(3, "Will the quiz cover chapter seven?", questionID)
+	if err != nil {
+		t.Fatalf("findSimilar failed with error: %v", err)
+	}
+	for _, q := range similar {
+		if q.ID == questionID {
+			t.Errorf("findSimilar returned the excluded question %d", questionID)
+		}
+	}
+}
+
+func TestMergeQuestions(t *testing.T) {
+	db := DB()
+
+	targetID, err := new(Question).PostQuestion(1, 3, "When are office hours?")
+	if err != nil {
+		t.Fatalf("postQuestion failed with error: %v", err)
+	}
+	defer db.Exec("DELETE FROM question WHERE id = $1", targetID)
+	sourceID, err := new(Question).PostQuestion(3, 3, "What time are office hours on Thursday?")
+	if err != nil {
+		t.Fatalf("postQuestion failed with error: %v", err)
+	}
+	defer db.Exec("DELETE FROM question WHERE id = $1", sourceID)
+	defer db.Exec("DELETE FROM vote WHERE question_id IN ($1, $2)", targetID, sourceID)
+
+	// User 1 voted for both questions, users 3 and 22 only for the source
+	for _, vote := range []struct{ questionID, userID int }{
+		{targetID, 1}, {sourceID, 1}, {sourceID, 3}, {sourceID, 22},
+	} {
+		if err := new(Question).VoteQuestion(vote.questionID, vote.userID); err != nil {
+			t.Fatalf("voteQuestion failed with error: %v", err)
+		}
+	}
+
+	answerID, err := new(Answer).PostAnswer(sourceID, 3, "Thursdays at 2pm")
+	if err != nil {
+		t.Fatalf("postAnswer failed with error: %v", err)
+	}
+	defer db.Exec("DELETE FROM answer WHERE id = $1", answerID)
+
+	if _, err := new(Question).Merge(sourceID, sourceID, ""); err != ErrInvalidMerge {
+		t.Errorf("merging a question into itself returned %v, want ErrInvalidMerge", err)
+	}
+
+	merged, err := new(Question).Merge(sourceID, targetID, "")
+	if err != nil {
+		t.Fatalf("merge failed with error: %v", err)
+	}
+	if merged.Votes != 3 || !merged.Answered {
+		t.Errorf("merged question has %d votes and answered %v, want 3 and true", merged.Votes, merged.Answered)
+	}
+	if merged.Text != "When are office hours? / What time are office hours on Thursday?" {
+		t.Errorf("merged question text = %q", merged.Text)
+	}
+
+	var votes int
+	db.QueryRow("SELECT COUNT(*) FROM vote WHERE question_id = $1", targetID).Scan(&votes)
+	if votes != 3 {
+		t.Errorf("target has %d vote rows, want 3", votes)
+	}
+	db.QueryRow("SELECT COUNT(*) FROM vote WHERE question_id = $1", sourceID).Scan(&votes)
+	if votes != 0 {
+		t.Errorf("source has %d vote rows left, want 0", votes)
+	}
+
+	answer, err := new(Answer).GetByID(answerID, 0)
+	if err != nil || answer.QuestionID != targetID {
+		t.Errorf("answer = %+v, %v, want it moved to question %d", answer, err, targetID)
+	}
+
+	source, _ := new(Question).GetByID(sourceID, 0)
+	if source.Status != QuestionMerged {
+		t.Errorf("source status = %q, want %q", source.Status, QuestionMerged)
+	}
+	questions, _ := new(Question).GetAllQuestions(3, "created_at", 0)
+	for _, q := range questions {
+		if q.ID == sourceID {
+			t.Errorf("merged question %d is still listed", sourceID)
+		}
+	}
+
+	// A merged question can't be merged again
+	if _, err := new(Question).Merge(sourceID, targetID, ""); err != ErrInvalidMerge {
+		t.Errorf("merging a merged question returned %v, want ErrInvalidMerge", err)
+	}
+}
+
+func TestPolls(t *testing.T) {
+	db := DB()
+
+	// Class session 3 belongs to section 3, where user 3 is the instructor and users 1 and 22 are students
+	pollID, err := new(Poll).CreatePoll(3, 3, "Did everyone follow that derivation?", PollYesNo, []string{"ignored"})
+	if err != nil {
+		t.Fatalf("createPoll failed with error: %v", err)
+	}
+	defer db.Exec("DELETE FROM poll WHERE id = $1", pollID)
+	defer db.Exec("DELETE FROM poll_option WHERE poll_id = $1", pollID)
+	defer db.Exec("DELETE FROM poll_response WHERE poll_id = $1", pollID)
+
+	poll, err := new(Poll).GetByID(pollID, 1, 0)
+	if err != nil {
+		t.Fatalf("getByID failed with error: %v", err)
+	}
+	if poll.Status != PollDraft || len(poll.Options) != 2 || poll.Options[0].Text != "Yes" || poll.Options[1].Text != "No" {
+		t.Fatalf("getByID returned %+v", poll)
+	}
+	yes, no := poll.Options[0].ID, poll.Options[1].ID
+
+	// Drafts take no answers
+	if err := new(Poll).Respond(pollID, yes, 1); err != ErrPollNotOpen {
+		t.Errorf("respond to a draft returned %v, want %v", err, ErrPollNotOpen)
+	}
+
+	if err := new(Poll).Open(pollID); err != nil {
+		t.Fatalf("open failed with error: %v", err)
+	}
+	if err := new(Poll).Open(pollID); err != ErrPollStatus {
+		t.Errorf("opening an open poll returned %v, want %v", err, ErrPollStatus)
+	}
+
+	if err := new(Poll).Respond(pollID, yes, 1); err != nil {
+		t.Fatalf("respond failed with error: %v", err)
+	}
+	if err := new(Poll).Respond(pollID, no, 22); err != nil {
+		t.Fatalf("respond failed with error: %v", err)
+	}
+
+	// Each user answers once, with an option of the poll
+	if err := new(Poll).Respond(pollID, no, 1); err != ErrPollAnswered {
+		t.Errorf("second answer returned %v, want %v", err, ErrPollAnswered)
+	}
+	if err := new(Poll).Respond(pollID, -1, 3); err != ErrInvalidPollOption {
+		t.Errorf("answer with another poll's option returned %v, want %v", err, ErrInvalidPollOption)
+	}
+
+	polls, err := new(Poll).GetByClassSessionID(3, 1, 0)
+	if err != nil {
+		t.Fatalf("getByClassSessionID failed with error: %v", err)
+	}
+	poll = polls[len(polls)-1]
+	if poll.ID != pollID || poll.Responses != 2 || poll.Options[0].Votes != 1 || poll.Options[1].Votes != 1 || poll.UserChoice != yes || poll.OpenedAt == "" {
+		t.Errorf("getByClassSessionID returned %+v", poll)
+	}
+
+	// Ending the class session closes its open polls
+	closed, err := new(Poll).CloseAll(3)
+	if err != nil {
+		t.Fatalf("closeAll failed with error: %v", err)
+	}
+	if len(closed) != 1 || closed[0] != pollID {
+		t.Errorf("closeAll closed %v, want [%d]", closed, pollID)
+	}
+	if err := new(Poll).Respond(pollID, yes, 3); err != ErrPollNotOpen {
+		t.Errorf("respond to a closed poll returned %v, want %v", err, ErrPollNotOpen)
+	}
+	if err := new(Poll).Close(pollID); err != ErrPollStatus {
+		t.Errorf("closing a closed poll returned %v, want %v", err, ErrPollStatus)
+	}
+
+	if _, err := new(Poll).GetByID(-1, 1, 0); err != sql.ErrNoRows {
+		t.Errorf("getByID of a missing poll returned %v, want %v", err, sql.ErrNoRows)
+	}
+}
+
+func TestQuizzes(t *testing.T) {
+	db := DB()
+
+	// Class session 3 belongs to section 3, where user 3 is the instructor and users 1 and 22 are students
+	attendanceID, err := new(Attendance).Add(3, 3, 3)
+	if err != nil {
+		t.Fatalf("add attendance failed with error: %v", err)
+	}
+	defer db.Exec("DELETE FROM attendance WHERE id = $1", attendanceID)
+	defer db.Exec("DELETE FROM user_attendance WHERE attendance_id = $1", attendanceID)
+	userAttendanceID, err := new(Attendance).AddUserAttendance(attendanceID, 1, "present")
+	if err != nil {
+		t.Fatalf("addUserAttendance failed with error: %v", err)
+	}
+
+	quizID, err := new(Quiz).CreateQuiz(3, 3, "Sorting", 0, []QuizQuestion{
+		{Text: "Which sort is stable?", Points: 2, Options: []QuizOption{{Text: "Merge sort", Correct: true}, {Text: "Heapsort"}}},
+		{Text: "Quicksort is O(n log n) in the worst case", Points: 1, Options: []QuizOption{{Text: "True"}, {Text: "False", Correct: true}}},
+	})
+	if err != nil {
+		t.Fatalf("createQuiz failed with error: %v", err)
+	}
+	defer db.Exec("DELETE FROM quiz WHERE id = $1", quizID)
+	defer db.Exec("DELETE FROM quiz_question WHERE quiz_id = $1", quizID)
+	defer db.Exec("DELETE FROM quiz_option WHERE question_id IN (SELECT id FROM quiz_question WHERE quiz_id = $1)", quizID)
+	defer db.Exec("DELETE FROM quiz_submission WHERE quiz_id = $1", quizID)
+	defer db.Exec("DELETE FROM quiz_answer WHERE submission_id IN (SELECT id FROM quiz_submission WHERE quiz_id = $1)", quizID)
+
+	quiz, err := new(Quiz).GetByID(quizID, 0)
+	if err != nil {
+		t.Fatalf("getByID failed with error: %v", err)
+	}
+	if quiz.Status != QuizDraft || quiz.MaxScore != 3 || len(quiz.Questions) != 2 || len(quiz.Questions[0].Options) != 2 || !quiz.Questions[1].Options[1].Correct {
+		t.Fatalf("getByID returned %+v", quiz)
+	}
+	stable, heapsort := quiz.Questions[0].Options[0].ID, quiz.Questions[0].Options[1].ID
+	trueOption, falseOption := quiz.Questions[1].Options[0].ID, quiz.Questions[1].Options[1].ID
+	first, second := quiz.Questions[0].ID, quiz.Questions[1].ID
+
+	// Drafts take no submissions
+	if _, err := new(Quiz).Submit(quizID, 1, map[int]int{first: stable}); err != ErrQuizNotOpen {
+		t.Errorf("submitting a draft returned %v, want %v", err, ErrQuizNotOpen)
+	}
+
+	if err := new(Quiz).Open(quizID); err != nil {
+		t.Fatalf("open failed with error: %v", err)
+	}
+	if err := new(Quiz).Open(quizID); err != ErrQuizStatus {
+		t.Errorf("opening an open quiz returned %v, want %v", err, ErrQuizStatus)
+	}
+
+	// Answers must be options of their question
+	if _, err := new(Quiz).Submit(quizID, 1, map[int]int{first: falseOption}); err != ErrInvalidQuizAnswer {
+		t.Errorf("answering with another question's option returned %v, want %v", err, ErrInvalidQuizAnswer)
+	}
+
+	submission, err := new(Quiz).Submit(quizID, 1, map[int]int{first: stable, second: trueOption})
+	if err != nil {
+		t.Fatalf("submit failed with error: %v", err)
+	}
+	if submission.Score != 2 || submission.MaxScore != 3 || submission.UserAttendanceID != int(userAttendanceID) {
+		t.Errorf("submit returned %+v, want a score of 2/3 linked to attendance %d", submission, userAttendanceID)
+	}
+	if _, err := new(Quiz).Submit(quizID, 1, map[int]int{first: heapsort}); err != ErrQuizSubmitted {
+		t.Errorf("second submission returned %v, want %v", err, ErrQuizSubmitted)
+	}
+
+	// A student without attendance is not given a status by their submission, and unanswered questions score nothing
+	submission, err = new(Quiz).Submit(quizID, 22, map[int]int{second: falseOption})
+	if err != nil {
+		t.Fatalf("submit failed with error: %v", err)
+	}
+	if submission.Score != 1 || submission.UserAttendanceID != 0 {
+		t.Errorf("submit returned %+v, want a score of 1 linked to no attendance", submission)
+	}
+	var statuses int
+	db.QueryRow("SELECT COUNT(*) FROM user_attendance WHERE attendance_id = $1 AND user_id = 22", attendanceID).Scan(&statuses)
+	if statuses != 0 {
+		t.Errorf("the submission gave the student %d attendance statuses, want none", statuses)
+	}
+
+	submissions, err := new(QuizSubmission).GetByAttendanceID(attendanceID, 0)
+	if err != nil {
+		t.Fatalf("getByAttendanceID failed with error: %v", err)
+	}
+	if len(submissions) != 2 || submissions[0].AttendanceStatus != "present" || submissions[1].AttendanceStatus != "" {
+		t.Errorf("getByAttendanceID returned %+v", submissions)
+	}
+
+	own, err := new(QuizSubmission).GetByUser(quizID, 1, 0)
+	if err != nil {
+		t.Fatalf("getByUser failed with error: %v", err)
+	}
+	if own.Score != 2 || own.QuizTitle != "Sorting" || own.Answers[first] != stable || own.Answers[second] != trueOption {
+		t.Errorf("getByUser returned %+v", own)
+	}
+	if _, err := new(QuizSubmission).GetByUser(quizID, 3, 0); err != sql.ErrNoRows {
+		t.Errorf("getByUser of a student who didn't submit returned %v, want %v", err, sql.ErrNoRows)
+	}
+
+	// Ending the class session closes its open quizzes
+	closed, err := new(Quiz).CloseAll(3)
+	if err != nil {
+		t.Fatalf("closeAll failed with error: %v", err)
+	}
+	if len(closed) != 1 || closed[0] != quizID {
+		t.Errorf("closeAll closed %v, want [%d]", closed, quizID)
+	}
+	if _, err := new(Quiz).Submit(quizID, 3, map[int]int{}); err != ErrQuizNotOpen {
+		t.Errorf("submitting a closed quiz returned %v, want %v", err, ErrQuizNotOpen)
+	}
+
+	// Every student of the section is in the export, with 0 when they didn't submit
+	scores, err := new(Quiz).GetScoresBySectionID(3, 0)
+	if err != nil {
+		t.Fatalf("getScoresBySectionID failed with error: %v", err)
+	}
+	found := map[int]QuizScore{}
+	for _, score := range scores {
+		if score.QuizID == quizID {
+			found[score.UserID] = score
+		}
+	}
+	if found[1].Score != 2 || !found[1].Submitted || found[22].Score != 1 || found[1].MaxScore != 3 || found[1].Date == "" {
+		t.Errorf("getScoresBySectionID returned %+v and %+v", found[1], found[22])
+	}
+	for userID, score := range found {
+		if userID != 1 && userID != 22 && score.Submitted {
+			t.Errorf("user %d has a submission they didn't make: %+v", userID, score)
+		}
+	}
+}
+
+func TestQuizTimeLimit(t *testing.T) {
+	db := DB()
+
+	quizID, err := new(Quiz).CreateQuiz(3, 3, "Warm up", 60, []QuizQuestion{
+		{Text: "2 + 2", Points: 1, Options: []QuizOption{{Text: "4", Correct: true}, {Text: "5"}}},
+	})
+	if err != nil {
+		t.Fatalf("createQuiz failed with error: %v", err)
+	}
+	defer db.Exec("DELETE FROM quiz WHERE id = $1", quizID)
+	defer db.Exec("DELETE FROM quiz_question WHERE quiz_id = $1", quizID)
+	defer db.Exec("DELETE FROM quiz_option WHERE question_id IN (SELECT id FROM quiz_question WHERE quiz_id = $1)", quizID)
+
+	if err := new(Quiz).Open(quizID); err != nil {
+		t.Fatalf("open failed with error: %v", err)
+	}
+	quiz, err := new(Quiz).GetByID(quizID, 0)
+	if err != nil {
+		t.Fatalf("getByID failed with error: %v", err)
+	}
+	if quiz.Remaining < 55 || quiz.Remaining > 60 {
+		t.Errorf("remaining is %d seconds, want about 60", quiz.Remaining)
+	}
+
+	// Past the time limit and its grace, submissions are refused
+	db.Exec("UPDATE quiz SET closes_at = $1 WHERE id = $2", time.Now().UTC().Add(-QuizGrace-time.Second).Format("2006-01-02 15:04:05"), quizID)
+	if _, err := new(Quiz).Submit(quizID, 1, map[int]int{}); err != ErrQuizTimeUp {
+		t.Errorf("submitting after the time limit returned %v, want %v", err, ErrQuizTimeUp)
+	}
+	if quiz, _ := new(Quiz).GetByID(quizID, 0); quiz.Remaining != 0 {
+		t.Errorf("remaining is %d seconds after the time limit, want 0", quiz.Remaining)
+	}
+}
+
+func TestCheckIn(t *testing.T) {
+	db := DB()
+
+	attendanceID, err := new(Attendance).Add(3, 3, 3)
+	if err != nil {
+		t.Fatalf("add attendance failed with error: %v", err)
+	}
+	defer db.Exec("DELETE FROM attendance WHERE id = $1", attendanceID)
+	defer db.Exec("DELETE FROM check_in WHERE attendance_id = $1", attendanceID)
+	defer db.Exec("DELETE FROM check_in_attempt WHERE attendance_id = $1", attendanceID)
+
+	if _, err := new(CheckIn).GetOpen(attendanceID); err != sql.ErrNoRows {
+		t.Fatalf("getOpen without a check-in returned %v, want %v", err, sql.ErrNoRows)
+	}
+
+	first, err := new(CheckIn).Open(attendanceID, 3, 30)
+	if err != nil {
+		t.Fatalf("open failed with error: %v", err)
+	}
+	// Opening again starts over with a new secret
+	checkIn, err := new(CheckIn).Open(attendanceID, 3, 30)
+	if err != nil {
+		t.Fatalf("open failed with error: %v", err)
+	}
+	open, err := new(CheckIn).GetOpen(attendanceID)
+	if err != nil || open.ID != checkIn.ID || open.Secret == first.Secret {
+		t.Fatalf("getOpen = %v %v, want only the second check-in open", open, err)
+	}
+
+	now := time.Unix(1700000000, 0)
+	code, expiresIn := open.CurrentCode(now)
+	if len(code) != CheckInCodeLength || expiresIn <= 0 || expiresIn > 30*time.Second {
+		t.Errorf("currentCode = %q changing in %v", code, expiresIn)
+	}
+	if other, _ := first.CurrentCode(now); other == code {
+		t.Errorf("both check-ins show the code %q", code)
+	}
+
+	for _, test := range []struct {
+		at   time.Duration
+		want string
+	}{
+		{0, CheckInAccepted},
+		{30 * time.Second, CheckInAccepted},
+		{60 * time.Second, CheckInExpired},
+		{time.Hour, CheckInWrong},
+	} {
+		if got := open.Verify(code, now.Add(test.at)); got != test.want {
+			t.Errorf("code verified %v later = %s, want %s", test.at, got, test.want)
+		}
+	}
+	if got := open.Verify("abc", now); got != CheckInWrong {
+		t.Errorf("verifying a wrong code = %s, want %s", got, CheckInWrong)
+	}
+
+	// Refused codes are counted per check-in and listed with who submitted them
+	for _, result := range []string{CheckInWrong, CheckInExpired, CheckInAccepted} {
+		if err := new(CheckInAttempt).LogAttempt(attendanceID, open.ID, 1, "123456", result); err != nil {
+			t.Fatalf("logAttempt failed with error: %v", err)
+		}
+	}
+	if err := new(CheckInAttempt).LogAttempt(attendanceID, 0, 1, "123456", CheckInClosed); err != nil {
+		t.Fatalf("logAttempt failed with error: %v", err)
+	}
+	if count, err := new(CheckInAttempt).CountRejected(open.ID, 1); err != nil || count != 2 {
+		t.Errorf("countRejected = %d %v, want 2", count, err)
+	}
+	attempts, err := new(CheckInAttempt).GetRejected(attendanceID, 0)
+	if err != nil || len(attempts) != 3 {
+		t.Fatalf("getRejected = %v %v, want 3 attempts", attempts, err)
+	}
+	if attempts[0].Result != CheckInClosed || attempts[0].CheckInID != 0 || attempts[0].Email != "student@coeus.education" {
+		t.Errorf("latest refused attempt = %+v", attempts[0])
+	}
+
+	if closed, err := new(CheckIn).Close(attendanceID); err != nil || !closed {
+		t.Errorf("close = %v %v, want true", closed, err)
+	}
+	if closed, _ := new(CheckIn).Close(attendanceID); closed {
+		t.Error("closing a closed check-in closed one")
+	}
+	if _, err := new(CheckIn).GetOpen(attendanceID); err != sql.ErrNoRows {
+		t.Errorf("getOpen after closing returned %v, want %v", err, sql.ErrNoRows)
+	}
+}
+
+func TestAttendanceStatuses(t *testing.T) {
+	db := DB()
+
+	attendanceID, err := new(Attendance).Add(3, 3, 3)
+	if err != nil {
+		t.Fatalf("add attendance failed with error: %v", err)
+	}
+	defer db.Exec("DELETE FROM attendance WHERE id = $1", attendanceID)
+	defer db.Exec("DELETE FROM user_attendance WHERE attendance_id = $1", attendanceID)
+	defer db.Exec("DELETE FROM attendance_status_change WHERE attendance_id = $1", attendanceID)
+
+	// The students of the section are on the roster before they have a status, the instructor isn't
+	roster, err := new(AttendanceRecord).GetRoster(attendanceID)
+	if err != nil {
+		t.Fatalf("getRoster failed with error: %v", err)
+	}
+	statuses := map[int]string{}
+	for _, record := range roster {
+		statuses[record.ID] = record.Status
+	}
+	if status, ok := statuses[1]; !ok || status != "" || len(statuses) != len(roster) {
+		t.Errorf("roster = %v, want each student once without a status", roster)
+	}
+	if _, ok := statuses[3]; ok {
+		t.Errorf("roster = %v, want the instructor left out", roster)
+	}
+
+	for _, test := range []struct {
+		status    string
+		changedBy int
+		source    string
+		changed   bool
+	}{
+		{AttendanceAbsent, 0, StatusSourceSessionEnd, true},
+		{AttendanceLate, 3, StatusSourceManual, true},
+		{AttendanceLate, 3, StatusSourceManual, false},
+	} {
+		changed, err := new(Attendance).SetUserAttendance(attendanceID, 1, test.status, test.changedBy, test.source, "bus was late")
+		if err != nil || changed != test.changed {
+			t.Errorf("setting %s = %v %v, want %v", test.status, changed, err, test.changed)
+		}
+	}
+	records, err := new(Attendance).GetByUser(1, attendanceID)
+	if err != nil || len(records) != 1 || records[0].Status != AttendanceLate {
+		t.Errorf("user attendance = %v %v, want one late record", records, err)
+	}
+
+	// Each change is audited with who made it, newest first
+	changes, err := new(StatusChange).GetStatusChanges(attendanceID, 0)
+	if err != nil || len(changes) != 2 {
+		t.Fatalf("getStatusChanges = %v %v, want 2 changes", changes, err)
+	}
+	if changes[0].OldStatus != AttendanceAbsent || changes[0].NewStatus != AttendanceLate || changes[0].ChangedBy != 3 ||
+		changes[0].ChangedByFirstName == "" || changes[0].Source != StatusSourceManual || changes[0].Reason != "bus was late" {
+		t.Errorf("latest change = %+v", changes[0])
+	}
+	if changes[1].OldStatus != "" || changes[1].ChangedBy != 0 || changes[1].Source != StatusSourceSessionEnd {
+		t.Errorf("first change = %+v", changes[1])
+	}
+
+	if ValidAttendanceStatus("tardy") || !ValidAttendanceStatus(AttendanceExcused) {
+		t.Errorf("validAttendanceStatus accepts the wrong statuses")
+	}
+}
+
+func TestExcuseRequests(t *testing.T) {
+	db := DB()
+
+	attendanceID, err := new(Attendance).Add(3, 3, 3)
+	if err != nil {
+		t.Fatalf("add attendance failed with error: %v", err)
+	}
+	defer db.Exec("DELETE FROM attendance WHERE id = $1", attendanceID)
+	defer db.Exec("DELETE FROM user_attendance WHERE attendance_id = $1", attendanceID)
+	defer db.Exec("DELETE FROM attendance_status_change WHERE attendance_id = $1", attendanceID)
+	defer db.Exec("DELETE FROM excuse_request WHERE attendance_id = $1", attendanceID)
+
+	denied, err := new(ExcuseRequest).Add(attendanceID, 1, "dentist")
+	if err != nil {
+		t.Fatalf("add failed with error: %v", err)
+	}
+	if _, err := new(ExcuseRequest).Add(attendanceID, 1, "dentist again"); err != ErrExcusePending {
+		t.Errorf("adding a second pending request returned %v, want %v", err, ErrExcusePending)
+	}
+	if err := new(ExcuseRequest).Decide(denied, 3, false, "no note"); err != nil {
+		t.Fatalf("deny failed with error: %v", err)
+	}
+	if err := new(ExcuseRequest).Decide(denied, 3, true, ""); err != ErrExcuseDecided {
+		t.Errorf("approving a denied request returned %v, want %v", err, ErrExcuseDecided)
+	}
+	if records, _ := new(Attendance).GetByUser(1, attendanceID); len(records) != 0 {
+		t.Errorf("user attendance after a denial = %v, want none", records)
+	}
+
+	// Once the denial is answered, the student can ask again and be excused
+	approved, err := new(ExcuseRequest).Add(attendanceID, 1, "doctor's note attached")
+	if err != nil {
+		t.Fatalf("add failed with error: %v", err)
+	}
+	if err := new(ExcuseRequest).Decide(approved, 3, true, "feel better"); err != nil {
+		t.Fatalf("approve failed with error: %v", err)
+	}
+	records, err := new(Attendance).GetByUser(1, attendanceID)
+	if err != nil || len(records) != 1 || records[0].Status != AttendanceExcused {
+		t.Errorf("user attendance after an approval = %v %v, want excused", records, err)
+	}
+	changes, err := new(StatusChange).GetStatusChanges(attendanceID, 0)
+	if err != nil || len(changes) != 1 || changes[0].Source != StatusSourceExcuse || changes[0].Reason != "doctor's note attached" {
+		t.Errorf("status changes = %+v %v, want the approval", changes, err)
+	}
+
+	excuses, err := new(ExcuseRequest).GetByAttendanceID(attendanceID, 0)
+	if err != nil || len(excuses) != 2 || excuses[0].ID != approved || excuses[0].Response != "feel better" || excuses[0].DecidedBy != 3 {
+		t.Errorf("getByAttendanceID = %+v %v", excuses, err)
+	}
+	excuse, err := new(ExcuseRequest).GetByID(denied, 0)
+	if err != nil || excuse.Status != ExcuseDenied || excuse.SectionID != 3 || excuse.DecidedAt == "" {
+		t.Errorf("getByID = %+v %v", excuse, err)
+	}
+	if _, err := new(ExcuseRequest).GetByID(-1, 0); err != sql.ErrNoRows {
+		t.Errorf("getByID of a missing request returned %v, want %v", err, sql.ErrNoRows)
+	}
+	if err := new(ExcuseRequest).Decide(-1, 3, true, ""); err != sql.ErrNoRows {
+		t.Errorf("deciding a missing request returned %v, want %v", err, sql.ErrNoRows)
+	}
+}
+
+func TestAttendanceSheet(t *testing.T) {
+	db := DB()
+
+	first, err := new(Attendance).Add(3, 3, 3)
+	if err != nil {
+		t.Fatalf("add attendance failed with error: %v", err)
+	}
+	second, err := new(Attendance).Add(3, 3, 3)
+	if err != nil {
+		t.Fatalf("add attendance failed with error: %v", err)
+	}
+	for _, attendanceID := range []int{first, second} {
+		defer db.Exec("DELETE FROM attendance WHERE id = $1", attendanceID)
+		defer db.Exec("DELETE FROM user_attendance WHERE attendance_id = $1", attendanceID)
+		defer db.Exec("DELETE FROM attendance_status_change WHERE attendance_id = $1", attendanceID)
+	}
+	new(Attendance).SetUserAttendance(first, 1, AttendancePresent, 1, StatusSourceCheckIn, "")
+	new(Attendance).SetUserAttendance(second, 1, AttendanceExcused, 3, StatusSourceManual, "")
+	new(Attendance).SetUserAttendance(first, 22, AttendanceAbsent, 0, StatusSourceSessionEnd, "")
+	new(Attendance).SetUserAttendance(second, 22, AttendanceLate, 22, StatusSourceCheckIn, "")
+
+	today := time.Now().UTC().Format("2006-01-02")
+	sheet, err := new(AttendanceSheet).Get(3, today, today, 0)
+	if err != nil {
+		t.Fatalf("get failed with error: %v", err)
+	}
+	if sheet.CourseNumber == "" || sheet.Name() == "" || len(sheet.Dates) < 2 {
+		t.Fatalf("sheet = %+v, want both attendances of today", sheet)
+	}
+	// Both attendances were taken today, the second is numbered
+	last := len(sheet.Dates) - 1
+	if sheet.Dates[last-1] != today && !strings.HasPrefix(sheet.Dates[last-1], today+" (") || !strings.HasPrefix(sheet.Dates[last], today+" (") {
+		t.Errorf("dates = %v", sheet.Dates)
+	}
+
+	rows := map[int]AttendanceSheetRow{}
+	for _, student := range sheet.Students {
+		if len(student.Statuses) != len(sheet.Dates) {
+			t.Errorf("student %d has %d statuses for %d dates", student.UserID, len(student.Statuses), len(sheet.Dates))
+		}
+		rows[student.UserID] = student
+	}
+	if _, ok := rows[3]; ok {
+		t.Errorf("the instructor is on the sheet")
+	}
+	student := rows[1]
+	if got := student.Statuses[last-1:]; got[0] != AttendancePresent || got[1] != AttendanceExcused {
+		t.Errorf("student 1 statuses = %v", student.Statuses)
+	}
+	// Being excused doesn't count against the rate
+	if rate, ok := student.Rate(); !ok || rate != 1 || student.Total(AttendanceExcused) != 1 {
+		t.Errorf("student 1 rate = %v %v with %d excused", rate, ok, student.Total(AttendanceExcused))
+	}
+	if rate, ok := rows[22].Rate(); !ok || rate != 0.5 {
+		t.Errorf("student 22 rate = %v %v, want 0.5", rate, ok)
+	}
+	if sheet.DateTotal(last, AttendanceLate) != 1 || sheet.DateTotal(last, AttendanceExcused) != 1 {
+		t.Errorf("totals of the second attendance are wrong")
+	}
+	if rate, ok := sheet.DateRate(last); !ok || rate != 1 {
+		t.Errorf("rate of the second attendance = %v %v, want 1", rate, ok)
+	}
+
+	// Nothing was taken before the range
+	sheet, err = new(AttendanceSheet).Get(3, "", "2000-01-01", 0)
+	if err != nil || len(sheet.Dates) != 0 {
+		t.Errorf("sheet of 2000 = %v %v, want no dates", sheet.Dates, err)
+	}
+
+	sectionIDs, err := new(AttendanceSheet).GetTaughtSectionIDs(3)
+	if err != nil || len(sectionIDs) == 0 {
+		t.Errorf("getTaughtSectionIDs = %v %v, want section 3", sectionIDs, err)
+	}
+	if sectionIDs, _ := new(AttendanceSheet).GetTaughtSectionIDs(1); len(sectionIDs) != 0 {
+		t.Errorf("student teaches the sections %v", sectionIDs)
+	}
+}
+
+func TestAttendanceAlerts(t *testing.T) {
+	db := DB()
+
+	// Check counts absences and leaves the rate alone until enough days count
+	thresholds := AttendanceThresholds{SectionID: 3, Absences: 2, Rate: 80}
+	for _, test := range []struct {
+		statuses []string
+		want     []string
+	}{
+		{[]string{AttendanceAbsent, AttendancePresent}, []string{}},
+		{[]string{AttendanceAbsent, AttendanceExcused, AttendanceAbsent}, []string{AlertAbsences}},
+		{[]string{AttendancePresent, AttendanceLate, AttendanceAbsent, ""}, []string{AlertRate}},
+		{[]string{AttendancePresent, AttendancePresent, AttendancePresent, AttendancePresent, AttendanceAbsent}, []string{}},
+	} {
+		alerts := thresholds.Check(test.statuses)
+		kinds := []string{}
+		for _, alert := range alerts {
+			kinds = append(kinds, alert.Kind)
+		}
+		if strings.Join(kinds, ",") != strings.Join(test.want, ",") {
+			t.Errorf("Check(%v) = %v, want %v", test.statuses, kinds, test.want)
+		}
+	}
+	if alerts := (AttendanceThresholds{}).Check([]string{AttendanceAbsent, AttendanceAbsent, AttendanceAbsent}); len(alerts) != 0 {
+		t.Errorf("thresholds turned off raised %v", alerts)
+	}
+	if alerts := thresholds.Check([]string{AttendancePresent, AttendanceAbsent, AttendanceAbsent}); len(alerts) != 2 || alerts[1].Observed != 33 {
+		t.Errorf("Check = %+v, want both alerts at a rate of 33", alerts)
+	}
+
+	// Thresholds are saved on the section
+	saved, err := new(AttendanceThresholds).GetThresholds(3)
+	if err != nil {
+		t.Fatalf("getThresholds failed with error: %v", err)
+	}
+	defer new(AttendanceThresholds).SetThresholds(saved)
+	if err := new(AttendanceThresholds).SetThresholds(AttendanceThresholds{SectionID: 3, Absences: 3, Rate: 75, Email: true}); err != nil {
+		t.Fatalf("setThresholds failed with error: %v", err)
+	}
+	if got, _ := new(AttendanceThresholds).GetThresholds(3); got.Absences != 3 || got.Rate != 75 || !got.Email {
+		t.Errorf("thresholds = %+v", got)
+	}
+	if err := new(AttendanceThresholds).SetThresholds(AttendanceThresholds{SectionID: -1}); err != sql.ErrNoRows {
+		t.Errorf("setThresholds of a missing section returned %v, want sql.ErrNoRows", err)
+	}
+
+	// The same threshold only raises one alert
+	alertID, created, err := new(AttendanceAlert).Add(3, 22, AlertAbsences, 3, 3, 0)
+	if err != nil || !created {
+		t.Fatalf("add alert = %v %v", created, err)
+	}
+	defer db.Exec("DELETE FROM attendance_alert WHERE id = $1", alertID)
+	if _, created, err := new(AttendanceAlert).Add(3, 22, AlertAbsences, 3, 4, 0); err != nil || created {
+		t.Errorf("adding the same alert again = %v %v, want not created", created, err)
+	}
+
+	alert, err := new(AttendanceAlert).GetByID(alertID, 0)
+	if err != nil || alert.UserID != 22 || alert.Observed != 3 || alert.CourseNumber == "" {
+		t.Errorf("getByID = %+v %v", alert, err)
+	}
+	if _, err := new(AttendanceAlert).GetByID(-1, 0); err != sql.ErrNoRows {
+		t.Errorf("getByID of a missing alert returned %v, want sql.ErrNoRows", err)
+	}
+
+	open := func(userID int) bool {
+		alerts, err := new(AttendanceAlert).GetOpenByTeacher(userID, 0)
+		if err != nil {
+			t.Fatalf("getOpenByTeacher failed with error: %v", err)
+		}
+		for _, alert := range alerts {
+			if alert.ID == alertID {
+				return true
+			}
+		}
+		return false
+	}
+	if !open(3) || open(22) {
+		t.Errorf("the alert isn't shown to the instructor only")
+	}
+
+	if dismissed, err := new(AttendanceAlert).Dismiss(alertID, 3); err != nil || !dismissed {
+		t.Errorf("dismiss = %v %v", dismissed, err)
+	}
+	if dismissed, _ := new(AttendanceAlert).Dismiss(alertID, 3); dismissed {
+		t.Errorf("an alert was dismissed twice")
+	}
+	if open(3) {
+		t.Errorf("a dismissed alert is still open")
+	}
+}
+
+func TestStudentAttendance(t *testing.T) {
+	db := DB()
+
+	first, err := new(Attendance).Add(3, 3, 3)
+	if err != nil {
+		t.Fatalf("add attendance failed with error: %v", err)
+	}
+	second, err := new(Attendance).Add(3, 3, 3)
+	if err != nil {
+		t.Fatalf("add attendance failed with error: %v", err)
+	}
+	for _, attendanceID := range []int{first, second} {
+		defer db.Exec("DELETE FROM attendance WHERE id = $1", attendanceID)
+		defer db.Exec("DELETE FROM user_attendance WHERE attendance_id = $1", attendanceID)
+		defer db.Exec("DELETE FROM attendance_status_change WHERE attendance_id = $1", attendanceID)
+	}
+	new(Attendance).SetUserAttendance(first, 22, AttendanceAbsent, 0, StatusSourceSessionEnd, "")
+	new(Attendance).SetUserAttendance(second, 22, AttendanceLate, 22, StatusSourceCheckIn, "")
+	new(Attendance).SetUserAttendance(second, 1, AttendanceAbsent, 0, StatusSourceSessionEnd, "")
+
+	// sessions returns the last two sessions of section 3 for a user
+	sessions := func(userID int) (StudentAttendance, []StudentAttendanceSession) {
+		t.Helper()
+		records, err := new(StudentAttendance).GetByUser(userID, 0)
+		if err != nil {
+			t.Fatalf("getByUser failed with error: %v", err)
+		}
+		for _, record := range records {
+			if record.SectionID == 3 {
+				if len(record.Sessions) < 2 {
+					t.Fatalf("section 3 has the sessions %+v", record.Sessions)
+				}
+				return record, record.Sessions[len(record.Sessions)-2:]
+			}
+		}
+		t.Fatalf("user %d has no record for section 3", userID)
+		return StudentAttendance{}, nil
+	}
+
+	record, got := sessions(22)
+	before := got[0]
+	if got[0].AttendanceID != first || got[0].Status != AttendanceAbsent || got[1].Status != AttendanceLate {
+		t.Errorf("sessions = %+v", got)
+	}
+	// The rate runs over the sessions: absent then late is 0% then 50%
+	if !got[1].Rated || got[1].Rate != 50 || record.Rate != got[1].Rate {
+		t.Errorf("running rate = %v %v, section rate %v", got[1].Rate, got[1].Rated, record.Rate)
+	}
+
+	// Questions and votes in the class session count once, on its first attendance
+	questionID, err := new(Question).PostQuestion(22, 3, "Is the record right?")
+	if err != nil {
+		t.Fatalf("post question failed with error: %v", err)
+	}
+	defer db.Exec("DELETE FROM question WHERE id = $1", questionID)
+	defer db.Exec("DELETE FROM vote WHERE question_id = $1", questionID)
+	if err := new(Question).VoteQuestion(questionID, 22); err != nil {
+		t.Fatalf("vote failed with error: %v", err)
+	}
+	_, got = sessions(22)
+	if got[0].Questions != before.Questions+1 || got[0].Votes != before.Votes+1 || got[1].Questions != 0 || got[1].Votes != 0 {
+		t.Errorf("sessions = %+v, want one more question and vote on the first", got)
+	}
+
+	// Each user only gets their own statuses
+	if _, got := sessions(1); got[0].Status != "" || got[1].Status != AttendanceAbsent {
+		t.Errorf("user 1 sessions = %+v", got)
+	}
+	if records, err := new(StudentAttendance).GetByUser(3, 0); err != nil || len(records) != 0 {
+		t.Errorf("the instructor, who isn't enrolled, has the records %+v %v", records, err)
+	}
+}
@@ -0,0 +1,403 @@
+package models
+
+import (
+	"database/sql"
+	"errors"
+)
+
+// Poll is a multiple choice or yes/no question a moderator asks the class during a class session.
+type Poll struct {
+	ID        int
+	SessionID int
+	UserID    int
+	Question  string
+	Kind      string
+	// Status is draft until the poll is opened to the class, then open and finally closed
+	Status    string
+	CreatedAt string
+	OpenedAt  string
+	ClosedAt  string
+	Options   []PollOption
+	// Responses is the number of users who answered the poll
+	Responses int
+	// UserChoice is the option chosen by the user the poll was read for, 0 if they haven't answered
+	UserChoice int
+}
+
+// PollOption is one of the answers to a poll, with the number of users who chose it.
+type PollOption struct {
+	ID       int
+	PollID   int
+	Position int
+	Text     string
+	Votes    int
+}
+
+// Poll kinds. Yes/no polls get the options Yes and No.
+const (
+	PollChoice = "choice"
+	PollYesNo  = "yes-no"
+)
+
+// Poll statuses
+const (
+	PollDraft  = "draft"
+	PollOpen   = "open"
+	PollClosed = "closed"
+)
+
+var (
+	// ErrPollStatus is returned when opening a poll that isn't a draft or closing one that isn't open.
+	ErrPollStatus = errors.New("poll is not in a status that allows this")
+	// ErrPollNotOpen is returned when answering a poll that isn't open.
+	ErrPollNotOpen = errors.New("poll is not open")
+	// ErrPollAnswered is returned when a user answers a poll they already answered.
+	ErrPollAnswered = errors.New("poll already answered")
+	// ErrInvalidPollOption is returned when answering a poll with an option of another poll.
+	ErrInvalidPollOption = errors.New("option does not belong to the poll")
+)
+
+// ** CREATE **
+// CreatePoll adds a draft poll with its options to a class session. The options of a yes/no poll are Yes and No.
+// It returns the ID of the new poll and any error encountered.
+func (p *Poll) CreatePoll(sessionID int, userID int, question string, kind string, options []string) (int, error) {
+	if kind == PollYesNo {
+		options = []string{"Yes", "No"}
+	}
+
+	tx, err := DB().Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var pollID int
+	err = tx.QueryRow(`
+	INSERT INTO
+		poll
+		(session_id,
+		user_id,
+		question,
+		kind,
+		status,
+		created_at)
+	VALUES
+		($1,
+		$2,
+		$3,
+		$4,
+		'draft',
+		datetime('now'))
+	RETURNING id`,
+		sessionID, userID, question, kind).Scan(&pollID)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, option := range options {
+		_, err = tx.Exec(`
+		INSERT INTO
+			poll_option
+			(poll_id,
+			position,
+			text)
+		VALUES
+			($1,
+			$2,
+			$3)`,
+			pollID, i+1, option)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return pollID, nil
+}
+
+// Respond records the option a user chose in an open poll. Each user answers a poll once.
+// It returns ErrPollNotOpen, ErrInvalidPollOption or ErrPollAnswered when the answer is refused and any other error encountered.
+func (p *Poll) Respond(pollID int, optionID int, userID int) error {
+	db := DB()
+
+	var status string
+	err := db.QueryRow(`
+	SELECT
+		poll.status
+	FROM
+		poll_option
+		JOIN poll ON poll.id = poll_option.poll_id
+	WHERE
+		poll_option.id = $1 AND
+		poll.id = $2`,
+		optionID, pollID).Scan(&status)
+	if err == sql.ErrNoRows {
+		return ErrInvalidPollOption
+	}
+	if err != nil {
+		return err
+	}
+	if status != PollOpen {
+		return ErrPollNotOpen
+	}
+
+	result, err := db.Exec(`
+	INSERT INTO
+		poll_response
+		(poll_id,
+		option_id,
+		user_id,
+		created_at)
+	VALUES
+		($1,
+		$2,
+		$3,
+		datetime('now'))
+	ON CONFLICT (poll_id, user_id) DO NOTHING`,
+		pollID, optionID, userID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrPollAnswered
+	}
+
+	return nil
+}
+
+// ** READ **
+// pollColumns are the columns scanned by scanPolls, with created_at, opened_at and closed_at
+// shifted by a timezone offset given three times.
+const pollColumns = `
+		id,
+		session_id,
+		user_id,
+		question,
+		kind,
+		status,
+		strftime('%H:%M', datetime(created_at, (? || ' minutes'))),
+		COALESCE(strftime('%H:%M', datetime(opened_at, (? || ' minutes'))), ''),
+		COALESCE(strftime('%H:%M', datetime(closed_at, (? || ' minutes'))), '')`
+
+// queryPolls returns the polls selected by a query of pollColumns, with their options,
+// their results and the option chosen by a user.
+// It returns a slice of Poll structs and any error encountered.
+func queryPolls(userID int, sqlStatement string, args ...interface{}) ([]Poll, error) {
+	db := DB()
+
+	rows, err := db.Query(sqlStatement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var polls []Poll
+	for rows.Next() {
+		var p Poll
+		err := rows.Scan(&p.ID, &p.SessionID, &p.UserID, &p.Question, &p.Kind, &p.Status, &p.CreatedAt, &p.OpenedAt, &p.ClosedAt)
+		if err != nil {
+			return nil, err
+		}
+		polls = append(polls, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	for i := range polls {
+		polls[i].Options, polls[i].Responses, err = pollResults(polls[i].ID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = db.QueryRow(`
+		SELECT
+			COALESCE(MAX(option_id), 0)
+		FROM
+			poll_response
+		WHERE
+			poll_id = $1 AND
+			user_id = $2`,
+			polls[i].ID, userID).Scan(&polls[i].UserChoice)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return polls, nil
+}
+
+// pollResults returns the options of a poll in order with the number of users who chose each,
+// and the number of users who answered.
+func pollResults(pollID int) ([]PollOption, int, error) {
+	rows, err := DB().Query(`
+	SELECT
+		poll_option.id,
+		poll_option.poll_id,
+		poll_option.position,
+		poll_option.text,
+		COUNT(poll_response.id)
+	FROM
+		poll_option
+		LEFT JOIN poll_response ON poll_response.option_id = poll_option.id
+	WHERE
+		poll_option.poll_id = $1
+	GROUP BY
+		poll_option.id,
+		poll_option.poll_id,
+		poll_option.position,
+		poll_option.text
+	ORDER BY
+		poll_option.position`,
+		pollID)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var options []PollOption
+	responses := 0
+	for rows.Next() {
+		var o PollOption
+		err := rows.Scan(&o.ID, &o.PollID, &o.Position, &o.Text, &o.Votes)
+		if err != nil {
+			return nil, 0, err
+		}
+		options = append(options, o)
+		responses += o.Votes
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return options, responses, nil
+}
+
+// GetByID returns a poll with its results and the option chosen by a user.
+// It returns a Poll struct and any error encountered, sql.ErrNoRows when there is no such poll.
+func (p *Poll) GetByID(pollID int, userID int, timezone int) (Poll, error) {
+	polls, err := queryPolls(userID, `
+	SELECT`+pollColumns+`
+	FROM
+		poll
+	WHERE
+		id = ?`,
+		timezone, timezone, timezone, pollID)
+	if err != nil {
+		return Poll{}, err
+	}
+	if len(polls) == 0 {
+		return Poll{}, sql.ErrNoRows
+	}
+
+	return polls[0], nil
+}
+
+// GetByClassSessionID returns the polls of a class session in the order they were created,
+// with their results and the option chosen by a user.
+// It returns a slice of Poll structs and any error encountered.
+func (p *Poll) GetByClassSessionID(classSessionID int, userID int, timezone int) ([]Poll, error) {
+	return queryPolls(userID, `
+	SELECT`+pollColumns+`
+	FROM
+		poll
+	WHERE
+		session_id = ?
+	ORDER BY
+		id`,
+		timezone, timezone, timezone, classSessionID)
+}
+
+// ** UPDATE **
+// setPollStatus moves a poll from one status to the next, stamping the time in column.
+// It returns ErrPollStatus if the poll is not in the status from and any other error encountered.
+func setPollStatus(pollID int, from string, to string, column string) error {
+	result, err := DB().Exec(`
+	UPDATE
+		poll
+	SET
+		status = $1,
+		`+column+` = datetime('now')
+	WHERE
+		id = $2 AND
+		status = $3`,
+		to, pollID, from)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrPollStatus
+	}
+
+	return nil
+}
+
+// Open opens a draft poll to the class.
+// It returns ErrPollStatus if the poll isn't a draft and any other error encountered.
+func (p *Poll) Open(pollID int) error {
+	return setPollStatus(pollID, PollDraft, PollOpen, "opened_at")
+}
+
+// Close stops an open poll from taking answers.
+// It returns ErrPollStatus if the poll isn't open and any other error encountered.
+func (p *Poll) Close(pollID int) error {
+	return setPollStatus(pollID, PollOpen, PollClosed, "closed_at")
+}
+
+// CloseAll closes the open polls of a class session, when it ends.
+// It returns the IDs of the polls closed and any error encountered.
+func (p *Poll) CloseAll(classSessionID int) ([]int, error) {
+	rows, err := DB().Query(`
+	SELECT
+		id
+	FROM
+		poll
+	WHERE
+		session_id = $1 AND
+		status = 'open'`,
+		classSessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pollIDs []int
+	for rows.Next() {
+		var pollID int
+		if err := rows.Scan(&pollID); err != nil {
+			return nil, err
+		}
+		pollIDs = append(pollIDs, pollID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	var closed []int
+	for _, pollID := range pollIDs {
+		err := p.Close(pollID)
+		if err == ErrPollStatus {
+			// Closed by a moderator in the meantime
+			continue
+		}
+		if err != nil {
+			return closed, err
+		}
+		closed = append(closed, pollID)
+	}
+
+	return closed, nil
+}
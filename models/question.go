@@ -1,355 +1,856 @@
-package models
-
-type Question struct {
-	ID           int
-	SessionID    int
-	UserID       int
-	Text         string
-	Votes        int
-	Answered     bool
-	CreatedAt    string
-	UpdatedAt    string
-	UserHasVoted bool
-}
-type Vote struct {
-	ID         int
-	QuestionID int
-	UserID     int
-}
-
-// ** CREATE **
-// PostQuestion posts a question to the database for a given class session and user.
-// It returns the ID of the new question and any error encountered.
-func (s Question) PostQuestion(userID int, sessionID int, text string) (int, error) {
-	db := NewDB()
-
-	sqlStatement := `
-	INSERT INTO
-		question
-	VALUES 
-		(NULL,
-		$1,
-		$2,
-		$3,
-		0, 
-		false, 
-		datetime('now'), 
-		datetime('now')
-		)
-	RETURNING id
-	`
-	var questionID int
-	err := db.QueryRow(sqlStatement, sessionID, userID, text).Scan(&questionID)
-	if err != nil {
-		return 0, err
-	}
-
-	return questionID, nil
-}
-
-// ** READ **
-// GetAllQuestions takes a class session id, a sortBy parameter, and a timezone offset.
-// It returns all questions for a given class session as a slice of Question
-// structs and any error encountered.
-func (s *Question) GetAllQuestions(classSessionID int, sortBy string, timezoneOffset int) ([]Question, error) {
-	db := NewDB()
-
-	// Get all questions for this session
-	sqlStatement := `
-        SELECT
-            id, session_id, user_id, text, votes, answered,
-			strftime('%H:%M', datetime(created_at, (? || ' minutes'))) as formatted_created_at,
-			datetime(updated_at, (? || ' minutes'))
-        FROM
-            question
-        WHERE
-            session_id = ?
-        ORDER BY
-            ` + sortBy + ` DESC
-    `
-	rows, err := db.Query(sqlStatement, timezoneOffset, timezoneOffset, classSessionID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var questions []Question
-	for rows.Next() {
-		q := Question{}
-		err := rows.Scan(&q.ID, &q.SessionID, &q.UserID, &q.Text, &q.Votes, &q.Answered, &q.CreatedAt, &q.UpdatedAt)
-		if err != nil {
-			return nil, err
-		}
-		questions = append(questions, q)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-
-	return questions, nil
-}
-
-// HasVoted checks if a user has voted for a question.
-// It returns true if the user has voted and false if not.
-func (s *Question) HasVoted(questionID int, userID int) (bool, error) {
-	db := NewDB()
-
-	var voteCount int
-	err := db.QueryRow(`
-	SELECT
-		COUNT(*)
-	FROM
-		vote
-	WHERE
-		question_id = $1 AND
-		user_id = $2`,
-		questionID, userID).Scan(&voteCount)
-	if err != nil {
-		return false, err
-	}
-
-	if voteCount > 0 {
-		return true, nil
-	}
-
-	return false, nil
-}
-
-// HasVotedAll checks if a user has voted for all questions in a session.
-// It returns a slice of structs the contain the question ID and a boolean.
-func (s *Question) HasVotedAll(sessionID int, userID int) ([]Question, error) {
-	db := NewDB()
-
-	// Get all questions for this session
-	sqlStatement := `
-			SELECT
-				id
-			FROM
-				question
-			WHERE
-				session_id = $1
-			`
-	rows, err := db.Query(sqlStatement, sessionID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var questions []Question
-	for rows.Next() {
-		q := Question{}
-		err := rows.Scan(&q.ID)
-		if err != nil {
-			return nil, err
-		}
-		questions = append(questions, q)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-
-	// Check if the user has voted for each question
-	for i, q := range questions {
-		hasVoted, err := s.HasVoted(q.ID, userID)
-		if err != nil {
-			return nil, err
-		}
-		questions[i].UserHasVoted = hasVoted
-	}
-
-	return questions, nil
-}
-
-// GetVoteCount returns the number of votes for a question.
-// It returns any error encountered.
-func (s *Question) GetVoteCount(questionID int) (int, error) {
-	db := NewDB()
-	var voteCount int
-	err := db.QueryRow(`
-	SELECT
-		votes
-	FROM
-		question
-	WHERE
-		id = $1`,
-		questionID).Scan(&voteCount)
-	if err != nil {
-		return 0, err
-	}
-
-	return voteCount, nil
-}
-
-// GetByID returns a question based on the question id.
-// It returns any error encountered and a question struct.
-func (s *Question) GetByID(questionID, timezone int) (Question, error) {
-	db := NewDB()
-	defer db.Close()
-
-	var q Question
-
-	stmt, err := db.Prepare(`
-		SELECT
-			id,
-			session_id,
-			user_id,
-			text,
-			votes,
-			answered,
-			strftime('%H:%M', datetime(created_at, (? || ' minutes'))) as formatted_created_at,
-			datetime(updated_at, (? || ' minutes'))
-		FROM
-			question
-		WHERE
-			id = ?
-	`)
-	if err != nil {
-		return Question{}, err
-	}
-	defer stmt.Close()
-
-	err = stmt.QueryRow(timezone, timezone, questionID).Scan(&q.ID, &q.SessionID, &q.UserID, &q.Text, &q.Votes, &q.Answered, &q.CreatedAt, &q.UpdatedAt)
-	if err != nil {
-		return Question{}, err
-	}
-
-	return q, nil
-}
-
-// GetUnansweredQuestions returns all unanswered questions for a given class session by votes or time.
-// It returns any error encountered and a slice of Question structs.
-func (s *Question) GetUnansweredQuestions(classSessionID int, timezoneOffset int, sortBy string) ([]Question, error) {
-
-	db := NewDB()
-
-	// Get all questions for this session
-	sqlStatement := `
-		SELECT
-			id, 
-			session_id, 
-			user_id, 
-			text, votes, 
-			answered,
-			strftime('%H:%M', datetime(created_at, (? || ' minutes'))) as formatted_created_at,
-			datetime(updated_at, (? || ' minutes'))
-		FROM
-			question
-		WHERE
-			session_id = ? AND
-			answered = false
-	`
-
-	if sortBy == "votes" {
-		sqlStatement += "ORDER BY votes DESC"
-	} else if sortBy == "time" {
-		sqlStatement += "ORDER BY created_at DESC"
-	} else {
-		// Default sorting, or handle the invalid sortBy parameter
-		sqlStatement += "ORDER BY votes DESC"
-	}
-
-	rows, err := db.Query(sqlStatement, timezoneOffset, timezoneOffset, classSessionID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var questions []Question
-	for rows.Next() {
-		q := Question{}
-		err := rows.Scan(&q.ID, &q.SessionID, &q.UserID, &q.Text, &q.Votes, &q.Answered, &q.CreatedAt, &q.UpdatedAt)
-		if err != nil {
-			return nil, err
-		}
-		questions = append(questions, q)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-
-	return questions, nil
-}
-
-// ** UPDATE **
-// MarkQuestion marks a question as answered.
-// It returns any error encountered.
-func (s *Question) MarkQuestion(questionID int) error {
-	db := NewDB()
-	sqlStatement := `
-	UPDATE
-		question
-	SET
-		answered = true
-	WHERE
-		id = $1`
-	_, err := db.Exec(sqlStatement, questionID)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// VoteQuestion adds a vote to a question based on the question ID.
-// It returns any error encountered.
-func (s *Question) VoteQuestion(questionID int, userID int) error {
-	db := NewDB()
-
-	// Insert vote into the database
-	sqlStatement := `
-	INSERT INTO
-		vote
-	VALUES
-		(NULL,
-		$1,
-		$2
-		)`
-	_, err := db.Exec(sqlStatement, questionID, userID)
-	if err != nil {
-		return err
-	}
-
-	// Get the current number of votes for the question
-	var currentVotes int
-	err = db.QueryRow(`
-	SELECT
-		votes
-	FROM
-		question
-	WHERE
-		id = $1`,
-		questionID).Scan(&currentVotes)
-	if err != nil {
-		return err
-	}
-
-	// Increment the number of votes for the question
-	sqlStatement = `
-	UPDATE
-		question
-	SET
-		votes = $1
-	WHERE
-		id = $2`
-	_, err = db.Exec(sqlStatement, currentVotes+1, questionID)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// ** HELPER **
-// HasVotedAppend takes a slice of question structs and a slice of voted question structs.
-// It returns a slice of question structs with a new voted field set to true if the question has been voted on by that user and false if not.
-func (s *Question) HasVotedAppend(questions []Question, votedQuestions []Question) []Question {
-
-	for i, q := range questions {
-		for _, v := range votedQuestions {
-			if q.ID == v.ID && v.UserHasVoted == true {
-				questions[i].UserHasVoted = true
-			}
-		}
-	}
-	return questions
-}
+package models
+
+import (
+	"database/sql"
+	"errors"
+	"sort"
+)
+
+type Question struct {
+	ID           int
+	SessionID    int
+	UserID       int
+	Text         string
+	Votes        int
+	Answered     bool
+	CreatedAt    string
+	UpdatedAt    string
+	UserHasVoted bool
+	Answers      []Answer
+	// Status is pending while a question waits in the moderation queue of a
+	// pre-moderated section, then approved or rejected.
+	Status          string
+	RejectionReason string
+}
+
+// Question statuses. A merged question was folded into a duplicate and is no longer shown.
+const (
+	QuestionPending  = "pending"
+	QuestionApproved = "approved"
+	QuestionRejected = "rejected"
+	QuestionMerged   = "merged"
+)
+
+// ErrQuestionNotPending is returned when moderating a question that has already left the moderation queue.
+var ErrQuestionNotPending = errors.New("question is not waiting for moderation")
+
+// ErrInvalidMerge is returned when two questions can't be merged: they are the same question,
+// belong to different class sessions, or one of them isn't shown to the class.
+var ErrInvalidMerge = errors.New("questions can't be merged")
+
+// SimilarQuestion is an open question that resembles a new one, with how alike the two are.
+type SimilarQuestion struct {
+	Question
+	Score float64
+}
+
+type Vote struct {
+	ID         int
+	QuestionID int
+	UserID     int
+}
+
+// Answer is a written reply to a question by a moderator of the question's section.
+type Answer struct {
+	ID            int
+	QuestionID    int
+	UserID        int
+	Text          string
+	FirstName     string
+	LastName      string
+	ModeratorType string
+	CreatedAt     string
+	UpdatedAt     string
+}
+
+// ** CREATE **
+// PostQuestion posts a question to the database for a given class session and user.
+// It returns the ID of the new question and any error encountered.
+func (s Question) PostQuestion(userID int, sessionID int, text string) (int, error) {
+	return postQuestion(userID, sessionID, text, QuestionApproved)
+}
+
+// PostPendingQuestion posts a question to the moderation queue of a class session.
+// The question stays hidden from the class until a moderator approves it.
+// It returns the ID of the new question and any error encountered.
+func (s Question) PostPendingQuestion(userID int, sessionID int, text string) (int, error) {
+	return postQuestion(userID, sessionID, text, QuestionPending)
+}
+
+// postQuestion adds a question with the given moderation status.
+func postQuestion(userID int, sessionID int, text string, status string) (int, error) {
+	db := DB()
+
+	sqlStatement := `
+	INSERT INTO
+		question
+		(session_id,
+		user_id,
+		text,
+		votes,
+		answered,
+		status,
+		created_at,
+		updated_at)
+	VALUES
+		($1,
+		$2,
+		$3,
+		0,
+		false,
+		$4,
+		datetime('now'),
+		datetime('now')
+		)
+	RETURNING id
+	`
+	var questionID int
+	err := db.QueryRow(sqlStatement, sessionID, userID, text, status).Scan(&questionID)
+	if err != nil {
+		return 0, err
+	}
+
+	return questionID, nil
+}
+
+// PostAnswer adds an answer to a question and marks the question as answered.
+// It returns the ID of the new answer and any error encountered.
+func (a Answer) PostAnswer(questionID int, userID int, text string) (int, error) {
+	tx, err := DB().Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var answerID int
+	err = tx.QueryRow(`
+	INSERT INTO
+		answer
+		(question_id,
+		user_id,
+		text,
+		created_at,
+		updated_at)
+	VALUES
+		($1,
+		$2,
+		$3,
+		datetime('now'),
+		datetime('now'))
+	RETURNING id`,
+		questionID, userID, text).Scan(&answerID)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(`
+	UPDATE
+		question
+	SET
+		answered = true
+	WHERE
+		id = $1`,
+		questionID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return answerID, nil
+}
+
+// ** READ **
+// approvedAt is when a question reached the class session feed: when it was approved,
+// or when it was asked if it never waited for a moderator.
+const approvedAt = "COALESCE(moderated_at, created_at)"
+
+// GetAllQuestions takes a class session id, a sortBy parameter, and a timezone offset.
+// It returns all questions for a given class session as a slice of Question
+// structs and any error encountered.
+func (s *Question) GetAllQuestions(classSessionID int, sortBy string, timezoneOffset int) ([]Question, error) {
+	db := DB()
+
+	// Approved questions reach the feed when they are approved, but keep the time they were asked
+	if sortBy == "created_at" {
+		sortBy = approvedAt
+	}
+
+	// Get all questions for this session
+	sqlStatement := `
+        SELECT
+            id, session_id, user_id, text, votes, answered,
+			strftime('%H:%M', datetime(created_at, (? || ' minutes'))) as formatted_created_at,
+			datetime(updated_at, (? || ' minutes'))
+        FROM
+            question
+        WHERE
+            session_id = ? AND
+            status = 'approved'
+        ORDER BY
+            ` + sortBy + ` DESC
+    `
+	rows, err := db.Query(sqlStatement, timezoneOffset, timezoneOffset, classSessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var questions []Question
+	for rows.Next() {
+		q := Question{}
+		err := rows.Scan(&q.ID, &q.SessionID, &q.UserID, &q.Text, &q.Votes, &q.Answered, &q.CreatedAt, &q.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		questions = append(questions, q)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return questions, nil
+}
+
+// HasVoted checks if a user has voted for a question.
+// It returns true if the user has voted and false if not.
+func (s *Question) HasVoted(questionID int, userID int) (bool, error) {
+	db := DB()
+
+	var voteCount int
+	err := db.QueryRow(`
+	SELECT
+		COUNT(*)
+	FROM
+		vote
+	WHERE
+		question_id = $1 AND
+		user_id = $2`,
+		questionID, userID).Scan(&voteCount)
+	if err != nil {
+		return false, err
+	}
+
+	if voteCount > 0 {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// HasVotedAll checks if a user has voted for all questions in a session.
+// It returns a slice of structs the contain the question ID and a boolean.
+func (s *Question) HasVotedAll(sessionID int, userID int) ([]Question, error) {
+	db := DB()
+
+	// Get all questions for this session
+	sqlStatement := `
+			SELECT
+				id
+			FROM
+				question
+			WHERE
+				session_id = $1 AND
+				status = 'approved'
+			`
+	rows, err := db.Query(sqlStatement, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var questions []Question
+	for rows.Next() {
+		q := Question{}
+		err := rows.Scan(&q.ID)
+		if err != nil {
+			return nil, err
+		}
+		questions = append(questions, q)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Check if the user has voted for each question
+	for i, q := range questions {
+		hasVoted, err := s.HasVoted(q.ID, userID)
+		if err != nil {
+			return nil, err
+		}
+		questions[i].UserHasVoted = hasVoted
+	}
+
+	return questions, nil
+}
+
+// GetVoteCount returns the number of votes for a question.
+// It returns any error encountered.
+func (s *Question) GetVoteCount(questionID int) (int, error) {
+	db := DB()
+	var voteCount int
+	err := db.QueryRow(`
+	SELECT
+		votes
+	FROM
+		question
+	WHERE
+		id = $1`,
+		questionID).Scan(&voteCount)
+	if err != nil {
+		return 0, err
+	}
+
+	return voteCount, nil
+}
+
+// GetByID returns a question based on the question id.
+// It returns any error encountered and a question struct.
+func (s *Question) GetByID(questionID, timezone int) (Question, error) {
+	db := DB()
+
+	var q Question
+
+	stmt, err := db.Prepare(`
+		SELECT
+			id,
+			session_id,
+			user_id,
+			text,
+			votes,
+			answered,
+			strftime('%H:%M', datetime(created_at, (? || ' minutes'))) as formatted_created_at,
+			datetime(updated_at, (? || ' minutes')),
+			status,
+			COALESCE(rejection_reason, '')
+		FROM
+			question
+		WHERE
+			id = ?
+	`)
+	if err != nil {
+		return Question{}, err
+	}
+	defer stmt.Close()
+
+	err = stmt.QueryRow(timezone, timezone, questionID).Scan(&q.ID, &q.SessionID, &q.UserID, &q.Text, &q.Votes, &q.Answered, &q.CreatedAt, &q.UpdatedAt, &q.Status, &q.RejectionReason)
+	if err != nil {
+		return Question{}, err
+	}
+
+	return q, nil
+}
+
+// GetUnansweredQuestions returns all unanswered questions for a given class session by votes or time.
+// It returns any error encountered and a slice of Question structs.
+func (s *Question) GetUnansweredQuestions(classSessionID int, timezoneOffset int, sortBy string) ([]Question, error) {
+
+	db := DB()
+
+	// Get all questions for this session
+	sqlStatement := `
+		SELECT
+			id, 
+			session_id, 
+			user_id, 
+			text, votes, 
+			answered,
+			strftime('%H:%M', datetime(created_at, (? || ' minutes'))) as formatted_created_at,
+			datetime(updated_at, (? || ' minutes'))
+		FROM
+			question
+		WHERE
+			session_id = ? AND
+			answered = false AND
+			status = 'approved'
+	`
+
+	if sortBy == "votes" {
+		sqlStatement += "ORDER BY votes DESC"
+	} else if sortBy == "time" {
+		sqlStatement += "ORDER BY " + approvedAt + " DESC"
+	} else {
+		// Default sorting, or handle the invalid sortBy parameter
+		sqlStatement += "ORDER BY votes DESC"
+	}
+
+	rows, err := db.Query(sqlStatement, timezoneOffset, timezoneOffset, classSessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var questions []Question
+	for rows.Next() {
+		q := Question{}
+		err := rows.Scan(&q.ID, &q.SessionID, &q.UserID, &q.Text, &q.Votes, &q.Answered, &q.CreatedAt, &q.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		questions = append(questions, q)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return questions, nil
+}
+
+// GetPendingQuestions returns the questions of a class session waiting in the moderation queue, oldest first.
+// It returns a slice of Question structs and any error encountered.
+func (s *Question) GetPendingQuestions(classSessionID int, timezoneOffset int) ([]Question, error) {
+	db := DB()
+
+	sqlStatement := `
+		SELECT
+			id,
+			session_id,
+			user_id,
+			text,
+			votes,
+			answered,
+			strftime('%H:%M', datetime(created_at, (? || ' minutes'))) as formatted_created_at,
+			datetime(updated_at, (? || ' minutes')),
+			status
+		FROM
+			question
+		WHERE
+			session_id = ? AND
+			status = 'pending'
+		ORDER BY
+			created_at,
+			id
+	`
+	rows, err := db.Query(sqlStatement, timezoneOffset, timezoneOffset, classSessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var questions []Question
+	for rows.Next() {
+		q := Question{}
+		err := rows.Scan(&q.ID, &q.SessionID, &q.UserID, &q.Text, &q.Votes, &q.Answered, &q.CreatedAt, &q.UpdatedAt, &q.Status)
+		if err != nil {
+			return nil, err
+		}
+		questions = append(questions, q)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return questions, nil
+}
+
+// FindSimilar compares text against the open questions of a class session.
+// It returns the questions at least DuplicateThreshold alike, most similar first and at most
+// three, leaving out the question excludeID, and any error encountered.
+func (s *Question) FindSimilar(classSessionID int, text string, excludeID int) ([]SimilarQuestion, error) {
+	questions, err := s.GetUnansweredQuestions(classSessionID, 0, "votes")
+	if err != nil {
+		return nil, err
+	}
+
+	var similar []SimilarQuestion
+	for _, q := range questions {
+		if q.ID == excludeID {
+			continue
+		}
+		score := QuestionSimilarity(text, q.Text)
+		if score >= DuplicateThreshold {
+			similar = append(similar, SimilarQuestion{Question: q, Score: score})
+		}
+	}
+
+	sort.SliceStable(similar, func(i, j int) bool {
+		return similar[i].Score > similar[j].Score
+	})
+	if len(similar) > 3 {
+		similar = similar[:3]
+	}
+
+	return similar, nil
+}
+
+// answerColumns selects an answer with its author's name and moderator type in the question's section.
+const answerColumns = `
+		answer.id,
+		answer.question_id,
+		answer.user_id,
+		answer.text,
+		user.first_name,
+		user.last_name,
+		COALESCE(moderator.type, ''),
+		strftime('%H:%M', datetime(answer.created_at, (? || ' minutes'))),
+		datetime(answer.updated_at, (? || ' minutes'))
+	FROM
+		answer
+		JOIN
+		user
+		ON answer.user_id = user.id
+		JOIN
+		question
+		ON answer.question_id = question.id
+		JOIN
+		class_session
+		ON question.session_id = class_session.id
+		LEFT JOIN
+		moderator
+		ON moderator.user_id = answer.user_id AND moderator.section_id = class_session.section_id`
+
+// queryAnswers runs an answer query and scans the rows into Answer structs.
+func queryAnswers(sqlStatement string, args ...interface{}) ([]Answer, error) {
+	db := DB()
+
+	rows, err := db.Query(sqlStatement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var answers []Answer
+	for rows.Next() {
+		a := Answer{}
+		err := rows.Scan(&a.ID, &a.QuestionID, &a.UserID, &a.Text, &a.FirstName, &a.LastName, &a.ModeratorType, &a.CreatedAt, &a.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		answers = append(answers, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return answers, nil
+}
+
+// GetByID returns an answer based on the answer id.
+// It returns an Answer struct and any error encountered.
+func (a *Answer) GetByID(answerID, timezone int) (Answer, error) {
+	answers, err := queryAnswers(`SELECT`+answerColumns+`
+	WHERE
+		answer.id = ?`,
+		timezone, timezone, answerID)
+	if err != nil {
+		return Answer{}, err
+	}
+	if len(answers) == 0 {
+		return Answer{}, sql.ErrNoRows
+	}
+
+	return answers[0], nil
+}
+
+// GetByClassSessionID returns the answers to every question of a class session, oldest first.
+// It returns a slice of Answer structs and any error encountered.
+func (a *Answer) GetByClassSessionID(classSessionID, timezone int) ([]Answer, error) {
+	return queryAnswers(`SELECT`+answerColumns+`
+	WHERE
+		question.session_id = ?
+	ORDER BY
+		answer.created_at,
+		answer.id`,
+		timezone, timezone, classSessionID)
+}
+
+// ** UPDATE **
+// MarkQuestion marks a question as answered.
+// It returns any error encountered.
+func (s *Question) MarkQuestion(questionID int) error {
+	db := DB()
+	sqlStatement := `
+	UPDATE
+		question
+	SET
+		answered = true
+	WHERE
+		id = $1`
+	_, err := db.Exec(sqlStatement, questionID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// moderate moves a pending question to a new status.
+// It returns ErrQuestionNotPending if the question already left the queue and any other error encountered.
+func moderate(sqlStatement string, args ...interface{}) error {
+	db := DB()
+
+	result, err := db.Exec(sqlStatement, args...)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrQuestionNotPending
+	}
+
+	return nil
+}
+
+// EditPending replaces the text of a question waiting in the moderation queue.
+// It returns any error encountered.
+func (s *Question) EditPending(questionID int, text string) error {
+	return moderate(`
+	UPDATE
+		question
+	SET
+		text = $1,
+		updated_at = datetime('now')
+	WHERE
+		id = $2 AND
+		status = 'pending'`,
+		text, questionID)
+}
+
+// Approve publishes a pending question to its class session. The question is shown from
+// the time it is approved, so it doesn't appear below questions asked while it waited.
+// It returns any error encountered.
+func (s *Question) Approve(questionID int, moderatorID int) error {
+	return moderate(`
+	UPDATE
+		question
+	SET
+		status = 'approved',
+		moderated_by = $1,
+		moderated_at = datetime('now'),
+		updated_at = datetime('now')
+	WHERE
+		id = $2 AND
+		status = 'pending'`,
+		moderatorID, questionID)
+}
+
+// Reject removes a pending question from the moderation queue without publishing it.
+// It returns any error encountered.
+func (s *Question) Reject(questionID int, moderatorID int, reason string) error {
+	return moderate(`
+	UPDATE
+		question
+	SET
+		status = 'rejected',
+		rejection_reason = $1,
+		moderated_by = $2,
+		moderated_at = datetime('now'),
+		updated_at = datetime('now')
+	WHERE
+		id = $3 AND
+		status = 'pending'`,
+		reason, moderatorID, questionID)
+}
+
+// Merge folds the question sourceID into its duplicate targetID. The target takes the combined
+// text, the votes of everyone who hadn't already voted for it, and the source's answers; the source
+// is hidden. An empty text keeps the target's text, adding the source's when it says something new.
+// It returns the merged target question and any error encountered.
+func (s *Question) Merge(sourceID int, targetID int, text string) (Question, error) {
+	if sourceID == targetID {
+		return Question{}, ErrInvalidMerge
+	}
+
+	tx, err := DB().Begin()
+	if err != nil {
+		return Question{}, err
+	}
+	defer tx.Rollback()
+
+	load := func(questionID int) (Question, error) {
+		var q Question
+		err := tx.QueryRow(`
+		SELECT
+			id,
+			session_id,
+			text,
+			votes,
+			answered,
+			status
+		FROM
+			question
+		WHERE
+			id = $1`,
+			questionID).Scan(&q.ID, &q.SessionID, &q.Text, &q.Votes, &q.Answered, &q.Status)
+		if err == sql.ErrNoRows {
+			return Question{}, ErrInvalidMerge
+		}
+		return q, err
+	}
+
+	source, err := load(sourceID)
+	if err != nil {
+		return Question{}, err
+	}
+	target, err := load(targetID)
+	if err != nil {
+		return Question{}, err
+	}
+	if source.SessionID != target.SessionID || source.Status != QuestionApproved || target.Status != QuestionApproved {
+		return Question{}, ErrInvalidMerge
+	}
+
+	if text == "" {
+		text = target.Text
+		if QuestionSimilarity(source.Text, target.Text) < 0.9 {
+			text = target.Text + " / " + source.Text
+		}
+	}
+
+	// Move the votes of users who haven't voted for the target; the others would count twice
+	result, err := tx.Exec(`
+	UPDATE
+		vote
+	SET
+		question_id = $1
+	WHERE
+		question_id = $2 AND
+		user_id NOT IN (SELECT user_id FROM vote WHERE question_id = $3)`,
+		targetID, sourceID, targetID)
+	if err != nil {
+		return Question{}, err
+	}
+	moved, err := result.RowsAffected()
+	if err != nil {
+		return Question{}, err
+	}
+
+	_, err = tx.Exec(`
+	DELETE FROM
+		vote
+	WHERE
+		question_id = $1`,
+		sourceID)
+	if err != nil {
+		return Question{}, err
+	}
+
+	_, err = tx.Exec(`
+	UPDATE
+		answer
+	SET
+		question_id = $1
+	WHERE
+		question_id = $2`,
+		targetID, sourceID)
+	if err != nil {
+		return Question{}, err
+	}
+
+	_, err = tx.Exec(`
+	UPDATE
+		question
+	SET
+		text = $1,
+		votes = votes + $2,
+		answered = $3,
+		updated_at = datetime('now')
+	WHERE
+		id = $4`,
+		text, moved, target.Answered || source.Answered, targetID)
+	if err != nil {
+		return Question{}, err
+	}
+
+	_, err = tx.Exec(`
+	UPDATE
+		question
+	SET
+		status = 'merged',
+		merged_into = $1,
+		updated_at = datetime('now')
+	WHERE
+		id = $2`,
+		targetID, sourceID)
+	if err != nil {
+		return Question{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Question{}, err
+	}
+
+	return s.GetByID(targetID, 0)
+}
+
+// VoteQuestion adds a vote to a question based on the question ID.
+// It returns any error encountered.
+func (s *Question) VoteQuestion(questionID int, userID int) error {
+	db := DB()
+
+	// Insert vote into the database
+	sqlStatement := `
+	INSERT INTO
+		vote
+	VALUES
+		(NULL,
+		$1,
+		$2
+		)`
+	_, err := db.Exec(sqlStatement, questionID, userID)
+	if err != nil {
+		return err
+	}
+
+	// Get the current number of votes for the question
+	var currentVotes int
+	err = db.QueryRow(`
+	SELECT
+		votes
+	FROM
+		question
+	WHERE
+		id = $1`,
+		questionID).Scan(&currentVotes)
+	if err != nil {
+		return err
+	}
+
+	// Increment the number of votes for the question
+	sqlStatement = `
+	UPDATE
+		question
+	SET
+		votes = $1
+	WHERE
+		id = $2`
+	_, err = db.Exec(sqlStatement, currentVotes+1, questionID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ** HELPER **
+// HasVotedAppend takes a slice of question structs and a slice of voted question structs.
+// It returns a slice of question structs with a new voted field set to true if the question has been voted on by that user and false if not.
+func (s *Question) HasVotedAppend(questions []Question, votedQuestions []Question) []Question {
+
+	for i, q := range questions {
+		for _, v := range votedQuestions {
+			if q.ID == v.ID && v.UserHasVoted == true {
+				questions[i].UserHasVoted = true
+			}
+		}
+	}
+	return questions
+}
+
+// AnswersAppend takes a slice of question structs and the answers of their class session.
+// It returns the slice of question structs with the answers of each question attached.
+func (s *Question) AnswersAppend(questions []Question, answers []Answer) []Question {
+	for i, q := range questions {
+		for _, a := range answers {
+			if a.QuestionID == q.ID {
+				questions[i].Answers = append(questions[i].Answers, a)
+			}
+		}
+	}
+	return questions
+}
@@ -0,0 +1,837 @@
+package models
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Quiz is a graded set of multiple choice questions a moderator gives the class during a class session.
+type Quiz struct {
+	ID        int
+	SessionID int
+	UserID    int
+	Title     string
+	// TimeLimit is the number of seconds students have to submit once the quiz is opened, 0 for no limit
+	TimeLimit int
+	// Status is draft until the quiz is opened to the class, then open and finally closed
+	Status    string
+	CreatedAt string
+	OpenedAt  string
+	ClosedAt  string
+	// Remaining is the number of seconds left to submit an open quiz with a time limit
+	Remaining int
+	Questions []QuizQuestion
+	// MaxScore is the sum of the points of the questions
+	MaxScore int
+	// Submissions is the number of students who submitted the quiz
+	Submissions int
+}
+
+// QuizQuestion is a question of a quiz, worth its points when answered with its correct option.
+type QuizQuestion struct {
+	ID       int
+	QuizID   int
+	Position int
+	Text     string
+	Points   int
+	Options  []QuizOption
+}
+
+// QuizOption is one of the answers to a quiz question.
+type QuizOption struct {
+	ID         int
+	QuestionID int
+	Position   int
+	Text       string
+	Correct    bool
+}
+
+// QuizSubmission is the answers of a student to a quiz and the score they got.
+type QuizSubmission struct {
+	ID        int
+	QuizID    int
+	QuizTitle string
+	UserID    int
+	FirstName string
+	LastName  string
+	Email     string
+	// UserAttendanceID is the attendance of the student for the class session of the quiz, 0 if none was taken
+	UserAttendanceID int
+	AttendanceStatus string
+	Score            int
+	MaxScore         int
+	SubmittedAt      string
+	// Answers maps the questions answered to the option chosen
+	Answers map[int]int
+}
+
+// QuizScore is the score of a student of a section in a quiz, as exported for grading.
+type QuizScore struct {
+	Date             string
+	ClassSessionID   int
+	QuizID           int
+	QuizTitle        string
+	UserID           int
+	Email            string
+	FirstName        string
+	LastName         string
+	Submitted        bool
+	Score            int
+	MaxScore         int
+	SubmittedAt      string
+	AttendanceStatus string
+}
+
+// Quiz statuses
+const (
+	QuizDraft  = "draft"
+	QuizOpen   = "open"
+	QuizClosed = "closed"
+)
+
+// QuizGrace is how long after the time limit of a quiz submissions are still taken, for the answers on their way.
+const QuizGrace = 5 * time.Second
+
+// quizTimeLayout is the layout of the dates stored in the database.
+const quizTimeLayout = "2006-01-02 15:04:05"
+
+var (
+	// ErrQuizStatus is returned when opening a quiz that isn't a draft or closing one that isn't open.
+	ErrQuizStatus = errors.New("quiz is not in a status that allows this")
+	// ErrQuizNotOpen is returned when submitting a quiz that isn't open.
+	ErrQuizNotOpen = errors.New("quiz is not open")
+	// ErrQuizTimeUp is returned when submitting a quiz after its time limit.
+	ErrQuizTimeUp = errors.New("quiz time limit is over")
+	// ErrQuizSubmitted is returned when a student submits a quiz they already submitted.
+	ErrQuizSubmitted = errors.New("quiz already submitted")
+	// ErrInvalidQuizAnswer is returned when a quiz is answered with an option of another question.
+	ErrInvalidQuizAnswer = errors.New("option does not belong to the question")
+)
+
+// ** CREATE **
+// CreateQuiz adds a draft quiz with its questions and their options to a class session.
+// The positions and ids of the questions and options given are ignored.
+// It returns the ID of the new quiz and any error encountered.
+func (q *Quiz) CreateQuiz(sessionID int, userID int, title string, timeLimit int, questions []QuizQuestion) (int, error) {
+	tx, err := DB().Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var quizID int
+	err = tx.QueryRow(`
+	INSERT INTO
+		quiz
+		(session_id,
+		user_id,
+		title,
+		time_limit,
+		status,
+		created_at)
+	VALUES
+		($1,
+		$2,
+		$3,
+		$4,
+		'draft',
+		datetime('now'))
+	RETURNING id`,
+		sessionID, userID, title, timeLimit).Scan(&quizID)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, question := range questions {
+		var questionID int
+		err = tx.QueryRow(`
+		INSERT INTO
+			quiz_question
+			(quiz_id,
+			position,
+			text,
+			points)
+		VALUES
+			($1,
+			$2,
+			$3,
+			$4)
+		RETURNING id`,
+			quizID, i+1, question.Text, question.Points).Scan(&questionID)
+		if err != nil {
+			return 0, err
+		}
+
+		for j, option := range question.Options {
+			_, err = tx.Exec(`
+			INSERT INTO
+				quiz_option
+				(question_id,
+				position,
+				text,
+				correct)
+			VALUES
+				($1,
+				$2,
+				$3,
+				$4)`,
+				questionID, j+1, option.Text, option.Correct)
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return quizID, nil
+}
+
+// Submit scores the answers of a student to an open quiz and records them, once. Questions left
+// unanswered score nothing. The submission is linked to the student's attendance for the class
+// session when they have one; a quiz never sets an attendance status.
+// It returns the submission, ErrQuizNotOpen, ErrQuizTimeUp, ErrInvalidQuizAnswer or ErrQuizSubmitted
+// when it is refused, sql.ErrNoRows when there is no such quiz and any other error encountered.
+func (q *Quiz) Submit(quizID int, userID int, answers map[int]int) (QuizSubmission, error) {
+	tx, err := DB().Begin()
+	if err != nil {
+		return QuizSubmission{}, err
+	}
+	defer tx.Rollback()
+
+	var sessionID int
+	var status string
+	var closesAt sql.NullString
+	err = tx.QueryRow(`
+	SELECT
+		session_id,
+		status,
+		closes_at
+	FROM
+		quiz
+	WHERE
+		id = $1`,
+		quizID).Scan(&sessionID, &status, &closesAt)
+	if err != nil {
+		return QuizSubmission{}, err
+	}
+	if status != QuizOpen {
+		return QuizSubmission{}, ErrQuizNotOpen
+	}
+	if closesAt.Valid {
+		deadline, err := time.Parse(quizTimeLayout, closesAt.String)
+		if err != nil {
+			return QuizSubmission{}, err
+		}
+		if time.Now().After(deadline.Add(QuizGrace)) {
+			return QuizSubmission{}, ErrQuizTimeUp
+		}
+	}
+
+	// Read the answer key
+	rows, err := tx.Query(`
+	SELECT
+		quiz_question.id,
+		quiz_question.points,
+		quiz_option.id,
+		quiz_option.correct
+	FROM
+		quiz_question
+		JOIN quiz_option ON quiz_option.question_id = quiz_question.id
+	WHERE
+		quiz_question.quiz_id = $1`,
+		quizID)
+	if err != nil {
+		return QuizSubmission{}, err
+	}
+	defer rows.Close()
+
+	points := make(map[int]int)
+	optionQuestion := make(map[int]int)
+	correct := make(map[int]bool)
+	for rows.Next() {
+		var questionID, questionPoints, optionID int
+		var optionCorrect bool
+		if err := rows.Scan(&questionID, &questionPoints, &optionID, &optionCorrect); err != nil {
+			return QuizSubmission{}, err
+		}
+		points[questionID] = questionPoints
+		optionQuestion[optionID] = questionID
+		correct[optionID] = optionCorrect
+	}
+	if err := rows.Err(); err != nil {
+		return QuizSubmission{}, err
+	}
+	rows.Close()
+
+	submission := QuizSubmission{
+		QuizID:  quizID,
+		UserID:  userID,
+		Answers: answers,
+	}
+	for _, questionPoints := range points {
+		submission.MaxScore += questionPoints
+	}
+	for questionID, optionID := range answers {
+		if answered, ok := optionQuestion[optionID]; !ok || answered != questionID {
+			return QuizSubmission{}, ErrInvalidQuizAnswer
+		}
+		if correct[optionID] {
+			submission.Score += points[questionID]
+		}
+	}
+
+	// Link the submission to the attendance of the student for the class session
+	userAttendanceID, err := quizUserAttendance(tx, sessionID, userID)
+	if err != nil {
+		return QuizSubmission{}, err
+	}
+	submission.UserAttendanceID = int(userAttendanceID.Int64)
+
+	err = tx.QueryRow(`
+	INSERT INTO
+		quiz_submission
+		(quiz_id,
+		user_id,
+		user_attendance_id,
+		score,
+		max_score,
+		submitted_at)
+	VALUES
+		($1,
+		$2,
+		$3,
+		$4,
+		$5,
+		datetime('now'))
+	ON CONFLICT (quiz_id, user_id) DO NOTHING
+	RETURNING id`,
+		quizID, userID, userAttendanceID, submission.Score, submission.MaxScore).Scan(&submission.ID)
+	if err == sql.ErrNoRows {
+		return QuizSubmission{}, ErrQuizSubmitted
+	}
+	if err != nil {
+		return QuizSubmission{}, err
+	}
+
+	for questionID, optionID := range answers {
+		answerPoints := 0
+		if correct[optionID] {
+			answerPoints = points[questionID]
+		}
+		_, err = tx.Exec(`
+		INSERT INTO
+			quiz_answer
+			(submission_id,
+			question_id,
+			option_id,
+			points)
+		VALUES
+			($1,
+			$2,
+			$3,
+			$4)`,
+			submission.ID, questionID, optionID, answerPoints)
+		if err != nil {
+			return QuizSubmission{}, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return QuizSubmission{}, err
+	}
+
+	return submission, nil
+}
+
+// quizUserAttendance returns the latest user_attendance record of a user for a class session.
+// It returns the id of the record, null when the user has none, and any error encountered.
+func quizUserAttendance(tx *Tx, classSessionID int, userID int) (sql.NullInt64, error) {
+	var userAttendanceID sql.NullInt64
+	err := tx.QueryRow(`
+	SELECT
+		user_attendance.id
+	FROM
+		user_attendance
+		JOIN attendance ON attendance.id = user_attendance.attendance_id
+	WHERE
+		attendance.class_session_id = $1 AND
+		user_attendance.user_id = $2
+	ORDER BY
+		user_attendance.id DESC
+	LIMIT 1`,
+		classSessionID, userID).Scan(&userAttendanceID)
+	if err == sql.ErrNoRows {
+		return userAttendanceID, nil
+	}
+	return userAttendanceID, err
+}
+
+// ** READ **
+// quizColumns are the columns scanned by queryQuizzes, with created_at, opened_at and closed_at
+// shifted by a timezone offset given three times.
+const quizColumns = `
+		id,
+		session_id,
+		user_id,
+		title,
+		time_limit,
+		status,
+		strftime('%H:%M', datetime(created_at, (? || ' minutes'))),
+		COALESCE(strftime('%H:%M', datetime(opened_at, (? || ' minutes'))), ''),
+		COALESCE(strftime('%H:%M', datetime(closed_at, (? || ' minutes'))), ''),
+		COALESCE(closes_at, ''),
+		(SELECT COUNT(*) FROM quiz_submission WHERE quiz_submission.quiz_id = quiz.id)`
+
+// queryQuizzes returns the quizzes selected by a query of quizColumns, with their questions and options.
+// It returns a slice of Quiz structs and any error encountered.
+func queryQuizzes(sqlStatement string, args ...interface{}) ([]Quiz, error) {
+	rows, err := DB().Query(sqlStatement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var quizzes []Quiz
+	now := time.Now()
+	for rows.Next() {
+		var q Quiz
+		var closesAt string
+		err := rows.Scan(&q.ID, &q.SessionID, &q.UserID, &q.Title, &q.TimeLimit, &q.Status, &q.CreatedAt, &q.OpenedAt, &q.ClosedAt, &closesAt, &q.Submissions)
+		if err != nil {
+			return nil, err
+		}
+		if q.Status == QuizOpen && closesAt != "" {
+			deadline, err := time.Parse(quizTimeLayout, closesAt)
+			if err != nil {
+				return nil, err
+			}
+			if remaining := deadline.Sub(now); remaining > 0 {
+				q.Remaining = int(remaining.Round(time.Second) / time.Second)
+			}
+		}
+		quizzes = append(quizzes, q)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	for i := range quizzes {
+		quizzes[i].Questions, err = quizQuestions(quizzes[i].ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, question := range quizzes[i].Questions {
+			quizzes[i].MaxScore += question.Points
+		}
+	}
+
+	return quizzes, nil
+}
+
+// quizQuestions returns the questions of a quiz in order with their options.
+func quizQuestions(quizID int) ([]QuizQuestion, error) {
+	rows, err := DB().Query(`
+	SELECT
+		quiz_question.id,
+		quiz_question.quiz_id,
+		quiz_question.position,
+		quiz_question.text,
+		quiz_question.points,
+		quiz_option.id,
+		quiz_option.position,
+		quiz_option.text,
+		quiz_option.correct
+	FROM
+		quiz_question
+		JOIN quiz_option ON quiz_option.question_id = quiz_question.id
+	WHERE
+		quiz_question.quiz_id = $1
+	ORDER BY
+		quiz_question.position,
+		quiz_option.position`,
+		quizID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var questions []QuizQuestion
+	for rows.Next() {
+		var question QuizQuestion
+		var option QuizOption
+		err := rows.Scan(&question.ID, &question.QuizID, &question.Position, &question.Text, &question.Points,
+			&option.ID, &option.Position, &option.Text, &option.Correct)
+		if err != nil {
+			return nil, err
+		}
+		option.QuestionID = question.ID
+
+		if len(questions) == 0 || questions[len(questions)-1].ID != question.ID {
+			questions = append(questions, question)
+		}
+		last := &questions[len(questions)-1]
+		last.Options = append(last.Options, option)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return questions, nil
+}
+
+// GetByID returns a quiz with its questions and their options.
+// It returns a Quiz struct and any error encountered, sql.ErrNoRows when there is no such quiz.
+func (q *Quiz) GetByID(quizID int, timezone int) (Quiz, error) {
+	quizzes, err := queryQuizzes(`
+	SELECT`+quizColumns+`
+	FROM
+		quiz
+	WHERE
+		id = ?`,
+		timezone, timezone, timezone, quizID)
+	if err != nil {
+		return Quiz{}, err
+	}
+	if len(quizzes) == 0 {
+		return Quiz{}, sql.ErrNoRows
+	}
+
+	return quizzes[0], nil
+}
+
+// GetByClassSessionID returns the quizzes of a class session in the order they were created,
+// with their questions and options.
+// It returns a slice of Quiz structs and any error encountered.
+func (q *Quiz) GetByClassSessionID(classSessionID int, timezone int) ([]Quiz, error) {
+	return queryQuizzes(`
+	SELECT`+quizColumns+`
+	FROM
+		quiz
+	WHERE
+		session_id = ?
+	ORDER BY
+		id`,
+		timezone, timezone, timezone, classSessionID)
+}
+
+// quizSubmissionColumns are the columns scanned by querySubmissions.
+const quizSubmissionColumns = `
+		quiz_submission.id,
+		quiz_submission.quiz_id,
+		quiz.title,
+		quiz_submission.user_id,
+		user.first_name,
+		user.last_name,
+		user.email,
+		COALESCE(quiz_submission.user_attendance_id, 0),
+		COALESCE(user_attendance.status, ''),
+		quiz_submission.score,
+		quiz_submission.max_score,
+		strftime('%H:%M', datetime(quiz_submission.submitted_at, (? || ' minutes')))`
+
+// quizSubmissionTables are the tables the columns of quizSubmissionColumns are read from.
+const quizSubmissionTables = `
+		quiz_submission
+		JOIN quiz ON quiz.id = quiz_submission.quiz_id
+		JOIN user ON user.id = quiz_submission.user_id
+		LEFT JOIN user_attendance ON user_attendance.id = quiz_submission.user_attendance_id`
+
+// querySubmissions returns the submissions selected by a query of quizSubmissionColumns.
+// It returns a slice of QuizSubmission structs and any error encountered.
+func querySubmissions(sqlStatement string, args ...interface{}) ([]QuizSubmission, error) {
+	rows, err := DB().Query(sqlStatement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var submissions []QuizSubmission
+	for rows.Next() {
+		var s QuizSubmission
+		err := rows.Scan(&s.ID, &s.QuizID, &s.QuizTitle, &s.UserID, &s.FirstName, &s.LastName, &s.Email,
+			&s.UserAttendanceID, &s.AttendanceStatus, &s.Score, &s.MaxScore, &s.SubmittedAt)
+		if err != nil {
+			return nil, err
+		}
+		submissions = append(submissions, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return submissions, nil
+}
+
+// GetByQuizID returns the submissions of a quiz by student name.
+// It returns a slice of QuizSubmission structs and any error encountered.
+func (s *QuizSubmission) GetByQuizID(quizID int, timezone int) ([]QuizSubmission, error) {
+	return querySubmissions(`
+	SELECT`+quizSubmissionColumns+`
+	FROM`+quizSubmissionTables+`
+	WHERE
+		quiz_submission.quiz_id = ?
+	ORDER BY
+		user.last_name,
+		user.first_name`,
+		timezone, quizID)
+}
+
+// GetByAttendanceID returns the submissions to the quizzes of the class session an attendance was taken for.
+// It returns a slice of QuizSubmission structs and any error encountered.
+func (s *QuizSubmission) GetByAttendanceID(attendanceID int, timezone int) ([]QuizSubmission, error) {
+	return querySubmissions(`
+	SELECT`+quizSubmissionColumns+`
+	FROM`+quizSubmissionTables+`
+		JOIN attendance ON attendance.class_session_id = quiz.session_id
+	WHERE
+		attendance.id = ?
+	ORDER BY
+		quiz.id`,
+		timezone, attendanceID)
+}
+
+// GetByUser returns the submission of a student to a quiz with the option they chose for each question answered.
+// It returns a QuizSubmission struct and any error encountered, sql.ErrNoRows when they didn't submit it.
+func (s *QuizSubmission) GetByUser(quizID int, userID int, timezone int) (QuizSubmission, error) {
+	submissions, err := querySubmissions(`
+	SELECT`+quizSubmissionColumns+`
+	FROM`+quizSubmissionTables+`
+	WHERE
+		quiz_submission.quiz_id = ? AND
+		quiz_submission.user_id = ?`,
+		timezone, quizID, userID)
+	if err != nil {
+		return QuizSubmission{}, err
+	}
+	if len(submissions) == 0 {
+		return QuizSubmission{}, sql.ErrNoRows
+	}
+	submission := submissions[0]
+
+	rows, err := DB().Query(`
+	SELECT
+		question_id,
+		option_id
+	FROM
+		quiz_answer
+	WHERE
+		submission_id = $1`,
+		submission.ID)
+	if err != nil {
+		return QuizSubmission{}, err
+	}
+	defer rows.Close()
+
+	submission.Answers = make(map[int]int)
+	for rows.Next() {
+		var questionID, optionID int
+		if err := rows.Scan(&questionID, &optionID); err != nil {
+			return QuizSubmission{}, err
+		}
+		submission.Answers[questionID] = optionID
+	}
+	if err := rows.Err(); err != nil {
+		return QuizSubmission{}, err
+	}
+
+	return submission, nil
+}
+
+// GetScoresBySectionID returns the score of every student enrolled in a section in every quiz
+// given in its class sessions, by date and student name. Students who didn't submit a quiz are
+// listed with a score of 0, along with their attendance for the class session.
+// It returns a slice of QuizScore structs and any error encountered.
+func (q *Quiz) GetScoresBySectionID(sectionID int, timezone int) ([]QuizScore, error) {
+	rows, err := DB().Query(`
+	SELECT
+		COALESCE(strftime('%Y-%m-%d', datetime(quiz.opened_at, (? || ' minutes'))), ''),
+		quiz.session_id,
+		quiz.id,
+		quiz.title,
+		user.id,
+		user.email,
+		user.first_name,
+		user.last_name,
+		quiz_submission.id IS NOT NULL,
+		COALESCE(quiz_submission.score, 0),
+		(SELECT COALESCE(SUM(points), 0) FROM quiz_question WHERE quiz_question.quiz_id = quiz.id),
+		COALESCE(strftime('%H:%M', datetime(quiz_submission.submitted_at, (? || ' minutes'))), ''),
+		COALESCE((
+			SELECT
+				user_attendance.status
+			FROM
+				user_attendance
+				JOIN attendance ON attendance.id = user_attendance.attendance_id
+			WHERE
+				attendance.class_session_id = quiz.session_id AND
+				user_attendance.user_id = user.id
+			ORDER BY
+				user_attendance.id DESC
+			LIMIT 1), '')
+	FROM
+		quiz
+		JOIN class_session ON class_session.id = quiz.session_id
+		JOIN enrollment ON enrollment.section_id = class_session.section_id
+		JOIN user ON user.id = enrollment.user_id
+		LEFT JOIN quiz_submission ON quiz_submission.quiz_id = quiz.id AND quiz_submission.user_id = user.id
+	WHERE
+		class_session.section_id = ? AND
+		quiz.status != 'draft'
+	ORDER BY
+		quiz.opened_at,
+		quiz.id,
+		user.last_name,
+		user.first_name`,
+		timezone, timezone, sectionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var scores []QuizScore
+	for rows.Next() {
+		var s QuizScore
+		err := rows.Scan(&s.Date, &s.ClassSessionID, &s.QuizID, &s.QuizTitle, &s.UserID, &s.Email, &s.FirstName, &s.LastName,
+			&s.Submitted, &s.Score, &s.MaxScore, &s.SubmittedAt, &s.AttendanceStatus)
+		if err != nil {
+			return nil, err
+		}
+		scores = append(scores, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return scores, nil
+}
+
+// ** UPDATE **
+// Open opens a draft quiz to the class. The time limit, if any, starts running now.
+// It returns ErrQuizStatus if the quiz isn't a draft and any other error encountered.
+func (q *Quiz) Open(quizID int) error {
+	db := DB()
+
+	var timeLimit int
+	err := db.QueryRow(`
+	SELECT
+		time_limit
+	FROM
+		quiz
+	WHERE
+		id = $1`,
+		quizID).Scan(&timeLimit)
+	if err == sql.ErrNoRows {
+		return ErrQuizStatus
+	}
+	if err != nil {
+		return err
+	}
+
+	var closesAt sql.NullString
+	if timeLimit > 0 {
+		closesAt.String = time.Now().UTC().Add(time.Duration(timeLimit) * time.Second).Format(quizTimeLayout)
+		closesAt.Valid = true
+	}
+
+	result, err := db.Exec(`
+	UPDATE
+		quiz
+	SET
+		status = 'open',
+		opened_at = datetime('now'),
+		closes_at = $1
+	WHERE
+		id = $2 AND
+		status = 'draft'`,
+		closesAt, quizID)
+	if err != nil {
+		return err
+	}
+
+	return quizStatusChanged(result)
+}
+
+// Close stops an open quiz from taking submissions.
+// It returns ErrQuizStatus if the quiz isn't open and any other error encountered.
+func (q *Quiz) Close(quizID int) error {
+	result, err := DB().Exec(`
+	UPDATE
+		quiz
+	SET
+		status = 'closed',
+		closed_at = datetime('now')
+	WHERE
+		id = $1 AND
+		status = 'open'`,
+		quizID)
+	if err != nil {
+		return err
+	}
+
+	return quizStatusChanged(result)
+}
+
+// quizStatusChanged returns ErrQuizStatus if an update of the status of a quiz changed no row
+// and any error encountered.
+func quizStatusChanged(result sql.Result) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrQuizStatus
+	}
+
+	return nil
+}
+
+// CloseAll closes the open quizzes of a class session, when it ends.
+// It returns the IDs of the quizzes closed and any error encountered.
+func (q *Quiz) CloseAll(classSessionID int) ([]int, error) {
+	rows, err := DB().Query(`
+	SELECT
+		id
+	FROM
+		quiz
+	WHERE
+		session_id = $1 AND
+		status = 'open'`,
+		classSessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var quizIDs []int
+	for rows.Next() {
+		var quizID int
+		if err := rows.Scan(&quizID); err != nil {
+			return nil, err
+		}
+		quizIDs = append(quizIDs, quizID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	var closed []int
+	for _, quizID := range quizIDs {
+		err := q.Close(quizID)
+		if err == ErrQuizStatus {
+			// Closed by a moderator or its time limit in the meantime
+			continue
+		}
+		if err != nil {
+			return closed, err
+		}
+		closed = append(closed, quizID)
+	}
+
+	return closed, nil
+}
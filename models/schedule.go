@@ -0,0 +1,741 @@
+package models
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Day abbreviations accepted in schedule.day, longest first so that "Th" wins over "T".
+var scheduleDayNames = []struct {
+	name string
+	day  time.Weekday
+}{
+	{"wednesday", time.Wednesday}, {"thursday", time.Thursday}, {"saturday", time.Saturday},
+	{"tuesday", time.Tuesday}, {"monday", time.Monday}, {"friday", time.Friday}, {"sunday", time.Sunday},
+	{"thurs", time.Thursday}, {"tues", time.Tuesday},
+	{"mon", time.Monday}, {"tue", time.Tuesday}, {"wed", time.Wednesday}, {"thu", time.Thursday},
+	{"fri", time.Friday}, {"sat", time.Saturday}, {"sun", time.Sunday},
+	{"tu", time.Tuesday}, {"th", time.Thursday}, {"sa", time.Saturday}, {"su", time.Sunday},
+	{"m", time.Monday}, {"t", time.Tuesday}, {"w", time.Wednesday}, {"r", time.Thursday}, {"f", time.Friday},
+	{"u", time.Sunday},
+}
+
+// ParseScheduleDays parses the days of a schedule such as "M W F", "TuTh" or "Monday, Wednesday".
+// It returns the weekdays in order and any error encountered.
+func ParseScheduleDays(days string) ([]time.Weekday, error) {
+	s := strings.ToLower(days)
+	s = strings.NewReplacer(",", " ", "/", " ", "&", " ", "-", " ", ".", " ").Replace(s)
+
+	seen := map[time.Weekday]bool{}
+	for _, word := range strings.Fields(s) {
+		if word == "and" {
+			continue
+		}
+		for word != "" {
+			matched := false
+			for _, d := range scheduleDayNames {
+				if strings.HasPrefix(word, d.name) {
+					seen[d.day] = true
+					word = word[len(d.name):]
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unknown day %q in schedule %q", word, days)
+			}
+		}
+	}
+
+	if len(seen) == 0 {
+		return nil, fmt.Errorf("no days in schedule %q", days)
+	}
+
+	var weekdays []time.Weekday
+	for d := range seen {
+		weekdays = append(weekdays, d)
+	}
+	sort.Slice(weekdays, func(i, j int) bool { return weekdays[i] < weekdays[j] })
+
+	return weekdays, nil
+}
+
+// ParseTimeslot parses a time range such as "11:15 AM-12:05 PM", "12pm - 1pm" or "18:00-20:00".
+// A start time without AM or PM takes the meridiem of the end time when that keeps it before the end.
+// It returns the start and end as offsets from midnight and any error encountered.
+func ParseTimeslot(timeslot string) (time.Duration, time.Duration, error) {
+	s := strings.ToLower(strings.TrimSpace(timeslot))
+	s = strings.NewReplacer("–", "-", "—", "-", " to ", "-").Replace(s)
+
+	parts := strings.Split(s, "-")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("timeslot %q is not a start-end range", timeslot)
+	}
+
+	startHour, startMinute, startSuffix, err := parseClock(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("timeslot %q: %w", timeslot, err)
+	}
+	endHour, endMinute, endSuffix, err := parseClock(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("timeslot %q: %w", timeslot, err)
+	}
+
+	end, err := clockOffset(endHour, endMinute, endSuffix)
+	if err != nil {
+		return 0, 0, fmt.Errorf("timeslot %q: %w", timeslot, err)
+	}
+
+	if startSuffix == "" && endSuffix != "" {
+		startSuffix = endSuffix
+		if start, err := clockOffset(startHour, startMinute, startSuffix); err != nil || start >= end {
+			startSuffix = "am"
+		}
+	}
+	start, err := clockOffset(startHour, startMinute, startSuffix)
+	if err != nil {
+		return 0, 0, fmt.Errorf("timeslot %q: %w", timeslot, err)
+	}
+
+	if end <= start {
+		return 0, 0, fmt.Errorf("timeslot %q ends before it starts", timeslot)
+	}
+
+	return start, end, nil
+}
+
+// parseClock splits a clock time such as "11:15 am" or "1pm" into its parts.
+func parseClock(clock string) (int, int, string, error) {
+	c := strings.ReplaceAll(strings.TrimSpace(clock), " ", "")
+	c = strings.ReplaceAll(c, ".", "")
+
+	suffix := ""
+	switch {
+	case strings.HasSuffix(c, "am"), strings.HasSuffix(c, "pm"):
+		suffix = c[len(c)-2:]
+		c = c[:len(c)-2]
+	case strings.HasSuffix(c, "a"), strings.HasSuffix(c, "p"):
+		suffix = c[len(c)-1:] + "m"
+		c = c[:len(c)-1]
+	}
+
+	hourText, minuteText := c, "0"
+	if i := strings.IndexByte(c, ':'); i >= 0 {
+		hourText, minuteText = c[:i], c[i+1:]
+	}
+
+	hour, err := strconv.Atoi(hourText)
+	if err != nil {
+		return 0, 0, "", fmt.Errorf("invalid time %q", clock)
+	}
+	minute, err := strconv.Atoi(minuteText)
+	if err != nil || minute < 0 || minute > 59 {
+		return 0, 0, "", fmt.Errorf("invalid time %q", clock)
+	}
+
+	return hour, minute, suffix, nil
+}
+
+// clockOffset converts a 12 or 24 hour clock time to an offset from midnight.
+func clockOffset(hour, minute int, suffix string) (time.Duration, error) {
+	switch suffix {
+	case "am", "pm":
+		if hour < 1 || hour > 12 {
+			return 0, fmt.Errorf("invalid hour %d", hour)
+		}
+		hour = hour % 12
+		if suffix == "pm" {
+			hour += 12
+		}
+	default:
+		if hour < 0 || hour > 24 || (hour == 24 && minute != 0) {
+			return 0, fmt.Errorf("invalid hour %d", hour)
+		}
+	}
+
+	return time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute, nil
+}
+
+// Schedule is the weekly recurrence of a section's meetings. Meetings happen on
+// each weekday between StartTime and EndTime, in the organization timezone,
+// for every date of the course term that is not one of the section's holidays.
+type Schedule struct {
+	ID        int
+	SectionID int
+	Weekdays  []time.Weekday
+	// StartTime and EndTime are 24 hour "15:04" clock times.
+	StartTime string
+	EndTime   string
+	Room      string
+	// TermStart and TermEnd are the course start and end dates, "2006-01-02".
+	TermStart string
+	TermEnd   string
+	// Holidays are the "2006-01-02" dates the section does not meet.
+	Holidays []string
+}
+
+// Holiday is a date on which a section does not meet.
+type Holiday struct {
+	ID          int
+	SectionID   int
+	Date        string
+	Description string
+}
+
+// Occurrence is a single meeting of a section.
+type Occurrence struct {
+	SectionID  int
+	ScheduleID int
+	Start      time.Time
+	End        time.Time
+	Room       string
+}
+
+// DateLayout is the layout of course and holiday dates.
+const DateLayout = "2006-01-02"
+
+// clockLayout is the layout of schedule start and end times.
+const clockLayout = "15:04"
+
+// Two letter weekday codes stored in schedule.weekdays, as used by iCalendar.
+var weekdayCodes = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// Weekday abbreviations written to the schedule.day display column.
+var weekdayAbbreviations = []string{"Su", "M", "Tu", "W", "Th", "F", "Sa"}
+
+// ParseSchedule parses the free text day and timeslot of a schedule such as "M W F" and "11:15 AM-12:05 PM".
+// It returns a Schedule with the weekdays and times set and any error encountered.
+func ParseSchedule(day, timeslot string) (Schedule, error) {
+	days, err := ParseScheduleDays(day)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	start, end, err := ParseTimeslot(timeslot)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	return Schedule{Weekdays: days, StartTime: formatClock(start), EndTime: formatClock(end)}, nil
+}
+
+// ParseScheduleString parses a "days|time" schedule such as "M W F | 11:15 AM-12:05 PM", as in ParseSchedule.
+// It returns a Schedule with the weekdays and times set and any error encountered.
+func ParseScheduleString(schedule string) (Schedule, error) {
+	parts := strings.Split(schedule, "|")
+	if len(parts) != 2 {
+		return Schedule{}, fmt.Errorf("schedule %q is not in the form days|time", schedule)
+	}
+
+	return ParseSchedule(strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]))
+}
+
+// formatClock formats an offset from midnight as a "15:04" clock time.
+func formatClock(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+}
+
+// parseClockTime parses a "15:04" clock time as an offset from midnight.
+func parseClockTime(clock string) (time.Duration, error) {
+	t, err := time.Parse(clockLayout, clock)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, want HH:MM", clock)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// Validate checks that the schedule has at least one weekday, valid times that end after
+// they start, and a room name of at most 64 characters.
+// It returns the first problem found.
+func (s Schedule) Validate() error {
+	if len(s.Weekdays) == 0 {
+		return errors.New("schedule needs at least one weekday")
+	}
+	for _, d := range s.Weekdays {
+		if d < time.Sunday || d > time.Saturday {
+			return fmt.Errorf("invalid weekday %d", d)
+		}
+	}
+
+	start, err := parseClockTime(s.StartTime)
+	if err != nil {
+		return err
+	}
+	end, err := parseClockTime(s.EndTime)
+	if err != nil {
+		return err
+	}
+	if end <= start {
+		return fmt.Errorf("schedule ends at %s before it starts at %s", s.EndTime, s.StartTime)
+	}
+
+	if len(s.Room) > 64 {
+		return errors.New("room must be at most 64 characters")
+	}
+
+	return nil
+}
+
+// Days returns the weekdays as display text such as "M W F".
+func (s Schedule) Days() string {
+	var days []string
+	for _, d := range sortedWeekdays(s.Weekdays) {
+		days = append(days, weekdayAbbreviations[d])
+	}
+	return strings.Join(days, " ")
+}
+
+// Timeslot returns the start and end times as display text such as "11:15 AM-12:05 PM".
+func (s Schedule) Timeslot() string {
+	start, errStart := time.Parse(clockLayout, s.StartTime)
+	end, errEnd := time.Parse(clockLayout, s.EndTime)
+	if errStart != nil || errEnd != nil {
+		return s.StartTime + "-" + s.EndTime
+	}
+	return start.Format("03:04 PM") + "-" + end.Format("03:04 PM")
+}
+
+// WeekdayCodes returns the weekdays as two letter codes such as "MO,WE,FR".
+func (s Schedule) WeekdayCodes() string {
+	var codes []string
+	for _, d := range sortedWeekdays(s.Weekdays) {
+		codes = append(codes, weekdayCodes[d])
+	}
+	return strings.Join(codes, ",")
+}
+
+// parseWeekdayCodes parses the weekday codes stored in schedule.weekdays.
+func parseWeekdayCodes(codes string) []time.Weekday {
+	var weekdays []time.Weekday
+	for _, code := range strings.Split(codes, ",") {
+		for d, c := range weekdayCodes {
+			if strings.EqualFold(strings.TrimSpace(code), c) {
+				weekdays = append(weekdays, time.Weekday(d))
+			}
+		}
+	}
+	return sortedWeekdays(weekdays)
+}
+
+// sortedWeekdays returns the weekdays without duplicates, Sunday first.
+func sortedWeekdays(weekdays []time.Weekday) []time.Weekday {
+	seen := map[time.Weekday]bool{}
+	var sorted []time.Weekday
+	for _, d := range weekdays {
+		if !seen[d] {
+			seen[d] = true
+			sorted = append(sorted, d)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
+
+// MeetsOn reports whether the schedule meets on the given weekday.
+func (s Schedule) MeetsOn(day time.Weekday) bool {
+	for _, d := range s.Weekdays {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+// term returns the first and last dates of the course term. Either may be zero when unset.
+// Dates entered in the wrong order are swapped.
+func (s Schedule) term(loc *time.Location) (time.Time, time.Time) {
+	first, _ := time.ParseInLocation(DateLayout, s.TermStart, loc)
+	last, _ := time.ParseInLocation(DateLayout, s.TermEnd, loc)
+	if !first.IsZero() && !last.IsZero() && last.Before(first) {
+		first, last = last, first
+	}
+	return first, last
+}
+
+// Occurrences expands the schedule into the meetings that start between from and to,
+// in the given timezone, skipping dates outside the course term and holidays.
+func (s Schedule) Occurrences(from, to time.Time, loc *time.Location) []Occurrence {
+	start, errStart := parseClockTime(s.StartTime)
+	end, errEnd := parseClockTime(s.EndTime)
+	if errStart != nil || errEnd != nil || len(s.Weekdays) == 0 {
+		return nil
+	}
+
+	termFirst, termLast := s.term(loc)
+	holidays := map[string]bool{}
+	for _, h := range s.Holidays {
+		holidays[h] = true
+	}
+
+	from, to = from.In(loc), to.In(loc)
+	var occurrences []Occurrence
+	for day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, loc); day.Before(to); day = day.AddDate(0, 0, 1) {
+		if !s.MeetsOn(day.Weekday()) || holidays[day.Format(DateLayout)] {
+			continue
+		}
+		if (!termFirst.IsZero() && day.Before(termFirst)) || (!termLast.IsZero() && day.After(termLast)) {
+			continue
+		}
+
+		occurrence := Occurrence{
+			SectionID:  s.SectionID,
+			ScheduleID: s.ID,
+			Start:      day.Add(start),
+			End:        day.Add(end),
+			Room:       s.Room,
+		}
+		if occurrence.Start.Before(from) || !occurrence.Start.Before(to) {
+			continue
+		}
+		occurrences = append(occurrences, occurrence)
+	}
+
+	return occurrences
+}
+
+// ** CREATE **
+// Add validates a schedule and adds it to its section.
+// It returns the schedule id and any encountered errors.
+func (s Schedule) Add(schedule Schedule) (int, error) {
+	if err := schedule.Validate(); err != nil {
+		return 0, err
+	}
+
+	db := DB()
+
+	sqlStatement := `
+	INSERT INTO
+		schedule
+		(section_id,
+		day,
+		timeslot,
+		weekdays,
+		start_time,
+		end_time,
+		room)
+	VALUES
+		($1,
+		$2,
+		$3,
+		$4,
+		$5,
+		$6,
+		$7)
+	RETURNING id
+	`
+	var scheduleID int
+	err := db.QueryRow(sqlStatement, schedule.SectionID, schedule.Days(), schedule.Timeslot(), schedule.WeekdayCodes(),
+		schedule.StartTime, schedule.EndTime, schedule.Room).Scan(&scheduleID)
+	if err != nil {
+		return 0, err
+	}
+
+	return scheduleID, nil
+}
+
+// Add adds a holiday on which a section does not meet.
+// It returns the holiday id and any encountered errors.
+func (h Holiday) Add(sectionID int, date, description string) (int, error) {
+	if _, err := time.Parse(DateLayout, date); err != nil {
+		return 0, fmt.Errorf("invalid date %q, want YYYY-MM-DD", date)
+	}
+
+	db := DB()
+
+	var holidayID int
+	err := db.QueryRow(`
+	INSERT INTO
+		holiday
+		(section_id,
+		date,
+		description)
+	VALUES
+		($1,
+		$2,
+		$3)
+	RETURNING id`,
+		sectionID, date, description).Scan(&holidayID)
+	if err != nil {
+		return 0, err
+	}
+
+	return holidayID, nil
+}
+
+// ** READ **
+// scheduleColumns selects a schedule row with its course term dates.
+const scheduleColumns = `
+		schedule.id,
+		schedule.section_id,
+		COALESCE(schedule.weekdays, ''),
+		COALESCE(schedule.start_time, ''),
+		COALESCE(schedule.end_time, ''),
+		COALESCE(schedule.room, ''),
+		course.start_date,
+		course.end_date
+	FROM
+		schedule
+		JOIN
+		section
+		ON schedule.section_id = section.id
+		JOIN
+		course
+		ON section.course_id = course.id`
+
+// querySchedules runs a schedule query and loads the holidays of each section.
+func querySchedules(sqlStatement string, args ...interface{}) ([]Schedule, error) {
+	db := DB()
+
+	rows, err := db.Query(sqlStatement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var schedules []Schedule
+	for rows.Next() {
+		var schedule Schedule
+		var weekdays string
+		err := rows.Scan(&schedule.ID, &schedule.SectionID, &weekdays, &schedule.StartTime, &schedule.EndTime,
+			&schedule.Room, &schedule.TermStart, &schedule.TermEnd)
+		if err != nil {
+			return nil, err
+		}
+		schedule.Weekdays = parseWeekdayCodes(weekdays)
+		schedules = append(schedules, schedule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	holidays := map[int][]string{}
+	for i, schedule := range schedules {
+		dates, ok := holidays[schedule.SectionID]
+		if !ok {
+			sectionHolidays, err := new(Holiday).GetBySectionID(schedule.SectionID)
+			if err != nil {
+				return nil, err
+			}
+			for _, h := range sectionHolidays {
+				dates = append(dates, h.Date)
+			}
+			holidays[schedule.SectionID] = dates
+		}
+		schedules[i].Holidays = dates
+	}
+
+	return schedules, nil
+}
+
+// Get returns a schedule given its id.
+// It returns the Schedule struct and any encountered errors.
+func (s Schedule) Get(scheduleID int) (Schedule, error) {
+	schedules, err := querySchedules(`SELECT`+scheduleColumns+`
+	WHERE
+		schedule.id = $1`,
+		scheduleID)
+	if err != nil {
+		return Schedule{}, err
+	}
+	if len(schedules) == 0 {
+		return Schedule{}, sql.ErrNoRows
+	}
+
+	return schedules[0], nil
+}
+
+// GetBySectionID returns the schedules of a section.
+// It returns a slice of Schedule structs and any encountered errors.
+func (s Schedule) GetBySectionID(sectionID int) ([]Schedule, error) {
+	return querySchedules(`SELECT`+scheduleColumns+`
+	WHERE
+		schedule.section_id = $1
+	ORDER BY
+		schedule.id`,
+		sectionID)
+}
+
+// GetAll returns every schedule that has structured weekdays and times.
+// It returns a slice of Schedule structs and any encountered errors.
+func (s Schedule) GetAll() ([]Schedule, error) {
+	return querySchedules(`SELECT` + scheduleColumns + `
+	WHERE
+		schedule.weekdays IS NOT NULL
+	ORDER BY
+		schedule.section_id,
+		schedule.id`)
+}
+
+// GetOccurrences expands the schedules of a section into the meetings that start between from and to,
+// in the organization timezone, in start order.
+// It returns a slice of Occurrence structs and any encountered errors.
+func (s Schedule) GetOccurrences(sectionID int, from, to time.Time) ([]Occurrence, error) {
+	schedules, err := s.GetBySectionID(sectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := OrganizationLocation()
+	var occurrences []Occurrence
+	for _, schedule := range schedules {
+		occurrences = append(occurrences, schedule.Occurrences(from, to, loc)...)
+	}
+	sort.Slice(occurrences, func(i, j int) bool { return occurrences[i].Start.Before(occurrences[j].Start) })
+
+	return occurrences, nil
+}
+
+// GetBySectionID returns the holidays of a section in date order.
+// It returns a slice of Holiday structs and any encountered errors.
+func (h Holiday) GetBySectionID(sectionID int) ([]Holiday, error) {
+	db := DB()
+
+	rows, err := db.Query(`
+	SELECT
+		id,
+		section_id,
+		date,
+		description
+	FROM
+		holiday
+	WHERE
+		section_id = $1
+	ORDER BY
+		date`,
+		sectionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var holidays []Holiday
+	for rows.Next() {
+		var holiday Holiday
+		err := rows.Scan(&holiday.ID, &holiday.SectionID, &holiday.Date, &holiday.Description)
+		if err != nil {
+			return nil, err
+		}
+		holidays = append(holidays, holiday)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return holidays, nil
+}
+
+// ** UPDATE **
+// Update validates a schedule and replaces the stored one with the same id.
+// It returns any encountered errors.
+func (s Schedule) Update(schedule Schedule) error {
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+
+	db := DB()
+
+	_, err := db.Exec(`
+	UPDATE
+		schedule
+	SET
+		day = $1,
+		timeslot = $2,
+		weekdays = $3,
+		start_time = $4,
+		end_time = $5,
+		room = $6
+	WHERE
+		id = $7`,
+		schedule.Days(), schedule.Timeslot(), schedule.WeekdayCodes(), schedule.StartTime, schedule.EndTime,
+		schedule.Room, schedule.ID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ** DELETE **
+// Delete removes a schedule.
+// It returns any encountered errors.
+func (s Schedule) Delete(scheduleID int) error {
+	db := DB()
+
+	_, err := db.Exec(`
+	DELETE FROM
+		schedule
+	WHERE
+		id = $1`,
+		scheduleID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Delete removes a holiday from a section.
+// It returns any encountered errors.
+func (h Holiday) Delete(sectionID, holidayID int) error {
+	db := DB()
+
+	_, err := db.Exec(`
+	DELETE FROM
+		holiday
+	WHERE
+		id = $1
+		AND section_id = $2`,
+		holidayID, sectionID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// backfillSchedules fills the structured columns of schedule rows from their free text day and timeslot.
+// Rows whose text cannot be parsed are left unstructured.
+func backfillSchedules(tx *Tx) error {
+	rows, err := tx.Query(`SELECT id, day, timeslot FROM schedule WHERE weekdays IS NULL`)
+	if err != nil {
+		return err
+	}
+
+	type row struct {
+		id            int
+		day, timeslot string
+	}
+	var pending []row
+	for rows.Next() {
+		var r row
+		if err := rows.Scan(&r.id, &r.day, &r.timeslot); err != nil {
+			rows.Close()
+			return err
+		}
+		pending = append(pending, r)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, r := range pending {
+		schedule, err := ParseSchedule(r.day, r.timeslot)
+		if err != nil {
+			continue
+		}
+		_, err = tx.Exec(`UPDATE schedule SET weekdays = $1, start_time = $2, end_time = $3 WHERE id = $4`,
+			schedule.WeekdayCodes(), schedule.StartTime, schedule.EndTime, r.id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
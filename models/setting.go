@@ -16,7 +16,7 @@ type Setting struct {
 // Add adds a new setting to the database.
 // It returns any error encountered.
 func (s Setting) Add(UserId int) (int, error) {
-	db := NewDB()
+	db := DB()
 
 	// Get the Organization ID
 	organizationID, err := new(Organization).GetOrganizationID()
@@ -58,7 +58,7 @@ func (s Setting) Get(UserId int) (Setting, error) {
 	var DarkTheme bool
 	var TimezoneOffset int
 
-	db := NewDB()
+	db := DB()
 	sqlStatement := `
 		SELECT 
 			* 
@@ -83,7 +83,7 @@ func (s Setting) Get(UserId int) (Setting, error) {
 // Update setting for a user in the database.
 // It returns any error encountered.
 func (s Setting) Update(userID int, name string, value string, timezoneOffset int) error {
-	db := NewDB()
+	db := DB()
 
 	sqlStatement := fmt.Sprintf(`
         UPDATE 
@@ -105,7 +105,7 @@ func (s Setting) Update(userID int, name string, value string, timezoneOffset in
 // UpdateTimezone updates the timezone offset for a user in the database.
 // It returns any error encountered.
 func (s Setting) UpdateTimezone(userID int, timezoneOffset int) error {
-	db := NewDB()
+	db := DB()
 
 	sqlStatement := `
 		UPDATE
@@ -125,7 +125,7 @@ func (s Setting) UpdateTimezone(userID int, timezoneOffset int) error {
 
 // ToggleDarkTheme toggles the dark theme setting for a user in the database.
 func (s Setting) ToggleDarkTheme(userID int) (bool, error) {
-	db := NewDB()
+	db := DB()
 
 	updateStatement := `
 		UPDATE 
@@ -161,7 +161,7 @@ func (s Setting) ToggleDarkTheme(userID int) (bool, error) {
 // Delete deletes a setting from the database.
 // It returns any error encountered.
 func (s Setting) Delete(UserId int) error {
-	db := NewDB()
+	db := DB()
 
 	sqlStatement := `
 		DELETE FROM
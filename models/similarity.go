@@ -0,0 +1,98 @@
+package models
+
+import (
+	"strings"
+	"unicode"
+)
+
+// DuplicateThreshold is the similarity from which a question is suggested as a duplicate of another.
+const DuplicateThreshold = 0.6
+
+// stopWords are left out when comparing questions, so "what is a pointer" and "pointer?" still match.
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true, "be": true, "but": true,
+	"by": true, "can": true, "could": true, "do": true, "does": true, "for": true, "from": true,
+	"how": true, "i": true, "if": true, "in": true, "is": true, "it": true, "me": true, "my": true,
+	"of": true, "on": true, "or": true, "please": true, "should": true, "so": true, "that": true,
+	"the": true, "this": true, "to": true, "was": true, "we": true, "what": true, "when": true,
+	"where": true, "which": true, "who": true, "why": true, "will": true, "with": true, "would": true,
+	"you": true, "s": true, "t": true,
+}
+
+// questionWords splits a question into lower case content words with simple plural endings removed.
+func questionWords(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var words []string
+	for _, word := range fields {
+		if stopWords[word] {
+			continue
+		}
+		switch {
+		case len(word) > 4 && strings.HasSuffix(word, "ies"):
+			word = strings.TrimSuffix(word, "ies") + "y"
+		case len(word) > 3 && strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss"):
+			word = strings.TrimSuffix(word, "s")
+		}
+		words = append(words, word)
+	}
+	return words
+}
+
+// trigrams returns the set of three character sequences of text, padded so short words count too.
+func trigrams(text string) map[string]bool {
+	runes := []rune(" " + text + " ")
+	set := make(map[string]bool)
+	for i := 0; i+3 <= len(runes); i++ {
+		set[string(runes[i:i+3])] = true
+	}
+	return set
+}
+
+// QuestionSimilarity scores how alike two questions are, from 0 for unrelated to 1 for the same question.
+// It is the higher of the overlap of their content words (Jaccard) and of their character
+// trigrams (Dice), so both reworded questions and typos are caught.
+func QuestionSimilarity(a, b string) float64 {
+	wordsA, wordsB := questionWords(a), questionWords(b)
+	if len(wordsA) == 0 || len(wordsB) == 0 {
+		// Nothing but stop words; compare the raw text
+		wordsA = strings.Fields(strings.ToLower(a))
+		wordsB = strings.Fields(strings.ToLower(b))
+	}
+	if len(wordsA) == 0 || len(wordsB) == 0 {
+		return 0
+	}
+
+	setA := make(map[string]bool)
+	for _, word := range wordsA {
+		setA[word] = true
+	}
+	setB := make(map[string]bool)
+	for _, word := range wordsB {
+		setB[word] = true
+	}
+	shared := 0
+	for word := range setA {
+		if setB[word] {
+			shared++
+		}
+	}
+	jaccard := float64(shared) / float64(len(setA)+len(setB)-shared)
+
+	gramsA := trigrams(strings.Join(wordsA, " "))
+	gramsB := trigrams(strings.Join(wordsB, " "))
+	sharedGrams := 0
+	for gram := range gramsA {
+		if gramsB[gram] {
+			sharedGrams++
+		}
+	}
+	dice := 2 * float64(sharedGrams) / float64(len(gramsA)+len(gramsB))
+
+	if dice > jaccard {
+		return dice
+	}
+	return jaccard
+}
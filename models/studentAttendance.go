@@ -0,0 +1,204 @@
+package models
+
+import "database/sql"
+
+// StudentAttendance is the attendance of a student in one of the sections they are enrolled in, with how they
+// took part in each session.
+type StudentAttendance struct {
+	SectionID    int
+	CourseNumber string
+	CourseTitle  string
+	SectionName  string
+	// Sessions are the days attendance was taken in the section, oldest first
+	Sessions []StudentAttendanceSession
+	// Questions and Votes are the totals of the Sessions
+	Questions int
+	Votes     int
+	// Rate is the attendance rate of the student in percent, Rated being false while no status counts
+	Rate  float64
+	Rated bool
+}
+
+// StudentAttendanceSession is a day of a StudentAttendance.
+type StudentAttendanceSession struct {
+	AttendanceID   int
+	ClassSessionID int
+	Date           string
+	// Status is the status of the student that day, empty when they have none
+	Status string
+	// Questions and Votes are the questions the student asked and the votes they cast in the class session
+	Questions int
+	Votes     int
+	// Rate is the attendance rate of the student in percent up to that day, Rated being false while no status counts
+	Rate  float64
+	Rated bool
+}
+
+// ** READ **
+// GetByUser gets the attendance of a user in the sections they are enrolled in but don't teach, by course
+// number and section name, with dates in the timezone offset in minutes.
+// A class session with more than one attendance counts its questions and votes on the first only.
+// It returns a slice of StudentAttendance structs and any error encountered.
+func (s *StudentAttendance) GetByUser(userID int, timezone int) ([]StudentAttendance, error) {
+	db := DB()
+	records := []StudentAttendance{}
+
+	rows, err := db.Query(`
+	SELECT
+		section.id,
+		course.number,
+		course.title,
+		section.name
+	FROM
+		enrollment
+	JOIN
+		section ON section.id = enrollment.section_id
+	JOIN
+		course ON course.id = section.course_id
+	WHERE
+		enrollment.user_id = ?
+	AND
+		section.id NOT IN (
+			SELECT
+				moderator.section_id
+			FROM
+				moderator
+			WHERE
+				moderator.user_id = ?
+			AND
+				moderator.type IN ('instructor', 'teacher assistant'))
+	GROUP BY
+		section.id,
+		course.number,
+		course.title,
+		section.name
+	ORDER BY
+		course.number,
+		section.name,
+		section.id`,
+		userID, userID)
+	if err != nil {
+		return records, err
+	}
+	defer rows.Close()
+
+	sections := map[int]int{}
+	for rows.Next() {
+		record := StudentAttendance{Sessions: []StudentAttendanceSession{}}
+		if err := rows.Scan(&record.SectionID, &record.CourseNumber, &record.CourseTitle, &record.SectionName); err != nil {
+			return records, err
+		}
+		sections[record.SectionID] = len(records)
+		records = append(records, record)
+	}
+	if err := rows.Err(); err != nil {
+		return records, err
+	}
+
+	// The attendances of those sections with the latest status of the user, and what they did in the class session
+	rows, err = db.Query(`
+	SELECT
+		attendance.id,
+		attendance.section_id,
+		attendance.class_session_id,
+		strftime('%Y-%m-%d', datetime(attendance.attended_at, (? || ' minutes'))),
+		(SELECT
+			user_attendance.status
+		FROM
+			user_attendance
+		WHERE
+			user_attendance.attendance_id = attendance.id
+		AND
+			user_attendance.user_id = ?
+		ORDER BY
+			user_attendance.id DESC
+		LIMIT 1),
+		(SELECT
+			COUNT(*)
+		FROM
+			question
+		WHERE
+			question.session_id = attendance.class_session_id
+		AND
+			question.user_id = ?),
+		(SELECT
+			COUNT(*)
+		FROM
+			vote
+		JOIN
+			question ON question.id = vote.question_id
+		WHERE
+			question.session_id = attendance.class_session_id
+		AND
+			vote.user_id = ?),
+		attendance.id = (
+			SELECT
+				MIN(first.id)
+			FROM
+				attendance AS first
+			WHERE
+				first.section_id = attendance.section_id
+			AND
+				first.class_session_id = attendance.class_session_id)
+	FROM
+		attendance
+	WHERE
+		attendance.section_id IN (
+			SELECT
+				enrollment.section_id
+			FROM
+				enrollment
+			WHERE
+				enrollment.user_id = ?)
+	ORDER BY
+		attendance.attended_at,
+		attendance.id`,
+		timezone, userID, userID, userID, userID)
+	if err != nil {
+		return records, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var session StudentAttendanceSession
+		var sectionID int
+		var status sql.NullString
+		var first bool
+		err := rows.Scan(&session.AttendanceID, &sectionID, &session.ClassSessionID, &session.Date, &status,
+			&session.Questions, &session.Votes, &first)
+		if err != nil {
+			return records, err
+		}
+		if !first {
+			session.Questions, session.Votes = 0, 0
+		}
+		session.Status = status.String
+
+		index, ok := sections[sectionID]
+		if !ok {
+			continue
+		}
+		records[index].Sessions = append(records[index].Sessions, session)
+	}
+	if err := rows.Err(); err != nil {
+		return records, err
+	}
+
+	// The running rates and totals
+	for i := range records {
+		record := &records[i]
+		statuses := []string{}
+		for j := range record.Sessions {
+			session := &record.Sessions[j]
+			statuses = append(statuses, session.Status)
+			rate, ok := attendanceRate(statuses)
+			session.Rate, session.Rated = rate*100, ok
+			record.Questions += session.Questions
+			record.Votes += session.Votes
+		}
+		rate, ok := attendanceRate(statuses)
+		record.Rate, record.Rated = rate*100, ok
+	}
+
+	return records, nil
+}
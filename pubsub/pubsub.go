@@ -0,0 +1,108 @@
+package pubsub
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// ErrClosed is returned when publishing or subscribing on a closed broker.
+var ErrClosed = errors.New("pub/sub broker closed")
+
+// Handler receives the messages published on a channel.
+type Handler func(message []byte)
+
+// Broker delivers the messages published on a channel to every handler subscribed to it.
+// With a network broker the handlers of every server instance sharing it receive the message,
+// including those of the instance that published it, so live events reach clients connected
+// to any instance.
+type Broker interface {
+	// Publish sends a message to the subscribers of a channel.
+	Publish(channel string, message []byte) error
+	// Subscribe calls handler with every message published on a channel from now on.
+	Subscribe(channel string, handler Handler) error
+	// Close stops delivering messages and releases the broker's connections.
+	Close() error
+}
+
+// Config selects and configures the broker.
+type Config struct {
+	// Driver is "local" for a broker within this process, or "redis".
+	Driver string
+	// URL is the address of the network broker, such as redis://:password@localhost:6379.
+	URL string
+}
+
+// DefaultConfig returns the broker settings from the environment, falling back to a local broker.
+func DefaultConfig() Config {
+	return Config{
+		Driver: os.Getenv("PUBSUB_DRIVER"),
+		URL:    os.Getenv("PUBSUB_URL"),
+	}
+}
+
+// Open returns the broker described by cfg.
+func Open(cfg Config) (Broker, error) {
+	switch cfg.Driver {
+	case "", "local":
+		return NewLocal(), nil
+	case "redis":
+		return DialRedis(cfg.URL)
+	default:
+		return nil, fmt.Errorf("unknown pub/sub driver %q", cfg.Driver)
+	}
+}
+
+// ** LOCAL **
+
+// Local is a broker within a single process. Publish calls the handlers of the channel
+// before it returns, in the order they subscribed.
+type Local struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+	closed   bool
+}
+
+// NewLocal returns a broker with no subscribers.
+func NewLocal() *Local {
+	return &Local{handlers: make(map[string][]Handler)}
+}
+
+// Publish calls every handler subscribed to the channel with the message.
+func (l *Local) Publish(channel string, message []byte) error {
+	l.mu.RLock()
+	handlers := l.handlers[channel]
+	closed := l.closed
+	l.mu.RUnlock()
+
+	if closed {
+		return ErrClosed
+	}
+	for _, handler := range handlers {
+		handler(message)
+	}
+	return nil
+}
+
+// Subscribe adds a handler for the messages of a channel.
+func (l *Local) Subscribe(channel string, handler Handler) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return ErrClosed
+	}
+	l.handlers[channel] = append(l.handlers[channel], handler)
+	return nil
+}
+
+// Close drops every subscriber.
+func (l *Local) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.closed = true
+	l.handlers = make(map[string][]Handler)
+	return nil
+}
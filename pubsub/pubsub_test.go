@@ -0,0 +1,162 @@
+package pubsub_test
+
+import (
+	"coeus/pubsub"
+	"coeus/pubsub/pubsubtest"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// collector records the messages a handler receives.
+type collector struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+func (c *collector) handle(message []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.messages = append(c.messages, string(message))
+}
+
+// wait returns the messages once n have arrived, failing the test after a few seconds.
+func (c *collector) wait(t *testing.T, n int) []string {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		c.mu.Lock()
+		if len(c.messages) >= n {
+			messages := append([]string(nil), c.messages...)
+			c.mu.Unlock()
+			return messages
+		}
+		c.mu.Unlock()
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %d messages", n)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestLocal(t *testing.T) {
+	broker := pubsub.NewLocal()
+
+	var first, second, other collector
+	broker.Subscribe("votes", first.handle)
+	broker.Subscribe("votes", second.handle)
+	broker.Subscribe("other", other.handle)
+
+	if err := broker.Publish("votes", []byte("1")); err != nil {
+		t.Fatalf("publish failed with error: %v", err)
+	}
+
+	// Local handlers run before Publish returns
+	if len(first.messages) != 1 || len(second.messages) != 1 || len(other.messages) != 0 {
+		t.Errorf("delivered %v, %v and %v, want the message on the votes channel only", first.messages, second.messages, other.messages)
+	}
+
+	broker.Close()
+	if err := broker.Publish("votes", []byte("2")); err != pubsub.ErrClosed {
+		t.Errorf("publish on a closed broker returned %v, want ErrClosed", err)
+	}
+}
+
+func TestOpen(t *testing.T) {
+	if broker, err := pubsub.Open(pubsub.Config{}); err != nil {
+		t.Errorf("open without a driver failed with error: %v", err)
+	} else if _, ok := broker.(*pubsub.Local); !ok {
+		t.Errorf("open without a driver returned %T, want a local broker", broker)
+	}
+	if _, err := pubsub.Open(pubsub.Config{Driver: "carrier-pigeon"}); err == nil {
+		t.Error("open with an unknown driver succeeded")
+	}
+	if _, err := pubsub.Open(pubsub.Config{Driver: "redis", URL: "http://localhost:6379"}); err == nil {
+		t.Error("open with a URL that isn't redis:// succeeded")
+	}
+}
+
+func TestRedis(t *testing.T) {
+	server, err := pubsubtest.NewServer()
+	if err != nil {
+		t.Fatalf("starting the stand-in server failed with error: %v", err)
+	}
+	defer server.Close()
+
+	// Two instances of the server, each with its own broker
+	brokers := make([]*pubsub.Redis, 2)
+	collectors := make([]*collector, 2)
+	for i := range brokers {
+		brokers[i], err = pubsub.DialRedis(server.URL())
+		if err != nil {
+			t.Fatalf("dial failed with error: %v", err)
+		}
+		defer brokers[i].Close()
+
+		collectors[i] = &collector{}
+		if err := brokers[i].Subscribe("events", collectors[i].handle); err != nil {
+			t.Fatalf("subscribe failed with error: %v", err)
+		}
+	}
+
+	const perBroker = 50
+	var wg sync.WaitGroup
+	for i, broker := range brokers {
+		wg.Add(1)
+		go func(i int, broker *pubsub.Redis) {
+			defer wg.Done()
+			for n := 0; n < perBroker; n++ {
+				if err := broker.Publish("events", []byte(fmt.Sprintf("%d-%d", i, n))); err != nil {
+					t.Errorf("publish failed with error: %v", err)
+				}
+			}
+		}(i, broker)
+	}
+	wg.Wait()
+
+	// Both instances receive every message, including their own, in the same order
+	first := collectors[0].wait(t, 2*perBroker)
+	second := collectors[1].wait(t, 2*perBroker)
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("message %d is %s on one instance and %s on the other", i, first[i], second[i])
+		}
+	}
+}
+
+func TestRedisReconnect(t *testing.T) {
+	server, err := pubsubtest.NewServer()
+	if err != nil {
+		t.Fatalf("starting the stand-in server failed with error: %v", err)
+	}
+	defer server.Close()
+
+	broker, err := pubsub.DialRedis(server.URL())
+	if err != nil {
+		t.Fatalf("dial failed with error: %v", err)
+	}
+	defer broker.Close()
+
+	var received collector
+	if err := broker.Subscribe("events", received.handle); err != nil {
+		t.Fatalf("subscribe failed with error: %v", err)
+	}
+
+	// The server drops every connection; the broker reconnects and subscribes again
+	server.Disconnect()
+	deadline := time.Now().Add(5 * time.Second)
+	for server.Subscribers("events") != 1 {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the subscription to come back")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if err := broker.Publish("events", []byte("after")); err != nil {
+		t.Fatalf("publish after reconnecting failed with error: %v", err)
+	}
+	if messages := received.wait(t, 1); messages[0] != "after" {
+		t.Errorf("received %v, want the message published after reconnecting", messages)
+	}
+}
@@ -0,0 +1,198 @@
+// Package pubsubtest provides a stand-in for a Redis server, for testing the Redis broker and
+// several server instances sharing it without running Redis.
+package pubsubtest
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	"coeus/pubsub"
+)
+
+// Server understands the pub/sub subset of the Redis protocol: PING, AUTH, PUBLISH and SUBSCRIBE.
+type Server struct {
+	listener net.Listener
+
+	mu      sync.Mutex
+	clients map[*client]bool
+	// subscribers holds the clients subscribed to each channel
+	subscribers map[string]map[*client]bool
+	wg          sync.WaitGroup
+}
+
+// client is a connection to the server. Replies and messages may be written to it from
+// other clients' goroutines, so writes are serialized.
+type client struct {
+	conn     net.Conn
+	writeMu  sync.Mutex
+	channels map[string]bool
+}
+
+// NewServer starts a server on a local port.
+func NewServer() (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		listener:    listener,
+		clients:     make(map[*client]bool),
+		subscribers: make(map[string]map[*client]bool),
+	}
+	s.wg.Add(1)
+	go s.accept()
+	return s, nil
+}
+
+// URL returns the URL to give the Redis broker.
+func (s *Server) URL() string {
+	return "redis://" + s.listener.Addr().String()
+}
+
+// Disconnect drops every client connection, as a restarting server would.
+func (s *Server) Disconnect() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for c := range s.clients {
+		s.forget(c)
+	}
+}
+
+// Subscribers returns the number of clients subscribed to a channel.
+func (s *Server) Subscribers(channel string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.subscribers[channel])
+}
+
+// Close stops the server and drops every client connection.
+func (s *Server) Close() {
+	s.listener.Close()
+	s.Disconnect()
+	s.wg.Wait()
+}
+
+// accept serves every incoming connection until the listener is closed.
+func (s *Server) accept() {
+	defer s.wg.Done()
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		c := &client{conn: conn, channels: make(map[string]bool)}
+		s.mu.Lock()
+		s.clients[c] = true
+		s.mu.Unlock()
+
+		s.wg.Add(1)
+		go s.serve(c)
+	}
+}
+
+// serve runs the commands of a client until it disconnects.
+func (s *Server) serve(c *client) {
+	defer s.wg.Done()
+	defer s.drop(c)
+
+	reader := bufio.NewReader(c.conn)
+	for {
+		request, err := pubsub.ReadReply(reader)
+		if err != nil {
+			return
+		}
+		fields, ok := request.([]interface{})
+		if !ok || len(fields) == 0 {
+			c.write("-ERR invalid command\r\n")
+			continue
+		}
+		args := make([]string, len(fields))
+		for i, field := range fields {
+			arg, _ := field.([]byte)
+			args[i] = string(arg)
+		}
+
+		switch strings.ToUpper(args[0]) {
+		case "PING":
+			c.write("+PONG\r\n")
+		case "AUTH":
+			c.write("+OK\r\n")
+		case "PUBLISH":
+			if len(args) != 3 {
+				c.write("-ERR wrong number of arguments for 'publish' command\r\n")
+				continue
+			}
+			c.write(fmt.Sprintf(":%d\r\n", s.publish(args[1], args[2])))
+		case "SUBSCRIBE":
+			for _, channel := range args[1:] {
+				count := s.subscribe(c, channel)
+				c.write(fmt.Sprintf("*3\r\n%s%s:%d\r\n", bulk("subscribe"), bulk(channel), count))
+			}
+		default:
+			c.write(fmt.Sprintf("-ERR unknown command '%s'\r\n", args[0]))
+		}
+	}
+}
+
+// publish sends a message to the subscribers of a channel and returns how many there were.
+func (s *Server) publish(channel string, message string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Messages are written with the lock held so every subscriber sees the same order
+	for c := range s.subscribers[channel] {
+		c.write(fmt.Sprintf("*3\r\n%s%s%s", bulk("message"), bulk(channel), bulk(message)))
+	}
+	return len(s.subscribers[channel])
+}
+
+// subscribe adds a client to a channel and returns the number of channels it is subscribed to.
+func (s *Server) subscribe(c *client, channel string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.subscribers[channel]; !ok {
+		s.subscribers[channel] = make(map[*client]bool)
+	}
+	s.subscribers[channel][c] = true
+	c.channels[channel] = true
+	return len(c.channels)
+}
+
+// drop forgets a client that disconnected.
+func (s *Server) drop(c *client) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.forget(c)
+}
+
+// forget closes a client's connection and removes its subscriptions. The lock must be held.
+func (s *Server) forget(c *client) {
+	c.conn.Close()
+	delete(s.clients, c)
+	for channel := range c.channels {
+		delete(s.subscribers[channel], c)
+	}
+}
+
+// write sends raw protocol data to the client, ignoring errors as the read loop notices them.
+func (c *client) write(data string) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	c.conn.Write([]byte(data))
+}
+
+// bulk encodes a bulk string.
+func bulk(s string) string {
+	return fmt.Sprintf("$%d\r\n%s\r\n", len(s), s)
+}
@@ -0,0 +1,435 @@
+package pubsub
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// redisTimeout bounds dialing and each command sent to the server.
+const redisTimeout = 5 * time.Second
+
+// maxReconnectDelay is the longest wait between attempts to reconnect the subscription.
+const maxReconnectDelay = 30 * time.Second
+
+// Redis is a broker that publishes and subscribes through a server speaking the Redis protocol,
+// such as Redis, Valkey or KeyDB. It holds one connection to publish and one to receive the
+// messages of its channels, which it reconnects and resubscribes when it drops. Messages
+// published while the subscription is down are not received.
+type Redis struct {
+	addr     string
+	password string
+
+	// pubMu guards the connection used to publish
+	pubMu sync.Mutex
+	pub   *redisConn
+
+	// mu guards the handlers, the subscriber connection and the pending subscriptions
+	mu       sync.Mutex
+	handlers map[string][]Handler
+	sub      *redisConn
+	confirm  map[string][]chan struct{}
+
+	done      chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// DialRedis connects to the server at rawURL, either redis://[:password@]host[:port] or host:port.
+// It returns an error if the server can't be reached.
+func DialRedis(rawURL string) (*Redis, error) {
+	addr, password, err := parseRedisURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Redis{
+		addr:     addr,
+		password: password,
+		handlers: make(map[string][]Handler),
+		confirm:  make(map[string][]chan struct{}),
+		done:     make(chan struct{}),
+	}
+
+	r.pub, err = dialRedisConn(addr, password)
+	if err != nil {
+		return nil, err
+	}
+	r.sub, err = dialRedisConn(addr, password)
+	if err != nil {
+		r.pub.conn.Close()
+		return nil, err
+	}
+
+	r.wg.Add(1)
+	go r.run(r.sub)
+	return r, nil
+}
+
+// parseRedisURL returns the address and password of a server URL, defaulting to port 6379.
+func parseRedisURL(rawURL string) (string, string, error) {
+	if rawURL == "" {
+		return "localhost:6379", "", nil
+	}
+	if !strings.Contains(rawURL, "://") {
+		return rawURL, "", nil
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", "", err
+	}
+	if u.Scheme != "redis" {
+		return "", "", fmt.Errorf("unsupported pub/sub URL scheme %q", u.Scheme)
+	}
+
+	addr := u.Host
+	if u.Port() == "" {
+		addr = net.JoinHostPort(u.Hostname(), "6379")
+	}
+	password, _ := u.User.Password()
+	return addr, password, nil
+}
+
+// Publish sends a message to the subscribers of a channel on every instance, reconnecting once
+// if the connection was lost.
+func (r *Redis) Publish(channel string, message []byte) error {
+	r.pubMu.Lock()
+	defer r.pubMu.Unlock()
+
+	var err error
+	for attempt := 0; attempt < 2; attempt++ {
+		if r.isClosed() {
+			return ErrClosed
+		}
+		if r.pub == nil {
+			r.pub, err = dialRedisConn(r.addr, r.password)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = r.pub.do("PUBLISH", channel, string(message))
+		if err == nil {
+			return nil
+		}
+		var replyErr redisError
+		if errors.As(err, &replyErr) {
+			return err
+		}
+		r.pub.conn.Close()
+		r.pub = nil
+	}
+	return err
+}
+
+// Subscribe calls handler with every message published on a channel. The first handler of
+// a channel waits for the server to confirm the subscription, so no message published after
+// Subscribe returns is missed.
+func (r *Redis) Subscribe(channel string, handler Handler) error {
+	r.mu.Lock()
+	if r.isClosed() {
+		r.mu.Unlock()
+		return ErrClosed
+	}
+	first := len(r.handlers[channel]) == 0
+	r.handlers[channel] = append(r.handlers[channel], handler)
+	if !first || r.sub == nil {
+		// Already subscribed, or subscribed along with the others once reconnected
+		r.mu.Unlock()
+		return nil
+	}
+
+	confirmed := make(chan struct{})
+	r.confirm[channel] = append(r.confirm[channel], confirmed)
+	err := r.sub.write("SUBSCRIBE", channel)
+	if err != nil {
+		// Reconnecting subscribes to the channel
+		r.sub.conn.Close()
+	}
+	r.mu.Unlock()
+
+	select {
+	case <-confirmed:
+		return nil
+	case <-r.done:
+		return ErrClosed
+	case <-time.After(redisTimeout):
+		return fmt.Errorf("timed out subscribing to %q", channel)
+	}
+}
+
+// Close closes both connections and stops receiving messages.
+func (r *Redis) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.done)
+
+		r.pubMu.Lock()
+		if r.pub != nil {
+			r.pub.conn.Close()
+		}
+		r.pubMu.Unlock()
+
+		r.mu.Lock()
+		if r.sub != nil {
+			r.sub.conn.Close()
+		}
+		r.mu.Unlock()
+	})
+	r.wg.Wait()
+	return nil
+}
+
+// isClosed reports whether Close was called.
+func (r *Redis) isClosed() bool {
+	select {
+	case <-r.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// run receives the messages of the subscriber connection until the broker is closed,
+// reconnecting with a growing delay whenever the connection drops.
+func (r *Redis) run(sub *redisConn) {
+	defer r.wg.Done()
+
+	attempts := 0
+	for {
+		if sub != nil {
+			err := r.receive(sub)
+			if r.isClosed() {
+				return
+			}
+			log.Println("Pub/sub subscription lost, reconnecting:", err)
+
+			r.mu.Lock()
+			r.sub = nil
+			r.mu.Unlock()
+		}
+
+		delay := time.Duration(1<<uint(attempts)) * 100 * time.Millisecond
+		if delay > maxReconnectDelay || attempts > 16 {
+			delay = maxReconnectDelay
+		}
+		select {
+		case <-r.done:
+			return
+		case <-time.After(delay):
+		}
+
+		var err error
+		sub, err = r.resubscribe()
+		if err != nil {
+			log.Println("Failed to reconnect the pub/sub subscription:", err)
+			attempts++
+			continue
+		}
+		attempts = 0
+	}
+}
+
+// resubscribe opens a new subscriber connection subscribed to every channel with a handler.
+func (r *Redis) resubscribe() (*redisConn, error) {
+	sub, err := dialRedisConn(r.addr, r.password)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.isClosed() {
+		sub.conn.Close()
+		return nil, ErrClosed
+	}
+	if len(r.handlers) > 0 {
+		args := []string{"SUBSCRIBE"}
+		for channel := range r.handlers {
+			args = append(args, channel)
+		}
+		if err := sub.write(args...); err != nil {
+			sub.conn.Close()
+			return nil, err
+		}
+	}
+	r.sub = sub
+	return sub, nil
+}
+
+// receive hands the messages of a subscriber connection to the handlers of their channel
+// until the connection fails.
+func (r *Redis) receive(sub *redisConn) error {
+	for {
+		reply, err := sub.read()
+		if err != nil {
+			return err
+		}
+
+		fields, ok := reply.([]interface{})
+		if !ok || len(fields) != 3 {
+			continue
+		}
+		kind, channel := replyString(fields[0]), replyString(fields[1])
+
+		switch kind {
+		case "message":
+			r.mu.Lock()
+			handlers := r.handlers[channel]
+			r.mu.Unlock()
+
+			message, _ := fields[2].([]byte)
+			for _, handler := range handlers {
+				handler(message)
+			}
+		case "subscribe":
+			r.mu.Lock()
+			for _, confirmed := range r.confirm[channel] {
+				close(confirmed)
+			}
+			delete(r.confirm, channel)
+			r.mu.Unlock()
+		}
+	}
+}
+
+// ** PROTOCOL **
+
+// redisError is an error reply from the server.
+type redisError string
+
+func (e redisError) Error() string {
+	return string(e)
+}
+
+// redisConn is a connection speaking the Redis serialization protocol (RESP).
+type redisConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// dialRedisConn connects to a server and authenticates when a password is set.
+func dialRedisConn(addr string, password string) (*redisConn, error) {
+	conn, err := net.DialTimeout("tcp", addr, redisTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	rc := &redisConn{conn: conn, reader: bufio.NewReader(conn)}
+	if password != "" {
+		if _, err := rc.do("AUTH", password); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	return rc, nil
+}
+
+// do sends a command and returns its reply, or the error the server replied with.
+func (rc *redisConn) do(args ...string) (interface{}, error) {
+	rc.conn.SetDeadline(time.Now().Add(redisTimeout))
+	defer rc.conn.SetDeadline(time.Time{})
+
+	if err := rc.write(args...); err != nil {
+		return nil, err
+	}
+	reply, err := rc.read()
+	if err != nil {
+		return nil, err
+	}
+	if replyErr, ok := reply.(redisError); ok {
+		return nil, replyErr
+	}
+	return reply, nil
+}
+
+// write sends a command as an array of bulk strings.
+func (rc *redisConn) write(args ...string) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(&b, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+
+	rc.conn.SetWriteDeadline(time.Now().Add(redisTimeout))
+	_, err := io.WriteString(rc.conn, b.String())
+	return err
+}
+
+// read returns the next reply from the server.
+func (rc *redisConn) read() (interface{}, error) {
+	return ReadReply(rc.reader)
+}
+
+// ReadReply reads one value in the Redis serialization protocol: a string, a redisError,
+// an int64, a []byte (nil when null) or a []interface{} of values.
+func ReadReply(reader *bufio.Reader) (interface{}, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 || !strings.HasSuffix(line, "\r\n") {
+		return nil, fmt.Errorf("malformed reply %q", line)
+	}
+	kind, value := line[0], line[1:len(line)-2]
+
+	switch kind {
+	case '+':
+		return value, nil
+	case '-':
+		return redisError(value), nil
+	case ':':
+		return strconv.ParseInt(value, 10, 64)
+	case '$':
+		length, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, err
+		}
+		if length < 0 {
+			return []byte(nil), nil
+		}
+		data := make([]byte, length+2)
+		if _, err := io.ReadFull(reader, data); err != nil {
+			return nil, err
+		}
+		return data[:length], nil
+	case '*':
+		count, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, err
+		}
+		if count < 0 {
+			return []interface{}(nil), nil
+		}
+		values := make([]interface{}, count)
+		for i := range values {
+			if values[i], err = ReadReply(reader); err != nil {
+				return nil, err
+			}
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("unknown reply type %q", kind)
+	}
+}
+
+// replyString returns a string or bulk string reply as a string.
+func replyString(reply interface{}) string {
+	switch value := reply.(type) {
+	case string:
+		return value
+	case []byte:
+		return string(value)
+	default:
+		return ""
+	}
+}
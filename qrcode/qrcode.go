@@ -0,0 +1,513 @@
+// Package qrcode encodes short text, such as a URL, as a QR code and draws it as SVG or PNG.
+// It covers what Coeus shows on screen: byte mode, error correction level M and versions 1 to 10,
+// which holds up to 213 bytes.
+package qrcode
+
+import (
+	"errors"
+)
+
+// ErrTooLong is returned when the text doesn't fit in the largest version supported.
+var ErrTooLong = errors.New("text too long for a QR code")
+
+// Code is a QR code, a square of dark and light modules.
+type Code struct {
+	// Version is the QR code version, from 1 to 10, which sets its size
+	Version int
+	// Size is the number of modules on each side, without the quiet zone around them
+	Size int
+	// Mask is the data mask that was applied, from 0 to 7
+	Mask    int
+	modules [][]bool
+}
+
+// Dark reports whether the module at column x and row y is dark.
+func (c *Code) Dark(x, y int) bool {
+	return c.modules[y][x]
+}
+
+// block describes the error correction blocks of a version at level M: count blocks of
+// dataLength data codewords, each followed by ecLength error correction codewords.
+type block struct {
+	count      int
+	dataLength int
+}
+
+type version struct {
+	ecLength int
+	blocks   []block
+	// alignment lists the row and column centers of the alignment patterns
+	alignment []int
+}
+
+// versions lists, for error correction level M, the layout of versions 1 to 10.
+var versions = []version{
+	1:  {10, []block{{1, 16}}, nil},
+	2:  {16, []block{{1, 28}}, []int{6, 18}},
+	3:  {26, []block{{1, 44}}, []int{6, 22}},
+	4:  {18, []block{{2, 32}}, []int{6, 26}},
+	5:  {24, []block{{2, 43}}, []int{6, 30}},
+	6:  {16, []block{{4, 27}}, []int{6, 34}},
+	7:  {18, []block{{4, 31}}, []int{6, 22, 38}},
+	8:  {22, []block{{2, 38}, {2, 39}}, []int{6, 24, 42}},
+	9:  {22, []block{{3, 36}, {2, 37}}, []int{6, 26, 46}},
+	10: {26, []block{{4, 43}, {1, 44}}, []int{6, 28, 50}},
+}
+
+// dataCapacity returns the number of data codewords of a version.
+func (v version) dataCapacity() int {
+	n := 0
+	for _, b := range v.blocks {
+		n += b.count * b.dataLength
+	}
+	return n
+}
+
+// countBits returns the length of the character count of byte mode in a version.
+func countBits(v int) int {
+	if v < 10 {
+		return 8
+	}
+	return 16
+}
+
+// Encode returns the smallest QR code holding text in byte mode with error correction level M.
+// It returns ErrTooLong when text doesn't fit in version 10.
+func Encode(text string) (*Code, error) {
+	data := []byte(text)
+
+	v := 1
+	for ; v < len(versions); v++ {
+		if 4+countBits(v)+8*len(data) <= versions[v].dataCapacity()*8 {
+			break
+		}
+	}
+	if v == len(versions) {
+		return nil, ErrTooLong
+	}
+
+	codewords := interleave(versions[v], dataCodewords(v, data))
+
+	c := &Code{Version: v, Size: 17 + 4*v}
+	c.modules = newGrid(c.Size)
+	function := newGrid(c.Size)
+	c.drawFunctionPatterns(function)
+	c.drawCodewords(codewords, function)
+
+	// Keep the mask that leaves the fewest patterns confusing to readers
+	best, bestPenalty := 0, -1
+	for mask := 0; mask < 8; mask++ {
+		c.applyMask(mask, function)
+		c.drawFormat(mask)
+		if penalty := c.penalty(); bestPenalty < 0 || penalty < bestPenalty {
+			best, bestPenalty = mask, penalty
+		}
+		// Masking twice undoes it
+		c.applyMask(mask, function)
+	}
+	c.Mask = best
+	c.applyMask(best, function)
+	c.drawFormat(best)
+
+	return c, nil
+}
+
+func newGrid(size int) [][]bool {
+	grid := make([][]bool, size)
+	for i := range grid {
+		grid[i] = make([]bool, size)
+	}
+	return grid
+}
+
+// bitWriter appends bits to a byte slice, most significant first.
+type bitWriter struct {
+	bytes []byte
+	n     int
+}
+
+func (w *bitWriter) write(value int, length int) {
+	for i := length - 1; i >= 0; i-- {
+		if w.n%8 == 0 {
+			w.bytes = append(w.bytes, 0)
+		}
+		if value>>uint(i)&1 == 1 {
+			w.bytes[len(w.bytes)-1] |= 0x80 >> uint(w.n%8)
+		}
+		w.n++
+	}
+}
+
+// dataCodewords returns the data codewords of a version holding data in byte mode,
+// padded to the capacity of the version.
+func dataCodewords(v int, data []byte) []byte {
+	capacity := versions[v].dataCapacity()
+
+	w := &bitWriter{}
+	w.write(0x4, 4)
+	w.write(len(data), countBits(v))
+	for _, b := range data {
+		w.write(int(b), 8)
+	}
+
+	// Terminate with up to four zero bits, then fill the last byte
+	terminator := capacity*8 - w.n
+	if terminator > 4 {
+		terminator = 4
+	}
+	w.write(0, terminator)
+	if w.n%8 != 0 {
+		w.write(0, 8-w.n%8)
+	}
+
+	for pad := 0xEC; len(w.bytes) < capacity; pad ^= 0xEC ^ 0x11 {
+		w.bytes = append(w.bytes, byte(pad))
+	}
+	return w.bytes
+}
+
+// interleave splits the data codewords into the blocks of a version, adds their error correction
+// codewords and returns them in the order they are placed: the data codewords of every block in
+// turn, then their error correction codewords.
+func interleave(v version, data []byte) []byte {
+	divisor := rsDivisor(v.ecLength)
+
+	var dataBlocks, ecBlocks [][]byte
+	for _, b := range v.blocks {
+		for i := 0; i < b.count; i++ {
+			dataBlocks = append(dataBlocks, data[:b.dataLength])
+			ecBlocks = append(ecBlocks, rsRemainder(data[:b.dataLength], divisor))
+			data = data[b.dataLength:]
+		}
+	}
+
+	var result []byte
+	longest := v.blocks[len(v.blocks)-1].dataLength
+	for i := 0; i < longest; i++ {
+		for _, b := range dataBlocks {
+			if i < len(b) {
+				result = append(result, b[i])
+			}
+		}
+	}
+	for i := 0; i < v.ecLength; i++ {
+		for _, b := range ecBlocks {
+			result = append(result, b[i])
+		}
+	}
+	return result
+}
+
+// set draws a function module, which the data and the mask leave alone.
+func (c *Code) set(function [][]bool, x, y int, dark bool) {
+	c.modules[y][x] = dark
+	function[y][x] = true
+}
+
+// drawFunctionPatterns draws the finder, timing and alignment patterns and the version
+// information, and reserves the room of the format information.
+func (c *Code) drawFunctionPatterns(function [][]bool) {
+	size := c.Size
+
+	for i := 0; i < size; i++ {
+		c.set(function, 6, i, i%2 == 0)
+		c.set(function, i, 6, i%2 == 0)
+	}
+
+	// Finder patterns with their separators, in three corners
+	for _, center := range [][2]int{{3, 3}, {size - 4, 3}, {3, size - 4}} {
+		for dy := -4; dy <= 4; dy++ {
+			for dx := -4; dx <= 4; dx++ {
+				x, y := center[0]+dx, center[1]+dy
+				if x < 0 || x >= size || y < 0 || y >= size {
+					continue
+				}
+				distance := max(abs(dx), abs(dy))
+				c.set(function, x, y, distance != 2 && distance != 4)
+			}
+		}
+	}
+
+	// Alignment patterns, except where the finder patterns are
+	alignment := versions[c.Version].alignment
+	last := len(alignment) - 1
+	for i, y := range alignment {
+		for j, x := range alignment {
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			for dy := -2; dy <= 2; dy++ {
+				for dx := -2; dx <= 2; dx++ {
+					c.set(function, x+dx, y+dy, max(abs(dx), abs(dy)) != 1)
+				}
+			}
+		}
+	}
+
+	// Reserve the format information, drawn once the mask is chosen
+	c.drawFormatModules(function, 0)
+
+	if c.Version >= 7 {
+		bits := versionBits(c.Version)
+		for i := 0; i < 18; i++ {
+			dark := bits>>uint(i)&1 == 1
+			a, b := size-11+i%3, i/3
+			c.set(function, a, b, dark)
+			c.set(function, b, a, dark)
+		}
+	}
+}
+
+// drawFormat draws the format information of level M with a mask.
+func (c *Code) drawFormat(mask int) {
+	c.drawFormatModules(newGrid(c.Size), formatBits(mask))
+}
+
+// drawFormatModules draws the two copies of the format information bits, and the dark module beside them.
+func (c *Code) drawFormatModules(function [][]bool, bits int) {
+	size := c.Size
+	bit := func(i int) bool {
+		return bits>>uint(i)&1 == 1
+	}
+
+	for i := 0; i <= 5; i++ {
+		c.set(function, 8, i, bit(i))
+	}
+	c.set(function, 8, 7, bit(6))
+	c.set(function, 8, 8, bit(7))
+	c.set(function, 7, 8, bit(8))
+	for i := 9; i < 15; i++ {
+		c.set(function, 14-i, 8, bit(i))
+	}
+
+	for i := 0; i < 8; i++ {
+		c.set(function, size-1-i, 8, bit(i))
+	}
+	for i := 8; i < 15; i++ {
+		c.set(function, 8, size-15+i, bit(i))
+	}
+	c.set(function, 8, size-8, true)
+}
+
+// formatBits returns the 15 bits of format information for level M and a mask,
+// protected by a BCH code.
+func formatBits(mask int) int {
+	// Level M is 00
+	data := mask
+	remainder := data
+	for i := 0; i < 10; i++ {
+		remainder = remainder<<1 ^ (remainder>>9)*0x537
+	}
+	return (data<<10 | remainder) ^ 0x5412
+}
+
+// versionBits returns the 18 bits of version information, protected by a BCH code.
+func versionBits(v int) int {
+	remainder := v
+	for i := 0; i < 12; i++ {
+		remainder = remainder<<1 ^ (remainder>>11)*0x1F25
+	}
+	return v<<12 | remainder
+}
+
+// drawCodewords places the bits of the codewords in the modules left free, in pairs of columns
+// zigzagging up and down from the bottom right corner.
+func (c *Code) drawCodewords(codewords []byte, function [][]bool) {
+	size := c.Size
+	i := 0
+	for right := size - 1; right >= 1; right -= 2 {
+		// Skip the vertical timing pattern
+		if right == 6 {
+			right = 5
+		}
+		upward := (right+1)&2 == 0
+		for vertical := 0; vertical < size; vertical++ {
+			y := vertical
+			if upward {
+				y = size - 1 - vertical
+			}
+			for j := 0; j < 2; j++ {
+				x := right - j
+				if function[y][x] || i >= len(codewords)*8 {
+					continue
+				}
+				c.modules[y][x] = codewords[i/8]>>uint(7-i%8)&1 == 1
+				i++
+			}
+		}
+	}
+}
+
+// masked reports whether a mask flips the module at column x and row y.
+func masked(mask int, x, y int) bool {
+	switch mask {
+	case 0:
+		return (x+y)%2 == 0
+	case 1:
+		return y%2 == 0
+	case 2:
+		return x%3 == 0
+	case 3:
+		return (x+y)%3 == 0
+	case 4:
+		return (x/3+y/2)%2 == 0
+	case 5:
+		return x*y%2+x*y%3 == 0
+	case 6:
+		return (x*y%2+x*y%3)%2 == 0
+	default:
+		return ((x+y)%2+x*y%3)%2 == 0
+	}
+}
+
+// applyMask flips the data modules a mask selects.
+func (c *Code) applyMask(mask int, function [][]bool) {
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if !function[y][x] && masked(mask, x, y) {
+				c.modules[y][x] = !c.modules[y][x]
+			}
+		}
+	}
+}
+
+// penalty scores how hard the code is to read: long runs of one color, 2x2 blocks,
+// patterns that look like finders and an uneven share of dark modules.
+func (c *Code) penalty() int {
+	size := c.Size
+	penalty := 0
+
+	line := make([]bool, size)
+	for _, vertical := range []bool{false, true} {
+		for i := 0; i < size; i++ {
+			for j := 0; j < size; j++ {
+				if vertical {
+					line[j] = c.modules[j][i]
+				} else {
+					line[j] = c.modules[i][j]
+				}
+			}
+			penalty += runPenalty(line) + finderPenalty(line)
+		}
+	}
+
+	dark := 0
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			if c.modules[y][x] {
+				dark++
+			}
+			if x < size-1 && y < size-1 {
+				m := c.modules[y][x]
+				if c.modules[y][x+1] == m && c.modules[y+1][x] == m && c.modules[y+1][x+1] == m {
+					penalty += 3
+				}
+			}
+		}
+	}
+
+	total := size * size
+	penalty += abs(dark*100/total-50) / 5 * 10
+
+	return penalty
+}
+
+// runPenalty scores the runs of five or more modules of one color in a row or column.
+func runPenalty(line []bool) int {
+	penalty := 0
+	run := 1
+	for i := 1; i <= len(line); i++ {
+		if i < len(line) && line[i] == line[i-1] {
+			run++
+			continue
+		}
+		if run >= 5 {
+			penalty += 3 + run - 5
+		}
+		run = 1
+	}
+	return penalty
+}
+
+// finderLike is the dark and light pattern of a finder, followed by four light modules.
+var finderLike = []bool{true, false, true, true, true, false, true, false, false, false, false}
+
+// finderPenalty scores the patterns in a row or column that look like a finder.
+func finderPenalty(line []bool) int {
+	penalty := 0
+	n := len(finderLike)
+	for i := 0; i+n <= len(line); i++ {
+		forward, backward := true, true
+		for j := 0; j < n; j++ {
+			if line[i+j] != finderLike[j] {
+				forward = false
+			}
+			if line[i+j] != finderLike[n-1-j] {
+				backward = false
+			}
+		}
+		if forward {
+			penalty += 40
+		}
+		if backward {
+			penalty += 40
+		}
+	}
+	return penalty
+}
+
+// rsMultiply multiplies two elements of GF(256) modulo the QR code polynomial x^8 + x^4 + x^3 + x^2 + 1.
+func rsMultiply(x, y byte) byte {
+	z := 0
+	for i := 7; i >= 0; i-- {
+		z = z<<1 ^ (z>>7)*0x11D
+		z ^= int(y>>uint(i)&1) * int(x)
+	}
+	return byte(z)
+}
+
+// rsDivisor returns the coefficients of the Reed-Solomon generator polynomial of a degree,
+// from the highest power down, without the leading 1.
+func rsDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+	root := byte(1)
+	for i := 0; i < degree; i++ {
+		for j := range result {
+			result[j] = rsMultiply(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = rsMultiply(root, 0x02)
+	}
+	return result
+}
+
+// rsRemainder returns the error correction codewords of data.
+func rsRemainder(data []byte, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i, coefficient := range divisor {
+			result[i] ^= rsMultiply(coefficient, factor)
+		}
+	}
+	return result
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
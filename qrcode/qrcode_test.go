@@ -0,0 +1,230 @@
+package qrcode
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+func TestReedSolomon(t *testing.T) {
+	// "HELLO WORLD" at version 1-M, from the worked example of the QR code specification tutorials
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	want := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+	if got := rsRemainder(data, rsDivisor(10)); !bytes.Equal(got, want) {
+		t.Errorf("error correction codewords = %v, want %v", got, want)
+	}
+}
+
+func TestFormatAndVersionBits(t *testing.T) {
+	// Format information of level M for masks 0 to 7
+	want := []int{0x5412, 0x5125, 0x5E7C, 0x5B4B, 0x45F9, 0x40CE, 0x4F97, 0x4AA0}
+	for mask, bits := range want {
+		if got := formatBits(mask); got != bits {
+			t.Errorf("format bits of mask %d = %015b, want %015b", mask, got, bits)
+		}
+	}
+
+	if got := versionBits(7); got != 0x07C94 {
+		t.Errorf("version bits of version 7 = %018b, want %018b", got, 0x07C94)
+	}
+}
+
+func TestEncode(t *testing.T) {
+	for _, test := range []struct {
+		length  int
+		version int
+	}{
+		{1, 1},
+		{14, 1},
+		{15, 2},
+		{62, 4},
+		{106, 6},
+		{152, 8},
+		{213, 10},
+	} {
+		text := strings.Repeat("https://coeus.example.edu/", 10)[:test.length]
+		code, err := Encode(text)
+		if err != nil {
+			t.Fatalf("encoding %d bytes failed with error: %v", test.length, err)
+		}
+		if code.Version != test.version || code.Size != 17+4*test.version {
+			t.Errorf("%d bytes took version %d of size %d, want version %d", test.length, code.Version, code.Size, test.version)
+		}
+
+		if got := decode(t, code); got != text {
+			t.Errorf("version %d decodes to %q, want %q", code.Version, got, text)
+		}
+	}
+
+	if _, err := Encode(strings.Repeat("x", 214)); err != ErrTooLong {
+		t.Errorf("encoding 214 bytes returned %v, want %v", err, ErrTooLong)
+	}
+}
+
+// decode reads the text back from a code, checking its patterns, format information
+// and error correction along the way.
+func decode(t *testing.T, code *Code) string {
+	t.Helper()
+	size := code.Size
+
+	// The finder patterns are in three corners
+	finder := []string{"#######", "#.....#", "#.###.#", "#.###.#", "#.###.#", "#.....#", "#######"}
+	for _, corner := range [][2]int{{0, 0}, {size - 7, 0}, {0, size - 7}} {
+		for dy, row := range finder {
+			for dx, module := range row {
+				if code.Dark(corner[0]+dx, corner[1]+dy) != (module == '#') {
+					t.Fatalf("version %d: finder pattern at %v is wrong at %d,%d", code.Version, corner, dx, dy)
+				}
+			}
+		}
+	}
+
+	// Both copies of the format information name the mask
+	var first, second int
+	firstPositions := [][2]int{{8, 0}, {8, 1}, {8, 2}, {8, 3}, {8, 4}, {8, 5}, {8, 7}, {8, 8}, {7, 8}, {5, 8}, {4, 8}, {3, 8}, {2, 8}, {1, 8}, {0, 8}}
+	for i, p := range firstPositions {
+		if code.Dark(p[0], p[1]) {
+			first |= 1 << uint(i)
+		}
+		var x, y int
+		if i < 8 {
+			x, y = size-1-i, 8
+		} else {
+			x, y = 8, size-15+i
+		}
+		if code.Dark(x, y) {
+			second |= 1 << uint(i)
+		}
+	}
+	if first != formatBits(code.Mask) || second != first {
+		t.Fatalf("version %d: format information %015b and %015b, want %015b", code.Version, first, second, formatBits(code.Mask))
+	}
+
+	// Read the codewords back from the modules left for data, unmasked
+	blank := &Code{Version: code.Version, Size: size, modules: newGrid(size)}
+	function := newGrid(size)
+	blank.drawFunctionPatterns(function)
+	v := versions[code.Version]
+	total := v.dataCapacity()
+	for _, b := range v.blocks {
+		total += b.count * v.ecLength
+	}
+	codewords := make([]byte, total)
+	i := 0
+	for right := size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		upward := (right+1)&2 == 0
+		for vertical := 0; vertical < size; vertical++ {
+			y := vertical
+			if upward {
+				y = size - 1 - vertical
+			}
+			for j := 0; j < 2; j++ {
+				x := right - j
+				if function[y][x] || i >= total*8 {
+					continue
+				}
+				if code.Dark(x, y) != masked(code.Mask, x, y) {
+					codewords[i/8] |= 0x80 >> uint(i%8)
+				}
+				i++
+			}
+		}
+	}
+
+	// Undo the interleaving and check the error correction of every block
+	var blocks [][]byte
+	for _, b := range v.blocks {
+		for k := 0; k < b.count; k++ {
+			blocks = append(blocks, make([]byte, 0, b.dataLength))
+		}
+	}
+	next := 0
+	for i := 0; ; i++ {
+		placed := false
+		for k := range blocks {
+			if len(blocks[k]) < cap(blocks[k]) && len(blocks[k]) == i {
+				blocks[k] = append(blocks[k], codewords[next])
+				next++
+				placed = true
+			}
+		}
+		if !placed {
+			break
+		}
+	}
+	var data []byte
+	for k, block := range blocks {
+		var ec []byte
+		for i := 0; i < v.ecLength; i++ {
+			ec = append(ec, codewords[next+i*len(blocks)+k])
+		}
+		if want := rsRemainder(block, rsDivisor(v.ecLength)); !bytes.Equal(ec, want) {
+			t.Fatalf("version %d: block %d has error correction %v, want %v", code.Version, k, ec, want)
+		}
+		data = append(data, block...)
+	}
+
+	// Byte mode, the character count, then the bytes
+	bit := func(n int) int {
+		return int(data[n/8]>>uint(7-n%8)) & 1
+	}
+	read := func(start, length int) int {
+		value := 0
+		for n := start; n < start+length; n++ {
+			value = value<<1 | bit(n)
+		}
+		return value
+	}
+	if mode := read(0, 4); mode != 0x4 {
+		t.Fatalf("version %d: mode %04b, want byte mode", code.Version, mode)
+	}
+	bits := countBits(code.Version)
+	length := read(4, bits)
+	text := make([]byte, length)
+	for n := range text {
+		text[n] = byte(read(4+bits+8*n, 8))
+	}
+	return string(text)
+}
+
+func TestRender(t *testing.T) {
+	code, err := Encode("https://coeus.example.edu/class-session/3/3?check-in=123456")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := code.PNG(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("PNG doesn't decode: %v", err)
+	}
+	side := (code.Size + 2*QuietZone) * 4
+	if bounds := img.Bounds(); bounds.Dx() != side || bounds.Dy() != side {
+		t.Errorf("PNG is %v, want %d pixels square", bounds, side)
+	}
+	for _, module := range [][2]int{{0, 0}, {1, 1}, {8, 8}, {code.Size - 1, code.Size - 1}} {
+		r, _, _, _ := img.At((module[0]+QuietZone)*4+1, (module[1]+QuietZone)*4+1).RGBA()
+		if dark := r == 0; dark != code.Dark(module[0], module[1]) {
+			t.Errorf("PNG module %v dark = %v, want %v", module, dark, code.Dark(module[0], module[1]))
+		}
+	}
+	if r, _, _, _ := img.At(1, 1).RGBA(); r == 0 {
+		t.Error("PNG quiet zone is dark")
+	}
+
+	svg := string(code.SVG())
+	if !strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 `) || !strings.HasSuffix(svg, "</svg>") {
+		t.Errorf("SVG is %s", svg)
+	}
+	// The top left finder starts with a run of seven dark modules
+	if !strings.Contains(svg, `M4,4h7v1h-7z`) {
+		t.Errorf("SVG doesn't draw the top left finder: %s", svg)
+	}
+}
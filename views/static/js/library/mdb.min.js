@@ -1,20 +1,20 @@
-/*!
- * MDB5
- *   Version: FREE 6.2.0
- * 
- * 
- *   Copyright: Material Design for Bootstrap
- *   https://mdbootstrap.com/
- * 
- *   Read the license: https://mdbootstrap.com/general/license/
- * 
- * 
- *   Documentation: https://mdbootstrap.com/docs/standard/
- * 
- *   Support: https://mdbootstrap.com/support/
- * 
- *   Contact: contact@mdbootstrap.com
- * 
- */
-!function(t,e){"object"==typeof exports&&"object"==typeof module?module.exports=e():"function"==typeof define&&define.amd?define("mdb",[],e):"object"==typeof exports?exports.mdb=e():t.mdb=e()}(this,function(){return n=[function(t,e,n){"use strict";var i=n(16),o=n(68),r=n(44),s=n(25),a=n(11).f,c=n(110),l=n(113),u=n(24),n=n(8),h="Array Iterator",d=s.set,f=s.getterFor(h),s=(t.exports=c(Array,"Array",function(t,e){d(this,{type:h,target:i(t),index:0,kind:e})},function(){var t=f(this),e=t.target,n=t.kind,i=t.index++;return!e||i>=e.length?(t.target=void 0,l(void 0,!0)):l("keys"==n?i:"values"==n?e[i]:[i,e[i]],!1)},"values"),r.Arguments=r.Array);if(o("keys"),o("values"),o("entries"),!u&&n&&"values"!==s.name)try{a(s,"name",{value:"values"})}catch(t){}},function(t,e,n){function i(e,t){if(e){if(e[u]!==d)try{l(e,u,d)}catch(t){e[u]=d}if(e[h]||l(e,h,t),s[t])for(var n in c)if(e[n]!==c[n])try{l(e,n,c[n])}catch(t){e[n]=c[n]}}}var o,r=n(6),s=n(114),a=n(115),c=n(0),l=n(15),n=n(7),u=n("iterator"),h=n("toStringTag"),d=c.values;for(o in s)i(r[o]&&r[o].prototype,o);i(a,"DOMTokenList")},function(t,e){t.exports=function(t){try{return!!t()}catch(t){return!0}}},function(t,e,n){var n=n(47),i=n.all;t.exports=n.IS_HTMLDDA?function(t){return"function"==typeof t||t===i}:function(t){return"function"==typeof t}},function(t,e,n){var n=n(30),i=Function.prototype,o=i.call,i=n&&i.bind.bind(o,o);t.exports=n?i:function(t){return function(){return o.apply(t,arguments)}}},function(t,e,n){"use strict";var i=n(22),n=n(43);i({target:"RegExp",proto:!0,forced:/./.exec!==n},{exec:n})},function(n,t,e){!function(t){function e(t){return t&&t.Math==Math&&t}n.exports=e("object"==typeof globalThis&&globalThis)||e("object"==typeof window&&window)||e("object"==typeof self&&self)||e("object"==typeof t&&t)||function(){return this}()||Function("return this")()}.call(this,e(72))},function(t,e,n){var i=n(6),o=n(34),r=n(9),s=n(53),a=n(50),n=n(49),c=i.Symbol,l=o("wks"),u=n?c.for||c:c&&c.withoutSetter||s;t.exports=function(t){return r(l,t)||(l[t]=a&&r(c,t)?c[t]:u("Symbol."+t)),l[t]}},function(t,e,n){n=n(2);t.exports=!n(function(){return 7!=Object.defineProperty({},1,{get:function(){return 7}})[1]})},function(t,e,n){var i=n(4),o=n(37),r=i({}.hasOwnProperty);t.exports=Object.hasOwn||function(t,e){return r(o(t),e)}},function(t,e,n){"use strict";var i=n(22),o=n(59).includes,r=n(2),n=n(68);i({target:"Array",proto:!0,forced:r(function(){return!Array(1).includes()})},{includes:function(t){return o(this,t,1<arguments.length?arguments[1]:void 0)}}),n("includes")},function(t,e,n){var i=n(8),o=n(54),r=n(55),s=n(14),a=n(46),c=TypeError,l=Object.defineProperty,u=Object.getOwnPropertyDescriptor,h="enumerable",d="configurable",f="writable";e.f=i?r?function(t,e,n){var i;return s(t),e=a(e),s(n),"function"==typeof t&&"prototype"===e&&"value"in n&&f in n&&!n[f]&&(i=u(t,e))&&i[f]&&(t[e]=n.value,n={configurable:(d in n?n:i)[d],enumerable:(h in n?n:i)[h],writable:!1}),l(t,e,n)}:l:function(t,e,n){if(s(t),e=a(e),s(n),o)try{return l(t,e,n)}catch(t){}if("get"in n||"set"in n)throw c("Accessors not supported");return"value"in n&&(t[e]=n.value),t}},function(t,e,n){var n=n(30),i=Function.prototype.call;t.exports=n?i.bind(i):function(){return i.apply(i,arguments)}},function(t,e,n){var i=n(3),n=n(47),o=n.all;t.exports=n.IS_HTMLDDA?function(t){return"object"==typeof t?null!==t:i(t)||t===o}:function(t){return"object"==typeof t?null!==t:i(t)}},function(t,e,n){var i=n(13),o=String,r=TypeError;t.exports=function(t){if(i(t))return t;throw r(o(t)+" is not an object")}},function(t,e,n){var i=n(8),o=n(11),r=n(31);t.exports=i?function(t,e,n){return o.f(t,e,r(1,n))}:function(t,e,n){return t[e]=n,t}},function(t,e,n){var i=n(74),o=n(18);t.exports=function(t){return i(o(t))}},function(t,e,n){var n=n(4),i=n({}.toString),o=n("".slice);t.exports=function(t){return o(i(t),8,-1)}},function(t,e,n){var i=n(32),o=TypeError;t.exports=function(t){if(i(t))throw o("Can't call method on "+t);return t}},function(t,e,n){var s=n(3),a=n(11),c=n(56),l=n(36);t.exports=function(t,e,n,i){var o=(i=i||{}).enumerable,r=void 0!==i.name?i.name:e;if(s(n)&&c(n,r,i),i.global)o?t[e]=n:l(e,n);else{try{i.unsafe?t[e]&&(o=!0):delete t[e]}catch(t){}o?t[e]=n:a.f(t,e,{value:n,enumerable:!1,configurable:!i.nonConfigurable,writable:!i.nonWritable})}return t}},function(t,e,n){var i=n(88),o=String;t.exports=function(t){if("Symbol"===i(t))throw TypeError("Cannot convert a Symbol value to a string");return o(t)}},function(t,e,n){"use strict";var E=n(103),o=n(12),i=n(4),r=n(104),s=n(2),x=n(14),C=n(3),a=n(32),A=n(26),T=n(60),O=n(20),c=n(18),S=n(106),l=n(51),L=n(108),I=n(109),u=n(7)("replace"),k=Math.max,D=Math.min,j=i([].concat),P=i([].push),N=i("".indexOf),M=i("".slice),n="$0"==="a".replace(/./,"$0"),h=!!/./[u]&&""===/./[u]("a","$0");r("replace",function(t,b,y){var w=h?"$":"$0";return[function(t,e){var n=c(this),i=a(t)?void 0:l(t,u);return i?o(i,t,n,e):o(b,O(n),t,e)},function(t,e){var n=x(this),i=O(t);if("string"==typeof e&&-1===N(e,w)&&-1===N(e,"$<")){t=y(b,n,i,e);if(t.done)return t.value}for(var o,r=C(e),s=(r||(e=O(e)),n.global),a=(s&&(o=n.unicode,n.lastIndex=0),[]);null!==(d=I(n,i))&&(P(a,d),s);)""===O(d[0])&&(n.lastIndex=S(i,T(n.lastIndex),o));for(var c,l="",u=0,h=0;h<a.length;h++){for(var d,f=O((d=a[h])[0]),p=k(D(A(d.index),i.length),0),g=[],m=1;m<d.length;m++)P(g,void 0===(c=d[m])?c:String(c));var _=d.groups,v=r?(v=j([f],g,p,i),void 0!==_&&P(v,_),O(E(e,void 0,v))):L(f,i,p,g,_,e);u<=p&&(l+=M(i,u,p)+v,u=p+f.length)}return l+M(i,u)}]},!!s(function(){var t=/./;return t.exec=function(){var t=[];return t.groups={a:"7"},t},"7"!=="".replace(t,"$<a>")})||!n||h)},function(t,e,n){var l=n(6),u=n(45).f,h=n(15),d=n(19),f=n(36),p=n(82),g=n(61);t.exports=function(t,e){var n,i,o,r=t.target,s=t.global,a=t.stat,c=s?l:a?l[r]||f(r,{}):(l[r]||{}).prototype;if(c)for(n in e){if(i=e[n],o=t.dontCallGetSet?(o=u(c,n))&&o.value:c[n],!g(s?n:r+(a?".":"#")+n,t.forced)&&void 0!==o){if(typeof i==typeof o)continue;p(i,o)}(t.sham||o&&o.sham)&&h(i,"sham",!0),d(c,n,i,t)}}},function(t,e,n){var i=n(6),o=n(3);t.exports=function(t,e){return arguments.length<2?(n=i[t],o(n)?n:void 0):i[t]&&i[t][e];var n}},function(t,e){t.exports=!1},function(t,e,n){var i,o,r,s,a=n(81),c=n(6),l=n(13),u=n(15),h=n(9),d=n(35),f=n(40),n=n(41),p="Object already initialized",g=c.TypeError,c=c.WeakMap,m=a||d.state?((r=d.state||(d.state=new c)).get=r.get,r.has=r.has,r.set=r.set,i=function(t,e){if(r.has(t))throw g(p);return e.facade=t,r.set(t,e),e},o=function(t){return r.get(t)||{}},function(t){return r.has(t)}):(n[s=f("state")]=!0,i=function(t,e){if(h(t,s))throw g(p);return e.facade=t,u(t,s,e),e},o=function(t){return h(t,s)?t[s]:{}},function(t){return h(t,s)});t.exports={set:i,get:o,has:m,enforce:function(t){return m(t)?o(t):i(t,{})},getterFor:function(e){return function(t){if(l(t)&&(t=o(t)).type===e)return t;throw g("Incompatible receiver, "+e+" required")}}}},function(t,e,n){var i=n(85);t.exports=function(t){t=+t;return t!=t||0==t?0:i(t)}},function(t,e,n){function i(){}function o(t){t.write(g("")),t.close();var e=t.parentWindow.Object;return t=null,e}var r,s=n(14),a=n(90),c=n(42),l=n(41),u=n(92),h=n(38),n=n(40),d="prototype",f="script",p=n("IE_PROTO"),g=function(t){return"<"+f+">"+t+"</"+f+">"},m=function(){try{r=new ActiveXObject("htmlfile")}catch(t){}m="undefined"==typeof document||document.domain&&r?o(r):(t=h("iframe"),e="java"+f+":",t.style.display="none",u.appendChild(t),t.src=String(e),(e=t.contentWindow.document).open(),e.write(g("document.F=Object")),e.close(),e.F);for(var t,e,n=c.length;n--;)delete m[d][c[n]];return m()};l[p]=!0,t.exports=Object.create||function(t,e){var n;return null!==t?(i[d]=s(t),n=new i,i[d]=null,n[p]=t):n=m(),void 0===e?n:a.f(n,e)}},function(t,e,n){"use strict";var i=n(22),o=n(93).trim;i({target:"String",proto:!0,forced:n(94)("trim")},{trim:function(){return o(this)}})},function(M,H,t){var e=t(8),n=t(6),i=t(4),o=t(61),l=t(95),u=t(15),r=t(57).f,h=t(33),d=t(98),f=t(20),p=t(99),s=t(63),a=t(100),c=t(19),g=t(2),m=t(9),_=t(25).enforce,v=t(101),b=t(7),y=t(64),w=t(65),E=b("match"),x=n.RegExp,C=x.prototype,A=n.SyntaxError,T=i(C.exec),O=i("".charAt),S=i("".replace),L=i("".indexOf),R=i("".slice),B=/^\?<[^\s\d!#%&*+<=>@^][^\s!#%&*+<=>@^]*>/,I=/a/g,k=/a/g,t=new x(I)!==I,D=s.MISSED_STICKY,W=s.UNSUPPORTED_Y,b=e&&(!t||D||y||w||g(function(){return k[E]=!1,x(I)!=I||x(k)==k||"/a/i"!=x(I,"i")}));if(o("RegExp",b)){function j(t,e){var n,i,o=h(C,this),r=d(t),s=void 0===e,a=[],c=t;if(!o&&r&&s&&t.constructor===j)return t;if((r||h(C,t))&&(t=t.source,s)&&(e=p(c)),t=void 0===t?"":f(t),e=void 0===e?"":f(e),c=t,r=e=y&&"dotAll"in I&&(n=!!e&&-1<L(e,"s"))?S(e,/s/g,""):e,D&&"sticky"in I&&(i=!!e&&-1<L(e,"y"))&&W&&(e=S(e,/y/g,"")),w&&(t=(s=function(t){for(var e,n=t.length,i=0,o="",r=[],s={},a=!1,c=!1,l=0,u="";i<=n;i++){if("\\"===(e=O(t,i)))e+=O(t,++i);else if("]"===e)a=!1;else if(!a)switch(!0){case"["===e:a=!0;break;case"("===e:T(B,R(t,i+1))&&(i+=2,c=!0),o+=e,l++;continue;case">"===e&&c:if(""===u||m(s,u))throw new A("Invalid capture group name");s[u]=!0,c=!(r[r.length]=[u,l]),u="";continue}c?u+=e:o+=e}return[o,r]}(t))[0],a=s[1]),s=l(x(t,e),o?this:C,j),(n||i||a.length)&&(e=_(s),n&&(e.dotAll=!0,e.raw=j(function(t){for(var e,n=t.length,i=0,o="",r=!1;i<=n;i++)"\\"===(e=O(t,i))?o+=e+O(t,++i):r||"."!==e?("["===e?r=!0:"]"===e&&(r=!1),o+=e):o+="[\\s\\S]";return o}(t),r)),i&&(e.sticky=!0),a.length)&&(e.groups=a),t!==c)try{u(s,"source",""===c?"(?:)":c)}catch(t){}return s}for(var P=r(x),N=0;P.length>N;)a(j,x,P[N++]);(C.constructor=j).prototype=C,c(n,"RegExp",j,{constructor:!0})}v("RegExp")},function(t,e,n){n=n(2);t.exports=!n(function(){var t=function(){}.bind();return"function"!=typeof t||t.hasOwnProperty("prototype")})},function(t,e){t.exports=function(t,e){return{enumerable:!(1&t),configurable:!(2&t),writable:!(4&t),value:e}}},function(t,e){t.exports=function(t){return null==t}},function(t,e,n){n=n(4);t.exports=n({}.isPrototypeOf)},function(t,e,n){var i=n(24),o=n(35);(t.exports=function(t,e){return o[t]||(o[t]=void 0!==e?e:{})})("versions",[]).push({version:"3.28.0",mode:i?"pure":"global",copyright:"© 2014-2023 Denis Pushkarev (zloirock.ru)",license:"https://github.com/zloirock/core-js/blob/v3.28.0/LICENSE",source:"https://github.com/zloirock/core-js"})},function(t,e,n){var i=n(6),n=n(36),o="__core-js_shared__",i=i[o]||n(o,{});t.exports=i},function(t,e,n){var i=n(6),o=Object.defineProperty;t.exports=function(e,n){try{o(i,e,{value:n,configurable:!0,writable:!0})}catch(t){i[e]=n}return n}},function(t,e,n){var i=n(18),o=Object;t.exports=function(t){return o(i(t))}},function(t,e,n){var i=n(6),n=n(13),o=i.document,r=n(o)&&n(o.createElement);t.exports=function(t){return r?o.createElement(t):{}}},function(t,e,n){var i=n(8),n=n(9),o=Function.prototype,r=i&&Object.getOwnPropertyDescriptor,n=n(o,"name"),s=n&&"something"===function(){}.name,i=n&&(!i||r(o,"name").configurable);t.exports={EXISTS:n,PROPER:s,CONFIGURABLE:i}},function(t,e,n){var i=n(34),o=n(53),r=i("keys");t.exports=function(t){return r[t]||(r[t]=o(t))}},function(t,e){t.exports={}},function(t,e){t.exports=["constructor","hasOwnProperty","isPrototypeOf","propertyIsEnumerable","toLocaleString","toString","valueOf"]},function(t,e,n){"use strict";var p=n(12),i=n(4),g=n(20),m=n(62),o=n(63),r=n(34),_=n(27),v=n(25).get,s=n(64),n=n(65),b=r("native-string-replace",String.prototype.replace),y=RegExp.prototype.exec,w=y,E=i("".charAt),x=i("".indexOf),C=i("".replace),A=i("".slice),T=(r=/b*/g,p(y,i=/a/,"a"),p(y,r,"a"),0!==i.lastIndex||0!==r.lastIndex),O=o.BROKEN_CARET,S=void 0!==/()??/.exec("")[1];(T||S||O||s||n)&&(w=function(t){var e,n,i,o,r,s,a=this,c=v(a),t=g(t),l=c.raw;if(l)return l.lastIndex=a.lastIndex,h=p(w,l,t),a.lastIndex=l.lastIndex,h;var u=c.groups,l=O&&a.sticky,h=p(m,a),c=a.source,d=0,f=t;if(l&&(h=C(h,"y",""),-1===x(h,"g")&&(h+="g"),f=A(t,a.lastIndex),0<a.lastIndex&&(!a.multiline||a.multiline&&"\n"!==E(t,a.lastIndex-1))&&(c="(?: "+c+")",f=" "+f,d++),e=new RegExp("^(?:"+c+")",h)),S&&(e=new RegExp("^"+c+"$(?!\\s)",h)),T&&(n=a.lastIndex),i=p(y,l?e:a,f),l?i?(i.input=A(i.input,d),i[0]=A(i[0],d),i.index=a.lastIndex,a.lastIndex+=i[0].length):a.lastIndex=0:T&&i&&(a.lastIndex=a.global?i.index+i[0].length:n),S&&i&&1<i.length&&p(b,i[0],e,function(){for(o=1;o<arguments.length-2;o++)void 0===arguments[o]&&(i[o]=void 0)}),i&&u)for(i.groups=r=_(null),o=0;o<u.length;o++)r[(s=u[o])[0]]=i[s[1]];return i}),t.exports=w},function(t,e){t.exports={}},function(t,e,n){var i=n(8),o=n(12),r=n(73),s=n(31),a=n(16),c=n(46),l=n(9),u=n(54),h=Object.getOwnPropertyDescriptor;e.f=i?h:function(t,e){if(t=a(t),e=c(e),u)try{return h(t,e)}catch(t){}if(l(t,e))return s(!o(r.f,t,e),t[e])}},function(t,e,n){var i=n(75),o=n(48);t.exports=function(t){t=i(t,"string");return o(t)?t:t+""}},function(t,e){var n="object"==typeof document&&document.all;t.exports={all:n,IS_HTMLDDA:void 0===n&&void 0!==n}},function(t,e,n){var i=n(23),o=n(3),r=n(33),n=n(49),s=Object;t.exports=n?function(t){return"symbol"==typeof t}:function(t){var e=i("Symbol");return o(e)&&r(e.prototype,s(t))}},function(t,e,n){n=n(50);t.exports=n&&!Symbol.sham&&"symbol"==typeof Symbol.iterator},function(t,e,n){var i=n(76),n=n(2);t.exports=!!Object.getOwnPropertySymbols&&!n(function(){var t=Symbol();return!String(t)||!(Object(t)instanceof Symbol)||!Symbol.sham&&i&&i<41})},function(t,e,n){var i=n(52),o=n(32);t.exports=function(t,e){t=t[e];return o(t)?void 0:i(t)}},function(t,e,n){var i=n(3),o=n(78),r=TypeError;t.exports=function(t){if(i(t))return t;throw r(o(t)+" is not a function")}},function(t,e,n){var n=n(4),i=0,o=Math.random(),r=n(1..toString);t.exports=function(t){return"Symbol("+(void 0===t?"":t)+")_"+r(++i+o,36)}},function(t,e,n){var i=n(8),o=n(2),r=n(38);t.exports=!i&&!o(function(){return 7!=Object.defineProperty(r("div"),"a",{get:function(){return 7}}).a})},function(t,e,n){var i=n(8),n=n(2);t.exports=i&&n(function(){return 42!=Object.defineProperty(function(){},"prototype",{value:42,writable:!1}).prototype})},function(t,e,n){var i=n(4),o=n(2),r=n(3),s=n(9),a=n(8),c=n(39).CONFIGURABLE,l=n(80),n=n(25),u=n.enforce,h=n.get,d=String,f=Object.defineProperty,p=i("".slice),g=i("".replace),m=i([].join),_=a&&!o(function(){return 8!==f(function(){},"length",{value:8}).length}),v=String(String).split("String"),n=t.exports=function(t,e,n){"Symbol("===p(d(e),0,7)&&(e="["+g(d(e),/^Symbol\(([^)]*)\)/,"$1")+"]"),n&&n.getter&&(e="get "+e),n&&n.setter&&(e="set "+e),(!s(t,"name")||c&&t.name!==e)&&(a?f(t,"name",{value:e,configurable:!0}):t.name=e),_&&n&&s(n,"arity")&&t.length!==n.arity&&f(t,"length",{value:n.arity});try{n&&s(n,"constructor")&&n.constructor?a&&f(t,"prototype",{writable:!1}):t.prototype&&(t.prototype=void 0)}catch(t){}n=u(t);return s(n,"source")||(n.source=m(v,"string"==typeof e?e:"")),t};Function.prototype.toString=n(function(){return r(this)&&h(this).source||l(this)},"toString")},function(t,e,n){var i=n(58),o=n(42).concat("length","prototype");e.f=Object.getOwnPropertyNames||function(t){return i(t,o)}},function(t,e,n){var i=n(4),s=n(9),a=n(16),c=n(59).indexOf,l=n(41),u=i([].push);t.exports=function(t,e){var n,i=a(t),o=0,r=[];for(n in i)!s(l,n)&&s(i,n)&&u(r,n);for(;e.length>o;)!s(i,n=e[o++])||~c(r,n)||u(r,n);return r}},function(t,e,n){function i(a){return function(t,e,n){var i,o=c(t),r=u(o),s=l(n,r);if(a&&e!=e){for(;s<r;)if((i=o[s++])!=i)return!0}else for(;s<r;s++)if((a||s in o)&&o[s]===e)return a||s||0;return!a&&-1}}var c=n(16),l=n(84),u=n(86);t.exports={includes:i(!0),indexOf:i(!1)}},function(t,e,n){var i=n(26),o=Math.min;t.exports=function(t){return 0<t?o(i(t),9007199254740991):0}},function(t,e,n){function i(t,e){return(t=c[a(t)])==u||t!=l&&(r(e)?o(e):!!e)}var o=n(2),r=n(3),s=/#|\.prototype\./,a=i.normalize=function(t){return String(t).replace(s,".").toLowerCase()},c=i.data={},l=i.NATIVE="N",u=i.POLYFILL="P";t.exports=i},function(t,e,n){"use strict";var i=n(14);t.exports=function(){var t=i(this),e="";return t.hasIndices&&(e+="d"),t.global&&(e+="g"),t.ignoreCase&&(e+="i"),t.multiline&&(e+="m"),t.dotAll&&(e+="s"),t.unicode&&(e+="u"),t.unicodeSets&&(e+="v"),t.sticky&&(e+="y"),e}},function(t,e,n){var i=n(2),o=n(6).RegExp,n=i(function(){var t=o("a","y");return t.lastIndex=2,null!=t.exec("abcd")}),r=n||i(function(){return!o("a","y").sticky}),i=n||i(function(){var t=o("^r","gy");return t.lastIndex=2,null!=t.exec("str")});t.exports={BROKEN_CARET:i,MISSED_STICKY:r,UNSUPPORTED_Y:n}},function(t,e,n){var i=n(2),o=n(6).RegExp;t.exports=i(function(){var t=o(".","s");return!(t.dotAll&&t.exec("\n")&&"s"===t.flags)})},function(t,e,n){var i=n(2),o=n(6).RegExp;t.exports=i(function(){var t=o("(?<a>b)","g");return"b"!==t.exec("b").groups.a||"bc"!=="b".replace(t,"$<a>c")})},function(t,e){t.exports="\t\n\v\f\r                　\u2028\u2029\ufeff"},function(t,e,n){var o=n(96),r=n(14),s=n(97);t.exports=Object.setPrototypeOf||("__proto__"in{}?function(){var n,i=!1,t={};try{(n=o(Object.prototype,"__proto__","set"))(t,[]),i=t instanceof Array}catch(t){}return function(t,e){return r(t),s(e),i?n(t,e):t.__proto__=e,t}}():void 0)},function(t,e,n){var i=n(7),o=n(27),n=n(11).f,r=i("unscopables"),s=Array.prototype;null==s[r]&&n(s,r,{configurable:!0,value:o(null)}),t.exports=function(t){s[r][t]=!0}},function(t,e,n){"use strict";var i,o,r=n(2),s=n(3),a=n(13),c=n(27),l=n(70),u=n(19),h=n(7),n=n(24),d=h("iterator"),h=!1;[].keys&&("next"in(o=[].keys())?(l=l(l(o)))!==Object.prototype&&(i=l):h=!0),!a(i)||r(function(){var t={};return i[d].call(t)!==t})?i={}:n&&(i=c(i)),s(i[d])||u(i,d,function(){return this}),t.exports={IteratorPrototype:i,BUGGY_SAFARI_ITERATORS:h}},function(t,e,n){var i=n(9),o=n(3),r=n(37),s=n(40),n=n(112),a=s("IE_PROTO"),c=Object,l=c.prototype;t.exports=n?c.getPrototypeOf:function(t){var e,t=r(t);return i(t,a)?t[a]:(e=t.constructor,o(e)&&t instanceof e?e.prototype:t instanceof c?l:null)}},function(t,e,n){var i=n(11).f,o=n(9),r=n(7)("toStringTag");t.exports=function(t,e,n){(t=t&&!n?t.prototype:t)&&!o(t,r)&&i(t,r,{configurable:!0,value:e})}},function(t,e){var n=function(){return this}();try{n=n||new Function("return this")()}catch(t){"object"==typeof window&&(n=window)}t.exports=n},function(t,e,n){"use strict";var i={}.propertyIsEnumerable,o=Object.getOwnPropertyDescriptor,r=o&&!i.call({1:2},1);e.f=r?function(t){t=o(this,t);return!!t&&t.enumerable}:i},function(t,e,n){var i=n(4),o=n(2),r=n(17),s=Object,a=i("".split);t.exports=o(function(){return!s("z").propertyIsEnumerable(0)})?function(t){return"String"==r(t)?a(t,""):s(t)}:s},function(t,e,n){var i=n(12),o=n(13),r=n(48),s=n(51),a=n(79),n=n(7),c=TypeError,l=n("toPrimitive");t.exports=function(t,e){if(!o(t)||r(t))return t;var n=s(t,l);if(n){if(n=i(n,t,e=void 0===e?"default":e),!o(n)||r(n))return n;throw c("Can't convert object to primitive value")}return a(t,e=void 0===e?"number":e)}},function(t,e,n){var i,o,r=n(6),n=n(77),s=r.process,r=r.Deno,s=s&&s.versions||r&&r.version,r=s&&s.v8;!(o=r?0<(i=r.split("."))[0]&&i[0]<4?1:+(i[0]+i[1]):o)&&n&&(!(i=n.match(/Edge\/(\d+)/))||74<=i[1])&&(i=n.match(/Chrome\/(\d+)/))&&(o=+i[1]),t.exports=o},function(t,e){t.exports="undefined"!=typeof navigator&&String(navigator.userAgent)||""},function(t,e){var n=String;t.exports=function(t){try{return n(t)}catch(t){return"Object"}}},function(t,e,n){var o=n(12),r=n(3),s=n(13),a=TypeError;t.exports=function(t,e){var n,i;if("string"===e&&r(n=t.toString)&&!s(i=o(n,t)))return i;if(r(n=t.valueOf)&&!s(i=o(n,t)))return i;if("string"!==e&&r(n=t.toString)&&!s(i=o(n,t)))return i;throw a("Can't convert object to primitive value")}},function(t,e,n){var i=n(4),o=n(3),n=n(35),r=i(Function.toString);o(n.inspectSource)||(n.inspectSource=function(t){return r(t)}),t.exports=n.inspectSource},function(t,e,n){var i=n(6),n=n(3),i=i.WeakMap;t.exports=n(i)&&/native code/.test(String(i))},function(t,e,n){var c=n(9),l=n(83),u=n(45),h=n(11);t.exports=function(t,e,n){for(var i=l(e),o=h.f,r=u.f,s=0;s<i.length;s++){var a=i[s];c(t,a)||n&&c(n,a)||o(t,a,r(e,a))}}},function(t,e,n){var i=n(23),o=n(4),r=n(57),s=n(87),a=n(14),c=o([].concat);t.exports=i("Reflect","ownKeys")||function(t){var e=r.f(a(t)),n=s.f;return n?c(e,n(t)):e}},function(t,e,n){var i=n(26),o=Math.max,r=Math.min;t.exports=function(t,e){t=i(t);return t<0?o(t+e,0):r(t,e)}},function(t,e){var n=Math.ceil,i=Math.floor;t.exports=Math.trunc||function(t){t=+t;return(0<t?i:n)(t)}},function(t,e,n){var i=n(60);t.exports=function(t){return i(t.length)}},function(t,e){e.f=Object.getOwnPropertySymbols},function(t,e,n){var i=n(89),o=n(3),r=n(17),s=n(7)("toStringTag"),a=Object,c="Arguments"==r(function(){return arguments}());t.exports=i?r:function(t){var e;return void 0===t?"Undefined":null===t?"Null":"string"==typeof(e=function(t,e){try{return t[e]}catch(t){}}(t=a(t),s))?e:c?r(t):"Object"==(e=r(t))&&o(t.callee)?"Arguments":e}},function(t,e,n){var i={};i[n(7)("toStringTag")]="z",t.exports="[object z]"===String(i)},function(t,e,n){var i=n(8),o=n(55),a=n(11),c=n(14),l=n(16),u=n(91);e.f=i&&!o?Object.defineProperties:function(t,e){c(t);for(var n,i=l(e),o=u(e),r=o.length,s=0;s<r;)a.f(t,n=o[s++],i[n]);return t}},function(t,e,n){var i=n(58),o=n(42);t.exports=Object.keys||function(t){return i(t,o)}},function(t,e,n){n=n(23);t.exports=n("document","documentElement")},function(t,e,n){function i(e){return function(t){t=s(r(t));return 1&e&&(t=a(t,c,"")),t=2&e?a(t,l,"$1"):t}}var o=n(4),r=n(18),s=n(20),n=n(66),a=o("".replace),c=RegExp("^["+n+"]+"),l=RegExp("(^|[^"+n+"])["+n+"]+$");t.exports={start:i(1),end:i(2),trim:i(3)}},function(t,e,n){var i=n(39).PROPER,o=n(2),r=n(66);t.exports=function(t){return o(function(){return!!r[t]()||"​᠎"!=="​᠎"[t]()||i&&r[t].name!==t})}},function(t,e,n){var i=n(3),o=n(13),r=n(67);t.exports=function(t,e,n){return r&&i(e=e.constructor)&&e!==n&&o(e=e.prototype)&&e!==n.prototype&&r(t,e),t}},function(t,e,n){var i=n(4),o=n(52);t.exports=function(t,e,n){try{return i(o(Object.getOwnPropertyDescriptor(t,e)[n]))}catch(t){}}},function(t,e,n){var i=n(3),o=String,r=TypeError;t.exports=function(t){if("object"==typeof t||i(t))return t;throw r("Can't set "+o(t)+" as a prototype")}},function(t,e,n){var i=n(13),o=n(17),r=n(7)("match");t.exports=function(t){var e;return i(t)&&(void 0!==(e=t[r])?!!e:"RegExp"==o(t))}},function(t,e,n){var i=n(12),o=n(9),r=n(33),s=n(62),a=RegExp.prototype;t.exports=function(t){var e=t.flags;return void 0!==e||"flags"in a||o(t,"flags")||!r(a,t)?e:i(s,t)}},function(t,e,n){var i=n(11).f;t.exports=function(t,e,n){n in t||i(t,n,{configurable:!0,get:function(){return e[n]},set:function(t){e[n]=t}})}},function(t,e,n){"use strict";var i=n(23),o=n(102),r=n(7),s=n(8),a=r("species");t.exports=function(t){t=i(t);s&&t&&!t[a]&&o(t,a,{configurable:!0,get:function(){return this}})}},function(t,e,n){var i=n(56),o=n(11);t.exports=function(t,e,n){return n.get&&i(n.get,e,{getter:!0}),n.set&&i(n.set,e,{setter:!0}),o.f(t,e,n)}},function(t,e,n){var n=n(30),i=Function.prototype,o=i.apply,r=i.call;t.exports="object"==typeof Reflect&&Reflect.apply||(n?r.bind(o):function(){return r.apply(o,arguments)})},function(t,e,n){"use strict";n(5);var c=n(105),l=n(19),u=n(43),h=n(2),d=n(7),f=n(15),p=d("species"),g=RegExp.prototype;t.exports=function(n,t,e,i){var s,o=d(n),a=!h(function(){var t={};return t[o]=function(){return 7},7!=""[n](t)}),r=a&&!h(function(){var t=!1,e=/a/;return"split"===n&&((e={constructor:{}}).constructor[p]=function(){return e},e.flags="",e[o]=/./[o]),e.exec=function(){return t=!0,null},e[o](""),!t});a&&r&&!e||(s=c(/./[o]),r=t(o,""[n],function(t,e,n,i,o){var t=c(t),r=e.exec;return r===u||r===g.exec?a&&!o?{done:!0,value:s(e,n,i)}:{done:!0,value:t(n,e,i)}:{done:!1}}),l(String.prototype,n,r[0]),l(g,o,r[1])),i&&f(g[o],"sham",!0)}},function(t,e,n){var i=n(17),o=n(4);t.exports=function(t){if("Function"===i(t))return o(t)}},function(t,e,n){"use strict";var i=n(107).charAt;t.exports=function(t,e,n){return e+(n?i(t,e).length:1)}},function(t,e,n){function i(o){return function(t,e){var n,t=s(a(t)),e=r(e),i=t.length;return e<0||i<=e?o?"":void 0:(n=l(t,e))<55296||56319<n||e+1===i||(i=l(t,e+1))<56320||57343<i?o?c(t,e):n:o?u(t,e,e+2):i-56320+(n-55296<<10)+65536}}var o=n(4),r=n(26),s=n(20),a=n(18),c=o("".charAt),l=o("".charCodeAt),u=o("".slice);t.exports={codeAt:i(!1),charAt:i(!0)}},function(t,e,n){var i=n(4),o=n(37),d=Math.floor,f=i("".charAt),p=i("".replace),g=i("".slice),m=/\$([$&'`]|\d{1,2}|<[^>]*>)/g,_=/\$([$&'`]|\d{1,2})/g;t.exports=function(r,s,a,c,l,t){var u=a+r.length,h=c.length,e=_;return void 0!==l&&(l=o(l),e=m),p(t,e,function(t,e){var n;switch(f(e,0)){case"$":return"$";case"&":return r;case"`":return g(s,0,a);case"'":return g(s,u);case"<":n=l[g(e,1,-1)];break;default:var i,o=+e;if(0==o)return t;if(h<o)return 0!==(i=d(o/10))&&i<=h?void 0===c[i-1]?f(e,1):c[i-1]+f(e,1):t;n=c[o-1]}return void 0===n?"":n})}},function(t,e,n){var i=n(12),o=n(14),r=n(3),s=n(17),a=n(43),c=TypeError;t.exports=function(t,e){var n=t.exec;if(r(n))return null!==(n=i(n,t,e))&&o(n),n;if("RegExp"===s(t))return i(a,t,e);throw c("RegExp#exec called on incompatible receiver")}},function(t,e,n){"use strict";function g(){return this}var m=n(22),_=n(12),v=n(24),i=n(39),b=n(3),y=n(111),w=n(70),E=n(67),x=n(71),C=n(15),A=n(19),o=n(7),T=n(44),n=n(69),O=i.PROPER,S=i.CONFIGURABLE,L=n.IteratorPrototype,I=n.BUGGY_SAFARI_ITERATORS,k=o("iterator"),D="values",j="entries";t.exports=function(t,e,n,i,o,r,s){y(n,e,i);function a(t){if(t===o&&f)return f;if(!I&&t in h)return h[t];switch(t){case"keys":case D:case j:return function(){return new n(this,t)}}return function(){return new n(this)}}var c,l,i=e+" Iterator",u=!1,h=t.prototype,d=h[k]||h["@@iterator"]||o&&h[o],f=!I&&d||a(o),p="Array"==e&&h.entries||d;if(p&&(p=w(p.call(new t)))!==Object.prototype&&p.next&&(v||w(p)===L||(E?E(p,L):b(p[k])||A(p,k,g)),x(p,i,!0,!0),v)&&(T[i]=g),O&&o==D&&d&&d.name!==D&&(!v&&S?C(h,"name",D):(u=!0,f=function(){return _(d,this)})),o)if(c={values:a(D),keys:r?f:a("keys"),entries:a(j)},s)for(l in c)!I&&!u&&l in h||A(h,l,c[l]);else m({target:e,proto:!0,forced:I||u},c);return v&&!s||h[k]===f||A(h,k,f,{name:o}),T[e]=f,c}},function(t,e,n){"use strict";function o(){return this}var r=n(69).IteratorPrototype,s=n(27),a=n(31),c=n(71),l=n(44);t.exports=function(t,e,n,i){e+=" Iterator";return t.prototype=s(r,{next:a(+!i,n)}),c(t,e,!1,!0),l[e]=o,t}},function(t,e,n){n=n(2);t.exports=!n(function(){function t(){}return t.prototype.constructor=null,Object.getPrototypeOf(new t)!==t.prototype})},function(t,e){t.exports=function(t,e){return{value:t,done:e}}},function(t,e){t.exports={CSSRuleList:0,CSSStyleDeclaration:0,CSSValueList:0,ClientRectList:0,DOMRectList:0,DOMStringList:0,DOMTokenList:1,DataTransferItemList:0,FileList:0,HTMLAllCollection:0,HTMLCollection:0,HTMLFormElement:0,HTMLSelectElement:0,MediaList:0,MimeTypeArray:0,NamedNodeMap:0,NodeList:1,PaintRequestList:0,Plugin:0,PluginArray:0,SVGLengthList:0,SVGNumberList:0,SVGPathSegList:0,SVGPointList:0,SVGStringList:0,SVGTransformList:0,SourceBufferList:0,StyleSheetList:0,TextTrackCueList:0,TextTrackList:0,TouchList:0}},function(t,e,n){n=n(38)("span").classList,n=n&&n.constructor&&n.constructor.prototype;t.exports=n===Object.prototype?void 0:n},function(t,e){function o(t){var e=i[t];return void 0!==e||(e=i[t]={id:t,exports:{}},n[t](e,e.exports,o)),e.exports}var n,i;n={454:(t,e,n)=>{"use strict";n.d(e,{Z:()=>i});e=n(645),n=n.n(e)()(function(t){return t[1]});n.push([t.id,"INPUT:-webkit-autofill,SELECT:-webkit-autofill,TEXTAREA:-webkit-autofill{animation-name:onautofillstart}INPUT:not(:-webkit-autofill),SELECT:not(:-webkit-autofill),TEXTAREA:not(:-webkit-autofill){animation-name:onautofillcancel}@keyframes onautofillstart{}@keyframes onautofillcancel{}",""]);const i=n},645:t=>{"use strict";t.exports=function(n){var c=[];return c.toString=function(){return this.map(function(t){var e=n(t);return t[2]?"@media ".concat(t[2]," {").concat(e,"}"):e}).join("")},c.i=function(t,e,n){"string"==typeof t&&(t=[[null,t,""]]);var i={};if(n)for(var o=0;o<this.length;o++){var r=this[o][0];null!=r&&(i[r]=!0)}for(var s=0;s<t.length;s++){var a=[].concat(t[s]);n&&i[a[0]]||(e&&(a[2]?a[2]="".concat(e," and ").concat(a[2]):a[2]=e),c.push(a))}},c}},810:()=>{if("undefined"!=typeof window)try{var t=new window.CustomEvent("test",{cancelable:!0});if(t.preventDefault(),!0!==t.defaultPrevented)throw new Error("Could not prevent default")}catch(t){function e(t,e){var n,i;return(e=e||{}).bubbles=!!e.bubbles,e.cancelable=!!e.cancelable,(n=document.createEvent("CustomEvent")).initCustomEvent(t,e.bubbles,e.cancelable,e.detail),i=n.preventDefault,n.preventDefault=function(){i.call(this);try{Object.defineProperty(this,"defaultPrevented",{get:function(){return!0}})}catch(t){this.defaultPrevented=!0}},n}e.prototype=window.Event.prototype,window.CustomEvent=e}},379:(t,e,o)=>{"use strict";i={};var n,i,r=function(t){if(void 0===i[t]){var e=document.querySelector(t);if(window.HTMLIFrameElement&&e instanceof window.HTMLIFrameElement)try{e=e.contentDocument.head}catch(t){e=null}i[t]=e}return i[t]},l=[];function u(t){for(var e=-1,n=0;n<l.length;n++)if(l[n].identifier===t){e=n;break}return e}function a(t,e){for(var n={},i=[],o=0;o<t.length;o++){var r=t[o],s=e.base?r[0]+e.base:r[0],a=n[s]||0,c="".concat(s," ").concat(a),s=(n[s]=a+1,u(c)),a={css:r[1],media:r[2],sourceMap:r[3]};-1!==s?(l[s].references++,l[s].updater(a)):l.push({identifier:c,updater:function(e,t){var n,i,o;{var r;o=t.singleton?(r=p++,n=f=f||h(t),i=d.bind(null,n,r,!1),d.bind(null,n,r,!0)):(n=h(t),i=function(t,e,n){var i=n.css,o=n.media,n=n.sourceMap;if(o?t.setAttribute("media",o):t.removeAttribute("media"),n&&"undefined"!=typeof btoa&&(i+="\n/*# sourceMappingURL=data:application/json;base64,".concat(btoa(unescape(encodeURIComponent(JSON.stringify(n))))," */")),t.styleSheet)t.styleSheet.cssText=i;else{for(;t.firstChild;)t.removeChild(t.firstChild);t.appendChild(document.createTextNode(i))}}.bind(null,n,t),function(){var t;null!==(t=n).parentNode&&t.parentNode.removeChild(t)})}return i(e),function(t){t?t.css===e.css&&t.media===e.media&&t.sourceMap===e.sourceMap||i(e=t):o()}}(a,e),references:1}),i.push(c)}return i}function h(t){var e=document.createElement("style"),n=t.attributes||{};if(void 0===n.nonce&&(i=o.nc)&&(n.nonce=i),Object.keys(n).forEach(function(t){e.setAttribute(t,n[t])}),"function"==typeof t.insert)t.insert(e);else{var i=r(t.insert||"head");if(!i)throw new Error("Couldn't find a style target. This probably means that the value for the 'insert' parameter is invalid.");i.appendChild(e)}return e}s=[];var s,c=function(t,e){return s[t]=e,s.filter(Boolean).join("\n")};function d(t,e,n,i){var n=n?"":i.media?"@media ".concat(i.media," {").concat(i.css,"}"):i.css;t.styleSheet?t.styleSheet.cssText=c(e,n):(i=document.createTextNode(n),(n=t.childNodes)[e]&&t.removeChild(n[e]),n.length?t.insertBefore(i,n[e]):t.appendChild(i))}var f=null,p=0;t.exports=function(t,r){(r=r||{}).singleton||"boolean"==typeof r.singleton||(r.singleton=n=void 0===n?Boolean(window&&document&&document.all&&!window.atob):n);var s=a(t=t||[],r);return function(t){if(t=t||[],"[object Array]"===Object.prototype.toString.call(t)){for(var e=0;e<s.length;e++){var n=u(s[e]);l[n].references--}for(var t=a(t,r),i=0;i<s.length;i++){var o=u(s[i]);0===l[o].references&&(l[o].updater(),l.splice(o,1))}s=t}}}}},i={},o.n=t=>{var e=t&&t.__esModule?()=>t.default:()=>t;return o.d(e,{a:e}),e},o.d=(t,e)=>{for(var n in e)o.o(e,n)&&!o.o(t,n)&&Object.defineProperty(t,n,{enumerable:!0,get:e[n]})},o.o=(t,e)=>Object.prototype.hasOwnProperty.call(t,e),(()=>{"use strict";var t=o(379),t=o.n(t),e=o(454);function n(t){var e;t.hasAttribute("autocompleted")||(t.setAttribute("autocompleted",""),e=new window.CustomEvent("onautocomplete",{bubbles:!0,cancelable:!0,detail:null}),t.dispatchEvent(e))||(t.value="")}function i(t){t.hasAttribute("autocompleted")&&(t.removeAttribute("autocompleted"),t.dispatchEvent(new window.CustomEvent("onautocomplete",{bubbles:!0,cancelable:!1,detail:null})))}t()(e.Z,{insert:"head",singleton:!1}),e.Z.locals,o(810),document.addEventListener("animationstart",function(t){("onautofillstart"===t.animationName?n:i)(t.target)},!0),document.addEventListener("input",function(t){("insertReplacementText"!==t.inputType&&"data"in t?i:n)(t.target)},!0)})()},,,function(M,t,e){"use strict";e.r(t),e.d(t,"Alert",function(){return Fe}),e.d(t,"Button",function(){return ee}),e.d(t,"Carousel",function(){return xn}),e.d(t,"Collapse",function(){return ea}),e.d(t,"Offcanvas",function(){return ke}),e.d(t,"Dropdown",function(){return Na}),e.d(t,"Input",function(){return Ds}),e.d(t,"Modal",function(){return $n}),e.d(t,"Popover",function(){return Jo}),e.d(t,"Ripple",function(){return Ya}),e.d(t,"ScrollSpy",function(){return gr}),e.d(t,"Tab",function(){return Mr}),e.d(t,"Toast",function(){return bs}),e.d(t,"Tooltip",function(){return Kr}),e.d(t,"Range",function(){return tc});var n={};e.r(n),e.d(n,"top",function(){return O}),e.d(n,"bottom",function(){return S}),e.d(n,"right",function(){return L}),e.d(n,"left",function(){return I}),e.d(n,"auto",function(){return Gn}),e.d(n,"basePlacements",function(){return Zn}),e.d(n,"start",function(){return Jn}),e.d(n,"end",function(){return ti}),e.d(n,"clippingParents",function(){return ei}),e.d(n,"viewport",function(){return ni}),e.d(n,"popper",function(){return ii}),e.d(n,"reference",function(){return oi}),e.d(n,"variationPlacements",function(){return ri}),e.d(n,"placements",function(){return si}),e.d(n,"beforeRead",function(){return ai}),e.d(n,"read",function(){return ci}),e.d(n,"afterRead",function(){return li}),e.d(n,"beforeMain",function(){return ui}),e.d(n,"main",function(){return hi}),e.d(n,"afterMain",function(){return di}),e.d(n,"beforeWrite",function(){return fi}),e.d(n,"write",function(){return pi}),e.d(n,"afterWrite",function(){return gi}),e.d(n,"modifierPhases",function(){return mi}),e.d(n,"applyStyles",function(){return bi}),e.d(n,"arrow",function(){return Hi}),e.d(n,"computeStyles",function(){return Fi}),e.d(n,"eventListeners",function(){return zi}),e.d(n,"flip",function(){return io}),e.d(n,"hide",function(){return so}),e.d(n,"offset",function(){return ao}),e.d(n,"popperOffsets",function(){return co}),e.d(n,"preventOverflow",function(){return lo}),e.d(n,"popperGenerator",function(){return go}),e.d(n,"detectOverflow",function(){return no}),e.d(n,"createPopperBase",function(){return mo}),e.d(n,"createPopper",function(){return _o}),e.d(n,"createPopperLite",function(){return vo}),e(5),e(28),e(29);const H=t=>{let e=t.getAttribute("data-mdb-target");return e&&"#"!==e||(t=t.getAttribute("href"),e=t&&"#"!==t?t.trim():null),e},R=t=>{t=H(t);return t?document.querySelector(t):null};const B=(o,r,s)=>{Object.keys(s).forEach(t=>{var e,n=s[t],i=r[t],i=i&&((e=i)[0]||e).nodeType?"element":null==(e=i)?"".concat(e):{}.toString.call(e).match(/\s([a-z]+)/i)[1].toLowerCase();if(!new RegExp(n).test(i))throw new Error("".concat(o.toUpperCase(),": ")+'Option "'.concat(t,'" provided type "').concat(i,'" ')+'but expected type "'.concat(n,'".'))})};const i=()=>{var t=window["jQuery"];return t&&!document.body.hasAttribute("data-mdb-no-jquery")?t:null},o=t=>{"loading"===document.readyState?document.addEventListener("DOMContentLoaded",t):t()};document.documentElement.dir;const W=t=>document.createElement(t);const F=(()=>{const i={};let o=1;return{set(t,e,n){void 0===t[e]&&(t[e]={key:e,id:o},o++),i[t[e].id]=n},get(t,e){return t&&void 0!==t[e]&&(t=t[e]).key===e?i[t.id]:null},delete(t,e){var n;void 0!==t[e]&&(n=t[e]).key===e&&(delete i[n.id],delete t[e])}}})();var r={setData(t,e,n){F.set(t,e,n)},getData(t,e){return F.get(t,e)},removeData(t,e){F.delete(t,e)}};e(21),e(0),e(1);const q=i(),z=/[^.]*(?=\..*)\.|.*/,Q=/\..*/,V=/::\d+$/,U={};let Y=1;const X={mouseenter:"mouseover",mouseleave:"mouseout"},K=["click","dblclick","mouseup","mousedown","contextmenu","mousewheel","DOMMouseScroll","mouseover","mouseout","mousemove","selectstart","selectend","keydown","keypress","keyup","orientationchange","touchstart","touchmove","touchend","touchcancel","pointerdown","pointermove","pointerup","pointerleave","pointercancel","gesturestart","gesturechange","gestureend","focus","blur","change","reset","select","submit","focusin","focusout","load","unload","beforeunload","resize","move","DOMContentLoaded","readystatechange","error","abort","scroll"];function $(t,e){return e&&"".concat(e,"::").concat(Y++)||t.uidEvent||Y++}function G(t){var e=$(t);return t.uidEvent=e,U[e]=U[e]||{},U[e]}function Z(n,i,t){var o=2<arguments.length&&void 0!==t?t:null,r=Object.keys(n);for(let t=0,e=r.length;t<e;t++){var s=n[r[t]];if(s.originalHandler===i&&s.delegationSelector===o)return s}return null}function J(t,e,n){var i="string"==typeof e,n=i?n:e;let o=t.replace(Q,"");e=X[o],e&&(o=e),e=-1<K.indexOf(o);return[i,n,o=e?o:t]}function tt(t,e,n,i,o){var r,s,a,c,l,u,h,d,f,p;"string"==typeof e&&t&&(n||(n=i,i=null),[r,s,a]=J(e,n,i),(l=Z(c=(c=G(t))[a]||(c[a]={}),s,r?n:null))?l.oneOff=l.oneOff&&o:(l=$(s,e.replace(z,"")),(e=r?(d=t,f=n,p=i,function n(i){var o=d.querySelectorAll(f);for(let e=i["target"];e&&e!==this;e=e.parentNode)for(let t=o.length;t--;)if(o[t]===e)return i.delegateTarget=e,n.oneOff&&nt.off(d,i.type,p),p.apply(e,[i]);return null}):(u=t,h=n,function t(e){return e.delegateTarget=u,t.oneOff&&nt.off(u,e.type,h),h.apply(u,[e])})).delegationSelector=r?n:null,e.originalHandler=s,e.oneOff=o,c[e.uidEvent=l]=e,t.addEventListener(a,e,r)))}function et(t,e,n,i,o){i=Z(e[n],i,o);i&&(t.removeEventListener(n,i,Boolean(o)),delete e[n][i.uidEvent])}const nt={on(t,e,n,i){tt(t,e,n,i,!1)},one(t,e,n,i){tt(t,e,n,i,!0)},off(s,a,t,e){if("string"==typeof a&&s){const[n,i,o]=J(a,t,e),r=o!==a,c=G(s);e="."===a.charAt(0);if(void 0!==i)return c&&c[o]?void et(s,c,o,i,n?t:null):void 0;e&&Object.keys(c).forEach(t=>{{var e=s,n=c,i=t,o=a.slice(1);const r=n[i]||{};Object.keys(r).forEach(t=>{-1<t.indexOf(o)&&(t=r[t],et(e,n,i,t.originalHandler,t.delegationSelector))})}});const l=c[o]||{};Object.keys(l).forEach(t=>{var e=t.replace(V,"");(!r||-1<a.indexOf(e))&&(e=l[t],et(s,c,o,e.originalHandler,e.delegationSelector))})}},trigger(t,e,n){if("string"!=typeof e||!t)return null;var i=e.replace(Q,""),o=e!==i,r=-1<K.indexOf(i);let s,a=!0,c=!0,l=!1,u=null;return o&&q&&(s=q.Event(e,n),q(t).trigger(s),a=!s.isPropagationStopped(),c=!s.isImmediatePropagationStopped(),l=s.isDefaultPrevented()),r?(u=document.createEvent("HTMLEvents")).initEvent(i,a,!0):u=new CustomEvent(e,{bubbles:a,cancelable:!0}),void 0!==n&&Object.keys(n).forEach(t=>{Object.defineProperty(u,t,{get(){return n[t]}})}),l&&u.preventDefault(),c&&t.dispatchEvent(u),u.defaultPrevented&&void 0!==s&&s.preventDefault(),u}};var s=nt;function it(t){return"true"===t||"false"!==t&&(t===Number(t).toString()?Number(t):""===t||"null"===t?null:t)}function ot(t){return t.replace(/[A-Z]/g,t=>"-".concat(t.toLowerCase()))}var c={setDataAttribute(t,e,n){t.setAttribute("data-mdb-".concat(ot(e)),n)},removeDataAttribute(t,e){t.removeAttribute("data-mdb-".concat(ot(e)))},getDataAttributes(t){if(!t)return{};const n={...t.dataset};return Object.keys(n).filter(t=>t.startsWith("mdb")).forEach(t=>{let e=t.replace(/^mdb/,"");e=e.charAt(0).toLowerCase()+e.slice(1,e.length),n[e]=it(n[t])}),n},getDataAttribute(t,e){return it(t.getAttribute("data-mdb-".concat(ot(e))))},offset(t){t=t.getBoundingClientRect();return{top:t.top+document.body.scrollTop,left:t.left+document.body.scrollLeft}},position(t){return{top:t.offsetTop,left:t.offsetLeft}},style(t,e){Object.assign(t.style,e)},toggleClass(t,e){t&&(t.classList.contains(e)?t.classList.remove(e):t.classList.add(e))},addClass(t,e){t.classList.contains(e)||t.classList.add(e)},addStyle(e,n){Object.keys(n).forEach(t=>{e.style[t]=n[t]})},removeClass(t,e){t.classList.contains(e)&&t.classList.remove(e)},hasClass(t,e){return t.classList.contains(e)}};var a={closest(t,e){return t.closest(e)},matches(t,e){return t.matches(e)},find(t){var e=1<arguments.length&&void 0!==arguments[1]?arguments[1]:document.documentElement;return[].concat(...Element.prototype.querySelectorAll.call(e,t))},findOne(t){var e=1<arguments.length&&void 0!==arguments[1]?arguments[1]:document.documentElement;return Element.prototype.querySelector.call(e,t)},children(t,e){return[].concat(...t.children).filter(t=>t.matches(e))},parents(t,e){var n=[];let i=t.parentNode;for(;i&&i.nodeType===Node.ELEMENT_NODE&&3!==i.nodeType;)this.matches(i,e)&&n.push(i),i=i.parentNode;return n},prev(t,e){let n=t.previousElementSibling;for(;n;){if(n.matches(e))return[n];n=n.previousElementSibling}return[]},next(t,e){let n=t.nextElementSibling;for(;n;){if(this.matches(n,e))return[n];n=n.nextElementSibling}return[]}};e(10);const rt=1e3,st="transitionend",at=e=>{let n=e.getAttribute("data-mdb-target");if(!n||"#"===n){let t=e.getAttribute("href");if(!t||!t.includes("#")&&!t.startsWith("."))return null;t.includes("#")&&!t.startsWith("#")&&(t="#".concat(t.split("#")[1])),n=t&&"#"!==t?t.trim():null}return n},ct=t=>{t=at(t);return t&&document.querySelector(t)?t:null},l=t=>{t=at(t);return t?document.querySelector(t):null},lt=t=>{t.dispatchEvent(new Event(st))},u=t=>!(!t||"object"!=typeof t)&&void 0!==(t=void 0!==t.jquery?t[0]:t).nodeType,ut=t=>u(t)?t.jquery?t[0]:t:"string"==typeof t&&0<t.length?document.querySelector(t):null,ht=t=>{if(!u(t)||0===t.getClientRects().length)return!1;var e="visible"===getComputedStyle(t).getPropertyValue("visibility"),n=t.closest("details:not([open])");if(n&&n!==t){t=t.closest("summary");if(t&&t.parentNode!==n)return!1;if(null===t)return!1}return e},dt=t=>!t||t.nodeType!==Node.ELEMENT_NODE||!!t.classList.contains("disabled")||(void 0!==t.disabled?t.disabled:t.hasAttribute("disabled")&&"false"!==t.getAttribute("disabled")),ft=t=>{var e;return document.documentElement.attachShadow?"function"==typeof t.getRootNode?(e=t.getRootNode())instanceof ShadowRoot?e:null:t instanceof ShadowRoot?t:t.parentNode?ft(t.parentNode):null:null},pt=()=>{},gt=t=>{t.offsetHeight},mt=()=>window.jQuery&&!document.body.hasAttribute("data-mdb-no-jquery")?window.jQuery:null,_t=[],h=()=>"rtl"===document.documentElement.dir;t=i=>{var t;t=()=>{const t=mt();if(t){const e=i.NAME,n=t.fn[e];t.fn[e]=i.jQueryInterface,t.fn[e].Constructor=i,t.fn[e].noConflict=()=>(t.fn[e]=n,i.jQueryInterface)}},"loading"===document.readyState?(_t.length||document.addEventListener("DOMContentLoaded",()=>{for(const t of _t)t()}),_t.push(t)):t()};function vt(n,i){if(!(2<arguments.length&&void 0!==arguments[2])||arguments[2]){var t=(t=>{if(!t)return 0;let{transitionDuration:e,transitionDelay:n}=window.getComputedStyle(t);var t=Number.parseFloat(e),i=Number.parseFloat(n);return t||i?(e=e.split(",")[0],n=n.split(",")[0],(Number.parseFloat(e)+Number.parseFloat(n))*rt):0})(i)+5;let e=!1;const o=t=>{t=t.target;t===i&&(e=!0,i.removeEventListener(st,o),d(n))};i.addEventListener(st,o),setTimeout(()=>{e||lt(i)},t)}else d(n)}const d=t=>{"function"==typeof t&&t()},bt=(t,e,n,i)=>{var o=t.length;let r=t.indexOf(e);return-1===r?!n&&i?t[o-1]:t[0]:(r+=n?1:-1,i&&(r=(r+o)%o),t[Math.max(0,Math.min(r,o-1))])},yt=/[^.]*(?=\..*)\.|.*/,wt=/\..*/,Et=/::\d+$/,xt={};let Ct=1;const At={mouseenter:"mouseover",mouseleave:"mouseout"},Tt=new Set(["click","dblclick","mouseup","mousedown","contextmenu","mousewheel","DOMMouseScroll","mouseover","mouseout","mousemove","selectstart","selectend","keydown","keypress","keyup","orientationchange","touchstart","touchmove","touchend","touchcancel","pointerdown","pointermove","pointerup","pointerleave","pointercancel","gesturestart","gesturechange","gestureend","focus","blur","change","reset","select","submit","focusin","focusout","load","unload","beforeunload","resize","move","DOMContentLoaded","readystatechange","error","abort","scroll"]);function Ot(t,e){return e&&"".concat(e,"::").concat(Ct++)||t.uidEvent||Ct++}function St(t){var e=Ot(t);return t.uidEvent=e,xt[e]=xt[e]||{},xt[e]}function Lt(t,e,n){let i=2<arguments.length&&void 0!==n?n:null;return Object.values(t).find(t=>t.callable===e&&t.delegationSelector===i)}function It(t,e,n){var i="string"==typeof e,e=!i&&e||n;let o=jt(t);return[i,e,o=Tt.has(o)?o:t]}function kt(i,o,r,s,a){if("string"==typeof o&&i){let[t,e,n]=It(o,r,s);o in At&&(e=(c=e,function(t){if(!t.relatedTarget||t.relatedTarget!==t.delegateTarget&&!t.delegateTarget.contains(t.relatedTarget))return c.call(this,t)}));var c,l,u,h,d,f,s=St(i),s=s[n]||(s[n]={}),p=Lt(s,e,t?r:null);p?p.oneOff=p.oneOff&&a:(p=Ot(e,o.replace(yt,"")),(o=t?(h=i,d=r,f=e,function e(n){var i=h.querySelectorAll(d);for(let t=n["target"];t&&t!==this;t=t.parentNode)for(const o of i)if(o===t)return Nt(n,{delegateTarget:t}),e.oneOff&&Pt.off(h,n.type,d,f),f.apply(t,[n])}):(l=i,u=e,function t(e){return Nt(e,{delegateTarget:l}),t.oneOff&&Pt.off(l,e.type,u),u.apply(l,[e])})).delegationSelector=t?r:null,o.callable=e,o.oneOff=a,s[o.uidEvent=p]=o,i.addEventListener(n,o,t))}}function Dt(t,e,n,i,o){i=Lt(e[n],i,o);i&&(t.removeEventListener(n,i,Boolean(o)),delete e[n][i.uidEvent])}function jt(t){return t=t.replace(wt,""),At[t]||t}const Pt={on(t,e,n,i){kt(t,e,n,i,!1)},one(t,e,n,i){kt(t,e,n,i,!0)},off(t,e,n,i){if("string"==typeof e&&t){var[i,o,r]=It(e,n,i),s=r!==e,a=St(t),c=a[r]||{},l=e.startsWith(".");if(void 0!==o)return Object.keys(c).length?void Dt(t,a,r,o,i?n:null):void 0;if(l)for(const _ of Object.keys(a)){u=g=p=f=d=h=void 0;var u,h=t,d=a,f=_,p=e.slice(1),g=d[f]||{};for(const v of Object.keys(g))v.includes(p)&&Dt(h,d,f,(u=g[v]).callable,u.delegationSelector)}for(const b of Object.keys(c)){var m=b.replace(Et,"");s&&!e.includes(m)||Dt(t,a,r,(m=c[b]).callable,m.delegationSelector)}}},trigger(t,e,n){if("string"!=typeof e||!t)return null;var i=mt();let o=null,r=!0,s=!0,a=!1;e!==jt(e)&&i&&(o=i.Event(e,n),i(t).trigger(o),r=!o.isPropagationStopped(),s=!o.isImmediatePropagationStopped(),a=o.isDefaultPrevented());i=Nt(i=new Event(e,{bubbles:r,cancelable:!0}),n);return a&&i.preventDefault(),s&&t.dispatchEvent(i),i.defaultPrevented&&o&&o.preventDefault(),i}};function Nt(e,t){for(const[n,i]of Object.entries(t||{}))try{e[n]=i}catch(t){Object.defineProperty(e,n,{configurable:!0,get(){return i}})}return e}var f=Pt;const Mt=new Map;var Ht=function(t,e,n){Mt.has(t)||Mt.set(t,new Map);t=Mt.get(t);t.has(e)||0===t.size?t.set(e,n):console.error("Bootstrap doesn't allow more than one instance per element. Bound instance: ".concat(Array.from(t.keys())[0],"."))},Rt=function(t,e){return Mt.has(t)&&Mt.get(t).get(e)||null},Bt=function(t,e){var n;Mt.has(t)&&((n=Mt.get(t)).delete(e),0===n.size)&&Mt.delete(t)};function Wt(e){if("true"===e)return!0;if("false"===e)return!1;if(e===Number(e).toString())return Number(e);if(""===e||"null"===e)return null;if("string"!=typeof e)return e;try{return JSON.parse(decodeURIComponent(e))}catch(t){return e}}function Ft(t){return t.replace(/[A-Z]/g,t=>"-".concat(t.toLowerCase()))}var p={setDataAttribute(t,e,n){t.setAttribute("data-mdb-".concat(Ft(e)),n)},removeDataAttribute(t,e){t.removeAttribute("data-mdb-".concat(Ft(e)))},getDataAttributes(e){if(!e)return{};var n={};for(const i of Object.keys(e.dataset).filter(t=>t.startsWith("mdb")&&!t.startsWith("mdbConfig"))){let t=i.replace(/^mdb/,"");n[t=t.charAt(0).toLowerCase()+t.slice(1,t.length)]=Wt(e.dataset[i])}return n},getDataAttribute(t,e){return Wt(t.getAttribute("data-mdb-".concat(Ft(e))))}};var g=class{static get Default(){return{}}static get DefaultType(){return{}}static get NAME(){throw new Error('You have to implement the static method "NAME", for each component!')}_getConfig(t){return t=this._mergeConfigObj(t),t=this._configAfterMerge(t),this._typeCheckConfig(t),t}_configAfterMerge(t){return t}_mergeConfigObj(t,e){var n=u(e)?p.getDataAttribute(e,"config"):{};return{...this.constructor.Default,..."object"==typeof n?n:{},...u(e)?p.getDataAttributes(e):{},..."object"==typeof t?t:{}}}_typeCheckConfig(t){var e=1<arguments.length&&void 0!==arguments[1]?arguments[1]:this.constructor.DefaultType;for(const o of Object.keys(e)){var n=e[o],i=t[o],i=u(i)?"element":null==(i=i)?"".concat(i):Object.prototype.toString.call(i).match(/\s([a-z]+)/i)[1].toLowerCase();if(!new RegExp(n).test(i))throw new TypeError("".concat(this.constructor.NAME.toUpperCase(),': Option "').concat(o,'" provided type "').concat(i,'" but expected type "').concat(n,'".'))}}};var m=class extends g{constructor(t,e){super(),(t=ut(t))&&(this._element=t,this._config=this._getConfig(e),Ht(this._element,this.constructor.DATA_KEY,this))}dispose(){Bt(this._element,this.constructor.DATA_KEY),f.off(this._element,this.constructor.EVENT_KEY);for(const t of Object.getOwnPropertyNames(this))this[t]=null}_queueCallback(t,e){var n=!(2<arguments.length&&void 0!==arguments[2])||arguments[2];vt(t,e,n)}_getConfig(t){return t=this._mergeConfigObj(t,this._element),t=this._configAfterMerge(t),this._typeCheckConfig(t),t}static getInstance(t){return Rt(ut(t),this.DATA_KEY)}static getOrCreateInstance(t){var e=1<arguments.length&&void 0!==arguments[1]?arguments[1]:{};return this.getInstance(t)||new this(t,"object"==typeof e?e:null)}static get VERSION(){return"5.2.3"}static get DATA_KEY(){return"bs.".concat(this.NAME)}static get EVENT_KEY(){return".".concat(this.DATA_KEY)}static eventName(t){return"".concat(t).concat(this.EVENT_KEY)}};var _=".".concat("bs.button");const qt='[data-mdb-toggle="button"]';_="click".concat(_).concat(".data-api");class zt extends m{static get NAME(){return"button"}toggle(){this._element.setAttribute("aria-pressed",this._element.classList.toggle("active"))}static jQueryInterface(e){return this.each(function(){var t=zt.getOrCreateInstance(this);"toggle"===e&&t[e]()})}}f.on(document,_,qt,t=>{t.preventDefault();t=t.target.closest(qt);zt.getOrCreateInstance(t).toggle()}),t(zt);_=zt;const Qt="button",Vt="mdb.".concat(Qt);var v=".".concat(Vt);const Ut="click".concat(v),Yt="transitionend",Xt="mouseenter",Kt="mouseleave",$t="hide".concat(v),Gt="hidden".concat(v),Zt="show".concat(v),Jt="shown".concat(v),te="fixed-action-btn";class b extends _{constructor(t){super(t),this._fn={},this._element&&(r.setData(this._element,Vt,this),this._init())}static get NAME(){return Qt}static jQueryInterface(n,i){return this.each(function(){let t=r.getData(this,Vt);var e="object"==typeof n&&n;if((t||!/dispose/.test(n))&&(t=t||new b(this,e),"string"==typeof n)){if(void 0===t[n])throw new TypeError('No method named "'.concat(n,'"'));t[n](i)}})}get _actionButton(){return a.findOne(".fixed-action-btn:not(.smooth-scroll) > .btn-floating",this._element)}get _buttonListElements(){return a.find("ul .btn",this._element)}get _buttonList(){return a.findOne("ul",this._element)}get _isTouchDevice(){return"ontouchstart"in document.documentElement}show(){c.hasClass(this._element,te)&&(s.off(this._buttonList,Yt),s.trigger(this._element,Zt),this._bindListOpenTransitionEnd(),c.addStyle(this._element,{height:"".concat(this._fullContainerHeight,"px")}),this._toggleVisibility(!0))}hide(){c.hasClass(this._element,te)&&(s.off(this._buttonList,Yt),s.trigger(this._element,$t),this._bindListHideTransitionEnd(),this._toggleVisibility(!1))}dispose(){c.hasClass(this._element,te)&&(s.off(this._actionButton,Ut),this._actionButton.removeEventListener(Xt,this._fn.mouseenter),this._element.removeEventListener(Kt,this._fn.mouseleave)),super.dispose()}_init(){c.hasClass(this._element,te)&&(this._saveInitialHeights(),this._setInitialStyles(),this._bindInitialEvents())}_bindMouseEnter(){this._actionButton.addEventListener(Xt,this._fn.mouseenter=()=>{this._isTouchDevice||this.show()})}_bindMouseLeave(){this._element.addEventListener(Kt,this._fn.mouseleave=()=>{this.hide()})}_bindClick(){s.on(this._actionButton,Ut,()=>{c.hasClass(this._element,"active")?this.hide():this.show()})}_bindListHideTransitionEnd(){s.on(this._buttonList,Yt,t=>{"transform"===t.propertyName&&(s.off(this._buttonList,Yt),this._element.style.height="".concat(this._initialContainerHeight,"px"),s.trigger(this._element,Gt))})}_bindListOpenTransitionEnd(){s.on(this._buttonList,Yt,t=>{"transform"===t.propertyName&&(s.off(this._buttonList,Yt),s.trigger(this._element,Jt))})}_toggleVisibility(t){const e=t?"addClass":"removeClass";t=t?"translate(0)":"translateY(".concat(this._fullContainerHeight,"px)");c.addStyle(this._buttonList,{transform:t}),this._buttonListElements&&this._buttonListElements.forEach(t=>c[e](t,"shown")),c[e](this._element,"active")}_getHeight(t){t=window.getComputedStyle(t);return parseFloat(t.getPropertyValue("height"))}_saveInitialHeights(){this._initialContainerHeight=this._getHeight(this._element),this._initialListHeight=this._getHeight(this._buttonList),this._fullContainerHeight=this._initialContainerHeight+this._initialListHeight}_bindInitialEvents(){this._bindClick(),this._bindMouseEnter(),this._bindMouseLeave()}_setInitialStyles(){this._buttonList.style.marginBottom="".concat(this._initialContainerHeight,"px"),this._buttonList.style.transform="translateY(".concat(this._fullContainerHeight,"px)"),this._element.style.height="".concat(this._initialContainerHeight,"px")}}a.find(".fixed-action-btn").forEach(t=>{let e=b.getInstance(t);return e=e||new b(t)}),a.find('[data-mdb-toggle="button"]').forEach(t=>{let e=b.getInstance(t);return e=e||new b(t)}),o(()=>{const t=i();if(t){const e=t.fn[Qt];t.fn[Qt]=b.jQueryInterface,t.fn[Qt].Constructor=b,t.fn[Qt].noConflict=()=>(t.fn[Qt]=e,b.jQueryInterface)}});var ee=b,y={find(t){var e=1<arguments.length&&void 0!==arguments[1]?arguments[1]:document.documentElement;return[].concat(...Element.prototype.querySelectorAll.call(e,t))},findOne(t){var e=1<arguments.length&&void 0!==arguments[1]?arguments[1]:document.documentElement;return Element.prototype.querySelector.call(e,t)},children(t,e){return[].concat(...t.children).filter(t=>t.matches(e))},parents(t,e){var n=[];let i=t.parentNode.closest(e);for(;i;)n.push(i),i=i.parentNode.closest(e);return n},prev(t,e){let n=t.previousElementSibling;for(;n;){if(n.matches(e))return[n];n=n.previousElementSibling}return[]},next(t,e){let n=t.nextElementSibling;for(;n;){if(n.matches(e))return[n];n=n.nextElementSibling}return[]},focusableChildren(t){var e=["a","button","input","textarea","select","details","[tabindex]",'[contenteditable="true"]'].map(t=>"".concat(t,':not([tabindex^="-"])')).join(",");return this.find(e,t).filter(t=>!dt(t)&&ht(t))}};const ne=".fixed-top, .fixed-bottom, .is-fixed, .sticky-top",ie=".sticky-top",oe="padding-right",re="margin-right";var se=class{constructor(){this._element=document.body}getWidth(){var t=document.documentElement.clientWidth;return Math.abs(window.innerWidth-t)}hide(){const e=this.getWidth();this._disableOverFlow(),this._setElementAttributes(this._element,oe,t=>t+e),this._setElementAttributes(ne,oe,t=>t+e),this._setElementAttributes(ie,re,t=>t-e)}reset(){this._resetElementAttributes(this._element,"overflow"),this._resetElementAttributes(this._element,oe),this._resetElementAttributes(ne,oe),this._resetElementAttributes(ie,re)}isOverflowing(){return 0<this.getWidth()}_disableOverFlow(){this._saveInitialAttribute(this._element,"overflow"),this._element.style.overflow="hidden"}_setElementAttributes(t,n,i){const o=this.getWidth();this._applyManipulationCallback(t,t=>{var e;t!==this._element&&window.innerWidth>t.clientWidth+o||(this._saveInitialAttribute(t,n),e=window.getComputedStyle(t).getPropertyValue(n),t.style.setProperty(n,"".concat(i(Number.parseFloat(e)),"px")))})}_saveInitialAttribute(t,e){var n=t.style.getPropertyValue(e);n&&p.setDataAttribute(t,e,n)}_resetElementAttributes(t,n){this._applyManipulationCallback(t,t=>{var e=p.getDataAttribute(t,n);null===e?t.style.removeProperty(n):(p.removeDataAttribute(t,n),t.style.setProperty(n,e))})}_applyManipulationCallback(t,e){if(u(t))e(t);else for(const n of y.find(t,this._element))e(n)}};const ae="backdrop",ce="mousedown.bs.".concat(ae),le={className:"modal-backdrop",clickCallback:null,isAnimated:!1,isVisible:!0,rootElement:"body"},ue={className:"string",clickCallback:"(function|null)",isAnimated:"boolean",isVisible:"boolean",rootElement:"(element|string)"};var he=class extends g{constructor(t){super(),this._config=this._getConfig(t),this._isAppended=!1,this._element=null}static get Default(){return le}static get DefaultType(){return ue}static get NAME(){return ae}show(t){var e;this._config.isVisible?(this._append(),e=this._getElement(),this._config.isAnimated&&gt(e),e.classList.add("show"),this._emulateAnimation(()=>{d(t)})):d(t)}hide(t){this._config.isVisible?(this._getElement().classList.remove("show"),this._emulateAnimation(()=>{this.dispose(),d(t)})):d(t)}dispose(){this._isAppended&&(f.off(this._element,ce),this._element.remove(),this._isAppended=!1)}_getElement(){var t;return this._element||((t=document.createElement("div")).className=this._config.className,this._config.isAnimated&&t.classList.add("fade"),this._element=t),this._element}_configAfterMerge(t){return t.rootElement=ut(t.rootElement),t}_append(){var t;this._isAppended||(t=this._getElement(),this._config.rootElement.append(t),f.on(t,ce,()=>{d(this._config.clickCallback)}),this._isAppended=!0)}_emulateAnimation(t){vt(t,this._getElement(),this._config.isAnimated)}};const de=".".concat("bs.focustrap"),fe="focusin".concat(de),pe="keydown.tab".concat(de),ge="backward",me={autofocus:!0,trapElement:null},_e={autofocus:"boolean",trapElement:"element"};function ve(e){let n=1<arguments.length&&void 0!==arguments[1]?arguments[1]:"hide";var t="click.dismiss".concat(e.EVENT_KEY);const i=e.NAME;f.on(document,t,'[data-mdb-dismiss="'.concat(i,'"]'),function(t){["A","AREA"].includes(this.tagName)&&t.preventDefault(),dt(this)||(t=l(this)||this.closest(".".concat(i)),e.getOrCreateInstance(t)[n]())})}var be=class extends g{constructor(t){super(),this._config=this._getConfig(t),this._isActive=!1,this._lastTabNavDirection=null}static get Default(){return me}static get DefaultType(){return _e}static get NAME(){return"focustrap"}activate(){this._isActive||(this._config.autofocus&&this._config.trapElement.focus(),f.off(document,de),f.on(document,fe,t=>this._handleFocusin(t)),f.on(document,pe,t=>this._handleKeydown(t)),this._isActive=!0)}deactivate(){this._isActive&&(this._isActive=!1,f.off(document,de))}_handleFocusin(t){var e=this._config["trapElement"];t.target===document||t.target===e||e.contains(t.target)||(0===(t=y.focusableChildren(e)).length?e:this._lastTabNavDirection===ge?t[t.length-1]:t[0]).focus()}_handleKeydown(t){"Tab"===t.key&&(this._lastTabNavDirection=t.shiftKey?ge:"forward")}};var v=".".concat("bs.offcanvas"),_=".data-api",w="load".concat(v).concat(_);const ye="showing",we=".offcanvas.show",Ee="show".concat(v),xe="shown".concat(v),Ce="hide".concat(v),Ae="hidePrevented".concat(v),Te="hidden".concat(v);var E="resize".concat(v),_="click".concat(v).concat(_);const Oe="keydown.dismiss".concat(v);const Se={backdrop:!0,keyboard:!0,scroll:!1},Le={backdrop:"(boolean|string)",keyboard:"boolean",scroll:"boolean"};class Ie extends m{constructor(t,e){super(t,e),this._isShown=!1,this._backdrop=this._initializeBackDrop(),this._focustrap=this._initializeFocusTrap(),this._addEventListeners()}static get Default(){return Se}static get DefaultType(){return Le}static get NAME(){return"offcanvas"}toggle(t){return this._isShown?this.hide():this.show(t)}show(t){this._isShown||f.trigger(this._element,Ee,{relatedTarget:t}).defaultPrevented||(this._isShown=!0,this._backdrop.show(),this._config.scroll||(new se).hide(),this._element.setAttribute("aria-modal",!0),this._element.setAttribute("role","dialog"),this._element.classList.add(ye),this._queueCallback(()=>{this._config.scroll&&!this._config.backdrop||this._focustrap.activate(),this._element.classList.add("show"),this._element.classList.remove(ye),f.trigger(this._element,xe,{relatedTarget:t})},this._element,!0))}hide(){this._isShown&&!f.trigger(this._element,Ce).defaultPrevented&&(this._focustrap.deactivate(),this._element.blur(),this._isShown=!1,this._element.classList.add("hiding"),this._backdrop.hide(),this._queueCallback(()=>{this._element.classList.remove("show","hiding"),this._element.removeAttribute("aria-modal"),this._element.removeAttribute("role"),this._config.scroll||(new se).reset(),f.trigger(this._element,Te)},this._element,!0))}dispose(){this._backdrop.dispose(),this._focustrap.deactivate(),super.dispose()}_initializeBackDrop(){var t=Boolean(this._config.backdrop);return new he({className:"offcanvas-backdrop",isVisible:t,isAnimated:!0,rootElement:this._element.parentNode,clickCallback:t?()=>{"static"===this._config.backdrop?f.trigger(this._element,Ae):this.hide()}:null})}_initializeFocusTrap(){return new be({trapElement:this._element})}_addEventListeners(){f.on(this._element,Oe,t=>{"Escape"===t.key&&(this._config.keyboard?this.hide():f.trigger(this._element,Ae))})}static jQueryInterface(e){return this.each(function(){var t=Ie.getOrCreateInstance(this,e);if("string"==typeof e){if(void 0===t[e]||e.startsWith("_")||"constructor"===e)throw new TypeError('No method named "'.concat(e,'"'));t[e](this)}})}}f.on(document,_,'[data-mdb-toggle="offcanvas"]',function(t){var e=l(this);["A","AREA"].includes(this.tagName)&&t.preventDefault(),dt(this)||(f.one(e,Te,()=>{ht(this)&&this.focus()}),(t=y.findOne(we))&&t!==e&&Ie.getInstance(t).hide(),Ie.getOrCreateInstance(e).toggle(this))}),f.on(window,w,()=>{for(const t of y.find(we))Ie.getOrCreateInstance(t).show()}),f.on(window,E,()=>{for(const t of y.find("[aria-modal][class*=show][class*=offcanvas-]"))"fixed"!==getComputedStyle(t).position&&Ie.getOrCreateInstance(t).hide()}),ve(Ie),t(Ie);var ke=Ie;v=".".concat("bs.alert");const De="close".concat(v),je="closed".concat(v);class Pe extends m{static get NAME(){return"alert"}close(){var t;f.trigger(this._element,De).defaultPrevented||(this._element.classList.remove("show"),t=this._element.classList.contains("fade"),this._queueCallback(()=>this._destroyElement(),this._element,t))}_destroyElement(){this._element.remove(),f.trigger(this._element,je),this.dispose()}static jQueryInterface(e){return this.each(function(){var t=Pe.getOrCreateInstance(this);if("string"==typeof e){if(void 0===t[e]||e.startsWith("_")||"constructor"===e)throw new TypeError('No method named "'.concat(e,'"'));t[e](this)}})}}ve(Pe,"close"),t(Pe);_=Pe;const Ne="alert";w="mdb.".concat(Ne),E=".".concat(w);const Me="close.bs.alert",He="closed.bs.alert",Re="close".concat(E),Be="closed".concat(E);class We extends _{constructor(t){super(t,1<arguments.length&&void 0!==arguments[1]?arguments[1]:{}),this._init()}dispose(){s.off(this._element,Me),s.off(this._element,He),super.dispose()}static get NAME(){return Ne}_init(){this._bindCloseEvent(),this._bindClosedEvent()}_bindCloseEvent(){s.on(this._element,Me,()=>{s.trigger(this._element,Re)})}_bindClosedEvent(){s.on(this._element,He,()=>{s.trigger(this._element,Be)})}}a.find(".alert").forEach(t=>{var e=We.getInstance(t);e||new We(t)}),o(()=>{const t=i();if(t){const e=t.fn[Ne];t.fn[Ne]=We.jQueryInterface,t.fn[Ne].Constructor=We,t.fn[Ne].noConflict=()=>(t.fn[Ne]=e,We.jQueryInterface)}});var Fe=We;const qe=".bs.swipe",ze="touchstart".concat(qe),Qe="touchmove".concat(qe),Ve="touchend".concat(qe),Ue="pointerdown".concat(qe),Ye="pointerup".concat(qe),Xe={endCallback:null,leftCallback:null,rightCallback:null},Ke={endCallback:"(function|null)",leftCallback:"(function|null)",rightCallback:"(function|null)"};class $e extends g{constructor(t,e){super(),(this._element=t)&&$e.isSupported()&&(this._config=this._getConfig(e),this._deltaX=0,this._supportPointerEvents=Boolean(window.PointerEvent),this._initEvents())}static get Default(){return Xe}static get DefaultType(){return Ke}static get NAME(){return"swipe"}dispose(){f.off(this._element,qe)}_start(t){this._supportPointerEvents?this._eventIsPointerPenTouch(t)&&(this._deltaX=t.clientX):this._deltaX=t.touches[0].clientX}_end(t){this._eventIsPointerPenTouch(t)&&(this._deltaX=t.clientX-this._deltaX),this._handleSwipe(),d(this._config.endCallback)}_move(t){this._deltaX=t.touches&&1<t.touches.length?0:t.touches[0].clientX-this._deltaX}_handleSwipe(){var t=Math.abs(this._deltaX);t<=40||(t=t/this._deltaX,this._deltaX=0,t&&d(0<t?this._config.rightCallback:this._config.leftCallback))}_initEvents(){this._supportPointerEvents?(f.on(this._element,Ue,t=>this._start(t)),f.on(this._element,Ye,t=>this._end(t)),this._element.classList.add("pointer-event")):(f.on(this._element,ze,t=>this._start(t)),f.on(this._element,Qe,t=>this._move(t)),f.on(this._element,Ve,t=>this._end(t)))}_eventIsPointerPenTouch(t){return this._supportPointerEvents&&("pen"===t.pointerType||"touch"===t.pointerType)}static isSupported(){return"ontouchstart"in document.documentElement||0<navigator.maxTouchPoints}}var Ge=$e;v=".".concat("bs.carousel"),w=".data-api";const Ze="next",Je="prev",tn="left",en="right",nn="slide".concat(v),on="slid".concat(v),rn="keydown".concat(v),sn="mouseenter".concat(v),an="mouseleave".concat(v),cn="dragstart".concat(v);E="load".concat(v).concat(w),_="click".concat(v).concat(w);const ln="carousel",un="active",hn=".active",dn=".carousel-item";hn,dn;const fn={ArrowLeft:en,ArrowRight:tn},pn={interval:5e3,keyboard:!0,pause:"hover",ride:!1,touch:!0,wrap:!0},gn={interval:"(number|boolean)",keyboard:"boolean",pause:"(string|boolean)",ride:"(boolean|string)",touch:"boolean",wrap:"boolean"};class mn extends m{constructor(t,e){super(t,e),this._interval=null,this._activeElement=null,this._isSliding=!1,this.touchTimeout=null,this._swipeHelper=null,this._indicatorsElement=y.findOne(".carousel-indicators",this._element),this._addEventListeners(),this._config.ride===ln&&this.cycle()}static get Default(){return pn}static get DefaultType(){return gn}static get NAME(){return"carousel"}next(){this._slide(Ze)}nextWhenVisible(){!document.hidden&&ht(this._element)&&this.next()}prev(){this._slide(Je)}pause(){this._isSliding&&lt(this._element),this._clearInterval()}cycle(){this._clearInterval(),this._updateInterval(),this._interval=setInterval(()=>this.nextWhenVisible(),this._config.interval)}_maybeEnableCycle(){this._config.ride&&(this._isSliding?f.one(this._element,on,()=>this.cycle()):this.cycle())}to(t){var e,n=this._getItems();t>n.length-1||t<0||(this._isSliding?f.one(this._element,on,()=>this.to(t)):(e=this._getItemIndex(this._getActive()))!==t&&(e=e<t?Ze:Je,this._slide(e,n[t])))}dispose(){this._swipeHelper&&this._swipeHelper.dispose(),super.dispose()}_configAfterMerge(t){return t.defaultInterval=t.interval,t}_addEventListeners(){this._config.keyboard&&f.on(this._element,rn,t=>this._keydown(t)),"hover"===this._config.pause&&(f.on(this._element,sn,()=>this.pause()),f.on(this._element,an,()=>this._maybeEnableCycle())),this._config.touch&&Ge.isSupported()&&this._addTouchEventListeners()}_addTouchEventListeners(){for(const t of y.find(".carousel-item img",this._element))f.on(t,cn,t=>t.preventDefault());this._swipeHelper=new Ge(this._element,{leftCallback:()=>this._slide(this._directionToOrder(tn)),rightCallback:()=>this._slide(this._directionToOrder(en)),endCallback:()=>{"hover"===this._config.pause&&(this.pause(),this.touchTimeout&&clearTimeout(this.touchTimeout),this.touchTimeout=setTimeout(()=>this._maybeEnableCycle(),500+this._config.interval))}})}_keydown(t){var e;/input|textarea/i.test(t.target.tagName)||(e=fn[t.key])&&(t.preventDefault(),this._slide(this._directionToOrder(e)))}_getItemIndex(t){return this._getItems().indexOf(t)}_setActiveIndicatorElement(t){var e;this._indicatorsElement&&((e=y.findOne(hn,this._indicatorsElement)).classList.remove(un),e.removeAttribute("aria-current"),e=y.findOne('[data-mdb-slide-to="'.concat(t,'"]'),this._indicatorsElement))&&(e.classList.add(un),e.setAttribute("aria-current","true"))}_updateInterval(){var t=this._activeElement||this._getActive();t&&(t=Number.parseInt(t.getAttribute("data-mdb-interval"),10),this._config.interval=t||this._config.defaultInterval)}_slide(e){var t=1<arguments.length&&void 0!==arguments[1]?arguments[1]:null;if(!this._isSliding){const i=this._getActive();var n=e===Ze;const o=t||bt(this._getItems(),i,n,this._config.wrap);if(o!==i){const r=this._getItemIndex(o),s=t=>f.trigger(this._element,t,{relatedTarget:o,direction:this._orderToDirection(e),from:this._getItemIndex(i),to:r});t=s(nn);if(!t.defaultPrevented&&i&&o){t=Boolean(this._interval);this.pause(),this._isSliding=!0,this._setActiveIndicatorElement(r),this._activeElement=o;const a=n?"carousel-item-start":"carousel-item-end",c=n?"carousel-item-next":"carousel-item-prev";o.classList.add(c),gt(o),i.classList.add(a),o.classList.add(a);this._queueCallback(()=>{o.classList.remove(a,c),o.classList.add(un),i.classList.remove(un,c,a),this._isSliding=!1,s(on)},i,this._isAnimated()),t&&this.cycle()}}}}_isAnimated(){return this._element.classList.contains("slide")}_getActive(){return y.findOne(".active.carousel-item",this._element)}_getItems(){return y.find(dn,this._element)}_clearInterval(){this._interval&&(clearInterval(this._interval),this._interval=null)}_directionToOrder(t){return h()?t===tn?Je:Ze:t===tn?Ze:Je}_orderToDirection(t){return h()?t===Je?tn:en:t===Je?en:tn}static jQueryInterface(e){return this.each(function(){var t=mn.getOrCreateInstance(this,e);if("number"==typeof e)t.to(e);else if("string"==typeof e){if(void 0===t[e]||e.startsWith("_")||"constructor"===e)throw new TypeError('No method named "'.concat(e,'"'));t[e]()}})}}f.on(document,_,"[data-mdb-slide], [data-mdb-slide-to]",function(t){var e=l(this);e&&e.classList.contains(ln)&&(t.preventDefault(),t=mn.getOrCreateInstance(e),(e=this.getAttribute("data-mdb-slide-to"))?t.to(e):"next"===p.getDataAttribute(this,"slide")?t.next():t.prev(),t._maybeEnableCycle())}),f.on(window,E,()=>{for(const t of y.find('[data-mdb-ride="carousel"]'))mn.getOrCreateInstance(t)}),t(mn);v=mn;const _n="carousel";w="mdb.".concat(_n),_=".".concat(w);const vn="slide.bs.carousel",bn="slid.bs.carousel",yn="slide".concat(_),wn="slid".concat(_);class En extends v{constructor(t,e){super(t,e),this._init()}dispose(){s.off(this._element,vn),s.off(this._element,bn),super.dispose()}static get NAME(){return _n}_init(){this._bindSlideEvent(),this._bindSlidEvent()}_bindSlideEvent(){s.on(this._element,vn,t=>{s.trigger(this._element,yn,{relatedTarget:t.relatedTarget,direction:t.direction,from:t.from,to:t.to})})}_bindSlidEvent(){s.on(this._element,bn,t=>{s.trigger(this._element,wn,{relatedTarget:t.relatedTarget,direction:t.direction,from:t.from,to:t.to})})}}a.find('[data-mdb-ride="carousel"]').forEach(t=>{var e=En.getInstance(t);e||new En(t,c.getDataAttributes(t))}),o(()=>{const t=i();if(t){const e=t.fn[_n];t.fn[_n]=En.jQueryInterface,t.fn[_n].Constructor=En,t.fn[_n].noConflict=()=>(t.fn[_n]=e,En.jQueryInterface)}});var xn=En;const x=".".concat("bs.modal");const Cn="hide".concat(x),An="hidePrevented".concat(x),Tn="hidden".concat(x),On="show".concat(x),Sn="shown".concat(x),Ln="resize".concat(x),In="click.dismiss".concat(x),kn="mousedown.dismiss".concat(x),Dn="keydown.dismiss".concat(x);E="click".concat(x).concat(".data-api");const jn="modal-open",Pn="modal-static";const Nn={backdrop:!0,focus:!0,keyboard:!0},Mn={backdrop:"(boolean|string)",focus:"boolean",keyboard:"boolean"};class Hn extends m{constructor(t,e){super(t,e),this._dialog=y.findOne(".modal-dialog",this._element),this._backdrop=this._initializeBackDrop(),this._focustrap=this._initializeFocusTrap(),this._isShown=!1,this._isTransitioning=!1,this._scrollBar=new se,this._addEventListeners()}static get Default(){return Nn}static get DefaultType(){return Mn}static get NAME(){return"modal"}toggle(t){return this._isShown?this.hide():this.show(t)}show(t){this._isShown||this._isTransitioning||f.trigger(this._element,On,{relatedTarget:t}).defaultPrevented||(this._isShown=!0,this._isTransitioning=!0,this._scrollBar.hide(),document.body.classList.add(jn),this._adjustDialog(),this._backdrop.show(()=>this._showElement(t)))}hide(){!this._isShown||this._isTransitioning||f.trigger(this._element,Cn).defaultPrevented||(this._isShown=!1,this._isTransitioning=!0,this._focustrap.deactivate(),this._element.classList.remove("show"),this._queueCallback(()=>this._hideModal(),this._element,this._isAnimated()))}dispose(){for(const t of[window,this._dialog])f.off(t,x);this._backdrop.dispose(),this._focustrap.deactivate(),super.dispose()}handleUpdate(){this._adjustDialog()}_initializeBackDrop(){return new he({isVisible:Boolean(this._config.backdrop)&&Boolean(!this._config.modalNonInvasive),isAnimated:this._isAnimated()})}_initializeFocusTrap(){return new be({trapElement:this._element})}_showElement(t){document.body.contains(this._element)||document.body.append(this._element),this._element.style.display="block",this._element.removeAttribute("aria-hidden"),this._element.setAttribute("aria-modal",!0),this._element.setAttribute("role","dialog"),this._element.scrollTop=0;var e=y.findOne(".modal-body",this._dialog);e&&(e.scrollTop=0),gt(this._element),this._element.classList.add("show");this._queueCallback(()=>{this._config.focus&&this._focustrap.activate(),this._isTransitioning=!1,f.trigger(this._element,Sn,{relatedTarget:t})},this._dialog,this._isAnimated())}_addEventListeners(){f.on(this._element,Dn,t=>{"Escape"===t.key&&(this._config.keyboard?(t.preventDefault(),this.hide()):this._triggerBackdropTransition())}),f.on(window,Ln,()=>{this._isShown&&!this._isTransitioning&&this._adjustDialog()}),f.on(this._element,kn,e=>{f.one(this._element,In,t=>{this._element===e.target&&this._element===t.target&&("static"===this._config.backdrop?this._triggerBackdropTransition():this._config.backdrop&&this.hide())})})}_hideModal(){this._element.style.display="none",this._element.setAttribute("aria-hidden",!0),this._element.removeAttribute("aria-modal"),this._element.removeAttribute("role"),this._isTransitioning=!1,this._backdrop.hide(()=>{document.body.classList.remove(jn),this._resetAdjustments(),this._scrollBar.reset(),f.trigger(this._element,Tn)})}_isAnimated(){return this._element.classList.contains("fade")}_triggerBackdropTransition(){var t=f.trigger(this._element,An);if(!t.defaultPrevented){t=this._element.scrollHeight>document.documentElement.clientHeight;const e=this._element.style.overflowY;"hidden"===e||this._element.classList.contains(Pn)||(t||(this._element.style.overflowY="hidden"),this._element.classList.add(Pn),this._queueCallback(()=>{this._element.classList.remove(Pn),this._queueCallback(()=>{this._element.style.overflowY=e},this._dialog)},this._dialog),this._element.focus())}}_adjustDialog(){var t,e=this._element.scrollHeight>document.documentElement.clientHeight,n=this._scrollBar.getWidth(),i=0<n;i&&!e&&(t=h()?"paddingLeft":"paddingRight",this._element.style[t]="".concat(n,"px")),!i&&e&&(t=h()?"paddingRight":"paddingLeft",this._element.style[t]="".concat(n,"px"))}_resetAdjustments(){this._element.style.paddingLeft="",this._element.style.paddingRight=""}static jQueryInterface(e,n){return this.each(function(){var t=Hn.getOrCreateInstance(this,e);if("string"==typeof e){if(void 0===t[e])throw new TypeError('No method named "'.concat(e,'"'));t[e](n)}})}}f.on(document,E,'[data-mdb-toggle="modal"]',function(t){const e=l(this);["A","AREA"].includes(this.tagName)&&t.preventDefault(),f.one(e,On,t=>{t.defaultPrevented||f.one(e,Tn,()=>{ht(this)&&this.focus()})}),y.find(".modal.show").forEach(t=>{t.classList.contains("modal-non-invasive-show")||Hn.getInstance(t).hide()}),Hn.getOrCreateInstance(e).toggle(this)}),ve(Hn),t(Hn);w=Hn;const Rn="modal";_="mdb.".concat(Rn),v=".".concat(_);const Bn="hide.bs.modal",Wn="hidePrevented.bs.modal",Fn="hidden.bs.modal",qn="show.bs.modal",zn="shown.bs.modal",Qn="hide".concat(v),Vn="hidePrevented".concat(v),Un="hidden".concat(v),Yn="show".concat(v),Xn="shown".concat(v);class Kn extends w{constructor(t,e){super(t,e),this._init()}dispose(){s.off(this._element,qn),s.off(this._element,zn),s.off(this._element,Bn),s.off(this._element,Fn),s.off(this._element,Wn),super.dispose()}static get NAME(){return Rn}_init(){this._bindShowEvent(),this._bindShownEvent(),this._bindHideEvent(),this._bindHiddenEvent(),this._bindHidePreventedEvent()}_bindShowEvent(){s.on(this._element,qn,t=>{s.trigger(this._element,Yn,{relatedTarget:t.relatedTarget})})}_bindShownEvent(){s.on(this._element,zn,t=>{s.trigger(this._element,Xn,{relatedTarget:t.relatedTarget})})}_bindHideEvent(){s.on(this._element,Bn,()=>{s.trigger(this._element,Qn)})}_bindHiddenEvent(){s.on(this._element,Fn,()=>{s.trigger(this._element,Un)})}_bindHidePreventedEvent(){s.on(this._element,Wn,()=>{s.trigger(this._element,Vn)})}}a.find('[data-mdb-toggle="modal"]').forEach(t=>{var t=(t=>{t=H(t);return t&&document.querySelector(t)?t:null})(t),t=a.findOne(t),e=Kn.getInstance(t);e||new Kn(t)}),o(()=>{const t=i();if(t){const e=t.fn[Rn];t.fn[Rn]=Kn.jQueryInterface,t.fn[Rn].Constructor=Kn,t.fn[Rn].noConflict=()=>(t.fn[Rn]=e,Kn.jQueryInterface)}});var $n=Kn,O="top",S="bottom",L="right",I="left",Gn="auto",Zn=[O,S,L,I],Jn="start",ti="end",ei="clippingParents",ni="viewport",ii="popper",oi="reference",ri=Zn.reduce(function(t,e){return t.concat([e+"-"+Jn,e+"-"+ti])},[]),si=[].concat(Zn,[Gn]).reduce(function(t,e){return t.concat([e,e+"-"+Jn,e+"-"+ti])},[]),ai="beforeRead",ci="read",li="afterRead",ui="beforeMain",hi="main",di="afterMain",fi="beforeWrite",pi="write",gi="afterWrite",mi=[ai,ci,li,ui,hi,di,fi,pi,gi];function C(t){return t?(t.nodeName||"").toLowerCase():null}function A(t){var e;return null==t?window:"[object Window]"!==t.toString()?(e=t.ownerDocument)&&e.defaultView||window:t}function _i(t){return t instanceof A(t).Element||t instanceof Element}function T(t){return t instanceof A(t).HTMLElement||t instanceof HTMLElement}function vi(t){return"undefined"!=typeof ShadowRoot&&(t instanceof A(t).ShadowRoot||t instanceof ShadowRoot)}var bi={name:"applyStyles",enabled:!0,phase:"write",fn:function(t){var o=t.state;Object.keys(o.elements).forEach(function(t){var e=o.styles[t]||{},n=o.attributes[t]||{},i=o.elements[t];T(i)&&C(i)&&(Object.assign(i.style,e),Object.keys(n).forEach(function(t){var e=n[t];!1===e?i.removeAttribute(t):i.setAttribute(t,!0===e?"":e)}))})},effect:function(t){var i=t.state,o={popper:{position:i.options.strategy,left:"0",top:"0",margin:"0"},arrow:{position:"absolute"},reference:{}};return Object.assign(i.elements.popper.style,o.popper),i.styles=o,i.elements.arrow&&Object.assign(i.elements.arrow.style,o.arrow),function(){Object.keys(i.elements).forEach(function(t){var e=i.elements[t],n=i.attributes[t]||{},t=Object.keys((i.styles.hasOwnProperty(t)?i.styles:o)[t]).reduce(function(t,e){return t[e]="",t},{});T(e)&&C(e)&&(Object.assign(e.style,t),Object.keys(n).forEach(function(t){e.removeAttribute(t)}))})}},requires:["computeStyles"]};function k(t){return t.split("-")[0]}var yi=Math.max,wi=Math.min,Ei=Math.round;function xi(){var t=navigator.userAgentData;return null!=t&&t.brands?t.brands.map(function(t){return t.brand+"/"+t.version}).join(" "):navigator.userAgent}function Ci(){return!/^((?!chrome|android).)*safari/i.test(xi())}function Ai(t,e,n){void 0===e&&(e=!1),void 0===n&&(n=!1);var i=t.getBoundingClientRect(),o=1,r=1;e&&T(t)&&(o=0<t.offsetWidth&&Ei(i.width)/t.offsetWidth||1,r=0<t.offsetHeight&&Ei(i.height)/t.offsetHeight||1);e=(_i(t)?A(t):window).visualViewport,t=!Ci()&&n,n=(i.left+(t&&e?e.offsetLeft:0))/o,t=(i.top+(t&&e?e.offsetTop:0))/r,e=i.width/o,o=i.height/r;return{width:e,height:o,top:t,right:n+e,bottom:t+o,left:n,x:n,y:t}}function Ti(t){var e=Ai(t),n=t.offsetWidth,i=t.offsetHeight;return Math.abs(e.width-n)<=1&&(n=e.width),Math.abs(e.height-i)<=1&&(i=e.height),{x:t.offsetLeft,y:t.offsetTop,width:n,height:i}}function Oi(t,e){var n=e.getRootNode&&e.getRootNode();if(t.contains(e))return!0;if(n&&vi(n)){var i=e;do{if(i&&t.isSameNode(i))return!0}while(i=i.parentNode||i.host)}return!1}function D(t){return A(t).getComputedStyle(t)}function Si(t){return((_i(t)?t.ownerDocument:t.document)||window.document).documentElement}function Li(t){return"html"===C(t)?t:t.assignedSlot||t.parentNode||(vi(t)?t.host:null)||Si(t)}function Ii(t){return T(t)&&"fixed"!==D(t).position?t.offsetParent:null}function ki(t){for(var e,n=A(t),i=Ii(t);i&&(e=i,0<=["table","td","th"].indexOf(C(e)))&&"static"===D(i).position;)i=Ii(i);return(!i||"html"!==C(i)&&("body"!==C(i)||"static"!==D(i).position))&&(i||function(t){var e=/firefox/i.test(xi()),n=/Trident/i.test(xi());if(!n||!T(t)||"fixed"!==D(t).position){var i=Li(t);for(vi(i)&&(i=i.host);T(i)&&["html","body"].indexOf(C(i))<0;){var o=D(i);if("none"!==o.transform||"none"!==o.perspective||"paint"===o.contain||-1!==["transform","perspective"].indexOf(o.willChange)||e&&"filter"===o.willChange||e&&o.filter&&"none"!==o.filter)return i;i=i.parentNode}}return null}(t))||n}function Di(t){return 0<=["top","bottom"].indexOf(t)?"x":"y"}function ji(t,e,n){return yi(t,wi(e,n))}function Pi(){return{top:0,right:0,bottom:0,left:0}}function Ni(t){return Object.assign({},Pi(),t)}function Mi(n,t){return t.reduce(function(t,e){return t[e]=n,t},{})}var Hi={name:"arrow",enabled:!0,phase:"main",fn:function(t){var e,n,i,o,r=t.state,s=t.name,t=t.options,a=r.elements.arrow,c=r.modifiersData.popperOffsets,l=Di(u=k(r.placement)),u=0<=[I,L].indexOf(u)?"height":"width";a&&c&&(t=t.padding,n=r,n=Ni("number"!=typeof(t="function"==typeof t?t(Object.assign({},n.rects,{placement:n.placement})):t)?t:Mi(t,Zn)),t=Ti(a),o="y"===l?O:I,i="y"===l?S:L,e=r.rects.reference[u]+r.rects.reference[l]-c[l]-r.rects.popper[u],c=c[l]-r.rects.reference[l],a=(a=ki(a))?"y"===l?a.clientHeight||0:a.clientWidth||0:0,o=n[o],n=a-t[u]-n[i],o=ji(o,i=a/2-t[u]/2+(e/2-c/2),n),r.modifiersData[s]=((a={})[l]=o,a.centerOffset=o-i,a))},effect:function(t){var e=t.state;null!=(t=void 0===(t=t.options.element)?"[data-popper-arrow]":t)&&("string"!=typeof t||(t=e.elements.popper.querySelector(t)))&&Oi(e.elements.popper,t)&&(e.elements.arrow=t)},requires:["popperOffsets"],requiresIfExists:["preventOverflow"]};function Ri(t){return t.split("-")[1]}var Bi={top:"auto",right:"auto",bottom:"auto",left:"auto"};function Wi(t){var e,n,i,o=t.popper,r=t.popperRect,s=t.placement,a=t.variation,c=t.offsets,l=t.position,u=t.gpuAcceleration,h=t.adaptive,d=t.roundOffsets,t=t.isFixed,f=c.x,f=void 0===f?0:f,p=c.y,p=void 0===p?0:p,g="function"==typeof d?d({x:f,y:p}):{x:f,y:p},g=(f=g.x,p=g.y,c.hasOwnProperty("x")),c=c.hasOwnProperty("y"),m=I,_=O,v=window,o=(h&&(n="clientHeight",e="clientWidth",(i=ki(o))===A(o)&&"static"!==D(i=Si(o)).position&&"absolute"===l&&(n="scrollHeight",e="scrollWidth"),s!==O&&(s!==I&&s!==L||a!==ti)||(_=S,p=(p-((t&&i===v&&v.visualViewport?v.visualViewport.height:i[n])-r.height))*(u?1:-1)),s!==I&&(s!==O&&s!==S||a!==ti)||(m=L,f=(f-((t&&i===v&&v.visualViewport?v.visualViewport.width:i[e])-r.width))*(u?1:-1))),Object.assign({position:l},h&&Bi)),t=!0===d?(s=(n={x:f,y:p}).x,n=n.y,a=window.devicePixelRatio||1,{x:Ei(s*a)/a||0,y:Ei(n*a)/a||0}):{x:f,y:p};return f=t.x,p=t.y,u?Object.assign({},o,((i={})[_]=c?"0":"",i[m]=g?"0":"",i.transform=(v.devicePixelRatio||1)<=1?"translate("+f+"px, "+p+"px)":"translate3d("+f+"px, "+p+"px, 0)",i)):Object.assign({},o,((e={})[_]=c?p+"px":"",e[m]=g?f+"px":"",e.transform="",e))}var Fi={name:"computeStyles",enabled:!0,phase:"beforeWrite",fn:function(t){var e=t.state,t=t.options,n=void 0===(n=t.gpuAcceleration)||n,i=void 0===(i=t.adaptive)||i,t=void 0===(t=t.roundOffsets)||t,n={placement:k(e.placement),variation:Ri(e.placement),popper:e.elements.popper,popperRect:e.rects.popper,gpuAcceleration:n,isFixed:"fixed"===e.options.strategy};null!=e.modifiersData.popperOffsets&&(e.styles.popper=Object.assign({},e.styles.popper,Wi(Object.assign({},n,{offsets:e.modifiersData.popperOffsets,position:e.options.strategy,adaptive:i,roundOffsets:t})))),null!=e.modifiersData.arrow&&(e.styles.arrow=Object.assign({},e.styles.arrow,Wi(Object.assign({},n,{offsets:e.modifiersData.arrow,position:"absolute",adaptive:!1,roundOffsets:t})))),e.attributes.popper=Object.assign({},e.attributes.popper,{"data-popper-placement":e.placement})},data:{}},qi={passive:!0};var zi={name:"eventListeners",enabled:!0,phase:"write",fn:function(){},effect:function(t){var e=t.state,n=t.instance,i=(t=t.options).scroll,o=void 0===i||i,r=void 0===(i=t.resize)||i,s=A(e.elements.popper),a=[].concat(e.scrollParents.reference,e.scrollParents.popper);return o&&a.forEach(function(t){t.addEventListener("scroll",n.update,qi)}),r&&s.addEventListener("resize",n.update,qi),function(){o&&a.forEach(function(t){t.removeEventListener("scroll",n.update,qi)}),r&&s.removeEventListener("resize",n.update,qi)}},data:{}},Qi={left:"right",right:"left",bottom:"top",top:"bottom"};function Vi(t){return t.replace(/left|right|bottom|top/g,function(t){return Qi[t]})}var Ui={start:"end",end:"start"};function Yi(t){return t.replace(/start|end/g,function(t){return Ui[t]})}function Xi(t){t=A(t);return{scrollLeft:t.pageXOffset,scrollTop:t.pageYOffset}}function Ki(t){return Ai(Si(t)).left+Xi(t).scrollLeft}function $i(t){var t=D(t),e=t.overflow,n=t.overflowX,t=t.overflowY;return/auto|scroll|overlay|hidden/.test(e+t+n)}function Gi(t,e){void 0===e&&(e=[]);var n=function t(e){return 0<=["html","body","#document"].indexOf(C(e))?e.ownerDocument.body:T(e)&&$i(e)?e:t(Li(e))}(t),t=n===(null==(t=t.ownerDocument)?void 0:t.body),i=A(n),i=t?[i].concat(i.visualViewport||[],$i(n)?n:[]):n,n=e.concat(i);return t?n:n.concat(Gi(Li(i)))}function Zi(t){return Object.assign({},t,{left:t.x,top:t.y,right:t.x+t.width,bottom:t.y+t.height})}function Ji(t,e,n){return e===ni?Zi((o=n,s=A(i=t),a=Si(i),s=s.visualViewport,c=a.clientWidth,a=a.clientHeight,u=l=0,s&&(c=s.width,a=s.height,(r=Ci())||!r&&"fixed"===o)&&(l=s.offsetLeft,u=s.offsetTop),{width:c,height:a,x:l+Ki(i),y:u})):_i(e)?((o=Ai(r=e,!1,"fixed"===(o=n))).top=o.top+r.clientTop,o.left=o.left+r.clientLeft,o.bottom=o.top+r.clientHeight,o.right=o.left+r.clientWidth,o.width=r.clientWidth,o.height=r.clientHeight,o.x=o.left,o.y=o.top,o):Zi((s=Si(t),c=Si(s),a=Xi(s),l=null==(l=s.ownerDocument)?void 0:l.body,i=yi(c.scrollWidth,c.clientWidth,l?l.scrollWidth:0,l?l.clientWidth:0),u=yi(c.scrollHeight,c.clientHeight,l?l.scrollHeight:0,l?l.clientHeight:0),s=-a.scrollLeft+Ki(s),a=-a.scrollTop,"rtl"===D(l||c).direction&&(s+=yi(c.clientWidth,l?l.clientWidth:0)-i),{width:i,height:u,x:s,y:a}));var i,o,r,s,a,c,l,u}function to(n,t,e,i){var o,r="clippingParents"===t?(s=Gi(Li(r=n)),_i(o=0<=["absolute","fixed"].indexOf(D(r).position)&&T(r)?ki(r):r)?s.filter(function(t){return _i(t)&&Oi(t,o)&&"body"!==C(t)}):[]):[].concat(t),s=[].concat(r,[e]),t=s[0],e=s.reduce(function(t,e){e=Ji(n,e,i);return t.top=yi(e.top,t.top),t.right=wi(e.right,t.right),t.bottom=wi(e.bottom,t.bottom),t.left=yi(e.left,t.left),t},Ji(n,t,i));return e.width=e.right-e.left,e.height=e.bottom-e.top,e.x=e.left,e.y=e.top,e}function eo(t){var e,n=t.reference,i=t.element,t=t.placement,o=t?k(t):null,t=t?Ri(t):null,r=n.x+n.width/2-i.width/2,s=n.y+n.height/2-i.height/2;switch(o){case O:e={x:r,y:n.y-i.height};break;case S:e={x:r,y:n.y+n.height};break;case L:e={x:n.x+n.width,y:s};break;case I:e={x:n.x-i.width,y:s};break;default:e={x:n.x,y:n.y}}var a=o?Di(o):null;if(null!=a){var c="y"===a?"height":"width";switch(t){case Jn:e[a]=e[a]-(n[c]/2-i[c]/2);break;case ti:e[a]=e[a]+(n[c]/2-i[c]/2)}}return e}function no(t,e){var i,e=e=void 0===e?{}:e,n=e.placement,n=void 0===n?t.placement:n,o=e.strategy,o=void 0===o?t.strategy:o,r=e.boundary,r=void 0===r?ei:r,s=e.rootBoundary,s=void 0===s?ni:s,a=e.elementContext,a=void 0===a?ii:a,c=e.altBoundary,c=void 0!==c&&c,e=e.padding,e=void 0===e?0:e,e=Ni("number"!=typeof e?e:Mi(e,Zn)),l=t.rects.popper,c=t.elements[c?a===ii?oi:ii:a],c=to(_i(c)?c:c.contextElement||Si(t.elements.popper),r,s,o),r=Ai(t.elements.reference),s=eo({reference:r,element:l,strategy:"absolute",placement:n}),o=Zi(Object.assign({},l,s)),l=a===ii?o:r,u={top:c.top-l.top+e.top,bottom:l.bottom-c.bottom+e.bottom,left:c.left-l.left+e.left,right:l.right-c.right+e.right},s=t.modifiersData.offset;return a===ii&&s&&(i=s[n],Object.keys(u).forEach(function(t){var e=0<=[L,S].indexOf(t)?1:-1,n=0<=[O,S].indexOf(t)?"y":"x";u[t]+=i[n]*e})),u}var io={name:"flip",enabled:!0,phase:"main",fn:function(t){var h=t.state,e=t.options,t=t.name;if(!h.modifiersData[t]._skip){for(var n=e.mainAxis,i=void 0===n||n,n=e.altAxis,o=void 0===n||n,n=e.fallbackPlacements,d=e.padding,f=e.boundary,p=e.rootBoundary,r=e.altBoundary,s=e.flipVariations,g=void 0===s||s,m=e.allowedAutoPlacements,s=h.options.placement,e=k(s),n=n||(e===s||!g?[Vi(s)]:k(n=s)===Gn?[]:(e=Vi(n),[Yi(n),e,Yi(e)])),a=[s].concat(n).reduce(function(t,e){return t.concat(k(e)===Gn?(n=h,i=(t=t=void 0===(t={placement:e,boundary:f,rootBoundary:p,padding:d,flipVariations:g,allowedAutoPlacements:m})?{}:t).placement,o=t.boundary,r=t.rootBoundary,s=t.padding,a=t.flipVariations,c=void 0===(t=t.allowedAutoPlacements)?si:t,l=Ri(i),t=l?a?ri:ri.filter(function(t){return Ri(t)===l}):Zn,u=(i=0===(i=t.filter(function(t){return 0<=c.indexOf(t)})).length?t:i).reduce(function(t,e){return t[e]=no(n,{placement:e,boundary:o,rootBoundary:r,padding:s})[k(e)],t},{}),Object.keys(u).sort(function(t,e){return u[t]-u[e]})):e);var n,i,o,r,s,a,c,l,u},[]),c=h.rects.reference,l=h.rects.popper,u=new Map,_=!0,v=a[0],b=0;b<a.length;b++){var y=a[b],w=k(y),E=Ri(y)===Jn,x=0<=[O,S].indexOf(w),C=x?"width":"height",A=no(h,{placement:y,boundary:f,rootBoundary:p,altBoundary:r,padding:d}),x=x?E?L:I:E?S:O,E=(c[C]>l[C]&&(x=Vi(x)),Vi(x)),C=[];if(i&&C.push(A[w]<=0),o&&C.push(A[x]<=0,A[E]<=0),C.every(function(t){return t})){v=y,_=!1;break}u.set(y,C)}if(_)for(var T=g?3:1;0<T;T--)if("break"===function(e){var t=a.find(function(t){t=u.get(t);if(t)return t.slice(0,e).every(function(t){return t})});if(t)return v=t,"break"}(T))break;h.placement!==v&&(h.modifiersData[t]._skip=!0,h.placement=v,h.reset=!0)}},requiresIfExists:["offset"],data:{_skip:!1}};function oo(t,e,n){return{top:t.top-e.height-(n=void 0===n?{x:0,y:0}:n).y,right:t.right-e.width+n.x,bottom:t.bottom-e.height+n.y,left:t.left-e.width-n.x}}function ro(e){return[O,L,S,I].some(function(t){return 0<=e[t]})}var so={name:"hide",enabled:!0,phase:"main",requiresIfExists:["preventOverflow"],fn:function(t){var e=t.state,t=t.name,n=e.rects.reference,i=e.rects.popper,o=e.modifiersData.preventOverflow,r=no(e,{elementContext:"reference"}),s=no(e,{altBoundary:!0}),r=oo(r,n),n=oo(s,i,o),s=ro(r),i=ro(n);e.modifiersData[t]={referenceClippingOffsets:r,popperEscapeOffsets:n,isReferenceHidden:s,hasPopperEscaped:i},e.attributes.popper=Object.assign({},e.attributes.popper,{"data-popper-reference-hidden":s,"data-popper-escaped":i})}};var ao={name:"offset",enabled:!0,phase:"main",requires:["popperOffsets"],fn:function(t){var s=t.state,e=t.options,t=t.name,a=void 0===(e=e.offset)?[0,0]:e,e=si.reduce(function(t,e){var n,i,o,r;return t[e]=(e=e,n=s.rects,i=a,o=k(e),r=0<=[I,O].indexOf(o)?-1:1,e=(n="function"==typeof i?i(Object.assign({},n,{placement:e})):i)[0]||0,i=(n[1]||0)*r,0<=[I,L].indexOf(o)?{x:i,y:e}:{x:e,y:i}),t},{}),n=(i=e[s.placement]).x,i=i.y;null!=s.modifiersData.popperOffsets&&(s.modifiersData.popperOffsets.x+=n,s.modifiersData.popperOffsets.y+=i),s.modifiersData[t]=e}};var co={name:"popperOffsets",enabled:!0,phase:"read",fn:function(t){var e=t.state,t=t.name;e.modifiersData[t]=eo({reference:e.rects.reference,element:e.rects.popper,strategy:"absolute",placement:e.placement})},data:{}};var lo={name:"preventOverflow",enabled:!0,phase:"main",fn:function(t){var e,n,i,o,r,s,a,c,l,u=t.state,h=t.options,t=t.name,d=void 0===(d=h.mainAxis)||d,f=void 0!==(f=h.altAxis)&&f,p=h.boundary,g=h.rootBoundary,m=h.altBoundary,_=h.padding,v=void 0===(v=h.tether)||v,h=void 0===(h=h.tetherOffset)?0:h,p=no(u,{boundary:p,rootBoundary:g,padding:_,altBoundary:m}),g=k(u.placement),m=!(_=Ri(u.placement)),b=Di(g),y="x"===b?"y":"x",w=u.modifiersData.popperOffsets,E=u.rects.reference,x=u.rects.popper,h="number"==typeof(h="function"==typeof h?h(Object.assign({},u.rects,{placement:u.placement})):h)?{mainAxis:h,altAxis:h}:Object.assign({mainAxis:0,altAxis:0},h),C=u.modifiersData.offset?u.modifiersData.offset[u.placement]:null,A={x:0,y:0};w&&(d&&(d="y"===b?"height":"width",s=(a=w[b])+p[n="y"===b?O:I],c=a-p[l="y"===b?S:L],e=v?-x[d]/2:0,o=(_===Jn?E:x)[d],_=_===Jn?-x[d]:-E[d],r=u.elements.arrow,r=v&&r?Ti(r):{width:0,height:0},n=(i=u.modifiersData["arrow#persistent"]?u.modifiersData["arrow#persistent"].padding:Pi())[n],i=i[l],l=ji(0,E[d],r[d]),r=m?E[d]/2-e-l-n-h.mainAxis:o-l-n-h.mainAxis,o=m?-E[d]/2+e+l+i+h.mainAxis:_+l+i+h.mainAxis,m=(n=u.elements.arrow&&ki(u.elements.arrow))?"y"===b?n.clientTop||0:n.clientLeft||0:0,_=a+o-(e=null!=(d=null==C?void 0:C[b])?d:0),l=ji(v?wi(s,a+r-e-m):s,a,v?yi(c,_):c),w[b]=l,A[b]=l-a),f&&(i="y"==y?"height":"width",o=(n=w[y])+p["x"===b?O:I],d=n-p["x"===b?S:L],r=-1!==[O,I].indexOf(g),m=null!=(e=null==C?void 0:C[y])?e:0,s=r?o:n-E[i]-x[i]-m+h.altAxis,_=r?n+E[i]+x[i]-m-h.altAxis:d,a=v&&r?(c=ji(c=s,n,l=_),l<c?l:c):ji(v?s:o,n,v?_:d),w[y]=a,A[y]=a-n),u.modifiersData[t]=A)},requiresIfExists:["offset"]};function uo(t,e,n){void 0===n&&(n=!1);var i=T(e),o=T(e)&&(s=(o=e).getBoundingClientRect(),r=Ei(s.width)/o.offsetWidth||1,s=Ei(s.height)/o.offsetHeight||1,1!==r||1!==s),r=Si(e),s=Ai(t,o,n),t={scrollLeft:0,scrollTop:0},a={x:0,y:0};return!i&&n||("body"===C(e)&&!$i(r)||(t=(i=e)!==A(i)&&T(i)?{scrollLeft:i.scrollLeft,scrollTop:i.scrollTop}:Xi(i)),T(e)?((a=Ai(e,!0)).x+=e.clientLeft,a.y+=e.clientTop):r&&(a.x=Ki(r))),{x:s.left+t.scrollLeft-a.x,y:s.top+t.scrollTop-a.y,width:s.width,height:s.height}}function ho(t){var n=new Map,i=new Set,o=[];return t.forEach(function(t){n.set(t.name,t)}),t.forEach(function(t){i.has(t.name)||!function e(t){i.add(t.name),[].concat(t.requires||[],t.requiresIfExists||[]).forEach(function(t){i.has(t)||(t=n.get(t))&&e(t)}),o.push(t)}(t)}),o}var fo={placement:"bottom",modifiers:[],strategy:"absolute"};function po(){for(var t=arguments.length,e=new Array(t),n=0;n<t;n++)e[n]=arguments[n];return!e.some(function(t){return!(t&&"function"==typeof t.getBoundingClientRect)})}function go(t){var t=t=void 0===t?{}:t,e=t.defaultModifiers,h=void 0===e?[]:e,e=t.defaultOptions,d=void 0===e?fo:e;return function(i,o,e){void 0===e&&(e=d);var n,r,s={placement:"bottom",orderedModifiers:[],options:Object.assign({},fo,d),modifiersData:{},elements:{reference:i,popper:o},attributes:{},styles:{}},a=[],c=!1,l={state:s,setOptions:function(t){var n,e,t="function"==typeof t?t(s.options):t,t=(u(),s.options=Object.assign({},d,s.options,t),s.scrollParents={reference:_i(i)?Gi(i):i.contextElement?Gi(i.contextElement):[],popper:Gi(o)},t=[].concat(h,s.options.modifiers),e=t.reduce(function(t,e){var n=t[e.name];return t[e.name]=n?Object.assign({},n,e,{options:Object.assign({},n.options,e.options),data:Object.assign({},n.data,e.data)}):e,t},{}),t=Object.keys(e).map(function(t){return e[t]}),n=ho(t),mi.reduce(function(t,e){return t.concat(n.filter(function(t){return t.phase===e}))},[]));return s.orderedModifiers=t.filter(function(t){return t.enabled}),s.orderedModifiers.forEach(function(t){var e=t.name,n=t.options,t=t.effect;"function"==typeof t&&(t=t({state:s,name:e,instance:l,options:void 0===n?{}:n}),a.push(t||function(){}))}),l.update()},forceUpdate:function(){if(!c){var t=s.elements,e=t.reference,t=t.popper;if(po(e,t)){s.rects={reference:uo(e,ki(t),"fixed"===s.options.strategy),popper:Ti(t)},s.reset=!1,s.placement=s.options.placement,s.orderedModifiers.forEach(function(t){return s.modifiersData[t.name]=Object.assign({},t.data)});for(var n,i,o,r=0;r<s.orderedModifiers.length;r++)!0===s.reset?(s.reset=!1,r=-1):(n=(o=s.orderedModifiers[r]).fn,i=o.options,o=o.name,"function"==typeof n&&(s=n({state:s,options:void 0===i?{}:i,name:o,instance:l})||s))}}},update:(n=function(){return new Promise(function(t){l.forceUpdate(),t(s)})},function(){return r=r||new Promise(function(t){Promise.resolve().then(function(){r=void 0,t(n())})})}),destroy:function(){u(),c=!0}};return po(i,o)&&l.setOptions(e).then(function(t){!c&&e.onFirstUpdate&&e.onFirstUpdate(t)}),l;function u(){a.forEach(function(t){return t()}),a=[]}}}var mo=go(),_o=go({defaultModifiers:[zi,co,Fi,bi,ao,io,lo,Hi,so]}),vo=go({defaultModifiers:[zi,co,Fi,bi]});const bo=new Set(["background","cite","href","itemtype","longdesc","poster","src","xlink:href"]);const yo=/^(?:(?:https?|mailto|ftp|tel|file|sms):|[^#&/:?]*(?:[#/?]|$))/i,wo=/^data:(?:image\/(?:bmp|gif|jpeg|jpg|png|tiff|webp)|video\/(?:mpeg|mp4|ogg|webm)|audio\/(?:mp3|oga|ogg|opus));base64,[\d+/a-z]+=*$/i;E={"*":["class","dir","id","lang","role",/^aria-[\w-]*$/i],a:["target","href","title","rel"],area:[],b:[],br:[],col:[],code:[],div:[],em:[],hr:[],h1:[],h2:[],h3:[],h4:[],h5:[],h6:[],i:[],img:["src","srcset","alt","title","width","height"],li:[],ol:[],p:[],pre:[],s:[],small:[],span:[],sub:[],sup:[],strong:[],u:[],ul:[]};function Eo(t,e,n){if(!t.length)return t;if(n&&"function"==typeof n)return n(t);n=(new window.DOMParser).parseFromString(t,"text/html");for(const s of[].concat(...n.body.querySelectorAll("*"))){var i=s.nodeName.toLowerCase();if(Object.keys(e).includes(i)){var o=[].concat(...s.attributes),r=[].concat(e["*"]||[],e[i]||[]);for(const a of o)((t,e)=>{const n=t.nodeName.toLowerCase();return e.includes(n)?!bo.has(n)||Boolean(yo.test(t.nodeValue)||wo.test(t.nodeValue)):e.filter(t=>t instanceof RegExp).some(t=>t.test(n))})(a,r)||s.removeAttribute(a.nodeName)}else s.remove()}return n.body.innerHTML}const xo={allowList:E,content:{},extraClass:"",html:!1,sanitize:!0,sanitizeFn:null,template:"<div></div>"},Co={allowList:"object",content:"object",extraClass:"(string|function)",html:"boolean",sanitize:"boolean",sanitizeFn:"(null|function)",template:"string"},Ao={entry:"(string|element|function|null)",selector:"(string|element)"};var To=class extends g{constructor(t){super(),this._config=this._getConfig(t)}static get Default(){return xo}static get DefaultType(){return Co}static get NAME(){return"TemplateFactory"}getContent(){return Object.values(this._config.content).map(t=>this._resolvePossibleFunction(t)).filter(Boolean)}hasContent(){return 0<this.getContent().length}changeContent(t){return this._checkContent(t),this._config.content={...this._config.content,...t},this}toHtml(){var t,e,n=document.createElement("div");n.innerHTML=this._maybeSanitize(this._config.template);for([t,e]of Object.entries(this._config.content))this._setContent(n,e,t);var i=n.children[0],o=this._resolvePossibleFunction(this._config.extraClass);return o&&i.classList.add(...o.split(" ")),i}_typeCheckConfig(t){super._typeCheckConfig(t),this._checkContent(t.content)}_checkContent(t){for(var[e,n]of Object.entries(t))super._typeCheckConfig({selector:e,entry:n},Ao)}_setContent(t,e,n){n=y.findOne(n,t);n&&((e=this._resolvePossibleFunction(e))?u(e)?this._putElementInTemplate(ut(e),n):this._config.html?n.innerHTML=this._maybeSanitize(e):n.textContent=e:n.remove())}_maybeSanitize(t){return this._config.sanitize?Eo(t,this._config.allowList,this._config.sanitizeFn):t}_resolvePossibleFunction(t){return"function"==typeof t?t(this):t}_putElementInTemplate(t,e){this._config.html?(e.innerHTML="",e.append(t)):e.textContent=t.textContent}};const Oo=new Set(["sanitize","allowList","sanitizeFn"]),So="fade";const Lo="show",Io=".".concat("modal"),ko="hide.bs.modal",Do="hover",jo="focus",Po={AUTO:"auto",TOP:"top",RIGHT:h()?"left":"right",BOTTOM:"bottom",LEFT:h()?"right":"left"},No={allowList:E,animation:!0,boundary:"clippingParents",container:!1,customClass:"",delay:0,fallbackPlacements:["top","right","bottom","left"],html:!1,offset:[0,0],placement:"top",popperConfig:null,sanitize:!0,sanitizeFn:null,selector:!1,template:'<div class="tooltip" role="tooltip"><div class="tooltip-arrow"></div><div class="tooltip-inner"></div></div>',title:"",trigger:"hover focus"},Mo={allowList:"object",animation:"boolean",boundary:"(string|element)",container:"(string|element|boolean)",customClass:"(string|function)",delay:"(number|object)",fallbackPlacements:"array",html:"boolean",offset:"(array|string|function)",placement:"(string|function)",popperConfig:"(null|object|function)",sanitize:"boolean",sanitizeFn:"(null|function)",selector:"(string|boolean)",template:"string",title:"(string|element|function)",trigger:"string"};class Ho extends m{constructor(t,e){if(void 0===n)throw new TypeError("Bootstrap's tooltips require Popper (https://popper.js.org)");super(t,e),this._isEnabled=!0,this._timeout=0,this._isHovered=null,this._activeTrigger={},this._popper=null,this._templateFactory=null,this._newContent=null,this.tip=null,this._setListeners(),this._config.selector||this._fixTitle()}static get Default(){return No}static get DefaultType(){return Mo}static get NAME(){return"tooltip"}enable(){this._isEnabled=!0}disable(){this._isEnabled=!1}toggleEnabled(){this._isEnabled=!this._isEnabled}toggle(){this._isEnabled&&(this._activeTrigger.click=!this._activeTrigger.click,this._isShown()?this._leave():this._enter())}dispose(){clearTimeout(this._timeout),f.off(this._element.closest(Io),ko,this._hideModalHandler),this._element.getAttribute("data-mdb-original-title")&&this._element.setAttribute("title",this._element.getAttribute("data-mdb-original-title")),this._disposePopper(),super.dispose()}show(){if("none"===this._element.style.display)throw new Error("Please use show on visible elements");if(this._isWithContent()&&this._isEnabled){var t=f.trigger(this._element,this.constructor.eventName("show")),e=(ft(this._element)||this._element.ownerDocument.documentElement).contains(this._element);if(!t.defaultPrevented&&e){this._disposePopper();t=this._getTipElement(),e=(this._element.setAttribute("aria-describedby",t.getAttribute("id")),this._config)["container"];if(this._element.ownerDocument.documentElement.contains(this.tip)||(e.append(t),f.trigger(this._element,this.constructor.eventName("inserted"))),this._popper=this._createPopper(t),t.classList.add(Lo),"ontouchstart"in document.documentElement)for(const n of[].concat(...document.body.children))f.on(n,"mouseover",pt);this._queueCallback(()=>{f.trigger(this._element,this.constructor.eventName("shown")),!1===this._isHovered&&this._leave(),this._isHovered=!1},this.tip,this._isAnimated())}}}hide(){if(this._isShown()){var t=f.trigger(this._element,this.constructor.eventName("hide"));if(!t.defaultPrevented){if(this._getTipElement().classList.remove(Lo),"ontouchstart"in document.documentElement)for(const e of[].concat(...document.body.children))f.off(e,"mouseover",pt);this._activeTrigger.click=!1,this._activeTrigger[jo]=!1,this._activeTrigger[Do]=!1,this._isHovered=null;this._queueCallback(()=>{this._isWithActiveTrigger()||(this._isHovered||this._disposePopper(),this._element.removeAttribute("aria-describedby"),f.trigger(this._element,this.constructor.eventName("hidden")))},this.tip,this._isAnimated())}}}update(){this._popper&&this._popper.update()}_isWithContent(){return Boolean(this._getTitle())}_getTipElement(){return this.tip||(this.tip=this._createTipElement(this._newContent||this._getContentForTemplate())),this.tip}_createTipElement(t){t=this._getTemplateFactory(t).toHtml();if(!t)return null;t.classList.remove(So,Lo),t.classList.add("bs-".concat(this.constructor.NAME,"-auto"));var e=(t=>{for(;t+=Math.floor(1e6*Math.random()),document.getElementById(t););return t})(this.constructor.NAME).toString();return t.setAttribute("id",e),this._isAnimated()&&t.classList.add(So),t}setContent(t){this._newContent=t,this._isShown()&&(this._disposePopper(),this.show())}_getTemplateFactory(t){return this._templateFactory?this._templateFactory.changeContent(t):this._templateFactory=new To({...this._config,content:t,extraClass:this._resolvePossibleFunction(this._config.customClass)}),this._templateFactory}_getContentForTemplate(){return{".tooltip-inner":this._getTitle()}}_getTitle(){return this._resolvePossibleFunction(this._config.title)||this._element.getAttribute("data-mdb-original-title")}_initializeOnDelegatedTarget(t){return this.constructor.getOrCreateInstance(t.delegateTarget,this._getDelegateConfig())}_isAnimated(){return this._config.animation||this.tip&&this.tip.classList.contains(So)}_isShown(){return this.tip&&this.tip.classList.contains(Lo)}_createPopper(t){var e="function"==typeof this._config.placement?this._config.placement.call(this,t,this._element):this._config.placement,e=Po[e.toUpperCase()];return _o(this._element,t,this._getPopperConfig(e))}_getOffset(){const e=this._config["offset"];return"string"==typeof e?e.split(",").map(t=>Number.parseInt(t,10)):"function"==typeof e?t=>e(t,this._element):e}_resolvePossibleFunction(t){return"function"==typeof t?t.call(this._element):t}_getPopperConfig(t){t={placement:t,modifiers:[{name:"flip",options:{fallbackPlacements:this._config.fallbackPlacements}},{name:"offset",options:{offset:this._getOffset()}},{name:"preventOverflow",options:{boundary:this._config.boundary}},{name:"arrow",options:{element:".".concat(this.constructor.NAME,"-arrow")}},{name:"preSetPlacement",enabled:!0,phase:"beforeMain",fn:t=>{this._getTipElement().setAttribute("data-popper-placement",t.state.placement)}}]};return{...t,..."function"==typeof this._config.popperConfig?this._config.popperConfig(t):this._config.popperConfig}}_setListeners(){var t,e;for(const n of this._config.trigger.split(" "))"click"===n?f.on(this._element,this.constructor.eventName("click"),this._config.selector,t=>{this._initializeOnDelegatedTarget(t).toggle()}):"manual"!==n&&(t=n===Do?this.constructor.eventName("mouseenter"):this.constructor.eventName("focusin"),e=n===Do?this.constructor.eventName("mouseleave"):this.constructor.eventName("focusout"),f.on(this._element,t,this._config.selector,t=>{var e=this._initializeOnDelegatedTarget(t);e._activeTrigger["focusin"===t.type?jo:Do]=!0,e._enter()}),f.on(this._element,e,this._config.selector,t=>{var e=this._initializeOnDelegatedTarget(t);e._activeTrigger["focusout"===t.type?jo:Do]=e._element.contains(t.relatedTarget),e._leave()}));this._hideModalHandler=()=>{this._element&&this.hide()},f.on(this._element.closest(Io),ko,this._hideModalHandler)}_fixTitle(){var t=this._element.getAttribute("title");t&&(this._element.getAttribute("aria-label")||this._element.textContent.trim()||this._element.setAttribute("aria-label",t),this._element.setAttribute("data-mdb-original-title",t),this._element.removeAttribute("title"))}_enter(){this._isShown()||this._isHovered?this._isHovered=!0:(this._isHovered=!0,this._setTimeout(()=>{this._isHovered&&this.show()},this._config.delay.show))}_leave(){this._isWithActiveTrigger()||(this._isHovered=!1,this._setTimeout(()=>{this._isHovered||this.hide()},this._config.delay.hide))}_setTimeout(t,e){clearTimeout(this._timeout),this._timeout=setTimeout(t,e)}_isWithActiveTrigger(){return Object.values(this._activeTrigger).includes(!0)}_getConfig(t){var e=p.getDataAttributes(this._element);for(const n of Object.keys(e))Oo.has(n)&&delete e[n];return t={...e,..."object"==typeof t&&t?t:{}},t=this._mergeConfigObj(t),t=this._configAfterMerge(t),this._typeCheckConfig(t),t}_configAfterMerge(t){return t.container=!1===t.container?document.body:ut(t.container),"number"==typeof t.delay&&(t.delay={show:t.delay,hide:t.delay}),"number"==typeof t.title&&(t.title=t.title.toString()),"number"==typeof t.content&&(t.content=t.content.toString()),t}_getDelegateConfig(){var t={};for(const e in this._config)this.constructor.Default[e]!==this._config[e]&&(t[e]=this._config[e]);return t.selector=!1,t.trigger="manual",t}_disposePopper(){this._popper&&(this._popper.destroy(),this._popper=null),this.tip&&(this.tip.remove(),this.tip=null)}static jQueryInterface(e){return this.each(function(){var t=Ho.getOrCreateInstance(this,e);if("string"==typeof e){if(void 0===t[e])throw new TypeError('No method named "'.concat(e,'"'));t[e]()}})}}t(Ho);_=Ho;const Ro={..._.Default,content:"",offset:[0,8],placement:"right",template:'<div class="popover" role="tooltip"><div class="popover-arrow"></div><h3 class="popover-header"></h3><div class="popover-body"></div></div>',trigger:"click"},Bo={..._.DefaultType,content:"(null|string|element|function)"};class Wo extends _{static get Default(){return Ro}static get DefaultType(){return Bo}static get NAME(){return"popover"}_isWithContent(){return this._getTitle()||this._getContent()}_getContentForTemplate(){return{".popover-header":this._getTitle(),".popover-body":this._getContent()}}_getContent(){return this._resolvePossibleFunction(this._config.content)}static jQueryInterface(e){return this.each(function(){var t=Wo.getOrCreateInstance(this,e);if("string"==typeof e){if(void 0===t[e])throw new TypeError('No method named "'.concat(e,'"'));t[e]()}})}}t(Wo);v=Wo;const Fo="popover";w="mdb.".concat(Fo),g=".".concat(w);const qo="show.bs.popover",zo="shown.bs.popover",Qo="hide.bs.popover",Vo="hidden.bs.popover",Uo="inserted.bs.popover",Yo="show".concat(g),Xo="shown".concat(g),Ko="hide".concat(g),$o="hidden".concat(g),Go="inserted".concat(g);class Zo extends v{constructor(t,e){super(t,e),this._init()}dispose(){s.off(this.element,qo),s.off(this.element,zo),s.off(this.element,Qo),s.off(this.element,Vo),s.off(this.element,Uo),super.dispose()}static get NAME(){return Fo}_init(){this._bindShowEvent(),this._bindShownEvent(),this._bindHideEvent(),this._bindHiddenEvent(),this._bindInsertedEvent()}_bindShowEvent(){s.on(this.element,qo,()=>{s.trigger(this.element,Yo)})}_bindShownEvent(){s.on(this.element,zo,()=>{s.trigger(this.element,Xo)})}_bindHideEvent(){s.on(this.element,Qo,()=>{s.trigger(this.element,Ko)})}_bindHiddenEvent(){s.on(this.element,Vo,()=>{s.trigger(this.element,$o)})}_bindInsertedEvent(){s.on(this.element,Uo,()=>{s.trigger(this.element,Go)})}}a.find('[data-mdb-toggle="popover"]').forEach(t=>{var e=Zo.getInstance(t);e||new Zo(t)}),o(()=>{const t=i();if(t){const e=t.fn[Fo];t.fn[Fo]=Zo.jQueryInterface,t.fn[Fo].Constructor=Zo,t.fn[Fo].noConflict=()=>(t.fn[Fo]=e,Zo.jQueryInterface)}});var Jo=Zo;E=".".concat("bs.scrollspy");const tr="activate".concat(E),er="click".concat(E);"load".concat(E).concat(".data-api");const nr="active";const ir="[href]";w=".nav-link";const or="".concat(w,", ").concat(".nav-item"," > ").concat(w,", ").concat(".list-group-item"),rr={offset:null,rootMargin:"0px 0px -25%",smoothScroll:!1,target:null,threshold:[.1,.5,1]},sr={offset:"(number|null)",rootMargin:"string",smoothScroll:"boolean",target:"element",threshold:"array"};class ar extends m{constructor(t,e){super(t,e),this._config.target&&(this._targetLinks=new Map,this._observableSections=new Map,this._rootElement="visible"===getComputedStyle(this._element).overflowY?null:this._element,this._activeTarget=null,this._observer=null,this._previousScrollData={visibleEntryTop:0,parentScrollTop:0},this.refresh())}static get Default(){return rr}static get DefaultType(){return sr}static get NAME(){return"scrollspy"}refresh(){this._initializeTargetsAndObservables(),this._maybeEnableSmoothScroll(),this._observer?this._observer.disconnect():this._observer=this._getNewObserver();for(const t of this._observableSections.values())this._observer.observe(t)}dispose(){this._observer&&this._observer.disconnect(),super.dispose()}_configAfterMerge(t){return t.target=ut(t.target)||document.body,t.rootMargin=t.offset?"".concat(t.offset,"px 0px -30%"):t.rootMargin,"string"==typeof t.threshold&&(t.threshold=t.threshold.split(",").map(t=>Number.parseFloat(t))),t}_maybeEnableSmoothScroll(){this._config.smoothScroll&&(f.off(this._config.target,er),f.on(this._config.target,er,ir,t=>{var e=this._observableSections.get(t.target.hash);e&&(t.preventDefault(),t=this._rootElement||window,e=e.offsetTop-this._element.offsetTop,t.scrollTo?t.scrollTo({top:e,behavior:"smooth"}):t.scrollTop=e)}))}_getNewObserver(){var t={root:this._rootElement,threshold:this._config.threshold,rootMargin:this._config.rootMargin};return new IntersectionObserver(t=>this._observerCallback(t),t)}_observerCallback(t){const e=t=>this._targetLinks.get("#".concat(t.target.id));var n=t=>{this._previousScrollData.visibleEntryTop=t.target.offsetTop,this._process(e(t))},i=(this._rootElement||document.documentElement).scrollTop,o=i>=this._previousScrollData.parentScrollTop;this._previousScrollData.parentScrollTop=i;for(const s of t)if(s.isIntersecting){var r=s.target.offsetTop>=this._previousScrollData.visibleEntryTop;if(o&&r){if(n(s),i)continue;return}o||r||n(s)}else this._activeTarget=null,this._clearActiveClass(e(s))}_initializeTargetsAndObservables(){var t;this._targetLinks=new Map,this._observableSections=new Map;for(const e of y.find(ir,this._config.target))e.hash&&!dt(e)&&(t=y.findOne(e.hash,this._element),ht(t))&&(this._targetLinks.set(e.hash,e),this._observableSections.set(e.hash,t))}_process(t){this._activeTarget!==t&&(this._clearActiveClass(this._config.target),(this._activeTarget=t).classList.add(nr),this._activateParents(t),f.trigger(this._element,tr,{relatedTarget:t}))}_activateParents(t){if(t.classList.contains("dropdown-item"))y.findOne(".dropdown-toggle",t.closest(".dropdown")).classList.add(nr);else for(const e of y.parents(t,".nav, .list-group"))for(const n of y.prev(e,or))n.classList.add(nr)}_clearActiveClass(t){t.classList.remove(nr);for(const e of y.find("".concat(ir,".").concat(nr),t))e.classList.remove(nr)}static jQueryInterface(e){return this.each(function(){var t=ar.getOrCreateInstance(this,e);if("string"==typeof e){if(void 0===t[e]||e.startsWith("_")||"constructor"===e)throw new TypeError('No method named "'.concat(e,'"'));t[e]()}})}}t(ar);g=ar;const cr="scrollspy";v="mdb.".concat(cr),E=".".concat(v);const lr="activate.bs.scrollspy",ur="activate".concat(E);w="load".concat(E).concat(".data-api");const hr="collapsible-scrollspy";const dr=".".concat("active"),fr=".".concat(hr);class pr extends g{constructor(t,e){super(t,e),this._collapsibles=[],this._init()}dispose(){s.off(this._scrollElement,lr),super.dispose()}static get NAME(){return cr}_init(){this._bindActivateEvent(),this._getCollapsibles(),0!==this._collapsibles.length&&(this._showSubsection(),this._hideSubsection())}_getHeight(t){return t.offsetHeight}_hide(t){t=a.findOne("ul",t.parentNode);t.style.overflow="hidden",t.style.height="".concat(0,"px")}_show(t,e){t.style.height=e}_getCollapsibles(){var t=a.find(fr);t&&t.forEach(t=>{var e=t.parentNode,e=a.findOne("ul",e),n=e.offsetHeight;this._collapsibles.push({element:e,relatedTarget:t.getAttribute("href"),height:"".concat(n,"px")})})}_showSubsection(){a.find(dr).filter(t=>c.hasClass(t,hr)).forEach(e=>{var t=a.findOne("ul",e.parentNode),n=this._collapsibles.find(t=>t.relatedTarget=e.getAttribute("href")).height;this._show(t,n)})}_hideSubsection(){a.find(fr).filter(t=>!1===c.hasClass(t,"active")).forEach(t=>{this._hide(t)})}_bindActivateEvent(){s.on(this._element,lr,t=>{this._showSubsection(),this._hideSubsection(),s.trigger(this._element,ur,{relatedTarget:t.relatedTarget})})}}s.on(window,w,()=>{a.find('[data-mdb-spy="scroll"]').forEach(t=>{var e=pr.getInstance(t);e||new pr(t,c.getDataAttributes(t))})}),o(()=>{const t=i();if(t){const e=t.fn[cr];t.fn[cr]=pr.jQueryInterface,t.fn[cr].Constructor=pr,t.fn[cr].noConflict=()=>(t.fn[cr]=e,pr.jQueryInterface)}});var gr=pr;v=".".concat("bs.tab");const mr="hide".concat(v),_r="hidden".concat(v),vr="show".concat(v),br="shown".concat(v);E="click".concat(v);const yr="keydown".concat(v);g="load".concat(v);const wr="ArrowRight",Er="ArrowDown",xr="active",Cr="show";w=":not(.dropdown-toggle)";v=".nav-link".concat(w,", .list-group-item").concat(w,', [role="tab"]').concat(w),w='[data-mdb-toggle="tab"], [data-mdb-toggle="pill"], [data-mdb-toggle="list"]';const Ar="".concat(v,", ").concat(w),Tr=".".concat(xr,'[data-mdb-toggle="tab"], .').concat(xr,'[data-mdb-toggle="pill"], .').concat(xr,'[data-mdb-toggle="list"]');class Or extends m{constructor(t){super(t),this._parent=this._element.closest('.list-group, .nav, [role="tablist"]'),this._parent&&(this._setInitialAttributes(this._parent,this._getChildren()),f.on(this._element,yr,t=>this._keydown(t)))}static get NAME(){return"tab"}show(){var t,e,n=this._element;this._elemIsActive(n)||(e=(t=this._getActiveElem())?f.trigger(t,mr,{relatedTarget:n}):null,f.trigger(n,vr,{relatedTarget:t}).defaultPrevented)||e&&e.defaultPrevented||(this._deactivate(t,n),this._activate(n,t))}_activate(t,e){t&&(t.classList.add(xr),this._activate(l(t)),this._queueCallback(()=>{"tab"!==t.getAttribute("role")?t.classList.add(Cr):(t.removeAttribute("tabindex"),t.setAttribute("aria-selected",!0),this._toggleDropDown(t,!0),f.trigger(t,br,{relatedTarget:e}))},t,t.classList.contains("fade")))}_deactivate(t,e){t&&(t.classList.remove(xr),t.blur(),this._deactivate(l(t)),this._queueCallback(()=>{"tab"!==t.getAttribute("role")?t.classList.remove(Cr):(t.setAttribute("aria-selected",!1),t.setAttribute("tabindex","-1"),this._toggleDropDown(t,!1),f.trigger(t,_r,{relatedTarget:e}))},t,t.classList.contains("fade")))}_keydown(t){var e;["ArrowLeft",wr,"ArrowUp",Er].includes(t.key)&&(t.stopPropagation(),t.preventDefault(),e=[wr,Er].includes(t.key),t=bt(this._getChildren().filter(t=>!dt(t)),t.target,e,!0))&&(t.focus({preventScroll:!0}),Or.getOrCreateInstance(t).show())}_getChildren(){return y.find(Ar,this._parent)}_getActiveElem(){return this._getChildren().find(t=>this._elemIsActive(t))||null}_setInitialAttributes(t,e){this._setAttributeIfNotExists(t,"role","tablist");for(const n of e)this._setInitialAttributesOnChild(n)}_setInitialAttributesOnChild(t){t=this._getInnerElement(t);var e=this._elemIsActive(t),n=this._getOuterElement(t);t.setAttribute("aria-selected",e),n!==t&&this._setAttributeIfNotExists(n,"role","presentation"),e||t.setAttribute("tabindex","-1"),this._setAttributeIfNotExists(t,"role","tab"),this._setInitialAttributesOnTargetPanel(t)}_setInitialAttributesOnTargetPanel(t){var e=l(t);e&&(this._setAttributeIfNotExists(e,"role","tabpanel"),t.id)&&this._setAttributeIfNotExists(e,"aria-labelledby","#".concat(t.id))}_toggleDropDown(t,n){const i=this._getOuterElement(t);i.classList.contains("dropdown")&&((t=(t,e)=>{t=y.findOne(t,i);t&&t.classList.toggle(e,n)})(".dropdown-toggle",xr),t(".dropdown-menu",Cr),i.setAttribute("aria-expanded",n))}_setAttributeIfNotExists(t,e,n){t.hasAttribute(e)||t.setAttribute(e,n)}_elemIsActive(t){return t.classList.contains(xr)}_getInnerElement(t){return t.matches(Ar)?t:y.findOne(Ar,t)}_getOuterElement(t){return t.closest(".nav-item, .list-group-item")||t}static jQueryInterface(e){return this.each(function(){var t=Or.getOrCreateInstance(this);if("string"==typeof e){if(void 0===t[e]||e.startsWith("_")||"constructor"===e)throw new TypeError('No method named "'.concat(e,'"'));t[e]()}})}}f.on(document,E,w,function(t){["A","AREA"].includes(this.tagName)&&t.preventDefault(),dt(this)||Or.getOrCreateInstance(this).show()}),f.on(window,g,()=>{for(const t of y.find(Tr))Or.getOrCreateInstance(t)}),t(Or);v=Or;const Sr="tab";E="mdb.".concat(Sr),w=".".concat(E);const Lr="show.bs.tab",Ir="shown.bs.tab",kr="show".concat(w),Dr="shown".concat(w),jr="hide".concat(w),Pr="hidden".concat(w);class Nr extends v{dispose(){s.off(this._element,Lr),s.off(this._element,Ir),super.dispose()}static get NAME(){return Sr}show(){var n=this._element;if(!this._elemIsActive(n)){var i=this._getActiveElem();let t=null,e=null;i&&(t=s.trigger(i,"hide.bs.tab",{relatedTarget:n}),e=s.trigger(i,jr,{relatedTarget:n}));var o=s.trigger(n,Lr,{relatedTarget:i}),r=s.trigger(n,kr,{relatedTarget:i});o.defaultPrevented&&r.defaultPrevented||t&&t.defaultPrevented&&e&&e.defaultPrevented||(this._deactivate(i,n),this._activate(n,i))}}_activate(t,e){t&&(t.classList.add("active"),this._activate(R(t)),this._queueCallback(()=>{"tab"!==t.getAttribute("role")?t.classList.add("show"):(t.focus(),t.removeAttribute("tabindex"),t.setAttribute("aria-selected",!0),this._toggleDropDown(t,!0),s.trigger(t,Ir,{relatedTarget:e}),s.trigger(t,Dr,{relatedTarget:e}))},t,t.classList.contains("fade")))}_deactivate(t,e){t&&(t.classList.remove("active"),t.blur(),this._deactivate(R(t)),this._queueCallback(()=>{"tab"!==t.getAttribute("role")?t.classList.remove("show"):(t.setAttribute("aria-selected",!1),t.setAttribute("tabindex","-1"),this._toggleDropDown(t,!1),s.trigger(t,"hidden.bs.tab",{relatedTarget:e}),s.trigger(t,Pr,{relatedTarget:e}))},t,t.classList.contains("fade")))}}a.find('[data-mdb-toggle="tab"], [data-mdb-toggle="pill"], [data-mdb-toggle="list"]').forEach(t=>{var e=Nr.getInstance(t);e||new Nr(t)}),o(()=>{const t=i();if(t){const e=t.fn.tab;t.fn.tab=Nr.jQueryInterface,t.fn.tab.Constructor=Nr,t.fn.tab.noConflict=()=>(t.fn.tab=e,Nr.jQueryInterface)}});var Mr=Nr;const Hr="tooltip";g="mdb.".concat(Hr),E=".".concat(g);const Rr="hide.bs.tooltip",Br="hidden.bs.tooltip",Wr="show.bs.tooltip",Fr="shown.bs.tooltip",qr="inserted.bs.tooltip",zr="hide".concat(E),Qr="hidden".concat(E),Vr="show".concat(E),Ur="shown".concat(E),Yr="inserted".concat(E);class Xr extends _{constructor(t,e){super(t,e),this._init()}dispose(){s.off(this._element,Wr),s.off(this._element,Fr),s.off(this._element,Rr),s.off(this._element,Br),s.off(this._element,qr),super.dispose()}static get NAME(){return Hr}_init(){this._bindShowEvent(),this._bindShownEvent(),this._bindHideEvent(),this._bindHiddenEvent(),this._bindHidePreventedEvent()}_bindShowEvent(){s.on(this.element,Wr,()=>{s.trigger(this.element,Vr)})}_bindShownEvent(){s.on(this.element,Fr,()=>{s.trigger(this.element,Ur)})}_bindHideEvent(){s.on(this.element,Rr,()=>{s.trigger(this.element,zr)})}_bindHiddenEvent(){s.on(this.element,Br,()=>{s.trigger(this.element,Qr)})}_bindHidePreventedEvent(){s.on(this.element,qr,()=>{s.trigger(this.element,Yr)})}}a.find('[data-mdb-toggle="tooltip"]').forEach(t=>{var e=Xr.getInstance(t);e||new Xr(t)}),o(()=>{const t=i();if(t){const e=t.fn[Hr];t.fn[Hr]=Xr.jQueryInterface,t.fn[Hr].Constructor=Xr,t.fn[Hr].noConflict=()=>(t.fn[Hr]=e,Xr.jQueryInterface)}});var Kr=Xr;w=".".concat("bs.toast");const $r="mouseover".concat(w),Gr="mouseout".concat(w),Zr="focusin".concat(w),Jr="focusout".concat(w),ts="hide".concat(w),es="hidden".concat(w),ns="show".concat(w),is="shown".concat(w),os="show",rs="showing",ss={animation:"boolean",autohide:"boolean",delay:"number"},as={animation:!0,autohide:!0,delay:5e3};class cs extends m{constructor(t,e){super(t,e),this._timeout=null,this._hasMouseInteraction=!1,this._hasKeyboardInteraction=!1,this._setListeners()}static get Default(){return as}static get DefaultType(){return ss}static get NAME(){return"toast"}show(){f.trigger(this._element,ns).defaultPrevented||(this._clearTimeout(),this._config.animation&&this._element.classList.add("fade"),this._element.classList.remove("hide"),gt(this._element),this._element.classList.add(os,rs),this._queueCallback(()=>{this._element.classList.remove(rs),f.trigger(this._element,is),this._maybeScheduleHide()},this._element,this._config.animation))}hide(){this.isShown()&&!f.trigger(this._element,ts).defaultPrevented&&(this._element.classList.add(rs),this._queueCallback(()=>{this._element.classList.add("hide"),this._element.classList.remove(rs,os),f.trigger(this._element,es)},this._element,this._config.animation))}dispose(){this._clearTimeout(),this.isShown()&&this._element.classList.remove(os),super.dispose()}isShown(){return this._element.classList.contains(os)}_maybeScheduleHide(){!this._config.autohide||this._hasMouseInteraction||this._hasKeyboardInteraction||(this._timeout=setTimeout(()=>{this.hide()},this._config.delay))}_onInteraction(t,e){switch(t.type){case"mouseover":case"mouseout":this._hasMouseInteraction=e;break;case"focusin":case"focusout":this._hasKeyboardInteraction=e}e?this._clearTimeout():(t=t.relatedTarget,this._element===t||this._element.contains(t)||this._maybeScheduleHide())}_setListeners(){f.on(this._element,$r,t=>this._onInteraction(t,!0)),f.on(this._element,Gr,t=>this._onInteraction(t,!1)),f.on(this._element,Zr,t=>this._onInteraction(t,!0)),f.on(this._element,Jr,t=>this._onInteraction(t,!1))}_clearTimeout(){clearTimeout(this._timeout),this._timeout=null}static jQueryInterface(e){return this.each(function(){var t=cs.getOrCreateInstance(this,e);if("string"==typeof e){if(void 0===t[e])throw new TypeError('No method named "'.concat(e,'"'));t[e](this)}})}}ve(cs),t(cs);v=cs;const ls="toast";g="mdb.".concat(ls),E=".".concat(g);const us="show.bs.toast",hs="shown.bs.toast",ds="hide.bs.toast",fs="hidden.bs.toast",ps="show".concat(E),gs="shown".concat(E),ms="hide".concat(E),_s="hidden".concat(E);class vs extends v{constructor(t,e){super(t,e),this._init()}dispose(){s.off(this._element,us),s.off(this._element,hs),s.off(this._element,ds),s.off(this._element,fs),super.dispose()}static get NAME(){return ls}_init(){this._bindShowEvent(),this._bindShownEvent(),this._bindHideEvent(),this._bindHiddenEvent()}_bindShowEvent(){s.on(this._element,us,()=>{s.trigger(this._element,ps)})}_bindShownEvent(){s.on(this._element,hs,()=>{s.trigger(this._element,gs)})}_bindHideEvent(){s.on(this._element,ds,()=>{s.trigger(this._element,ms)})}_bindHiddenEvent(){s.on(this._element,fs,()=>{s.trigger(this._element,_s)})}}a.find(".toast").forEach(t=>{var e=vs.getInstance(t);e||new vs(t)}),o(()=>{const t=i();if(t){const e=t.fn[ls];t.fn[ls]=vs.jQueryInterface,t.fn[ls].Constructor=vs,t.fn[ls].noConflict=()=>(t.fn[ls]=e,vs.jQueryInterface)}});var bs=vs;e(116);const ys="input",ws="mdb.input";_="form-outline";const Es="active",xs="form-notch",Cs="form-notch-leading",As="form-notch-middle";const Ts=".".concat(_," input"),Os=".".concat(_," textarea"),Ss=".".concat(xs),Ls=".".concat(Cs),Is=".".concat(As),ks=".".concat("form-helper");class j{constructor(t){this._element=t,this._label=null,this._labelWidth=0,this._labelMarginLeft=0,this._notchLeading=null,this._notchMiddle=null,this._notchTrailing=null,this._initiated=!1,this._helper=null,this._counter=!1,this._counterElement=null,this._maxLength=0,this._leadingIcon=null,this._element&&(r.setData(t,ws,this),this.init())}static get NAME(){return ys}get input(){return a.findOne("input",this._element)||a.findOne("textarea",this._element)}init(){this._initiated||(this._getLabelData(),this._applyDivs(),this._applyNotch(),this._activate(),this._getHelper(),this._getCounter(),this._initiated=!0)}update(){this._getLabelData(),this._getNotchData(),this._applyNotch(),this._activate(),this._getHelper(),this._getCounter()}forceActive(){c.addClass(this.input,Es)}forceInactive(){c.removeClass(this.input,Es)}dispose(){this._removeBorder(),r.removeData(this._element,ws),this._element=null}_getLabelData(){this._label=a.findOne("label",this._element),null===this._label?this._showPlaceholder():(this._getLabelWidth(),this._getLabelPositionInInputGroup(),this._toggleDefaultDatePlaceholder())}_getHelper(){this._helper=a.findOne(ks,this._element)}_getCounter(){this._counter=c.getDataAttribute(this.input,"showcounter"),this._counter&&(this._maxLength=this.input.maxLength,this._showCounter())}_showCounter(){var t;0<a.find(".form-counter",this._element).length||(this._counterElement=document.createElement("div"),c.addClass(this._counterElement,"form-counter"),t=this.input.value.length,this._counterElement.innerHTML="".concat(t," / ").concat(this._maxLength),this._helper.appendChild(this._counterElement),this._bindCounter())}_bindCounter(){s.on(this.input,"input",()=>{var t=this.input.value.length;this._counterElement.innerHTML="".concat(t," / ").concat(this._maxLength)})}_toggleDefaultDatePlaceholder(){var t=0<arguments.length&&void 0!==arguments[0]?arguments[0]:this.input;"date"===t.getAttribute("type")&&(document.activeElement===t||t.value?t.style.opacity=1:t.style.opacity=0)}_showPlaceholder(){c.addClass(this.input,"placeholder-active")}_getNotchData(){this._notchMiddle=a.findOne(Is,this._element),this._notchLeading=a.findOne(Ls,this._element)}_getLabelWidth(){this._labelWidth=.8*this._label.clientWidth+8}_getLabelPositionInInputGroup(){var t;this._labelMarginLeft=0,this._element.classList.contains("input-group")&&(t=this.input,t=a.prev(t,".input-group-text")[0],this._labelMarginLeft=void 0===t?0:t.offsetWidth-1)}_applyDivs(){var t=a.find(Ss,this._element),e=W("div");c.addClass(e,xs),this._notchLeading=W("div"),c.addClass(this._notchLeading,Cs),this._notchMiddle=W("div"),c.addClass(this._notchMiddle,As),this._notchTrailing=W("div"),c.addClass(this._notchTrailing,"form-notch-trailing"),1<=t.length||(e.append(this._notchLeading),e.append(this._notchMiddle),e.append(this._notchTrailing),this._element.append(e))}_applyNotch(){this._notchMiddle.style.width="".concat(this._labelWidth,"px"),this._notchLeading.style.width="".concat(this._labelMarginLeft+9,"px"),null!==this._label&&(this._label.style.marginLeft="".concat(this._labelMarginLeft,"px"))}_removeBorder(){var t=a.findOne(Ss,this._element);t&&t.remove()}_activate(e){o(()=>{this._getElements(e);var t=e?e.target:this.input;""!==t.value&&c.addClass(t,Es),this._toggleDefaultDatePlaceholder(t)})}_getElements(t){var e;t&&(this._element=t.target.parentNode,this._label=a.findOne("label",this._element)),t&&this._label&&(e=this._labelWidth,this._getLabelData(),e!==this._labelWidth)&&(this._notchMiddle=a.findOne(".form-notch-middle",t.target.parentNode),this._notchLeading=a.findOne(Ls,t.target.parentNode),this._applyNotch())}_deactivate(t){t=t?t.target:this.input;""===t.value&&t.classList.remove(Es),this._toggleDefaultDatePlaceholder(t)}static activate(e){return function(t){e._activate(t)}}static deactivate(e){return function(t){e._deactivate(t)}}static jQueryInterface(n,i){return this.each(function(){let t=r.getData(this,ws);var e="object"==typeof n&&n;if((t||!/dispose/.test(n))&&(t=t||new j(this,e),"string"==typeof n)){if(void 0===t[n])throw new TypeError('No method named "'.concat(n,'"'));t[n](i)}})}static getInstance(t){return r.getData(t,ws)}static getOrCreateInstance(t){var e=1<arguments.length&&void 0!==arguments[1]?arguments[1]:{};return this.getInstance(t)||new this(t,"object"==typeof e?e:null)}}s.on(document,"focus",Ts,j.activate(new j)),s.on(document,"input",Ts,j.activate(new j)),s.on(document,"blur",Ts,j.deactivate(new j)),s.on(document,"focus",Os,j.activate(new j)),s.on(document,"input",Os,j.activate(new j)),s.on(document,"blur",Os,j.deactivate(new j)),s.on(window,"shown.bs.modal",t=>{a.find(Ts,t.target).forEach(t=>{t=j.getInstance(t.parentNode);t&&t.update()}),a.find(Os,t.target).forEach(t=>{t=j.getInstance(t.parentNode);t&&t.update()})}),s.on(window,"shown.bs.dropdown",t=>{t=t.target.parentNode.querySelector(".dropdown-menu");t&&(a.find(Ts,t).forEach(t=>{t=j.getInstance(t.parentNode);t&&t.update()}),a.find(Os,t).forEach(t=>{t=j.getInstance(t.parentNode);t&&t.update()}))}),s.on(window,"shown.bs.tab",t=>{let e;e=(t.target.href||c.getDataAttribute(t.target,"target")).split("#")[1];t=a.findOne("#".concat(e));a.find(Ts,t).forEach(t=>{t=j.getInstance(t.parentNode);t&&t.update()}),a.find(Os,t).forEach(t=>{t=j.getInstance(t.parentNode);t&&t.update()})}),a.find(".".concat(_)).map(t=>new j(t)),s.on(window,"reset",t=>{a.find(Ts,t.target).forEach(t=>{t=j.getInstance(t.parentNode);t&&t.forceInactive()}),a.find(Os,t.target).forEach(t=>{t=j.getInstance(t.parentNode);t&&t.forceInactive()})}),s.on(window,"onautocomplete",t=>{var e=j.getInstance(t.target.parentNode);e&&t.cancelable&&e.forceActive()}),o(()=>{const t=i();if(t){const e=t.fn[ys];t.fn[ys]=j.jQueryInterface,t.fn[ys].Constructor=j,t.fn[ys].noConflict=()=>(t.fn[ys]=e,j.jQueryInterface)}});var Ds=j;w=".".concat("bs.collapse");const js="show".concat(w),Ps="shown".concat(w),Ns="hide".concat(w),Ms="hidden".concat(w);g="click".concat(w).concat(".data-api");const Hs="show",Rs="collapse",Bs="collapsing",Ws=":scope .".concat(Rs," .").concat(Rs),Fs='[data-mdb-toggle="collapse"]',qs={parent:null,toggle:!0},zs={parent:"(null|element)",toggle:"boolean"};class Qs extends m{constructor(t,e){super(t,e),this._isTransitioning=!1,this._triggerArray=[];for(const o of y.find(Fs)){var n=ct(o),i=y.find(n).filter(t=>t===this._element);null!==n&&i.length&&this._triggerArray.push(o)}this._initializeChildren(),this._config.parent||this._addAriaAndCollapsedClass(this._triggerArray,this._isShown()),this._config.toggle&&this.toggle()}static get Default(){return qs}static get DefaultType(){return zs}static get NAME(){return"collapse"}toggle(){this._isShown()?this.hide():this.show()}show(){if(!this._isTransitioning&&!this._isShown()){let t=[];if(!(t=this._config.parent?this._getFirstLevelChildren(".collapse.show, .collapse.collapsing").filter(t=>t!==this._element).map(t=>Qs.getOrCreateInstance(t,{toggle:!1})):t).length||!t[0]._isTransitioning){var e=f.trigger(this._element,js);if(!e.defaultPrevented){for(const i of t)i.hide();const n=this._getDimension();this._element.classList.remove(Rs),this._element.classList.add(Bs),this._element.style[n]=0,this._addAriaAndCollapsedClass(this._triggerArray,!0),this._isTransitioning=!0;e=n[0].toUpperCase()+n.slice(1),e="scroll".concat(e);this._queueCallback(()=>{this._isTransitioning=!1,this._element.classList.remove(Bs),this._element.classList.add(Rs,Hs),this._element.style[n]="",f.trigger(this._element,Ps)},this._element,!0),this._element.style[n]="".concat(this._element[e],"px")}}}}hide(){if(!this._isTransitioning&&this._isShown()){var t=f.trigger(this._element,Ns);if(!t.defaultPrevented){t=this._getDimension();this._element.style[t]="".concat(this._element.getBoundingClientRect()[t],"px"),gt(this._element),this._element.classList.add(Bs),this._element.classList.remove(Rs,Hs);for(const n of this._triggerArray){var e=l(n);e&&!this._isShown(e)&&this._addAriaAndCollapsedClass([n],!1)}this._isTransitioning=!0;this._element.style[t]="",this._queueCallback(()=>{this._isTransitioning=!1,this._element.classList.remove(Bs),this._element.classList.add(Rs),f.trigger(this._element,Ms)},this._element,!0)}}}_isShown(){return(0<arguments.length&&void 0!==arguments[0]?arguments[0]:this._element).classList.contains(Hs)}_configAfterMerge(t){return t.toggle=Boolean(t.toggle),t.parent=ut(t.parent),t}_getDimension(){return this._element.classList.contains("collapse-horizontal")?"width":"height"}_initializeChildren(){if(this._config.parent)for(const e of this._getFirstLevelChildren(Fs)){var t=l(e);t&&this._addAriaAndCollapsedClass([e],this._isShown(t))}}_getFirstLevelChildren(t){const e=y.find(Ws,this._config.parent);return y.find(t,this._config.parent).filter(t=>!e.includes(t))}_addAriaAndCollapsedClass(t,e){if(t.length)for(const n of t)n.classList.toggle("collapsed",!e),n.setAttribute("aria-expanded",e)}static jQueryInterface(e){const n={};return"string"==typeof e&&/show|hide/.test(e)&&(n.toggle=!1),this.each(function(){var t=Qs.getOrCreateInstance(this,n);if("string"==typeof e){if(void 0===t[e])throw new TypeError('No method named "'.concat(e,'"'));t[e]()}})}}f.on(document,g,Fs,function(t){("A"===t.target.tagName||t.delegateTarget&&"A"===t.delegateTarget.tagName)&&t.preventDefault();t=ct(this);for(const e of y.find(t))Qs.getOrCreateInstance(e,{toggle:!1}).toggle()}),t(Qs);E=Qs;const Vs="collapse";v="mdb.".concat(Vs),e=".".concat(v);const Us="show.bs.collapse",Ys="shown.bs.collapse",Xs="hide.bs.collapse",Ks="hidden.bs.collapse",$s="show".concat(e),Gs="shown".concat(e),Zs="hide".concat(e),Js="hidden".concat(e);class ta extends E{constructor(t){super(t,1<arguments.length&&void 0!==arguments[1]?arguments[1]:{}),this._init()}dispose(){s.off(this._element,Us),s.off(this._element,Ys),s.off(this._element,Xs),s.off(this._element,Ks),super.dispose()}static get NAME(){return Vs}_init(){this._bindShowEvent(),this._bindShownEvent(),this._bindHideEvent(),this._bindHiddenEvent()}_bindShowEvent(){s.on(this._element,Us,()=>{s.trigger(this._element,$s)})}_bindShownEvent(){s.on(this._element,Ys,()=>{s.trigger(this._element,Gs)})}_bindHideEvent(){s.on(this._element,Xs,()=>{s.trigger(this._element,Zs)})}_bindHiddenEvent(){s.on(this._element,Ks,()=>{s.trigger(this._element,Js)})}}a.find('[data-mdb-toggle="collapse"]').forEach(t=>{var e=ta.getInstance(t);e||new ta(t,{toggle:!1})}),o(()=>{const t=i();if(t){const e=t.fn[Vs];t.fn[Vs]=ta.jQueryInterface,t.fn[Vs].Constructor=ta,t.fn[Vs].noConflict=()=>(t.fn[Vs]=e,ta.jQueryInterface)}});var ea=ta;const na="dropdown";_=".".concat("bs.dropdown"),w=".data-api";const ia="ArrowDown",oa="hide".concat(_),ra="hidden".concat(_),sa="show".concat(_),aa="shown".concat(_);g="click".concat(_).concat(w),v="keydown".concat(_).concat(w),e="keyup".concat(_).concat(w);const ca="show",la='[data-mdb-toggle="dropdown"]:not(.disabled):not(:disabled)',ua="".concat(la,".").concat(ca),ha=".dropdown-menu",da=h()?"top-end":"top-start",fa=h()?"top-start":"top-end",pa=h()?"bottom-end":"bottom-start",ga=h()?"bottom-start":"bottom-end",ma=h()?"left-start":"right-start",_a=h()?"right-start":"left-start",va={autoClose:!0,boundary:"clippingParents",display:"dynamic",offset:[0,2],popperConfig:null,reference:"toggle"},ba={autoClose:"(boolean|string)",boundary:"(string|element)",display:"string",offset:"(array|string|function)",popperConfig:"(null|object|function)",reference:"(string|element|object)"};class P extends m{constructor(t,e){super(t,e),this._popper=null,this._parent=this._element.parentNode,this._menu=y.next(this._element,ha)[0]||y.prev(this._element,ha)[0]||y.findOne(ha,this._parent),this._inNavbar=this._detectNavbar()}static get Default(){return va}static get DefaultType(){return ba}static get NAME(){return na}toggle(){return this._isShown()?this.hide():this.show()}show(){if(!dt(this._element)&&!this._isShown()){var t={relatedTarget:this._element},e=f.trigger(this._element,sa,t);if(!e.defaultPrevented){if(this._createPopper(),"ontouchstart"in document.documentElement&&!this._parent.closest(".navbar-nav"))for(const n of[].concat(...document.body.children))f.on(n,"mouseover",pt);this._element.focus(),this._element.setAttribute("aria-expanded",!0),this._menu.classList.add(ca),this._element.classList.add(ca),f.trigger(this._element,aa,t)}}}hide(){var t;!dt(this._element)&&this._isShown()&&(t={relatedTarget:this._element},this._completeHide(t))}dispose(){this._popper&&this._popper.destroy(),super.dispose()}update(){this._inNavbar=this._detectNavbar(),this._popper&&this._popper.update()}_completeHide(t){var e=f.trigger(this._element,oa,t);if(!e.defaultPrevented){if("ontouchstart"in document.documentElement)for(const n of[].concat(...document.body.children))f.off(n,"mouseover",pt);this._popper&&this._popper.destroy(),this._menu.classList.remove(ca),this._element.classList.remove(ca),this._element.setAttribute("aria-expanded","false"),p.removeDataAttribute(this._menu,"popper"),f.trigger(this._element,ra,t)}}_getConfig(t){if("object"!=typeof(t=super._getConfig(t)).reference||u(t.reference)||"function"==typeof t.reference.getBoundingClientRect)return t;throw new TypeError("".concat(na.toUpperCase(),': Option "reference" provided type "object" without a required "getBoundingClientRect" method.'))}_createPopper(){if(void 0===n)throw new TypeError("Bootstrap's dropdowns require Popper (https://popper.js.org)");let t=this._element;"parent"===this._config.reference?t=this._parent:u(this._config.reference)?t=ut(this._config.reference):"object"==typeof this._config.reference&&(t=this._config.reference);var e=this._getPopperConfig();this._popper=_o(t,this._menu,e)}_isShown(){return this._menu.classList.contains(ca)}_getPlacement(){var t,e=this._parent;return e.classList.contains("dropend")?ma:e.classList.contains("dropstart")?_a:e.classList.contains("dropup-center")?"top":e.classList.contains("dropdown-center")?"bottom":(t="end"===getComputedStyle(this._menu).getPropertyValue("--bs-position").trim(),e.classList.contains("dropup")?t?fa:da:t?ga:pa)}_detectNavbar(){return null!==this._element.closest(".navbar")}_getOffset(){const e=this._config["offset"];return"string"==typeof e?e.split(",").map(t=>Number.parseInt(t,10)):"function"==typeof e?t=>e(t,this._element):e}_getPopperConfig(){var t={placement:this._getPlacement(),modifiers:[{name:"preventOverflow",options:{boundary:this._config.boundary}},{name:"offset",options:{offset:this._getOffset()}}]};return!this._inNavbar&&"static"!==this._config.display||(p.setDataAttribute(this._menu,"popper","static"),t.modifiers=[{name:"applyStyles",enabled:!1}]),{...t,..."function"==typeof this._config.popperConfig?this._config.popperConfig(t):this._config.popperConfig}}_selectMenuItem(t){var{key:t,target:e}=t,n=y.find(".dropdown-menu .dropdown-item:not(.disabled):not(:disabled)",this._menu).filter(t=>ht(t));n.length&&bt(n,e,t===ia,!n.includes(e)).focus()}static jQueryInterface(e){return this.each(function(){var t=P.getOrCreateInstance(this,e);if("string"==typeof e){if(void 0===t[e])throw new TypeError('No method named "'.concat(e,'"'));t[e]()}})}static clearMenus(t){if(2!==t.button&&("keyup"!==t.type||"Tab"===t.key))for(const o of y.find(ua)){var e,n,i=P.getInstance(o);i&&!1!==i._config.autoClose&&(e=(n=t.composedPath()).includes(i._menu),n.includes(i._element)||"inside"===i._config.autoClose&&!e||"outside"===i._config.autoClose&&e||i._menu.contains(t.target)&&("keyup"===t.type&&"Tab"===t.key||/input|select|option|textarea|form/i.test(t.target.tagName))||(n={relatedTarget:i._element},"click"===t.type&&(n.clickEvent=t),i._completeHide(n)))}}static dataApiKeydownHandler(t){var e=/input|textarea/i.test(t.target.tagName),n="Escape"===t.key,i=["ArrowUp",ia].includes(t.key);!i&&!n||e&&!n||(t.preventDefault(),e=this.matches(la)?this:y.prev(this,la)[0]||y.next(this,la)[0]||y.findOne(la,t.delegateTarget.parentNode),n=P.getOrCreateInstance(e),i?(t.stopPropagation(),n.show(),n._selectMenuItem(t)):n._isShown()&&(t.stopPropagation(),n.hide(),e.focus()))}}f.on(document,v,la,P.dataApiKeydownHandler),f.on(document,v,ha,P.dataApiKeydownHandler),f.on(document,g,P.clearMenus),f.on(document,e,P.clearMenus),f.on(document,g,la,function(t){t.preventDefault(),P.getOrCreateInstance(this).toggle()}),t(P);E=P;const ya="dropdown";_="mdb.".concat(ya),w=".".concat(_);const wa={offset:[0,2],flip:!0,boundary:"clippingParents",reference:"toggle",display:"dynamic",popperConfig:null,dropdownAnimation:"on"},Ea={offset:"(array|string|function)",flip:"boolean",boundary:"(string|element)",reference:"(string|element|object)",display:"string",popperConfig:"(null|object|function)",dropdownAnimation:"string"},xa="hide.bs.dropdown",Ca="hidden.bs.dropdown",Aa="show.bs.dropdown",Ta="shown.bs.dropdown",Oa="hide".concat(w),Sa="hidden".concat(w),La="show".concat(w),Ia="shown".concat(w),ka="animation",Da="fade-in",ja="fade-out";class Pa extends E{constructor(t,e){super(t,e),this._config=this._getConfig(e),this._menuStyle="",this._popperPlacement="",this._mdbPopperConfig="";t=window.matchMedia("(prefers-reduced-motion: reduce)").matches;"on"!==this._config.dropdownAnimation||t||this._init()}dispose(){s.off(this._element,Aa),s.off(this._parent,Ta),s.off(this._parent,xa),s.off(this._parent,Ca),super.dispose()}static get NAME(){return ya}_init(){this._bindShowEvent(),this._bindShownEvent(),this._bindHideEvent(),this._bindHiddenEvent()}_getConfig(t){t={...wa,...c.getDataAttributes(this._element),...t};return B(ya,t,Ea),t}_getOffset(){const e=this._config["offset"];return"string"==typeof e?e.split(",").map(t=>Number.parseInt(t,10)):"function"==typeof e?t=>e(t,this._element):e}_getPopperConfig(){var t={placement:this._getPlacement(),modifiers:[{name:"preventOverflow",options:{altBoundary:this._config.flip,boundary:this._config.boundary}},{name:"offset",options:{offset:this._getOffset()}}]};return"static"===this._config.display&&(t.modifiers=[{name:"applyStyles",enabled:!1}]),{...t,..."function"==typeof this._config.popperConfig?this._config.popperConfig(t):this._config.popperConfig}}_bindShowEvent(){s.on(this._element,Aa,t=>{s.trigger(this._element,La,{relatedTarget:t.relatedTarget}).defaultPrevented?t.preventDefault():this._dropdownAnimationStart("show")})}_bindShownEvent(){s.on(this._parent,Ta,t=>{s.trigger(this._parent,Ia,{relatedTarget:t.relatedTarget}).defaultPrevented&&t.preventDefault()})}_bindHideEvent(){s.on(this._parent,xa,t=>{s.trigger(this._parent,Oa,{relatedTarget:t.relatedTarget}).defaultPrevented?t.preventDefault():(this._menuStyle=this._menu.style.cssText,this._popperPlacement=this._menu.getAttribute("data-popper-placement"),this._mdbPopperConfig=this._menu.getAttribute("data-mdb-popper"))})}_bindHiddenEvent(){s.on(this._parent,Ca,t=>{s.trigger(this._parent,Sa,{relatedTarget:t.relatedTarget}).defaultPrevented?t.preventDefault():("static"!==this._config.display&&""!==this._menuStyle&&(this._menu.style.cssText=this._menuStyle),this._menu.setAttribute("data-popper-placement",this._popperPlacement),this._menu.setAttribute("data-mdb-popper",this._mdbPopperConfig),this._dropdownAnimationStart("hide"))})}_dropdownAnimationStart(t){"show"===t?(this._menu.classList.add(ka,Da),this._menu.classList.remove(ja)):(this._menu.classList.add(ka,ja),this._menu.classList.remove(Da)),this._bindAnimationEnd()}_bindAnimationEnd(){s.one(this._menu,"animationend",()=>{this._menu.classList.remove(ka,ja,Da)})}}a.find('[data-mdb-toggle="dropdown"]').forEach(t=>{var e=Pa.getInstance(t);e||new Pa(t)}),o(()=>{const t=i();if(t){const e=t.fn[ya];t.fn[ya]=Pa.jQueryInterface,t.fn[ya].Constructor=Pa,t.fn[ya].noConflict=()=>(t.fn[ya]=e,Pa.jQueryInterface)}});var Na=Pa;const Ma="ripple",Ha="mdb.ripple",N="ripple-surface",Ra="ripple-wave",Ba="input-wrapper",Wa=[".btn",".ripple"],Fa="ripple-surface-unbound",qa=[0,0,0],za=["primary","secondary","success","danger","warning","info","light","dark"],Qa={rippleCentered:!1,rippleColor:"",rippleDuration:"500ms",rippleRadius:0,rippleUnbound:!1},Va={rippleCentered:"boolean",rippleColor:"string",rippleDuration:"string",rippleRadius:"number",rippleUnbound:"boolean"};class Ua{constructor(t,e){this._element=t,this._options=this._getConfig(e),this._element&&(r.setData(t,Ha,this),c.addClass(this._element,N)),this._clickHandler=this._createRipple.bind(this),this._rippleTimer=null,this._isMinWidthSet=!1,this._rippleInSpan=!1,this.init()}static get NAME(){return Ma}init(){this._addClickEvent(this._element)}dispose(){r.removeData(this._element,Ha),s.off(this._element,"click",this._clickHandler),this._element=null,this._options=null}_autoInit(e){if(Wa.forEach(t=>{a.closest(e.target,t)&&(this._element=a.closest(e.target,t))}),this._options=this._getConfig(),"input"===this._element.tagName.toLowerCase()){var t=this._element.parentNode;if(this._rippleInSpan=!0,"span"===t.tagName.toLowerCase()&&t.classList.contains(N))this._element=t;else{var n=getComputedStyle(this._element).boxShadow;const o=this._element;var i=document.createElement("span");o.classList.contains("btn-block")&&(i.style.display="block"),s.one(i,"mouseup",t=>{0===t.button&&o.click()}),i.classList.add(N,Ba),c.addStyle(i,{border:0,"box-shadow":n}),t.replaceChild(i,this._element),i.appendChild(this._element),this._element=i}this._element.focus()}this._element.style.minWidth||(c.style(this._element,{"min-width":"".concat(getComputedStyle(this._element).width)}),this._isMinWidthSet=!0),c.addClass(this._element,N),this._createRipple(e)}_addClickEvent(t){s.on(t,"mousedown",this._clickHandler)}_getEventLayer(t){return{layerX:Math.round(t.clientX-t.target.getBoundingClientRect().x),layerY:Math.round(t.clientY-t.target.getBoundingClientRect().y)}}_createRipple(t){c.hasClass(this._element,N)||c.addClass(this._element,N);var{layerX:t,layerY:e}=this._getEventLayer(t),n=this._element.offsetHeight,i=this._element.offsetWidth,o=this._durationToMsNumber(this._options.rippleDuration),r={offsetX:this._options.rippleCentered?n/2:t,offsetY:this._options.rippleCentered?i/2:e,height:n,width:i},r=this._getDiameter(r),s=this._options.rippleRadius||r/2,a={delay:.5*o,duration:o-.5*o},i={left:this._options.rippleCentered?"".concat(i/2-s,"px"):"".concat(t-s,"px"),top:this._options.rippleCentered?"".concat(n/2-s,"px"):"".concat(e-s,"px"),height:"".concat(2*this._options.rippleRadius||r,"px"),width:"".concat(2*this._options.rippleRadius||r,"px"),transitionDelay:"0s, ".concat(a.delay,"ms"),transitionDuration:"".concat(o,"ms, ").concat(a.duration,"ms")},t=W("div");this._createHTMLRipple({wrapper:this._element,ripple:t,styles:i}),this._removeHTMLRipple({ripple:t,duration:o})}_createHTMLRipple(t){let{wrapper:e,ripple:n,styles:i}=t;Object.keys(i).forEach(t=>n.style[t]=i[t]),n.classList.add(Ra),""!==this._options.rippleColor&&(this._removeOldColorClasses(e),this._addColor(n,e)),this._toggleUnbound(e),this._appendRipple(n,e)}_removeHTMLRipple(t){let{ripple:e,duration:n}=t;this._rippleTimer&&(clearTimeout(this._rippleTimer),this._rippleTimer=null),this._rippleTimer=setTimeout(()=>{e&&(e.remove(),this._element)&&(a.find(".".concat(Ra),this._element).forEach(t=>{t.remove()}),this._isMinWidthSet&&(c.style(this._element,{"min-width":""}),this._isMinWidthSet=!1),this._rippleInSpan&&this._element.classList.contains(Ba)?this._removeWrapperSpan():c.removeClass(this._element,N))},n)}_removeWrapperSpan(){var t=this._element.firstChild;this._element.replaceWith(t),this._element=t,this._element.focus(),this._rippleInSpan=!1}_durationToMsNumber(t){return Number(t.replace("ms","").replace("s","000"))}_getConfig(){var t=0<arguments.length&&void 0!==arguments[0]?arguments[0]:{},e=c.getDataAttributes(this._element),t={...Qa,...e,...t};return B(Ma,t,Va),t}_getDiameter(t){var{offsetX:t,offsetY:e,height:n,width:i}=t,o=e<=n/2,r=t<=i/2,s=(t,e)=>Math.sqrt(t**2+e**2),a=e===n/2&&t===i/2;const c=!0==o&&!1==r,l=!0==o&&!0==r,u=!1==o&&!0==r,h=!1==o&&!1==r;o={topLeft:s(t,e),topRight:s(i-t,e),bottomLeft:s(t,n-e),bottomRight:s(i-t,n-e)};let d=0;return a||h?d=o.topLeft:u?d=o.topRight:l?d=o.bottomRight:c&&(d=o.bottomLeft),2*d}_appendRipple(t,e){e.appendChild(t),setTimeout(()=>{c.addClass(t,"active")},50)}_toggleUnbound(t){!0===this._options.rippleUnbound?c.addClass(t,Fa):t.classList.remove(Fa)}_addColor(t,e){za.find(t=>t===this._options.rippleColor.toLowerCase())?c.addClass(e,"".concat(N,"-").concat(this._options.rippleColor.toLowerCase())):(e=this._colorToRGB(this._options.rippleColor).join(","),e="rgba({{color}}, 0.2) 0, rgba({{color}}, 0.3) 40%, rgba({{color}}, 0.4) 50%, rgba({{color}}, 0.5) 60%, rgba({{color}}, 0) 70%".split("{{color}}").join("".concat(e)),t.style.backgroundImage="radial-gradient(circle, ".concat(e,")"))}_removeOldColorClasses(e){var t=new RegExp("".concat(N,"-[a-z]+"),"gi");(e.classList.value.match(t)||[]).forEach(t=>{e.classList.remove(t)})}_colorToRGB(t){var e,n,i;return"transparent"===t.toLowerCase()?qa:"#"===t[0]?((e=t).length<7&&(e="#".concat(e[1]).concat(e[1]).concat(e[2]).concat(e[2]).concat(e[3]).concat(e[3])),[parseInt(e.substr(1,2),16),parseInt(e.substr(3,2),16),parseInt(e.substr(5,2),16)]):(-1===t.indexOf("rgb")&&(e=t,n=document.body.appendChild(document.createElement("fictum")),i="rgb(1, 2, 3)",n.style.color=i,t=n.style.color!==i||(n.style.color=e,n.style.color===i)||""===n.style.color?qa:(e=getComputedStyle(n).color,document.body.removeChild(n),e)),0===t.indexOf("rgb")?((i=(i=t).match(/[.\d]+/g).map(t=>+Number(t))).length=3,i):qa)}static autoInitial(e){return function(t){e._autoInit(t)}}static jQueryInterface(t){return this.each(function(){return r.getData(this,Ha)?null:new Ua(this,t)})}static getInstance(t){return r.getData(t,Ha)}static getOrCreateInstance(t){var e=1<arguments.length&&void 0!==arguments[1]?arguments[1]:{};return this.getInstance(t)||new this(t,"object"==typeof e?e:null)}}Wa.forEach(t=>{s.one(document,"mousedown",t,Ua.autoInitial(new Ua))}),o(()=>{const t=i();if(t){const e=t.fn[Ma];t.fn[Ma]=Ua.jQueryInterface,t.fn[Ma].Constructor=Ua,t.fn[Ma].noConflict=()=>(t.fn[Ma]=e,Ua.jQueryInterface)}});var Ya=Ua;const Xa="range",Ka="mdb.range";const $a="thumb-active";const Ga=".".concat("thumb-value"),Za=".".concat("thumb");m=".".concat("range");class Ja{constructor(t){this._element=t,this._initiated=!1,this._thumb=null,this._element&&(r.setData(t,Ka,this),this.init())}static get NAME(){return Xa}get rangeInput(){return a.findOne("input[type=range]",this._element)}init(){this._initiated||(this._addThumb(),this._thumbUpdate(),this._handleEvents(),this._initiated=!0)}dispose(){this._disposeEvents(),r.removeData(this._element,Ka),this._element=null,this._thumb=null}_addThumb(){var t=W("span");c.addClass(t,"thumb"),t.innerHTML='<span class="thumb-value"></span>',this._element.append(t),this._thumb=a.findOne(Za,this._element)}_handleEvents(){s.on(this.rangeInput,"mousedown",()=>this._showThumb()),s.on(this.rangeInput,"mouseup",()=>this._hideThumb()),s.on(this.rangeInput,"touchstart",()=>this._showThumb()),s.on(this.rangeInput,"touchend",()=>this._hideThumb()),s.on(this.rangeInput,"input",()=>this._thumbUpdate())}_disposeEvents(){s.off(this.rangeInput,"mousedown",this._showThumb),s.off(this.rangeInput,"mouseup",this._hideThumb),s.off(this.rangeInput,"touchstart",this._showThumb),s.off(this.rangeInput,"touchend",this._hideThumb),s.off(this.rangeInput,"input",this._thumbUpdate)}_showThumb(){c.addClass(this._thumb,$a)}_hideThumb(){c.removeClass(this._thumb,$a)}_thumbUpdate(){var t=this.rangeInput,e=t.value,n=t.min||0,t=t.max||100,e=(a.findOne(Ga,this._thumb).textContent=e,Number(100*(e-n)/(t-n)));c.style(this._thumb,{left:"calc(".concat(e,"% + (").concat(8-.15*e,"px))")})}static getInstance(t){return r.getData(t,Ka)}static getOrCreateInstance(t){var e=1<arguments.length&&void 0!==arguments[1]?arguments[1]:{};return this.getInstance(t)||new this(t,"object"==typeof e?e:null)}static jQueryInterface(n,i){return this.each(function(){let t=r.getData(this,Ka);var e="object"==typeof n&&n;if((t||!/dispose/.test(n))&&(t=t||new Ja(this,e),"string"==typeof n)){if(void 0===t[n])throw new TypeError('No method named "'.concat(n,'"'));t[n](i)}})}}a.find(m).map(t=>new Ja(t)),o(()=>{const t=i();if(t){const e=t.fn[Xa];t.fn[Xa]=Ja.jQueryInterface,t.fn[Xa].Constructor=Ja,t.fn[Xa].noConflict=()=>(t.fn[Xa]=e,Ja.jQueryInterface)}});var tc=Ja}],i={},o.m=n,o.c=i,o.d=function(t,e,n){o.o(t,e)||Object.defineProperty(t,e,{enumerable:!0,get:n})},o.r=function(t){"undefined"!=typeof Symbol&&Symbol.toStringTag&&Object.defineProperty(t,Symbol.toStringTag,{value:"Module"}),Object.defineProperty(t,"__esModule",{value:!0})},o.t=function(e,t){if(1&t&&(e=o(e)),8&t)return e;if(4&t&&"object"==typeof e&&e&&e.__esModule)return e;var n=Object.create(null);if(o.r(n),Object.defineProperty(n,"default",{enumerable:!0,value:e}),2&t&&"string"!=typeof e)for(var i in e)o.d(n,i,function(t){return e[t]}.bind(null,i));return n},o.n=function(t){var e=t&&t.__esModule?function(){return t.default}:function(){return t};return o.d(e,"a",e),e},o.o=function(t,e){return Object.prototype.hasOwnProperty.call(t,e)},o.p="",o(o.s=119);function o(t){var e;return(i[t]||(e=i[t]={i:t,l:!1,exports:{}},n[t].call(e.exports,e,e.exports,o),e.l=!0,e)).exports}var n,i});
+/*!
+ * MDB5
+ *   Version: FREE 6.2.0
+ * 
+ * 
+ *   Copyright: Material Design for Bootstrap
+ *   https://mdbootstrap.com/
+ * 
+ *   Read the license: https://mdbootstrap.com/general/license/
+ * 
+ * 
+ *   Documentation: https://mdbootstrap.com/docs/standard/
+ * 
+ *   Support: https://mdbootstrap.com/support/
+ * 
+ *   Contact: contact@mdbootstrap.com
+ * 
+ */
+!function(t,e){"object"==typeof exports&&"object"==typeof module?module.exports=e():"function"==typeof define&&define.amd?define("mdb",[],e):"object"==typeof exports?exports.mdb=e():t.mdb=e()}(this,function(){return n=[function(t,e,n){"use strict";var i=n(16),o=n(68),r=n(44),s=n(25),a=n(11).f,c=n(110),l=n(113),u=n(24),n=n(8),h="Array Iterator",d=s.set,f=s.getterFor(h),s=(t.exports=c(Array,"Array",function(t,e){d(this,{type:h,target:i(t),index:0,kind:e})},function(){var t=f(this),e=t.target,n=t.kind,i=t.index++;return!e||i>=e.length?(t.target=void 0,l(void 0,!0)):l("keys"==n?i:"values"==n?e[i]:[i,e[i]],!1)},"values"),r.Arguments=r.Array);if(o("keys"),o("values"),o("entries"),!u&&n&&"values"!==s.name)try{a(s,"name",{value:"values"})}catch(t){}},function(t,e,n){function i(e,t){if(e){if(e[u]!==d)try{l(e,u,d)}catch(t){e[u]=d}if(e[h]||l(e,h,t),s[t])for(var n in c)if(e[n]!==c[n])try{l(e,n,c[n])}catch(t){e[n]=c[n]}}}var o,r=n(6),s=n(114),a=n(115),c=n(0),l=n(15),n=n(7),u=n("iterator"),h=n("toStringTag"),d=c.values;for(o in s)i(r[o]&&r[o].prototype,o);i(a,"DOMTokenList")},function(t,e){t.exports=function(t){try{return!!t()}catch(t){return!0}}},function(t,e,n){var n=n(47),i=n.all;t.exports=n.IS_HTMLDDA?function(t){return"function"==typeof t||t===i}:function(t){return"function"==typeof t}},function(t,e,n){var n=n(30),i=Function.prototype,o=i.call,i=n&&i.bind.bind(o,o);t.exports=n?i:function(t){return function(){return o.apply(t,arguments)}}},function(t,e,n){"use strict";var i=n(22),n=n(43);i({target:"RegExp",proto:!0,forced:/./.exec!==n},{exec:n})},function(n,t,e){!function(t){function e(t){return t&&t.Math==Math&&t}n.exports=e("object"==typeof globalThis&&globalThis)||e("object"==typeof window&&window)||e("object"==typeof self&&self)||e("object"==typeof t&&t)||function(){return this}()||Function("return this")()}.call(this,e(72))},function(t,e,n){var i=n(6),o=n(34),r=n(9),s=n(53),a=n(50),n=n(49),c=i.Symbol,l=o("wks"),u=n?c.for||c:c&&c.withoutSetter||s;t.exports=function(t){return r(l,t)||(l[t]=a&&r(c,t)?c[t]:u("Symbol."+t)),l[t]}},function(t,e,n){n=n(2);t.exports=!n(function(){return 7!=Object.defineProperty({},1,{get:function(){return 7}})[1]})},function(t,e,n){var i=n(4),o=n(37),r=i({}.hasOwnProperty);t.exports=Object.hasOwn||function(t,e){return r(o(t),e)}},function(t,e,n){"use strict";var i=n(22),o=n(59).includes,r=n(2),n=n(68);i({target:"Array",proto:!0,forced:r(function(){return!Array(1).includes()})},{includes:function(t){return o(this,t,1<arguments.length?arguments[1]:void 0)}}),n("includes")},function(t,e,n){var i=n(8),o=n(54),r=n(55),s=n(14),a=n(46),c=TypeError,l=Object.defineProperty,u=Object.getOwnPropertyDescriptor,h="enumerable",d="configurable",f="writable";e.f=i?r?function(t,e,n){var i;return s(t),e=a(e),s(n),"function"==typeof t&&"prototype"===e&&"value"in n&&f in n&&!n[f]&&(i=u(t,e))&&i[f]&&(t[e]=n.value,n={configurable:(d in n?n:i)[d],enumerable:(h in n?n:i)[h],writable:!1}),l(t,e,n)}:l:function(t,e,n){if(s(t),e=a(e),s(n),o)try{return l(t,e,n)}catch(t){}if("get"in n||"set"in n)throw c("Accessors not supported");return"value"in n&&(t[e]=n.value),t}},function(t,e,n){var n=n(30),i=Function.prototype.call;t.exports=n?i.bind(i):function(){return i.apply(i,arguments)}},function(t,e,n){var i=n(3),n=n(47),o=n.all;t.exports=n.IS_HTMLDDA?function(t){return"object"==typeof t?null!==t:i(t)||t===o}:function(t){return"object"==typeof t?null!==t:i(t)}},function(t,e,n){var i=n(13),o=String,r=TypeError;t.exports=function(t){if(i(t))return t;throw r(o(t)+" is not an object")}},function(t,e,n){var i=n(8),o=n(11),r=n(31);t.exports=i?function(t,e,n){return o.f(t,e,r(1,n))}:function(t,e,n){return t[e]=n,t}},function(t,e,n){var i=n(74),o=n(18);t.exports=function(t){return i(o(t))}},function(t,e,n){var n=n(4),i=n({}.toString),o=n("".slice);t.exports=function(t){return o(i(t),8,-1)}},function(t,e,n){var i=n(32),o=TypeError;t.exports=function(t){if(i(t))throw o("Can't call method on "+t);return t}},function(t,e,n){var s=n(3),a=n(11),c=n(56),l=n(36);t.exports=function(t,e,n,i){var o=(i=i||{}).enumerable,r=void 0!==i.name?i.name:e;if(s(n)&&c(n,r,i),i.global)o?t[e]=n:l(e,n);else{try{i.unsafe?t[e]&&(o=!0):delete t[e]}catch(t){}o?t[e]=n:a.f(t,e,{value:n,enumerable:!1,configurable:!i.nonConfigurable,writable:!i.nonWritable})}return t}},function(t,e,n){var i=n(88),o=String;t.exports=function(t){if("Symbol"===i(t))throw TypeError("Cannot convert a Symbol value to a string");return o(t)}},function(t,e,n){"use strict";var E=n(103),o=n(12),i=n(4),r=n(104),s=n(2),x=n(14),C=n(3),a=n(32),A=n(26),T=n(60),O=n(20),c=n(18),S=n(106),l=n(51),L=n(108),I=n(109),u=n(7)("replace"),k=Math.max,D=Math.min,j=i([].concat),P=i([].push),N=i("".indexOf),M=i("".slice),n="$0"==="a".replace(/./,"$0"),h=!!/./[u]&&""===/./[u]("a","$0");r("replace",function(t,b,y){var w=h?"$":"$0";return[function(t,e){var n=c(this),i=a(t)?void 0:l(t,u);return i?o(i,t,n,e):o(b,O(n),t,e)},function(t,e){var n=x(this),i=O(t);if("string"==typeof e&&-1===N(e,w)&&-1===N(e,"$<")){t=y(b,n,i,e);if(t.done)return t.value}for(var o,r=C(e),s=(r||(e=O(e)),n.global),a=(s&&(o=n.unicode,n.lastIndex=0),[]);null!==(d=I(n,i))&&(P(a,d),s);)""===O(d[0])&&(n.lastIndex=S(i,T(n.lastIndex),o));for(var c,l="",u=0,h=0;h<a.length;h++){for(var d,f=O((d=a[h])[0]),p=k(D(A(d.index),i.length),0),g=[],m=1;m<d.length;m++)P(g,void 0===(c=d[m])?c:String(c));var _=d.groups,v=r?(v=j([f],g,p,i),void 0!==_&&P(v,_),O(E(e,void 0,v))):L(f,i,p,g,_,e);u<=p&&(l+=M(i,u,p)+v,u=p+f.length)}return l+M(i,u)}]},!!s(function(){var t=/./;return t.exec=function(){var t=[];return t.groups={a:"7"},t},"7"!=="".replace(t,"$<a>")})||!n||h)},function(t,e,n){var l=n(6),u=n(45).f,h=n(15),d=n(19),f=n(36),p=n(82),g=n(61);t.exports=function(t,e){var n,i,o,r=t.target,s=t.global,a=t.stat,c=s?l:a?l[r]||f(r,{}):(l[r]||{}).prototype;if(c)for(n in e){if(i=e[n],o=t.dontCallGetSet?(o=u(c,n))&&o.value:c[n],!g(s?n:r+(a?".":"#")+n,t.forced)&&void 0!==o){if(typeof i==typeof o)continue;p(i,o)}(t.sham||o&&o.sham)&&h(i,"sham",!0),d(c,n,i,t)}}},function(t,e,n){var i=n(6),o=n(3);t.exports=function(t,e){return arguments.length<2?(n=i[t],o(n)?n:void 0):i[t]&&i[t][e];var n}},function(t,e){t.exports=!1},function(t,e,n){var i,o,r,s,a=n(81),c=n(6),l=n(13),u=n(15),h=n(9),d=n(35),f=n(40),n=n(41),p="Object already initialized",g=c.TypeError,c=c.WeakMap,m=a||d.state?((r=d.state||(d.state=new c)).get=r.get,r.has=r.has,r.set=r.set,i=function(t,e){if(r.has(t))throw g(p);return e.facade=t,r.set(t,e),e},o=function(t){return r.get(t)||{}},function(t){return r.has(t)}):(n[s=f("state")]=!0,i=function(t,e){if(h(t,s))throw g(p);return e.facade=t,u(t,s,e),e},o=function(t){return h(t,s)?t[s]:{}},function(t){return h(t,s)});t.exports={set:i,get:o,has:m,enforce:function(t){return m(t)?o(t):i(t,{})},getterFor:function(e){return function(t){if(l(t)&&(t=o(t)).type===e)return t;throw g("Incompatible receiver, "+e+" required")}}}},function(t,e,n){var i=n(85);t.exports=function(t){t=+t;return t!=t||0==t?0:i(t)}},function(t,e,n){function i(){}function o(t){t.write(g("")),t.close();var e=t.parentWindow.Object;return t=null,e}var r,s=n(14),a=n(90),c=n(42),l=n(41),u=n(92),h=n(38),n=n(40),d="prototype",f="script",p=n("IE_PROTO"),g=function(t){return"<"+f+">"+t+"</"+f+">"},m=function(){try{r=new ActiveXObject("htmlfile")}catch(t){}m="undefined"==typeof document||document.domain&&r?o(r):(t=h("iframe"),e="java"+f+":",t.style.display="none",u.appendChild(t),t.src=String(e),(e=t.contentWindow.document).open(),e.write(g("document.F=Object")),e.close(),e.F);for(var t,e,n=c.length;n--;)delete m[d][c[n]];return m()};l[p]=!0,t.exports=Object.create||function(t,e){var n;return null!==t?(i[d]=s(t),n=new i,i[d]=null,n[p]=t):n=m(),void 0===e?n:a.f(n,e)}},function(t,e,n){"use strict";var i=n(22),o=n(93).trim;i({target:"String",proto:!0,forced:n(94)("trim")},{trim:function(){return o(this)}})},function(M,H,t){var e=t(8),n=t(6),i=t(4),o=t(61),l=t(95),u=t(15),r=t(57).f,h=t(33),d=t(98),f=t(20),p=t(99),s=t(63),a=t(100),c=t(19),g=t(2),m=t(9),_=t(25).enforce,v=t(101),b=t(7),y=t(64),w=t(65),E=b("match"),x=n.RegExp,C=x.prototype,A=n.SyntaxError,T=i(C.exec),O=i("".charAt),S=i("".replace),L=i("".indexOf),R=i("".slice),B=/^\?<[^\s\d!#%&*+<=>@^][^\s!#%&*+<=>@^]*>/,I=/a/g,k=/a/g,t=new x(I)!==I,D=s.MISSED_STICKY,W=s.UNSUPPORTED_Y,b=e&&(!t||D||y||w||g(function(){return k[E]=!1,x(I)!=I||x(k)==k||"/a/i"!=x(I,"i")}));if(o("RegExp",b)){function j(t,e){var n,i,o=h(C,this),r=d(t),s=void 0===e,a=[],c=t;if(!o&&r&&s&&t.constructor===j)return t;if((r||h(C,t))&&(t=t.source,s)&&(e=p(c)),t=void 0===t?"":f(t),e=void 0===e?"":f(e),c=t,r=e=y&&"dotAll"in I&&(n=!!e&&-1<L(e,"s"))?S(e,/s/g,""):e,D&&"sticky"in I&&(i=!!e&&-1<L(e,"y"))&&W&&(e=S(e,/y/g,"")),w&&(t=(s=function(t){for(var e,n=t.length,i=0,o="",r=[],s={},a=!1,c=!1,l=0,u="";i<=n;i++){if("\\"===(e=O(t,i)))e+=O(t,++i);else if("]"===e)a=!1;else if(!a)switch(!0){case"["===e:a=!0;break;case"("===e:T(B,R(t,i+1))&&(i+=2,c=!0),o+=e,l++;continue;case">"===e&&c:if(""===u||m(s,u))throw new A("Invalid capture group name");s[u]=!0,c=!(r[r.length]=[u,l]),u="";continue}c?u+=e:o+=e}return[o,r]}(t))[0],a=s[1]),s=l(x(t,e),o?this:C,j),(n||i||a.length)&&(e=_(s),n&&(e.dotAll=!0,e.raw=j(function(t){for(var e,n=t.length,i=0,o="",r=!1;i<=n;i++)"\\"===(e=O(t,i))?o+=e+O(t,++i):r||"."!==e?("["===e?r=!0:"]"===e&&(r=!1),o+=e):o+="[\\s\\S]";return o}(t),r)),i&&(e.sticky=!0),a.length)&&(e.groups=a),t!==c)try{u(s,"source",""===c?"(?:)":c)}catch(t){}return s}for(var P=r(x),N=0;P.length>N;)a(j,x,P[N++]);(C.constructor=j).prototype=C,c(n,"RegExp",j,{constructor:!0})}v("RegExp")},function(t,e,n){n=n(2);t.exports=!n(function(){var t=function(){}.bind();return"function"!=typeof t||t.hasOwnProperty("prototype")})},function(t,e){t.exports=function(t,e){return{enumerable:!(1&t),configurable:!(2&t),writable:!(4&t),value:e}}},function(t,e){t.exports=function(t){return null==t}},function(t,e,n){n=n(4);t.exports=n({}.isPrototypeOf)},function(t,e,n){var i=n(24),o=n(35);(t.exports=function(t,e){return o[t]||(o[t]=void 0!==e?e:{})})("versions",[]).push({version:"3.28.0",mode:i?"pure":"global",copyright:"© 2014-2023 Denis Pushkarev (zloirock.ru)",license:"https://github.com/zloirock/core-js/blob/v3.28.0/LICENSE",source:"https://github.com/zloirock/core-js"})},function(t,e,n){var i=n(6),n=n(36),o="__core-js_shared__",i=i[o]||n(o,{});t.exports=i},function(t,e,n){var i=n(6),o=Object.defineProperty;t.exports=function(e,n){try{o(i,e,{value:n,configurable:!0,writable:!0})}catch(t){i[e]=n}return n}},function(t,e,n){var i=n(18),o=Object;t.exports=function(t){return o(i(t))}},function(t,e,n){var i=n(6),n=n(13),o=i.document,r=n(o)&&n(o.createElement);t.exports=function(t){return r?o.createElement(t):{}}},function(t,e,n){var i=n(8),n=n(9),o=Function.prototype,r=i&&Object.getOwnPropertyDescriptor,n=n(o,"name"),s=n&&"something"===function(){}.name,i=n&&(!i||r(o,"name").configurable);t.exports={EXISTS:n,PROPER:s,CONFIGURABLE:i}},function(t,e,n){var i=n(34),o=n(53),r=i("keys");t.exports=function(t){return r[t]||(r[t]=o(t))}},function(t,e){t.exports={}},function(t,e){t.exports=["constructor","hasOwnProperty","isPrototypeOf","propertyIsEnumerable","toLocaleString","toString","valueOf"]},function(t,e,n){"use strict";var p=n(12),i=n(4),g=n(20),m=n(62),o=n(63),r=n(34),_=n(27),v=n(25).get,s=n(64),n=n(65),b=r("native-string-replace",String.prototype.replace),y=RegExp.prototype.exec,w=y,E=i("".charAt),x=i("".indexOf),C=i("".replace),A=i("".slice),T=(r=/b*/g,p(y,i=/a/,"a"),p(y,r,"a"),0!==i.lastIndex||0!==r.lastIndex),O=o.BROKEN_CARET,S=void 0!==/()??/.exec("")[1];(T||S||O||s||n)&&(w=function(t){var e,n,i,o,r,s,a=this,c=v(a),t=g(t),l=c.raw;if(l)return l.lastIndex=a.lastIndex,h=p(w,l,t),a.lastIndex=l.lastIndex,h;var u=c.groups,l=O&&a.sticky,h=p(m,a),c=a.source,d=0,f=t;if(l&&(h=C(h,"y",""),-1===x(h,"g")&&(h+="g"),f=A(t,a.lastIndex),0<a.lastIndex&&(!a.multiline||a.multiline&&"\n"!==E(t,a.lastIndex-1))&&(c="(?: "+c+")",f=" "+f,d++),e=new RegExp("^(?:"+c+")",h)),S&&(e=new RegExp("^"+c+"$(?!\\s)",h)),T&&(n=a.lastIndex),i=p(y,l?e:a,f),l?i?(i.input=A(i.input,d),i[0]=A(i[0],d),i.index=a.lastIndex,a.lastIndex+=i[0].length):a.lastIndex=0:T&&i&&(a.lastIndex=a.global?i.index+i[0].length:n),S&&i&&1<i.length&&p(b,i[0],e,function(){for(o=1;o<arguments.length-2;o++)void 0===arguments[o]&&(i[o]=void 0)}),i&&u)for(i.groups=r=_(null),o=0;o<u.length;o++)r[(s=u[o])[0]]=i[s[1]];return i}),t.exports=w},function(t,e){t.exports={}},function(t,e,n){var i=n(8),o=n(12),r=n(73),s=n(31),a=n(16),c=n(46),l=n(9),u=n(54),h=Object.getOwnPropertyDescriptor;e.f=i?h:function(t,e){if(t=a(t),e=c(e),u)try{return h(t,e)}catch(t){}if(l(t,e))return s(!o(r.f,t,e),t[e])}},function(t,e,n){var i=n(75),o=n(48);t.exports=function(t){t=i(t,"string");return o(t)?t:t+""}},function(t,e){var n="object"==typeof document&&document.all;t.exports={all:n,IS_HTMLDDA:void 0===n&&void 0!==n}},function(t,e,n){var i=n(23),o=n(3),r=n(33),n=n(49),s=Object;t.exports=n?function(t){return"symbol"==typeof t}:function(t){var e=i("Symbol");return o(e)&&r(e.prototype,s(t))}},function(t,e,n){n=n(50);t.exports=n&&!Symbol.sham&&"symbol"==typeof Symbol.iterator},function(t,e,n){var i=n(76),n=n(2);t.exports=!!Object.getOwnPropertySymbols&&!n(function(){var t=Symbol();return!String(t)||!(Object(t)instanceof Symbol)||!Symbol.sham&&i&&i<41})},function(t,e,n){var i=n(52),o=n(32);t.exports=function(t,e){t=t[e];return o(t)?void 0:i(t)}},function(t,e,n){var i=n(3),o=n(78),r=TypeError;t.exports=function(t){if(i(t))return t;throw r(o(t)+" is not a function")}},function(t,e,n){var n=n(4),i=0,o=Math.random(),r=n(1..toString);t.exports=function(t){return"Symbol("+(void 0===t?"":t)+")_"+r(++i+o,36)}},function(t,e,n){var i=n(8),o=n(2),r=n(38);t.exports=!i&&!o(function(){return 7!=Object.defineProperty(r("div"),"a",{get:function(){return 7}}).a})},function(t,e,n){var i=n(8),n=n(2);t.exports=i&&n(function(){return 42!=Object.defineProperty(function(){},"prototype",{value:42,writable:!1}).prototype})},function(t,e,n){var i=n(4),o=n(2),r=n(3),s=n(9),a=n(8),c=n(39).CONFIGURABLE,l=n(80),n=n(25),u=n.enforce,h=n.get,d=String,f=Object.defineProperty,p=i("".slice),g=i("".replace),m=i([].join),_=a&&!o(function(){return 8!==f(function(){},"length",{value:8}).length}),v=String(String).split("String"),n=t.exports=function(t,e,n){"Symbol("===p(d(e),0,7)&&(e="["+g(d(e),/^Symbol\(([^)]*)\)/,"$1")+"]"),n&&n.getter&&(e="get "+e),n&&n.setter&&(e="set "+e),(!s(t,"name")||c&&t.name!==e)&&(a?f(t,"name",{value:e,configurable:!0}):t.name=e),_&&n&&s(n,"arity")&&t.length!==n.arity&&f(t,"length",{value:n.arity});try{n&&s(n,"constructor")&&n.constructor?a&&f(t,"prototype",{writable:!1}):t.prototype&&(t.prototype=void 0)}catch(t){}n=u(t);return s(n,"source")||(n.source=m(v,"string"==typeof e?e:"")),t};Function.prototype.toString=n(function(){return r(this)&&h(this).source||l(this)},"toString")},function(t,e,n){var i=n(58),o=n(42).concat("length","prototype");e.f=Object.getOwnPropertyNames||function(t){return i(t,o)}},function(t,e,n){var i=n(4),s=n(9),a=n(16),c=n(59).indexOf,l=n(41),u=i([].push);t.exports=function(t,e){var n,i=a(t),o=0,r=[];for(n in i)!s(l,n)&&s(i,n)&&u(r,n);for(;e.length>o;)!s(i,n=e[o++])||~c(r,n)||u(r,n);return r}},function(t,e,n){function i(a){return function(t,e,n){var i,o=c(t),r=u(o),s=l(n,r);if(a&&e!=e){for(;s<r;)if((i=o[s++])!=i)return!0}else for(;s<r;s++)if((a||s in o)&&o[s]===e)return a||s||0;return!a&&-1}}var c=n(16),l=n(84),u=n(86);t.exports={includes:i(!0),indexOf:i(!1)}},function(t,e,n){var i=n(26),o=Math.min;t.exports=function(t){return 0<t?o(i(t),9007199254740991):0}},function(t,e,n){function i(t,e){return(t=c[a(t)])==u||t!=l&&(r(e)?o(e):!!e)}var o=n(2),r=n(3),s=/#|\.prototype\./,a=i.normalize=function(t){return String(t).replace(s,".").toLowerCase()},c=i.data={},l=i.NATIVE="N",u=i.POLYFILL="P";t.exports=i},function(t,e,n){"use strict";var i=n(14);t.exports=function(){var t=i(this),e="";return t.hasIndices&&(e+="d"),t.global&&(e+="g"),t.ignoreCase&&(e+="i"),t.multiline&&(e+="m"),t.dotAll&&(e+="s"),t.unicode&&(e+="u"),t.unicodeSets&&(e+="v"),t.sticky&&(e+="y"),e}},function(t,e,n){var i=n(2),o=n(6).RegExp,n=i(function(){var t=o("a","y");return t.lastIndex=2,null!=t.exec("abcd")}),r=n||i(function(){return!o("a","y").sticky}),i=n||i(function(){var t=o("^r","gy");return t.lastIndex=2,null!=t.exec("str")});t.exports={BROKEN_CARET:i,MISSED_STICKY:r,UNSUPPORTED_Y:n}},function(t,e,n){var i=n(2),o=n(6).RegExp;t.exports=i(function(){var t=o(".","s");return!(t.dotAll&&t.exec("\n")&&"s"===t.flags)})},function(t,e,n){var i=n(2),o=n(6).RegExp;t.exports=i(function(){var t=o("(?<a>b)","g");return"b"!==t.exec("b").groups.a||"bc"!=="b".replace(t,"$<a>c")})},function(t,e){t.exports="\t\n\v\f\r                　\u2028\u2029\ufeff"},function(t,e,n){var o=n(96),r=n(14),s=n(97);t.exports=Object.setPrototypeOf||("__proto__"in{}?function(){var n,i=!1,t={};try{(n=o(Object.prototype,"__proto__","set"))(t,[]),i=t instanceof Array}catch(t){}return function(t,e){return r(t),s(e),i?n(t,e):t.__proto__=e,t}}():void 0)},function(t,e,n){var i=n(7),o=n(27),n=n(11).f,r=i("unscopables"),s=Array.prototype;null==s[r]&&n(s,r,{configurable:!0,value:o(null)}),t.exports=function(t){s[r][t]=!0}},function(t,e,n){"use strict";var i,o,r=n(2),s=n(3),a=n(13),c=n(27),l=n(70),u=n(19),h=n(7),n=n(24),d=h("iterator"),h=!1;[].keys&&("next"in(o=[].keys())?(l=l(l(o)))!==Object.prototype&&(i=l):h=!0),!a(i)||r(function(){var t={};return i[d].call(t)!==t})?i={}:n&&(i=c(i)),s(i[d])||u(i,d,function(){return this}),t.exports={IteratorPrototype:i,BUGGY_SAFARI_ITERATORS:h}},function(t,e,n){var i=n(9),o=n(3),r=n(37),s=n(40),n=n(112),a=s("IE_PROTO"),c=Object,l=c.prototype;t.exports=n?c.getPrototypeOf:function(t){var e,t=r(t);return i(t,a)?t[a]:(e=t.constructor,o(e)&&t instanceof e?e.prototype:t instanceof c?l:null)}},function(t,e,n){var i=n(11).f,o=n(9),r=n(7)("toStringTag");t.exports=function(t,e,n){(t=t&&!n?t.prototype:t)&&!o(t,r)&&i(t,r,{configurable:!0,value:e})}},function(t,e){var n=function(){return this}();try{n=n||new Function("return this")()}catch(t){"object"==typeof window&&(n=window)}t.exports=n},function(t,e,n){"use strict";var i={}.propertyIsEnumerable,o=Object.getOwnPropertyDescriptor,r=o&&!i.call({1:2},1);e.f=r?function(t){t=o(this,t);return!!t&&t.enumerable}:i},function(t,e,n){var i=n(4),o=n(2),r=n(17),s=Object,a=i("".split);t.exports=o(function(){return!s("z").propertyIsEnumerable(0)})?function(t){return"String"==r(t)?a(t,""):s(t)}:s},function(t,e,n){var i=n(12),o=n(13),r=n(48),s=n(51),a=n(79),n=n(7),c=TypeError,l=n("toPrimitive");t.exports=function(t,e){if(!o(t)||r(t))return t;var n=s(t,l);if(n){if(n=i(n,t,e=void 0===e?"default":e),!o(n)||r(n))return n;throw c("Can't convert object to primitive value")}return a(t,e=void 0===e?"number":e)}},function(t,e,n){var i,o,r=n(6),n=n(77),s=r.process,r=r.Deno,s=s&&s.versions||r&&r.version,r=s&&s.v8;!(o=r?0<(i=r.split("."))[0]&&i[0]<4?1:+(i[0]+i[1]):o)&&n&&(!(i=n.match(/Edge\/(\d+)/))||74<=i[1])&&(i=n.match(/Chrome\/(\d+)/))&&(o=+i[1]),t.exports=o},function(t,e){t.exports="undefined"!=typeof navigator&&String(navigator.userAgent)||""},function(t,e){var n=String;t.exports=function(t){try{return n(t)}catch(t){return"Object"}}},function(t,e,n){var o=n(12),r=n(3),s=n(13),a=TypeError;t.exports=function(t,e){var n,i;if("string"===e&&r(n=t.toString)&&!s(i=o(n,t)))return i;if(r(n=t.valueOf)&&!s(i=o(n,t)))return i;if("string"!==e&&r(n=t.toString)&&!s(i=o(n,t)))return i;throw a("Can't convert object to primitive value")}},function(t,e,n){var i=n(4),o=n(3),n=n(35),r=i(Function.toString);o(n.inspectSource)||(n.inspectSource=function(t){return r(t)}),t.exports=n.inspectSource},function(t,e,n){var i=n(6),n=n(3),i=i.WeakMap;t.exports=n(i)&&/native code/.test(String(i))},function(t,e,n){var c=n(9),l=n(83),u=n(45),h=n(11);t.exports=function(t,e,n){for(var i=l(e),o=h.f,r=u.f,s=0;s<i.length;s++){var a=i[s];c(t,a)||n&&c(n,a)||o(t,a,r(e,a))}}},function(t,e,n){var i=n(23),o=n(4),r=n(57),s=n(87),a=n(14),c=o([].concat);t.exports=i("Reflect","ownKeys")||function(t){var e=r.f(a(t)),n=s.f;return n?c(e,n(t)):e}},function(t,e,n){var i=n(26),o=Math.max,r=Math.min;t.exports=function(t,e){t=i(t);return t<0?o(t+e,0):r(t,e)}},function(t,e){var n=Math.ceil,i=Math.floor;t.exports=Math.trunc||function(t){t=+t;return(0<t?i:n)(t)}},function(t,e,n){var i=n(60);t.exports=function(t){return i(t.length)}},function(t,e){e.f=Object.getOwnPropertySymbols},function(t,e,n){var i=n(89),o=n(3),r=n(17),s=n(7)("toStringTag"),a=Object,c="Arguments"==r(function(){return arguments}());t.exports=i?r:function(t){var e;return void 0===t?"Undefined":null===t?"Null":"string"==typeof(e=function(t,e){try{return t[e]}catch(t){}}(t=a(t),s))?e:c?r(t):"Object"==(e=r(t))&&o(t.callee)?"Arguments":e}},function(t,e,n){var i={};i[n(7)("toStringTag")]="z",t.exports="[object z]"===String(i)},function(t,e,n){var i=n(8),o=n(55),a=n(11),c=n(14),l=n(16),u=n(91);e.f=i&&!o?Object.defineProperties:function(t,e){c(t);for(var n,i=l(e),o=u(e),r=o.length,s=0;s<r;)a.f(t,n=o[s++],i[n]);return t}},function(t,e,n){var i=n(58),o=n(42);t.exports=Object.keys||function(t){return i(t,o)}},function(t,e,n){n=n(23);t.exports=n("document","documentElement")},function(t,e,n){function i(e){return function(t){t=s(r(t));return 1&e&&(t=a(t,c,"")),t=2&e?a(t,l,"$1"):t}}var o=n(4),r=n(18),s=n(20),n=n(66),a=o("".replace),c=RegExp("^["+n+"]+"),l=RegExp("(^|[^"+n+"])["+n+"]+$");t.exports={start:i(1),end:i(2),trim:i(3)}},function(t,e,n){var i=n(39).PROPER,o=n(2),r=n(66);t.exports=function(t){return o(function(){return!!r[t]()||"​᠎"!=="​᠎"[t]()||i&&r[t].name!==t})}},function(t,e,n){var i=n(3),o=n(13),r=n(67);t.exports=function(t,e,n){return r&&i(e=e.constructor)&&e!==n&&o(e=e.prototype)&&e!==n.prototype&&r(t,e),t}},function(t,e,n){var i=n(4),o=n(52);t.exports=function(t,e,n){try{return i(o(Object.getOwnPropertyDescriptor(t,e)[n]))}catch(t){}}},function(t,e,n){var i=n(3),o=String,r=TypeError;t.exports=function(t){if("object"==typeof t||i(t))return t;throw r("Can't set "+o(t)+" as a prototype")}},function(t,e,n){var i=n(13),o=n(17),r=n(7)("match");t.exports=function(t){var e;return i(t)&&(void 0!==(e=t[r])?!!e:"RegExp"==o(t))}},function(t,e,n){var i=n(12),o=n(9),r=n(33),s=n(62),a=RegExp.prototype;t.exports=function(t){var e=t.flags;return void 0!==e||"flags"in a||o(t,"flags")||!r(a,t)?e:i(s,t)}},function(t,e,n){var i=n(11).f;t.exports=function(t,e,n){n in t||i(t,n,{configurable:!0,get:function(){return e[n]},set:function(t){e[n]=t}})}},function(t,e,n){"use strict";var i=n(23),o=n(102),r=n(7),s=n(8),a=r("species");t.exports=function(t){t=i(t);s&&t&&!t[a]&&o(t,a,{configurable:!0,get:function(){return this}})}},function(t,e,n){var i=n(56),o=n(11);t.exports=function(t,e,n){return n.get&&i(n.get,e,{getter:!0}),n.set&&i(n.set,e,{setter:!0}),o.f(t,e,n)}},function(t,e,n){var n=n(30),i=Function.prototype,o=i.apply,r=i.call;t.exports="object"==typeof Reflect&&Reflect.apply||(n?r.bind(o):function(){return r.apply(o,arguments)})},function(t,e,n){"use strict";n(5);var c=n(105),l=n(19),u=n(43),h=n(2),d=n(7),f=n(15),p=d("species"),g=RegExp.prototype;t.exports=function(n,t,e,i){var s,o=d(n),a=!h(function(){var t={};return t[o]=function(){return 7},7!=""[n](t)}),r=a&&!h(function(){var t=!1,e=/a/;return"split"===n&&((e={constructor:{}}).constructor[p]=function(){return e},e.flags="",e[o]=/./[o]),e.exec=function(){return t=!0,null},e[o](""),!t});a&&r&&!e||(s=c(/./[o]),r=t(o,""[n],function(t,e,n,i,o){var t=c(t),r=e.exec;return r===u||r===g.exec?a&&!o?{done:!0,value:s(e,n,i)}:{done:!0,value:t(n,e,i)}:{done:!1}}),l(String.prototype,n,r[0]),l(g,o,r[1])),i&&f(g[o],"sham",!0)}},function(t,e,n){var i=n(17),o=n(4);t.exports=function(t){if("Function"===i(t))return o(t)}},function(t,e,n){"use strict";var i=n(107).charAt;t.exports=function(t,e,n){return e+(n?i(t,e).length:1)}},function(t,e,n){function i(o){return function(t,e){var n,t=s(a(t)),e=r(e),i=t.length;return e<0||i<=e?o?"":void 0:(n=l(t,e))<55296||56319<n||e+1===i||(i=l(t,e+1))<56320||57343<i?o?c(t,e):n:o?u(t,e,e+2):i-56320+(n-55296<<10)+65536}}var o=n(4),r=n(26),s=n(20),a=n(18),c=o("".charAt),l=o("".charCodeAt),u=o("".slice);t.exports={codeAt:i(!1),charAt:i(!0)}},function(t,e,n){var i=n(4),o=n(37),d=Math.floor,f=i("".charAt),p=i("".replace),g=i("".slice),m=/\$([$&'`]|\d{1,2}|<[^>]*>)/g,_=/\$([$&'`]|\d{1,2})/g;t.exports=function(r,s,a,c,l,t){var u=a+r.length,h=c.length,e=_;return void 0!==l&&(l=o(l),e=m),p(t,e,function(t,e){var n;switch(f(e,0)){case"$":return"$";case"&":return r;case"`":return g(s,0,a);case"'":return g(s,u);case"<":n=l[g(e,1,-1)];break;default:var i,o=+e;if(0==o)return t;if(h<o)return 0!==(i=d(o/10))&&i<=h?void 0===c[i-1]?f(e,1):c[i-1]+f(e,1):t;n=c[o-1]}return void 0===n?"":n})}},function(t,e,n){var i=n(12),o=n(14),r=n(3),s=n(17),a=n(43),c=TypeError;t.exports=function(t,e){var n=t.exec;if(r(n))return null!==(n=i(n,t,e))&&o(n),n;if("RegExp"===s(t))return i(a,t,e);throw c("RegExp#exec called on incompatible receiver")}},function(t,e,n){"use strict";function g(){return this}var m=n(22),_=n(12),v=n(24),i=n(39),b=n(3),y=n(111),w=n(70),E=n(67),x=n(71),C=n(15),A=n(19),o=n(7),T=n(44),n=n(69),O=i.PROPER,S=i.CONFIGURABLE,L=n.IteratorPrototype,I=n.BUGGY_SAFARI_ITERATORS,k=o("iterator"),D="values",j="entries";t.exports=function(t,e,n,i,o,r,s){y(n,e,i);function a(t){if(t===o&&f)return f;if(!I&&t in h)return h[t];switch(t){case"keys":case D:case j:return function(){return new n(this,t)}}return function(){return new n(this)}}var c,l,i=e+" Iterator",u=!1,h=t.prototype,d=h[k]||h["@@iterator"]||o&&h[o],f=!I&&d||a(o),p="Array"==e&&h.entries||d;if(p&&(p=w(p.call(new t)))!==Object.prototype&&p.next&&(v||w(p)===L||(E?E(p,L):b(p[k])||A(p,k,g)),x(p,i,!0,!0),v)&&(T[i]=g),O&&o==D&&d&&d.name!==D&&(!v&&S?C(h,"name",D):(u=!0,f=function(){return _(d,this)})),o)if(c={values:a(D),keys:r?f:a("keys"),entries:a(j)},s)for(l in c)!I&&!u&&l in h||A(h,l,c[l]);else m({target:e,proto:!0,forced:I||u},c);return v&&!s||h[k]===f||A(h,k,f,{name:o}),T[e]=f,c}},function(t,e,n){"use strict";function o(){return this}var r=n(69).IteratorPrototype,s=n(27),a=n(31),c=n(71),l=n(44);t.exports=function(t,e,n,i){e+=" Iterator";return t.prototype=s(r,{next:a(+!i,n)}),c(t,e,!1,!0),l[e]=o,t}},function(t,e,n){n=n(2);t.exports=!n(function(){function t(){}return t.prototype.constructor=null,Object.getPrototypeOf(new t)!==t.prototype})},function(t,e){t.exports=function(t,e){return{value:t,done:e}}},function(t,e){t.exports={CSSRuleList:0,CSSStyleDeclaration:0,CSSValueList:0,ClientRectList:0,DOMRectList:0,DOMStringList:0,DOMTokenList:1,DataTransferItemList:0,FileList:0,HTMLAllCollection:0,HTMLCollection:0,HTMLFormElement:0,HTMLSelectElement:0,MediaList:0,MimeTypeArray:0,NamedNodeMap:0,NodeList:1,PaintRequestList:0,Plugin:0,PluginArray:0,SVGLengthList:0,SVGNumberList:0,SVGPathSegList:0,SVGPointList:0,SVGStringList:0,SVGTransformList:0,SourceBufferList:0,StyleSheetList:0,TextTrackCueList:0,TextTrackList:0,TouchList:0}},function(t,e,n){n=n(38)("span").classList,n=n&&n.constructor&&n.constructor.prototype;t.exports=n===Object.prototype?void 0:n},function(t,e){function o(t){var e=i[t];return void 0!==e||(e=i[t]={id:t,exports:{}},n[t](e,e.exports,o)),e.exports}var n,i;n={454:(t,e,n)=>{"use strict";n.d(e,{Z:()=>i});e=n(645),n=n.n(e)()(function(t){return t[1]});n.push([t.id,"INPUT:-webkit-autofill,SELECT:-webkit-autofill,TEXTAREA:-webkit-autofill{animation-name:onautofillstart}INPUT:not(:-webkit-autofill),SELECT:not(:-webkit-autofill),TEXTAREA:not(:-webkit-autofill){animation-name:onautofillcancel}@keyframes onautofillstart{}@keyframes onautofillcancel{}",""]);const i=n},645:t=>{"use strict";t.exports=function(n){var c=[];return c.toString=function(){return this.map(function(t){var e=n(t);return t[2]?"@media ".concat(t[2]," {").concat(e,"}"):e}).join("")},c.i=function(t,e,n){"string"==typeof t&&(t=[[null,t,""]]);var i={};if(n)for(var o=0;o<this.length;o++){var r=this[o][0];null!=r&&(i[r]=!0)}for(var s=0;s<t.length;s++){var a=[].concat(t[s]);n&&i[a[0]]||(e&&(a[2]?a[2]="".concat(e," and ").concat(a[2]):a[2]=e),c.push(a))}},c}},810:()=>{if("undefined"!=typeof window)try{var t=new window.CustomEvent("test",{cancelable:!0});if(t.preventDefault(),!0!==t.defaultPrevented)throw new Error("Could not prevent default")}catch(t){function e(t,e){var n,i;return(e=e||{}).bubbles=!!e.bubbles,e.cancelable=!!e.cancelable,(n=document.createEvent("CustomEvent")).initCustomEvent(t,e.bubbles,e.cancelable,e.detail),i=n.preventDefault,n.preventDefault=function(){i.call(this);try{Object.defineProperty(this,"defaultPrevented",{get:function(){return!0}})}catch(t){this.defaultPrevented=!0}},n}e.prototype=window.Event.prototype,window.CustomEvent=e}},379:(t,e,o)=>{"use strict";i={};var n,i,r=function(t){if(void 0===i[t]){var e=document.querySelector(t);if(window.HTMLIFrameElement&&e instanceof window.HTMLIFrameElement)try{e=e.contentDocument.head}catch(t){e=null}i[t]=e}return i[t]},l=[];function u(t){for(var e=-1,n=0;n<l.length;n++)if(l[n].identifier===t){e=n;break}return e}function a(t,e){for(var n={},i=[],o=0;o<t.length;o++){var r=t[o],s=e.base?r[0]+e.base:r[0],a=n[s]||0,c="".concat(s," ").concat(a),s=(n[s]=a+1,u(c)),a={css:r[1],media:r[2],sourceMap:r[3]};-1!==s?(l[s].references++,l[s].updater(a)):l.push({identifier:c,updater:function(e,t){var n,i,o;{var r;o=t.singleton?(r=p++,n=f=f||h(t),i=d.bind(null,n,r,!1),d.bind(null,n,r,!0)):(n=h(t),i=function(t,e,n){var i=n.css,o=n.media,n=n.sourceMap;if(o?t.setAttribute("media",o):t.removeAttribute("media"),n&&"undefined"!=typeof btoa&&(i+="\n/*# sourceMappingURL=data:application/json;base64,".concat(btoa(unescape(encodeURIComponent(JSON.stringify(n))))," */")),t.styleSheet)t.styleSheet.cssText=i;else{for(;t.firstChild;)t.removeChild(t.firstChild);t.appendChild(document.createTextNode(i))}}.bind(null,n,t),function(){var t;null!==(t=n).parentNode&&t.parentNode.removeChild(t)})}return i(e),function(t){t?t.css===e.css&&t.media===e.media&&t.sourceMap===e.sourceMap||i(e=t):o()}}(a,e),references:1}),i.push(c)}return i}function h(t){var e=document.createElement("style"),n=t.attributes||{};if(void 0===n.nonce&&(i=o.nc)&&(n.nonce=i),Object.keys(n).forEach(function(t){e.setAttribute(t,n[t])}),"function"==typeof t.insert)t.insert(e);else{var i=r(t.insert||"head");if(!i)throw new Error("Couldn't find a style target. This probably means that the value for the 'insert' parameter is invalid.");i.appendChild(e)}return e}s=[];var s,c=function(t,e){return s[t]=e,s.filter(Boolean).join("\n")};function d(t,e,n,i){var n=n?"":i.media?"@media ".concat(i.media," {").concat(i.css,"}"):i.css;t.styleSheet?t.styleSheet.cssText=c(e,n):(i=document.createTextNode(n),(n=t.childNodes)[e]&&t.removeChild(n[e]),n.length?t.insertBefore(i,n[e]):t.appendChild(i))}var f=null,p=0;t.exports=function(t,r){(r=r||{}).singleton||"boolean"==typeof r.singleton||(r.singleton=n=void 0===n?Boolean(window&&document&&document.all&&!window.atob):n);var s=a(t=t||[],r);return function(t){if(t=t||[],"[object Array]"===Object.prototype.toString.call(t)){for(var e=0;e<s.length;e++){var n=u(s[e]);l[n].references--}for(var t=a(t,r),i=0;i<s.length;i++){var o=u(s[i]);0===l[o].references&&(l[o].updater(),l.splice(o,1))}s=t}}}}},i={},o.n=t=>{var e=t&&t.__esModule?()=>t.default:()=>t;return o.d(e,{a:e}),e},o.d=(t,e)=>{for(var n in e)o.o(e,n)&&!o.o(t,n)&&Object.defineProperty(t,n,{enumerable:!0,get:e[n]})},o.o=(t,e)=>Object.prototype.hasOwnProperty.call(t,e),(()=>{"use strict";var t=o(379),t=o.n(t),e=o(454);function n(t){var e;t.hasAttribute("autocompleted")||(t.setAttribute("autocompleted",""),e=new window.CustomEvent("onautocomplete",{bubbles:!0,cancelable:!0,detail:null}),t.dispatchEvent(e))||(t.value="")}function i(t){t.hasAttribute("autocompleted")&&(t.removeAttribute("autocompleted"),t.dispatchEvent(new window.CustomEvent("onautocomplete",{bubbles:!0,cancelable:!1,detail:null})))}t()(e.Z,{insert:"head",singleton:!1}),e.Z.locals,o(810),document.addEventListener("animationstart",function(t){("onautofillstart"===t.animationName?n:i)(t.target)},!0),document.addEventListener("input",function(t){("insertReplacementText"!==t.inputType&&"data"in t?i:n)(t.target)},!0)})()},,,function(M,t,e){"use strict";e.r(t),e.d(t,"Alert",function(){return Fe}),e.d(t,"Button",function(){return ee}),e.d(t,"Carousel",function(){return xn}),e.d(t,"Collapse",function(){return ea}),e.d(t,"Offcanvas",function(){return ke}),e.d(t,"Dropdown",function(){return Na}),e.d(t,"Input",function(){return Ds}),e.d(t,"Modal",function(){return $n}),e.d(t,"Popover",function(){return Jo}),e.d(t,"Ripple",function(){return Ya}),e.d(t,"ScrollSpy",function(){return gr}),e.d(t,"Tab",function(){return Mr}),e.d(t,"Toast",function(){return bs}),e.d(t,"Tooltip",function(){return Kr}),e.d(t,"Range",function(){return tc});var n={};e.r(n),e.d(n,"top",function(){return O}),e.d(n,"bottom",function(){return S}),e.d(n,"right",function(){return L}),e.d(n,"left",function(){return I}),e.d(n,"auto",function(){return Gn}),e.d(n,"basePlacements",function(){return Zn}),e.d(n,"start",function(){return Jn}),e.d(n,"end",function(){return ti}),e.d(n,"clippingParents",function(){return ei}),e.d(n,"viewport",function(){return ni}),e.d(n,"popper",function(){return ii}),e.d(n,"reference",function(){return oi}),e.d(n,"variationPlacements",function(){return ri}),e.d(n,"placements",function(){return si}),e.d(n,"beforeRead",function(){return ai}),e.d(n,"read",function(){return ci}),e.d(n,"afterRead",function(){return li}),e.d(n,"beforeMain",function(){return ui}),e.d(n,"main",function(){return hi}),e.d(n,"afterMain",function(){return di}),e.d(n,"beforeWrite",function(){return fi}),e.d(n,"write",function(){return pi}),e.d(n,"afterWrite",function(){return gi}),e.d(n,"modifierPhases",function(){return mi}),e.d(n,"applyStyles",function(){return bi}),e.d(n,"arrow",function(){return Hi}),e.d(n,"computeStyles",function(){return Fi}),e.d(n,"eventListeners",function(){return zi}),e.d(n,"flip",function(){return io}),e.d(n,"hide",function(){return so}),e.d(n,"offset",function(){return ao}),e.d(n,"popperOffsets",function(){return co}),e.d(n,"preventOverflow",function(){return lo}),e.d(n,"popperGenerator",function(){return go}),e.d(n,"detectOverflow",function(){return no}),e.d(n,"createPopperBase",function(){return mo}),e.d(n,"createPopper",function(){return _o}),e.d(n,"createPopperLite",function(){return vo}),e(5),e(28),e(29);const H=t=>{let e=t.getAttribute("data-mdb-target");return e&&"#"!==e||(t=t.getAttribute("href"),e=t&&"#"!==t?t.trim():null),e},R=t=>{t=H(t);return t?document.querySelector(t):null};const B=(o,r,s)=>{Object.keys(s).forEach(t=>{var e,n=s[t],i=r[t],i=i&&((e=i)[0]||e).nodeType?"element":null==(e=i)?"".concat(e):{}.toString.call(e).match(/\s([a-z]+)/i)[1].toLowerCase();if(!new RegExp(n).test(i))throw new Error("".concat(o.toUpperCase(),": ")+'Option "'.concat(t,'" provided type "').concat(i,'" ')+'but expected type "'.concat(n,'".'))})};const i=()=>{var t=window["jQuery"];return t&&!document.body.hasAttribute("data-mdb-no-jquery")?t:null},o=t=>{"loading"===document.readyState?document.addEventListener("DOMContentLoaded",t):t()};document.documentElement.dir;const W=t=>document.createElement(t);const F=(()=>{const i={};let o=1;return{set(t,e,n){void 0===t[e]&&(t[e]={key:e,id:o},o++),i[t[e].id]=n},get(t,e){return t&&void 0!==t[e]&&(t=t[e]).key===e?i[t.id]:null},delete(t,e){var n;void 0!==t[e]&&(n=t[e]).key===e&&(delete i[n.id],delete t[e])}}})();var r={setData(t,e,n){F.set(t,e,n)},getData(t,e){return F.get(t,e)},removeData(t,e){F.delete(t,e)}};e(21),e(0),e(1);const q=i(),z=/[^.]*(?=\..*)\.|.*/,Q=/\..*/,V=/::\d+$/,U={};let Y=1;const X={mouseenter:"mouseover",mouseleave:"mouseout"},K=["click","dblclick","mouseup","mousedown","contextmenu","mousewheel","DOMMouseScroll","mouseover","mouseout","mousemove","selectstart","selectend","keydown","keypress","keyup","orientationchange","touchstart","touchmove","touchend","touchcancel","pointerdown","pointermove","pointerup","pointerleave","pointercancel","gesturestart","gesturechange","gestureend","focus","blur","change","reset","select","submit","focusin","focusout","load","unload","beforeunload","resize","move","DOMContentLoaded","readystatechange","error","abort","scroll"];function $(t,e){return e&&"".concat(e,"::").concat(Y++)||t.uidEvent||Y++}function G(t){var e=$(t);return t.uidEvent=e,U[e]=U[e]||{},U[e]}function Z(n,i,t){var o=2<arguments.length&&void 0!==t?t:null,r=Object.keys(n);for(let t=0,e=r.length;t<e;t++){var s=n[r[t]];if(s.originalHandler===i&&s.delegationSelector===o)return s}return null}function J(t,e,n){var i="string"==typeof e,n=i?n:e;let o=t.replace(Q,"");e=X[o],e&&(o=e),e=-1<K.indexOf(o);return[i,n,o=e?o:t]}function tt(t,e,n,i,o){var r,s,a,c,l,u,h,d,f,p;"string"==typeof e&&t&&(n||(n=i,i=null),[r,s,a]=J(e,n,i),(l=Z(c=(c=G(t))[a]||(c[a]={}),s,r?n:null))?l.oneOff=l.oneOff&&o:(l=$(s,e.replace(z,"")),(e=r?(d=t,f=n,p=i,function n(i){var o=d.querySelectorAll(f);for(let e=i["target"];e&&e!==this;e=e.parentNode)for(let t=o.length;t--;)if(o[t]===e)return i.delegateTarget=e,n.oneOff&&nt.off(d,i.type,p),p.apply(e,[i]);return null}):(u=t,h=n,function t(e){return e.delegateTarget=u,t.oneOff&&nt.off(u,e.type,h),h.apply(u,[e])})).delegationSelector=r?n:null,e.originalHandler=s,e.oneOff=o,c[e.uidEvent=l]=e,t.addEventListener(a,e,r)))}function et(t,e,n,i,o){i=Z(e[n],i,o);i&&(t.removeEventListener(n,i,Boolean(o)),delete e[n][i.uidEvent])}const nt={on(t,e,n,i){tt(t,e,n,i,!1)},one(t,e,n,i){tt(t,e,n,i,!0)},off(s,a,t,e){if("string"==typeof a&&s){const[n,i,o]=J(a,t,e),r=o!==a,c=G(s);e="."===a.charAt(0);if(void 0!==i)return c&&c[o]?void et(s,c,o,i,n?t:null):void 0;e&&Object.keys(c).forEach(t=>{{var e=s,n=c,i=t,o=a.slice(1);const r=n[i]||{};Object.keys(r).forEach(t=>{-1<t.indexOf(o)&&(t=r[t],et(e,n,i,t.originalHandler,t.delegationSelector))})}});const l=c[o]||{};Object.keys(l).forEach(t=>{var e=t.replace(V,"");(!r||-1<a.indexOf(e))&&(e=l[t],et(s,c,o,e.originalHandler,e.delegationSelector))})}},trigger(t,e,n){if("string"!=typeof e||!t)return null;var i=e.replace(Q,""),o=e!==i,r=-1<K.indexOf(i);let s,a=!0,c=!0,l=!1,u=null;return o&&q&&(s=q.Event(e,n),q(t).trigger(s),a=!s.isPropagationStopped(),c=!s.isImmediatePropagationStopped(),l=s.isDefaultPrevented()),r?(u=document.createEvent("HTMLEvents")).initEvent(i,a,!0):u=new CustomEvent(e,{bubbles:a,cancelable:!0}),void 0!==n&&Object.keys(n).forEach(t=>{Object.defineProperty(u,t,{get(){return n[t]}})}),l&&u.preventDefault(),c&&t.dispatchEvent(u),u.defaultPrevented&&void 0!==s&&s.preventDefault(),u}};var s=nt;function it(t){return"true"===t||"false"!==t&&(t===Number(t).toString()?Number(t):""===t||"null"===t?null:t)}function ot(t){return t.replace(/[A-Z]/g,t=>"-".concat(t.toLowerCase()))}var c={setDataAttribute(t,e,n){t.setAttribute("data-mdb-".concat(ot(e)),n)},removeDataAttribute(t,e){t.removeAttribute("data-mdb-".concat(ot(e)))},getDataAttributes(t){if(!t)return{};const n={...t.dataset};return Object.keys(n).filter(t=>t.startsWith("mdb")).forEach(t=>{let e=t.replace(/^mdb/,"");e=e.charAt(0).toLowerCase()+e.slice(1,e.length),n[e]=it(n[t])}),n},getDataAttribute(t,e){return it(t.getAttribute("data-mdb-".concat(ot(e))))},offset(t){t=t.getBoundingClientRect();return{top:t.top+document.body.scrollTop,left:t.left+document.body.scrollLeft}},position(t){return{top:t.offsetTop,left:t.offsetLeft}},style(t,e){Object.assign(t.style,e)},toggleClass(t,e){t&&(t.classList.contains(e)?t.classList.remove(e):t.classList.add(e))},addClass(t,e){t.classList.contains(e)||t.classList.add(e)},addStyle(e,n){Object.keys(n).forEach(t=>{e.style[t]=n[t]})},removeClass(t,e){t.classList.contains(e)&&t.classList.remove(e)},hasClass(t,e){return t.classList.contains(e)}};var a={closest(t,e){return t.closest(e)},matches(t,e){return t.matches(e)},find(t){var e=1<arguments.length&&void 0!==arguments[1]?arguments[1]:document.documentElement;return[].concat(...Element.prototype.querySelectorAll.call(e,t))},findOne(t){var e=1<arguments.length&&void 0!==arguments[1]?arguments[1]:document.documentElement;return Element.prototype.querySelector.call(e,t)},children(t,e){return[].concat(...t.children).filter(t=>t.matches(e))},parents(t,e){var n=[];let i=t.parentNode;for(;i&&i.nodeType===Node.ELEMENT_NODE&&3!==i.nodeType;)this.matches(i,e)&&n.push(i),i=i.parentNode;return n},prev(t,e){let n=t.previousElementSibling;for(;n;){if(n.matches(e))return[n];n=n.previousElementSibling}return[]},next(t,e){let n=t.nextElementSibling;for(;n;){if(this.matches(n,e))return[n];n=n.nextElementSibling}return[]}};e(10);const rt=1e3,st="transitionend",at=e=>{let n=e.getAttribute("data-mdb-target");if(!n||"#"===n){let t=e.getAttribute("href");if(!t||!t.includes("#")&&!t.startsWith("."))return null;t.includes("#")&&!t.startsWith("#")&&(t="#".concat(t.split("#")[1])),n=t&&"#"!==t?t.trim():null}return n},ct=t=>{t=at(t);return t&&document.querySelector(t)?t:null},l=t=>{t=at(t);return t?document.querySelector(t):null},lt=t=>{t.dispatchEvent(new Event(st))},u=t=>!(!t||"object"!=typeof t)&&void 0!==(t=void 0!==t.jquery?t[0]:t).nodeType,ut=t=>u(t)?t.jquery?t[0]:t:"string"==typeof t&&0<t.length?document.querySelector(t):null,ht=t=>{if(!u(t)||0===t.getClientRects().length)return!1;var e="visible"===getComputedStyle(t).getPropertyValue("visibility"),n=t.closest("details:not([open])");if(n&&n!==t){t=t.closest("summary");if(t&&t.parentNode!==n)return!1;if(null===t)return!1}return e},dt=t=>!t||t.nodeType!==Node.ELEMENT_NODE||!!t.classList.contains("disabled")||(void 0!==t.disabled?t.disabled:t.hasAttribute("disabled")&&"false"!==t.getAttribute("disabled")),ft=t=>{var e;return document.documentElement.attachShadow?"function"==typeof t.getRootNode?(e=t.getRootNode())instanceof ShadowRoot?e:null:t instanceof ShadowRoot?t:t.parentNode?ft(t.parentNode):null:null},pt=()=>{},gt=t=>{t.offsetHeight},mt=()=>window.jQuery&&!document.body.hasAttribute("data-mdb-no-jquery")?window.jQuery:null,_t=[],h=()=>"rtl"===document.documentElement.dir;t=i=>{var t;t=()=>{const t=mt();if(t){const e=i.NAME,n=t.fn[e];t.fn[e]=i.jQueryInterface,t.fn[e].Constructor=i,t.fn[e].noConflict=()=>(t.fn[e]=n,i.jQueryInterface)}},"loading"===document.readyState?(_t.length||document.addEventListener("DOMContentLoaded",()=>{for(const t of _t)t()}),_t.push(t)):t()};function vt(n,i){if(!(2<arguments.length&&void 0!==arguments[2])||arguments[2]){var t=(t=>{if(!t)return 0;let{transitionDuration:e,transitionDelay:n}=window.getComputedStyle(t);var t=Number.parseFloat(e),i=Number.parseFloat(n);return t||i?(e=e.split(",")[0],n=n.split(",")[0],(Number.parseFloat(e)+Number.parseFloat(n))*rt):0})(i)+5;let e=!1;const o=t=>{t=t.target;t===i&&(e=!0,i.removeEventListener(st,o),d(n))};i.addEventListener(st,o),setTimeout(()=>{e||lt(i)},t)}else d(n)}const d=t=>{"function"==typeof t&&t()},bt=(t,e,n,i)=>{var o=t.length;let r=t.indexOf(e);return-1===r?!n&&i?t[o-1]:t[0]:(r+=n?1:-1,i&&(r=(r+o)%o),t[Math.max(0,Math.min(r,o-1))])},yt=/[^.]*(?=\..*)\.|.*/,wt=/\..*/,Et=/::\d+$/,xt={};let Ct=1;const At={mouseenter:"mouseover",mouseleave:"mouseout"},Tt=new Set(["click","dblclick","mouseup","mousedown","contextmenu","mousewheel","DOMMouseScroll","mouseover","mouseout","mousemove","selectstart","selectend","keydown","keypress","keyup","orientationchange","touchstart","touchmove","touchend","touchcancel","pointerdown","pointermove","pointerup","pointerleave","pointercancel","gesturestart","gesturechange","gestureend","focus","blur","change","reset","select","submit","focusin","focusout","load","unload","beforeunload","resize","move","DOMContentLoaded","readystatechange","error","abort","scroll"]);function Ot(t,e){return e&&"".concat(e,"::").concat(Ct++)||t.uidEvent||Ct++}function St(t){var e=Ot(t);return t.uidEvent=e,xt[e]=xt[e]||{},xt[e]}function Lt(t,e,n){let i=2<arguments.length&&void 0!==n?n:null;return Object.values(t).find(t=>t.callable===e&&t.delegationSelector===i)}function It(t,e,n){var i="string"==typeof e,e=!i&&e||n;let o=jt(t);return[i,e,o=Tt.has(o)?o:t]}function kt(i,o,r,s,a){if("string"==typeof o&&i){let[t,e,n]=It(o,r,s);o in At&&(e=(c=e,function(t){if(!t.relatedTarget||t.relatedTarget!==t.delegateTarget&&!t.delegateTarget.contains(t.relatedTarget))return c.call(this,t)}));var c,l,u,h,d,f,s=St(i),s=s[n]||(s[n]={}),p=Lt(s,e,t?r:null);p?p.oneOff=p.oneOff&&a:(p=Ot(e,o.replace(yt,"")),(o=t?(h=i,d=r,f=e,function e(n){var i=h.querySelectorAll(d);for(let t=n["target"];t&&t!==this;t=t.parentNode)for(const o of i)if(o===t)return Nt(n,{delegateTarget:t}),e.oneOff&&Pt.off(h,n.type,d,f),f.apply(t,[n])}):(l=i,u=e,function t(e){return Nt(e,{delegateTarget:l}),t.oneOff&&Pt.off(l,e.type,u),u.apply(l,[e])})).delegationSelector=t?r:null,o.callable=e,o.oneOff=a,s[o.uidEvent=p]=o,i.addEventListener(n,o,t))}}function Dt(t,e,n,i,o){i=Lt(e[n],i,o);i&&(t.removeEventListener(n,i,Boolean(o)),delete e[n][i.uidEvent])}function jt(t){return t=t.replace(wt,""),At[t]||t}const Pt={on(t,e,n,i){kt(t,e,n,i,!1)},one(t,e,n,i){kt(t,e,n,i,!0)},off(t,e,n,i){if("string"==typeof e&&t){var[i,o,r]=It(e,n,i),s=r!==e,a=St(t),c=a[r]||{},l=e.startsWith(".");if(void 0!==o)return Object.keys(c).length?void Dt(t,a,r,o,i?n:null):void 0;if(l)for(const _ of Object.keys(a)){u=g=p=f=d=h=void 0;var u,h=t,d=a,f=_,p=e.slice(1),g=d[f]||{};for(const v of Object.keys(g))v.includes(p)&&Dt(h,d,f,(u=g[v]).callable,u.delegationSelector)}for(const b of Object.keys(c)){var m=b.replace(Et,"");s&&!e.includes(m)||Dt(t,a,r,(m=c[b]).callable,m.delegationSelector)}}},trigger(t,e,n){if("string"!=typeof e||!t)return null;var i=mt();let o=null,r=!0,s=!0,a=!1;e!==jt(e)&&i&&(o=i.Event(e,n),i(t).trigger(o),r=!o.isPropagationStopped(),s=!o.isImmediatePropagationStopped(),a=o.isDefaultPrevented());i=Nt(i=new Event(e,{bubbles:r,cancelable:!0}),n);return a&&i.preventDefault(),s&&t.dispatchEvent(i),i.defaultPrevented&&o&&o.preventDefault(),i}};function Nt(e,t){for(const[n,i]of Object.entries(t||{}))try{e[n]=i}catch(t){Object.defineProperty(e,n,{configurable:!0,get(){return i}})}return e}var f=Pt;const Mt=new Map;var Ht=function(t,e,n){Mt.has(t)||Mt.set(t,new Map);t=Mt.get(t);t.has(e)||0===t.size?t.set(e,n):console.error("Bootstrap doesn't allow more than one instance per element. Bound instance: ".concat(Array.from(t.keys())[0],"."))},Rt=function(t,e){return Mt.has(t)&&Mt.get(t).get(e)||null},Bt=function(t,e){var n;Mt.has(t)&&((n=Mt.get(t)).delete(e),0===n.size)&&Mt.delete(t)};function Wt(e){if("true"===e)return!0;if("false"===e)return!1;if(e===Number(e).toString())return Number(e);if(""===e||"null"===e)return null;if("string"!=typeof e)return e;try{return JSON.parse(decodeURIComponent(e))}catch(t){return e}}function Ft(t){return t.replace(/[A-Z]/g,t=>"-".concat(t.toLowerCase()))}var p={setDataAttribute(t,e,n){t.setAttribute("data-mdb-".concat(Ft(e)),n)},removeDataAttribute(t,e){t.removeAttribute("data-mdb-".concat(Ft(e)))},getDataAttributes(e){if(!e)return{};var n={};for(const i of Object.keys(e.dataset).filter(t=>t.startsWith("mdb")&&!t.startsWith("mdbConfig"))){let t=i.replace(/^mdb/,"");n[t=t.charAt(0).toLowerCase()+t.slice(1,t.length)]=Wt(e.dataset[i])}return n},getDataAttribute(t,e){return Wt(t.getAttribute("data-mdb-".concat(Ft(e))))}};var g=class{static get Default(){return{}}static get DefaultType(){return{}}static get NAME(){throw new Error('You have to implement the static method "NAME", for each component!')}_getConfig(t){return t=this._mergeConfigObj(t),t=this._configAfterMerge(t),this._typeCheckConfig(t),t}_configAfterMerge(t){return t}_mergeConfigObj(t,e){var n=u(e)?p.getDataAttribute(e,"config"):{};return{...this.constructor.Default,..."object"==typeof n?n:{},...u(e)?p.getDataAttributes(e):{},..."object"==typeof t?t:{}}}_typeCheckConfig(t){var e=1<arguments.length&&void 0!==arguments[1]?arguments[1]:this.constructor.DefaultType;for(const o of Object.keys(e)){var n=e[o],i=t[o],i=u(i)?"element":null==(i=i)?"".concat(i):Object.prototype.toString.call(i).match(/\s([a-z]+)/i)[1].toLowerCase();if(!new RegExp(n).test(i))throw new TypeError("".concat(this.constructor.NAME.toUpperCase(),': Option "').concat(o,'" provided type "').concat(i,'" but expected type "').concat(n,'".'))}}};var m=class extends g{constructor(t,e){super(),(t=ut(t))&&(this._element=t,this._config=this._getConfig(e),Ht(this._element,this.constructor.DATA_KEY,this))}dispose(){Bt(this._element,this.constructor.DATA_KEY),f.off(this._element,this.constructor.EVENT_KEY);for(const t of Object.getOwnPropertyNames(this))this[t]=null}_queueCallback(t,e){var n=!(2<arguments.length&&void 0!==arguments[2])||arguments[2];vt(t,e,n)}_getConfig(t){return t=this._mergeConfigObj(t,this._element),t=this._configAfterMerge(t),this._typeCheckConfig(t),t}static getInstance(t){return Rt(ut(t),this.DATA_KEY)}static getOrCreateInstance(t){var e=1<arguments.length&&void 0!==arguments[1]?arguments[1]:{};return this.getInstance(t)||new this(t,"object"==typeof e?e:null)}static get VERSION(){return"5.2.3"}static get DATA_KEY(){return"bs.".concat(this.NAME)}static get EVENT_KEY(){return".".concat(this.DATA_KEY)}static eventName(t){return"".concat(t).concat(this.EVENT_KEY)}};var _=".".concat("bs.button");const qt='[data-mdb-toggle="button"]';_="click".concat(_).concat(".data-api");class zt extends m{static get NAME(){return"button"}toggle(){this._element.setAttribute("aria-pressed",this._element.classList.toggle("active"))}static jQueryInterface(e){return this.each(function(){var t=zt.getOrCreateInstance(this);"toggle"===e&&t[e]()})}}f.on(document,_,qt,t=>{t.preventDefault();t=t.target.closest(qt);zt.getOrCreateInstance(t).toggle()}),t(zt);_=zt;const Qt="button",Vt="mdb.".concat(Qt);var v=".".concat(Vt);const Ut="click".concat(v),Yt="transitionend",Xt="mouseenter",Kt="mouseleave",$t="hide".concat(v),Gt="hidden".concat(v),Zt="show".concat(v),Jt="shown".concat(v),te="fixed-action-btn";class b extends _{constructor(t){super(t),this._fn={},this._element&&(r.setData(this._element,Vt,this),this._init())}static get NAME(){return Qt}static jQueryInterface(n,i){return this.each(function(){let t=r.getData(this,Vt);var e="object"==typeof n&&n;if((t||!/dispose/.test(n))&&(t=t||new b(this,e),"string"==typeof n)){if(void 0===t[n])throw new TypeError('No method named "'.concat(n,'"'));t[n](i)}})}get _actionButton(){return a.findOne(".fixed-action-btn:not(.smooth-scroll) > .btn-floating",this._element)}get _buttonListElements(){return a.find("ul .btn",this._element)}get _buttonList(){return a.findOne("ul",this._element)}get _isTouchDevice(){return"ontouchstart"in document.documentElement}show(){c.hasClass(this._element,te)&&(s.off(this._buttonList,Yt),s.trigger(this._element,Zt),this._bindListOpenTransitionEnd(),c.addStyle(this._element,{height:"".concat(this._fullContainerHeight,"px")}),this._toggleVisibility(!0))}hide(){c.hasClass(this._element,te)&&(s.off(this._buttonList,Yt),s.trigger(this._element,$t),this._bindListHideTransitionEnd(),this._toggleVisibility(!1))}dispose(){c.hasClass(this._element,te)&&(s.off(this._actionButton,Ut),this._actionButton.removeEventListener(Xt,this._fn.mouseenter),this._element.removeEventListener(Kt,this._fn.mouseleave)),super.dispose()}_init(){c.hasClass(this._element,te)&&(this._saveInitialHeights(),this._setInitialStyles(),this._bindInitialEvents())}_bindMouseEnter(){this._actionButton.addEventListener(Xt,this._fn.mouseenter=()=>{this._isTouchDevice||this.show()})}_bindMouseLeave(){this._element.addEventListener(Kt,this._fn.mouseleave=()=>{this.hide()})}_bindClick(){s.on(this._actionButton,Ut,()=>{c.hasClass(this._element,"active")?this.hide():this.show()})}_bindListHideTransitionEnd(){s.on(this._buttonList,Yt,t=>{"transform"===t.propertyName&&(s.off(this._buttonList,Yt),this._element.style.height="".concat(this._initialContainerHeight,"px"),s.trigger(this._element,Gt))})}_bindListOpenTransitionEnd(){s.on(this._buttonList,Yt,t=>{"transform"===t.propertyName&&(s.off(this._buttonList,Yt),s.trigger(this._element,Jt))})}_toggleVisibility(t){const e=t?"addClass":"removeClass";t=t?"translate(0)":"translateY(".concat(this._fullContainerHeight,"px)");c.addStyle(this._buttonList,{transform:t}),this._buttonListElements&&this._buttonListElements.forEach(t=>c[e](t,"shown")),c[e](this._element,"active")}_getHeight(t){t=window.getComputedStyle(t);return parseFloat(t.getPropertyValue("height"))}_saveInitialHeights(){this._initialContainerHeight=this._getHeight(this._element),this._initialListHeight=this._getHeight(this._buttonList),this._fullContainerHeight=this._initialContainerHeight+this._initialListHeight}_bindInitialEvents(){this._bindClick(),this._bindMouseEnter(),this._bindMouseLeave()}_setInitialStyles(){this._buttonList.style.marginBottom="".concat(this._initialContainerHeight,"px"),this._buttonList.style.transform="translateY(".concat(this._fullContainerHeight,"px)"),this._element.style.height="".concat(this._initialContainerHeight,"px")}}a.find(".fixed-action-btn").forEach(t=>{let e=b.getInstance(t);return e=e||new b(t)}),a.find('[data-mdb-toggle="button"]').forEach(t=>{let e=b.getInstance(t);return e=e||new b(t)}),o(()=>{const t=i();if(t){const e=t.fn[Qt];t.fn[Qt]=b.jQueryInterface,t.fn[Qt].Constructor=b,t.fn[Qt].noConflict=()=>(t.fn[Qt]=e,b.jQueryInterface)}});var ee=b,y={find(t){var e=1<arguments.length&&void 0!==arguments[1]?arguments[1]:document.documentElement;return[].concat(...Element.prototype.querySelectorAll.call(e,t))},findOne(t){var e=1<arguments.length&&void 0!==arguments[1]?arguments[1]:document.documentElement;return Element.prototype.querySelector.call(e,t)},children(t,e){return[].concat(...t.children).filter(t=>t.matches(e))},parents(t,e){var n=[];let i=t.parentNode.closest(e);for(;i;)n.push(i),i=i.parentNode.closest(e);return n},prev(t,e){let n=t.previousElementSibling;for(;n;){if(n.matches(e))return[n];n=n.previousElementSibling}return[]},next(t,e){let n=t.nextElementSibling;for(;n;){if(n.matches(e))return[n];n=n.nextElementSibling}return[]},focusableChildren(t){var e=["a","button","input","textarea","select","details","[tabindex]",'[contenteditable="true"]'].map(t=>"".concat(t,':not([tabindex^="-"])')).join(",");return this.find(e,t).filter(t=>!dt(t)&&ht(t))}};const ne=".fixed-top, .fixed-bottom, .is-fixed, .sticky-top",ie=".sticky-top",oe="padding-right",re="margin-right";var se=class{constructor(){this._element=document.body}getWidth(){var t=document.documentElement.clientWidth;return Math.abs(window.innerWidth-t)}hide(){const e=this.getWidth();this._disableOverFlow(),this._setElementAttributes(this._element,oe,t=>t+e),this._setElementAttributes(ne,oe,t=>t+e),this._setElementAttributes(ie,re,t=>t-e)}reset(){this._resetElementAttributes(this._element,"overflow"),this._resetElementAttributes(this._element,oe),this._resetElementAttributes(ne,oe),this._resetElementAttributes(ie,re)}isOverflowing(){return 0<this.getWidth()}_disableOverFlow(){this._saveInitialAttribute(this._element,"overflow"),this._element.style.overflow="hidden"}_setElementAttributes(t,n,i){const o=this.getWidth();this._applyManipulationCallback(t,t=>{var e;t!==this._element&&window.innerWidth>t.clientWidth+o||(this._saveInitialAttribute(t,n),e=window.getComputedStyle(t).getPropertyValue(n),t.style.setProperty(n,"".concat(i(Number.parseFloat(e)),"px")))})}_saveInitialAttribute(t,e){var n=t.style.getPropertyValue(e);n&&p.setDataAttribute(t,e,n)}_resetElementAttributes(t,n){this._applyManipulationCallback(t,t=>{var e=p.getDataAttribute(t,n);null===e?t.style.removeProperty(n):(p.removeDataAttribute(t,n),t.style.setProperty(n,e))})}_applyManipulationCallback(t,e){if(u(t))e(t);else for(const n of y.find(t,this._element))e(n)}};const ae="backdrop",ce="mousedown.bs.".concat(ae),le={className:"modal-backdrop",clickCallback:null,isAnimated:!1,isVisible:!0,rootElement:"body"},ue={className:"string",clickCallback:"(function|null)",isAnimated:"boolean",isVisible:"boolean",rootElement:"(element|string)"};var he=class extends g{constructor(t){super(),this._config=this._getConfig(t),this._isAppended=!1,this._element=null}static get Default(){return le}static get DefaultType(){return ue}static get NAME(){return ae}show(t){var e;this._config.isVisible?(this._append(),e=this._getElement(),this._config.isAnimated&&gt(e),e.classList.add("show"),this._emulateAnimation(()=>{d(t)})):d(t)}hide(t){this._config.isVisible?(this._getElement().classList.remove("show"),this._emulateAnimation(()=>{this.dispose(),d(t)})):d(t)}dispose(){this._isAppended&&(f.off(this._element,ce),this._element.remove(),this._isAppended=!1)}_getElement(){var t;return this._element||((t=document.createElement("div")).className=this._config.className,this._config.isAnimated&&t.classList.add("fade"),this._element=t),this._element}_configAfterMerge(t){return t.rootElement=ut(t.rootElement),t}_append(){var t;this._isAppended||(t=this._getElement(),this._config.rootElement.append(t),f.on(t,ce,()=>{d(this._config.clickCallback)}),this._isAppended=!0)}_emulateAnimation(t){vt(t,this._getElement(),this._config.isAnimated)}};const de=".".concat("bs.focustrap"),fe="focusin".concat(de),pe="keydown.tab".concat(de),ge="backward",me={autofocus:!0,trapElement:null},_e={autofocus:"boolean",trapElement:"element"};function ve(e){let n=1<arguments.length&&void 0!==arguments[1]?arguments[1]:"hide";var t="click.dismiss".concat(e.EVENT_KEY);const i=e.NAME;f.on(document,t,'[data-mdb-dismiss="'.concat(i,'"]'),function(t){["A","AREA"].includes(this.tagName)&&t.preventDefault(),dt(this)||(t=l(this)||this.closest(".".concat(i)),e.getOrCreateInstance(t)[n]())})}var be=class extends g{constructor(t){super(),this._config=this._getConfig(t),this._isActive=!1,this._lastTabNavDirection=null}static get Default(){return me}static get DefaultType(){return _e}static get NAME(){return"focustrap"}activate(){this._isActive||(this._config.autofocus&&this._config.trapElement.focus(),f.off(document,de),f.on(document,fe,t=>this._handleFocusin(t)),f.on(document,pe,t=>this._handleKeydown(t)),this._isActive=!0)}deactivate(){this._isActive&&(this._isActive=!1,f.off(document,de))}_handleFocusin(t){var e=this._config["trapElement"];t.target===document||t.target===e||e.contains(t.target)||(0===(t=y.focusableChildren(e)).length?e:this._lastTabNavDirection===ge?t[t.length-1]:t[0]).focus()}_handleKeydown(t){"Tab"===t.key&&(this._lastTabNavDirection=t.shiftKey?ge:"forward")}};var v=".".concat("bs.offcanvas"),_=".data-api",w="load".concat(v).concat(_);const ye="showing",we=".offcanvas.show",Ee="show".concat(v),xe="shown".concat(v),Ce="hide".concat(v),Ae="hidePrevented".concat(v),Te="hidden".concat(v);var E="resize".concat(v),_="click".concat(v).concat(_);const Oe="keydown.dismiss".concat(v);const Se={backdrop:!0,keyboard:!0,scroll:!1},Le={backdrop:"(boolean|string)",keyboard:"boolean",scroll:"boolean"};class Ie extends m{constructor(t,e){super(t,e),this._isShown=!1,this._backdrop=this._initializeBackDrop(),this._focustrap=this._initializeFocusTrap(),this._addEventListeners()}static get Default(){return Se}static get DefaultType(){return Le}static get NAME(){return"offcanvas"}toggle(t){return this._isShown?this.hide():this.show(t)}show(t){this._isShown||f.trigger(this._element,Ee,{relatedTarget:t}).defaultPrevented||(this._isShown=!0,this._backdrop.show(),this._config.scroll||(new se).hide(),this._element.setAttribute("aria-modal",!0),this._element.setAttribute("role","dialog"),this._element.classList.add(ye),this._queueCallback(()=>{this._config.scroll&&!this._config.backdrop||this._focustrap.activate(),this._element.classList.add("show"),this._element.classList.remove(ye),f.trigger(this._element,xe,{relatedTarget:t})},this._element,!0))}hide(){this._isShown&&!f.trigger(this._element,Ce).defaultPrevented&&(this._focustrap.deactivate(),this._element.blur(),this._isShown=!1,this._element.classList.add("hiding"),this._backdrop.hide(),this._queueCallback(()=>{this._element.classList.remove("show","hiding"),this._element.removeAttribute("aria-modal"),this._element.removeAttribute("role"),this._config.scroll||(new se).reset(),f.trigger(this._element,Te)},this._element,!0))}dispose(){this._backdrop.dispose(),this._focustrap.deactivate(),super.dispose()}_initializeBackDrop(){var t=Boolean(this._config.backdrop);return new he({className:"offcanvas-backdrop",isVisible:t,isAnimated:!0,rootElement:this._element.parentNode,clickCallback:t?()=>{"static"===this._config.backdrop?f.trigger(this._element,Ae):this.hide()}:null})}_initializeFocusTrap(){return new be({trapElement:this._element})}_addEventListeners(){f.on(this._element,Oe,t=>{"Escape"===t.key&&(this._config.keyboard?this.hide():f.trigger(this._element,Ae))})}static jQueryInterface(e){return this.each(function(){var t=Ie.getOrCreateInstance(this,e);if("string"==typeof e){if(void 0===t[e]||e.startsWith("_")||"constructor"===e)throw new TypeError('No method named "'.concat(e,'"'));t[e](this)}})}}f.on(document,_,'[data-mdb-toggle="offcanvas"]',function(t){var e=l(this);["A","AREA"].includes(this.tagName)&&t.preventDefault(),dt(this)||(f.one(e,Te,()=>{ht(this)&&this.focus()}),(t=y.findOne(we))&&t!==e&&Ie.getInstance(t).hide(),Ie.getOrCreateInstance(e).toggle(this))}),f.on(window,w,()=>{for(const t of y.find(we))Ie.getOrCreateInstance(t).show()}),f.on(window,E,()=>{for(const t of y.find("[aria-modal][class*=show][class*=offcanvas-]"))"fixed"!==getComputedStyle(t).position&&Ie.getOrCreateInstance(t).hide()}),ve(Ie),t(Ie);var ke=Ie;v=".".concat("bs.alert");const De="close".concat(v),je="closed".concat(v);class Pe extends m{static get NAME(){return"alert"}close(){var t;f.trigger(this._element,De).defaultPrevented||(this._element.classList.remove("show"),t=this._element.classList.contains("fade"),this._queueCallback(()=>this._destroyElement(),this._element,t))}_destroyElement(){this._element.remove(),f.trigger(this._element,je),this.dispose()}static jQueryInterface(e){return this.each(function(){var t=Pe.getOrCreateInstance(this);if("string"==typeof e){if(void 0===t[e]||e.startsWith("_")||"constructor"===e)throw new TypeError('No method named "'.concat(e,'"'));t[e](this)}})}}ve(Pe,"close"),t(Pe);_=Pe;const Ne="alert";w="mdb.".concat(Ne),E=".".concat(w);const Me="close.bs.alert",He="closed.bs.alert",Re="close".concat(E),Be="closed".concat(E);class We extends _{constructor(t){super(t,1<arguments.length&&void 0!==arguments[1]?arguments[1]:{}),this._init()}dispose(){s.off(this._element,Me),s.off(this._element,He),super.dispose()}static get NAME(){return Ne}_init(){this._bindCloseEvent(),this._bindClosedEvent()}_bindCloseEvent(){s.on(this._element,Me,()=>{s.trigger(this._element,Re)})}_bindClosedEvent(){s.on(this._element,He,()=>{s.trigger(this._element,Be)})}}a.find(".alert").forEach(t=>{var e=We.getInstance(t);e||new We(t)}),o(()=>{const t=i();if(t){const e=t.fn[Ne];t.fn[Ne]=We.jQueryInterface,t.fn[Ne].Constructor=We,t.fn[Ne].noConflict=()=>(t.fn[Ne]=e,We.jQueryInterface)}});var Fe=We;const qe=".bs.swipe",ze="touchstart".concat(qe),Qe="touchmove".concat(qe),Ve="touchend".concat(qe),Ue="pointerdown".concat(qe),Ye="pointerup".concat(qe),Xe={endCallback:null,leftCallback:null,rightCallback:null},Ke={endCallback:"(function|null)",leftCallback:"(function|null)",rightCallback:"(function|null)"};class $e extends g{constructor(t,e){super(),(this._element=t)&&$e.isSupported()&&(this._config=this._getConfig(e),this._deltaX=0,this._supportPointerEvents=Boolean(window.PointerEvent),this._initEvents())}static get Default(){return Xe}static get DefaultType(){return Ke}static get NAME(){return"swipe"}dispose(){f.off(this._element,qe)}_start(t){this._supportPointerEvents?this._eventIsPointerPenTouch(t)&&(this._deltaX=t.clientX):this._deltaX=t.touches[0].clientX}_end(t){this._eventIsPointerPenTouch(t)&&(this._deltaX=t.clientX-this._deltaX),this._handleSwipe(),d(this._config.endCallback)}_move(t){this._deltaX=t.touches&&1<t.touches.length?0:t.touches[0].clientX-this._deltaX}_handleSwipe(){var t=Math.abs(this._deltaX);t<=40||(t=t/this._deltaX,this._deltaX=0,t&&d(0<t?this._config.rightCallback:this._config.leftCallback))}_initEvents(){this._supportPointerEvents?(f.on(this._element,Ue,t=>this._start(t)),f.on(this._element,Ye,t=>this._end(t)),this._element.classList.add("pointer-event")):(f.on(this._element,ze,t=>this._start(t)),f.on(this._element,Qe,t=>this._move(t)),f.on(this._element,Ve,t=>this._end(t)))}_eventIsPointerPenTouch(t){return this._supportPointerEvents&&("pen"===t.pointerType||"touch"===t.pointerType)}static isSupported(){return"ontouchstart"in document.documentElement||0<navigator.maxTouchPoints}}var Ge=$e;v=".".concat("bs.carousel"),w=".data-api";const Ze="next",Je="prev",tn="left",en="right",nn="slide".concat(v),on="slid".concat(v),rn="keydown".concat(v),sn="mouseenter".concat(v),an="mouseleave".concat(v),cn="dragstart".concat(v);E="load".concat(v).concat(w),_="click".concat(v).concat(w);const ln="carousel",un="active",hn=".active",dn=".carousel-item";hn,dn;const fn={ArrowLeft:en,ArrowRight:tn},pn={interval:5e3,keyboard:!0,pause:"hover",ride:!1,touch:!0,wrap:!0},gn={interval:"(number|boolean)",keyboard:"boolean",pause:"(string|boolean)",ride:"(boolean|string)",touch:"boolean",wrap:"boolean"};class mn extends m{constructor(t,e){super(t,e),this._interval=null,this._activeElement=null,this._isSliding=!1,this.touchTimeout=null,this._swipeHelper=null,this._indicatorsElement=y.findOne(".carousel-indicators",this._element),this._addEventListeners(),this._config.ride===ln&&this.cycle()}static get Default(){return pn}static get DefaultType(){return gn}static get NAME(){return"carousel"}next(){this._slide(Ze)}nextWhenVisible(){!document.hidden&&ht(this._element)&&this.next()}prev(){this._slide(Je)}pause(){this._isSliding&&lt(this._element),this._clearInterval()}cycle(){this._clearInterval(),this._updateInterval(),this._interval=setInterval(()=>this.nextWhenVisible(),this._config.interval)}_maybeEnableCycle(){this._config.ride&&(this._isSliding?f.one(this._element,on,()=>this.cycle()):this.cycle())}to(t){var e,n=this._getItems();t>n.length-1||t<0||(this._isSliding?f.one(this._element,on,()=>this.to(t)):(e=this._getItemIndex(this._getActive()))!==t&&(e=e<t?Ze:Je,this._slide(e,n[t])))}dispose(){this._swipeHelper&&this._swipeHelper.dispose(),super.dispose()}_configAfterMerge(t){return t.defaultInterval=t.interval,t}_addEventListeners(){this._config.keyboard&&f.on(this._element,rn,t=>this._keydown(t)),"hover"===this._config.pause&&(f.on(this._element,sn,()=>this.pause()),f.on(this._element,an,()=>this._maybeEnableCycle())),this._config.touch&&Ge.isSupported()&&this._addTouchEventListeners()}_addTouchEventListeners(){for(const t of y.find(".carousel-item img",this._element))f.on(t,cn,t=>t.preventDefault());this._swipeHelper=new Ge(this._element,{leftCallback:()=>this._slide(this._directionToOrder(tn)),rightCallback:()=>this._slide(this._directionToOrder(en)),endCallback:()=>{"hover"===this._config.pause&&(this.pause(),this.touchTimeout&&clearTimeout(this.touchTimeout),this.touchTimeout=setTimeout(()=>this._maybeEnableCycle(),500+this._config.interval))}})}_keydown(t){var e;/input|textarea/i.test(t.target.tagName)||(e=fn[t.key])&&(t.preventDefault(),this._slide(this._directionToOrder(e)))}_getItemIndex(t){return this._getItems().indexOf(t)}_setActiveIndicatorElement(t){var e;this._indicatorsElement&&((e=y.findOne(hn,this._indicatorsElement)).classList.remove(un),e.removeAttribute("aria-current"),e=y.findOne('[data-mdb-slide-to="'.concat(t,'"]'),this._indicatorsElement))&&(e.classList.add(un),e.setAttribute("aria-current","true"))}_updateInterval(){var t=this._activeElement||this._getActive();t&&(t=Number.parseInt(t.getAttribute("data-mdb-interval"),10),this._config.interval=t||this._config.defaultInterval)}_slide(e){var t=1<arguments.length&&void 0!==arguments[1]?arguments[1]:null;if(!this._isSliding){const i=this._getActive();var n=e===Ze;const o=t||bt(this._getItems(),i,n,this._config.wrap);if(o!==i){const r=this._getItemIndex(o),s=t=>f.trigger(this._element,t,{relatedTarget:o,direction:this._orderToDirection(e),from:this._getItemIndex(i),to:r});t=s(nn);if(!t.defaultPrevented&&i&&o){t=Boolean(this._interval);this.pause(),this._isSliding=!0,this._setActiveIndicatorElement(r),this._activeElement=o;const a=n?"carousel-item-start":"carousel-item-end",c=n?"carousel-item-next":"carousel-item-prev";o.classList.add(c),gt(o),i.classList.add(a),o.classList.add(a);this._queueCallback(()=>{o.classList.remove(a,c),o.classList.add(un),i.classList.remove(un,c,a),this._isSliding=!1,s(on)},i,this._isAnimated()),t&&this.cycle()}}}}_isAnimated(){return this._element.classList.contains("slide")}_getActive(){return y.findOne(".active.carousel-item",this._element)}_getItems(){return y.find(dn,this._element)}_clearInterval(){this._interval&&(clearInterval(this._interval),this._interval=null)}_directionToOrder(t){return h()?t===tn?Je:Ze:t===tn?Ze:Je}_orderToDirection(t){return h()?t===Je?tn:en:t===Je?en:tn}static jQueryInterface(e){return this.each(function(){var t=mn.getOrCreateInstance(this,e);if("number"==typeof e)t.to(e);else if("string"==typeof e){if(void 0===t[e]||e.startsWith("_")||"constructor"===e)throw new TypeError('No method named "'.concat(e,'"'));t[e]()}})}}f.on(document,_,"[data-mdb-slide], [data-mdb-slide-to]",function(t){var e=l(this);e&&e.classList.contains(ln)&&(t.preventDefault(),t=mn.getOrCreateInstance(e),(e=this.getAttribute("data-mdb-slide-to"))?t.to(e):"next"===p.getDataAttribute(this,"slide")?t.next():t.prev(),t._maybeEnableCycle())}),f.on(window,E,()=>{for(const t of y.find('[data-mdb-ride="carousel"]'))mn.getOrCreateInstance(t)}),t(mn);v=mn;const _n="carousel";w="mdb.".concat(_n),_=".".concat(w);const vn="slide.bs.carousel",bn="slid.bs.carousel",yn="slide".concat(_),wn="slid".concat(_);class En extends v{constructor(t,e){super(t,e),this._init()}dispose(){s.off(this._element,vn),s.off(this._element,bn),super.dispose()}static get NAME(){return _n}_init(){this._bindSlideEvent(),this._bindSlidEvent()}_bindSlideEvent(){s.on(this._element,vn,t=>{s.trigger(this._element,yn,{relatedTarget:t.relatedTarget,direction:t.direction,from:t.from,to:t.to})})}_bindSlidEvent(){s.on(this._element,bn,t=>{s.trigger(this._element,wn,{relatedTarget:t.relatedTarget,direction:t.direction,from:t.from,to:t.to})})}}a.find('[data-mdb-ride="carousel"]').forEach(t=>{var e=En.getInstance(t);e||new En(t,c.getDataAttributes(t))}),o(()=>{const t=i();if(t){const e=t.fn[_n];t.fn[_n]=En.jQueryInterface,t.fn[_n].Constructor=En,t.fn[_n].noConflict=()=>(t.fn[_n]=e,En.jQueryInterface)}});var xn=En;const x=".".concat("bs.modal");const Cn="hide".concat(x),An="hidePrevented".concat(x),Tn="hidden".concat(x),On="show".concat(x),Sn="shown".concat(x),Ln="resize".concat(x),In="click.dismiss".concat(x),kn="mousedown.dismiss".concat(x),Dn="keydown.dismiss".concat(x);E="click".concat(x).concat(".data-api");const jn="modal-open",Pn="modal-static";const Nn={backdrop:!0,focus:!0,keyboard:!0},Mn={backdrop:"(boolean|string)",focus:"boolean",keyboard:"boolean"};class Hn extends m{constructor(t,e){super(t,e),this._dialog=y.findOne(".modal-dialog",this._element),this._backdrop=this._initializeBackDrop(),this._focustrap=this._initializeFocusTrap(),this._isShown=!1,this._isTransitioning=!1,this._scrollBar=new se,this._addEventListeners()}static get Default(){return Nn}static get DefaultType(){return Mn}static get NAME(){return"modal"}toggle(t){return this._isShown?this.hide():this.show(t)}show(t){this._isShown||this._isTransitioning||f.trigger(this._element,On,{relatedTarget:t}).defaultPrevented||(this._isShown=!0,this._isTransitioning=!0,this._scrollBar.hide(),document.body.classList.add(jn),this._adjustDialog(),this._backdrop.show(()=>this._showElement(t)))}hide(){!this._isShown||this._isTransitioning||f.trigger(this._element,Cn).defaultPrevented||(this._isShown=!1,this._isTransitioning=!0,this._focustrap.deactivate(),this._element.classList.remove("show"),this._queueCallback(()=>this._hideModal(),this._element,this._isAnimated()))}dispose(){for(const t of[window,this._dialog])f.off(t,x);this._backdrop.dispose(),this._focustrap.deactivate(),super.dispose()}handleUpdate(){this._adjustDialog()}_initializeBackDrop(){return new he({isVisible:Boolean(this._config.backdrop)&&Boolean(!this._config.modalNonInvasive),isAnimated:this._isAnimated()})}_initializeFocusTrap(){return new be({trapElement:this._element})}_showElement(t){document.body.contains(this._element)||document.body.append(this._element),this._element.style.display="block",this._element.removeAttribute("aria-hidden"),this._element.setAttribute("aria-modal",!0),this._element.setAttribute("role","dialog"),this._element.scrollTop=0;var e=y.findOne(".modal-body",this._dialog);e&&(e.scrollTop=0),gt(this._element),this._element.classList.add("show");this._queueCallback(()=>{this._config.focus&&this._focustrap.activate(),this._isTransitioning=!1,f.trigger(this._element,Sn,{relatedTarget:t})},this._dialog,this._isAnimated())}_addEventListeners(){f.on(this._element,Dn,t=>{"Escape"===t.key&&(this._config.keyboard?(t.preventDefault(),this.hide()):this._triggerBackdropTransition())}),f.on(window,Ln,()=>{this._isShown&&!this._isTransitioning&&this._adjustDialog()}),f.on(this._element,kn,e=>{f.one(this._element,In,t=>{this._element===e.target&&this._element===t.target&&("static"===this._config.backdrop?this._triggerBackdropTransition():this._config.backdrop&&this.hide())})})}_hideModal(){this._element.style.display="none",this._element.setAttribute("aria-hidden",!0),this._element.removeAttribute("aria-modal"),this._element.removeAttribute("role"),this._isTransitioning=!1,this._backdrop.hide(()=>{document.body.classList.remove(jn),this._resetAdjustments(),this._scrollBar.reset(),f.trigger(this._element,Tn)})}_isAnimated(){return this._element.classList.contains("fade")}_triggerBackdropTransition(){var t=f.trigger(this._element,An);if(!t.defaultPrevented){t=this._element.scrollHeight>document.documentElement.clientHeight;const e=this._element.style.overflowY;"hidden"===e||this._element.classList.contains(Pn)||(t||(this._element.style.overflowY="hidden"),this._element.classList.add(Pn),this._queueCallback(()=>{this._element.classList.remove(Pn),this._queueCallback(()=>{this._element.style.overflowY=e},this._dialog)},this._dialog),this._element.focus())}}_adjustDialog(){var t,e=this._element.scrollHeight>document.documentElement.clientHeight,n=this._scrollBar.getWidth(),i=0<n;i&&!e&&(t=h()?"paddingLeft":"paddingRight",this._element.style[t]="".concat(n,"px")),!i&&e&&(t=h()?"paddingRight":"paddingLeft",this._element.style[t]="".concat(n,"px"))}_resetAdjustments(){this._element.style.paddingLeft="",this._element.style.paddingRight=""}static jQueryInterface(e,n){return this.each(function(){var t=Hn.getOrCreateInstance(this,e);if("string"==typeof e){if(void 0===t[e])throw new TypeError('No method named "'.concat(e,'"'));t[e](n)}})}}f.on(document,E,'[data-mdb-toggle="modal"]',function(t){const e=l(this);["A","AREA"].includes(this.tagName)&&t.preventDefault(),f.one(e,On,t=>{t.defaultPrevented||f.one(e,Tn,()=>{ht(this)&&this.focus()})}),y.find(".modal.show").forEach(t=>{t.classList.contains("modal-non-invasive-show")||Hn.getInstance(t).hide()}),Hn.getOrCreateInstance(e).toggle(this)}),ve(Hn),t(Hn);w=Hn;const Rn="modal";_="mdb.".concat(Rn),v=".".concat(_);const Bn="hide.bs.modal",Wn="hidePrevented.bs.modal",Fn="hidden.bs.modal",qn="show.bs.modal",zn="shown.bs.modal",Qn="hide".concat(v),Vn="hidePrevented".concat(v),Un="hidden".concat(v),Yn="show".concat(v),Xn="shown".concat(v);class Kn extends w{constructor(t,e){super(t,e),this._init()}dispose(){s.off(this._element,qn),s.off(this._element,zn),s.off(this._element,Bn),s.off(this._element,Fn),s.off(this._element,Wn),super.dispose()}static get NAME(){return Rn}_init(){this._bindShowEvent(),this._bindShownEvent(),this._bindHideEvent(),this._bindHiddenEvent(),this._bindHidePreventedEvent()}_bindShowEvent(){s.on(this._element,qn,t=>{s.trigger(this._element,Yn,{relatedTarget:t.relatedTarget})})}_bindShownEvent(){s.on(this._element,zn,t=>{s.trigger(this._element,Xn,{relatedTarget:t.relatedTarget})})}_bindHideEvent(){s.on(this._element,Bn,()=>{s.trigger(this._element,Qn)})}_bindHiddenEvent(){s.on(this._element,Fn,()=>{s.trigger(this._element,Un)})}_bindHidePreventedEvent(){s.on(this._element,Wn,()=>{s.trigger(this._element,Vn)})}}a.find('[data-mdb-toggle="modal"]').forEach(t=>{var t=(t=>{t=H(t);return t&&document.querySelector(t)?t:null})(t),t=a.findOne(t),e=Kn.getInstance(t);e||new Kn(t)}),o(()=>{const t=i();if(t){const e=t.fn[Rn];t.fn[Rn]=Kn.jQueryInterface,t.fn[Rn].Constructor=Kn,t.fn[Rn].noConflict=()=>(t.fn[Rn]=e,Kn.jQueryInterface)}});var $n=Kn,O="top",S="bottom",L="right",I="left",Gn="auto",Zn=[O,S,L,I],Jn="start",ti="end",ei="clippingParents",ni="viewport",ii="popper",oi="reference",ri=Zn.reduce(function(t,e){return t.concat([e+"-"+Jn,e+"-"+ti])},[]),si=[].concat(Zn,[Gn]).reduce(function(t,e){return t.concat([e,e+"-"+Jn,e+"-"+ti])},[]),ai="beforeRead",ci="read",li="afterRead",ui="beforeMain",hi="main",di="afterMain",fi="beforeWrite",pi="write",gi="afterWrite",mi=[ai,ci,li,ui,hi,di,fi,pi,gi];function C(t){return t?(t.nodeName||"").toLowerCase():null}function A(t){var e;return null==t?window:"[object Window]"!==t.toString()?(e=t.ownerDocument)&&e.defaultView||window:t}function _i(t){return t instanceof A(t).Element||t instanceof Element}function T(t){return t instanceof A(t).HTMLElement||t instanceof HTMLElement}function vi(t){return"undefined"!=typeof ShadowRoot&&(t instanceof A(t).ShadowRoot||t instanceof ShadowRoot)}var bi={name:"applyStyles",enabled:!0,phase:"write",fn:function(t){var o=t.state;Object.keys(o.elements).forEach(function(t){var e=o.styles[t]||{},n=o.attributes[t]||{},i=o.elements[t];T(i)&&C(i)&&(Object.assign(i.style,e),Object.keys(n).forEach(function(t){var e=n[t];!1===e?i.removeAttribute(t):i.setAttribute(t,!0===e?"":e)}))})},effect:function(t){var i=t.state,o={popper:{position:i.options.strategy,left:"0",top:"0",margin:"0"},arrow:{position:"absolute"},reference:{}};return Object.assign(i.elements.popper.style,o.popper),i.styles=o,i.elements.arrow&&Object.assign(i.elements.arrow.style,o.arrow),function(){Object.keys(i.elements).forEach(function(t){var e=i.elements[t],n=i.attributes[t]||{},t=Object.keys((i.styles.hasOwnProperty(t)?i.styles:o)[t]).reduce(function(t,e){return t[e]="",t},{});T(e)&&C(e)&&(Object.assign(e.style,t),Object.keys(n).forEach(function(t){e.removeAttribute(t)}))})}},requires:["computeStyles"]};function k(t){return t.split("-")[0]}var yi=Math.max,wi=Math.min,Ei=Math.round;function xi(){var t=navigator.userAgentData;return null!=t&&t.brands?t.brands.map(function(t){return t.brand+"/"+t.version}).join(" "):navigator.userAgent}function Ci(){return!/^((?!chrome|android).)*safari/i.test(xi())}function Ai(t,e,n){void 0===e&&(e=!1),void 0===n&&(n=!1);var i=t.getBoundingClientRect(),o=1,r=1;e&&T(t)&&(o=0<t.offsetWidth&&Ei(i.width)/t.offsetWidth||1,r=0<t.offsetHeight&&Ei(i.height)/t.offsetHeight||1);e=(_i(t)?A(t):window).visualViewport,t=!Ci()&&n,n=(i.left+(t&&e?e.offsetLeft:0))/o,t=(i.top+(t&&e?e.offsetTop:0))/r,e=i.width/o,o=i.height/r;return{width:e,height:o,top:t,right:n+e,bottom:t+o,left:n,x:n,y:t}}function Ti(t){var e=Ai(t),n=t.offsetWidth,i=t.offsetHeight;return Math.abs(e.width-n)<=1&&(n=e.width),Math.abs(e.height-i)<=1&&(i=e.height),{x:t.offsetLeft,y:t.offsetTop,width:n,height:i}}function Oi(t,e){var n=e.getRootNode&&e.getRootNode();if(t.contains(e))return!0;if(n&&vi(n)){var i=e;do{if(i&&t.isSameNode(i))return!0}while(i=i.parentNode||i.host)}return!1}function D(t){return A(t).getComputedStyle(t)}function Si(t){return((_i(t)?t.ownerDocument:t.document)||window.document).documentElement}function Li(t){return"html"===C(t)?t:t.assignedSlot||t.parentNode||(vi(t)?t.host:null)||Si(t)}function Ii(t){return T(t)&&"fixed"!==D(t).position?t.offsetParent:null}function ki(t){for(var e,n=A(t),i=Ii(t);i&&(e=i,0<=["table","td","th"].indexOf(C(e)))&&"static"===D(i).position;)i=Ii(i);return(!i||"html"!==C(i)&&("body"!==C(i)||"static"!==D(i).position))&&(i||function(t){var e=/firefox/i.test(xi()),n=/Trident/i.test(xi());if(!n||!T(t)||"fixed"!==D(t).position){var i=Li(t);for(vi(i)&&(i=i.host);T(i)&&["html","body"].indexOf(C(i))<0;){var o=D(i);if("none"!==o.transform||"none"!==o.perspective||"paint"===o.contain||-1!==["transform","perspective"].indexOf(o.willChange)||e&&"filter"===o.willChange||e&&o.filter&&"none"!==o.filter)return i;i=i.parentNode}}return null}(t))||n}function Di(t){return 0<=["top","bottom"].indexOf(t)?"x":"y"}function ji(t,e,n){return yi(t,wi(e,n))}function Pi(){return{top:0,right:0,bottom:0,left:0}}function Ni(t){return Object.assign({},Pi(),t)}function Mi(n,t){return t.reduce(function(t,e){return t[e]=n,t},{})}var Hi={name:"arrow",enabled:!0,phase:"main",fn:function(t){var e,n,i,o,r=t.state,s=t.name,t=t.options,a=r.elements.arrow,c=r.modifiersData.popperOffsets,l=Di(u=k(r.placement)),u=0<=[I,L].indexOf(u)?"height":"width";a&&c&&(t=t.padding,n=r,n=Ni("number"!=typeof(t="function"==typeof t?t(Object.assign({},n.rects,{placement:n.placement})):t)?t:Mi(t,Zn)),t=Ti(a),o="y"===l?O:I,i="y"===l?S:L,e=r.rects.reference[u]+r.rects.reference[l]-c[l]-r.rects.popper[u],c=c[l]-r.rects.reference[l],a=(a=ki(a))?"y"===l?a.clientHeight||0:a.clientWidth||0:0,o=n[o],n=a-t[u]-n[i],o=ji(o,i=a/2-t[u]/2+(e/2-c/2),n),r.modifiersData[s]=((a={})[l]=o,a.centerOffset=o-i,a))},effect:function(t){var e=t.state;null!=(t=void 0===(t=t.options.element)?"[data-popper-arrow]":t)&&("string"!=typeof t||(t=e.elements.popper.querySelector(t)))&&Oi(e.elements.popper,t)&&(e.elements.arrow=t)},requires:["popperOffsets"],requiresIfExists:["preventOverflow"]};function Ri(t){return t.split("-")[1]}var Bi={top:"auto",right:"auto",bottom:"auto",left:"auto"};function Wi(t){var e,n,i,o=t.popper,r=t.popperRect,s=t.placement,a=t.variation,c=t.offsets,l=t.position,u=t.gpuAcceleration,h=t.adaptive,d=t.roundOffsets,t=t.isFixed,f=c.x,f=void 0===f?0:f,p=c.y,p=void 0===p?0:p,g="function"==typeof d?d({x:f,y:p}):{x:f,y:p},g=(f=g.x,p=g.y,c.hasOwnProperty("x")),c=c.hasOwnProperty("y"),m=I,_=O,v=window,o=(h&&(n="clientHeight",e="clientWidth",(i=ki(o))===A(o)&&"static"!==D(i=Si(o)).position&&"absolute"===l&&(n="scrollHeight",e="scrollWidth"),s!==O&&(s!==I&&s!==L||a!==ti)||(_=S,p=(p-((t&&i===v&&v.visualViewport?v.visualViewport.height:i[n])-r.height))*(u?1:-1)),s!==I&&(s!==O&&s!==S||a!==ti)||(m=L,f=(f-((t&&i===v&&v.visualViewport?v.visualViewport.width:i[e])-r.width))*(u?1:-1))),Object.assign({position:l},h&&Bi)),t=!0===d?(s=(n={x:f,y:p}).x,n=n.y,a=window.devicePixelRatio||1,{x:Ei(s*a)/a||0,y:Ei(n*a)/a||0}):{x:f,y:p};return f=t.x,p=t.y,u?Object.assign({},o,((i={})[_]=c?"0":"",i[m]=g?"0":"",i.transform=(v.devicePixelRatio||1)<=1?"translate("+f+"px, "+p+"px)":"translate3d("+f+"px, "+p+"px, 0)",i)):Object.assign({},o,((e={})[_]=c?p+"px":"",e[m]=g?f+"px":"",e.transform="",e))}var Fi={name:"computeStyles",enabled:!0,phase:"beforeWrite",fn:function(t){var e=t.state,t=t.options,n=void 0===(n=t.gpuAcceleration)||n,i=void 0===(i=t.adaptive)||i,t=void 0===(t=t.roundOffsets)||t,n={placement:k(e.placement),variation:Ri(e.placement),popper:e.elements.popper,popperRect:e.rects.popper,gpuAcceleration:n,isFixed:"fixed"===e.options.strategy};null!=e.modifiersData.popperOffsets&&(e.styles.popper=Object.assign({},e.styles.popper,Wi(Object.assign({},n,{offsets:e.modifiersData.popperOffsets,position:e.options.strategy,adaptive:i,roundOffsets:t})))),null!=e.modifiersData.arrow&&(e.styles.arrow=Object.assign({},e.styles.arrow,Wi(Object.assign({},n,{offsets:e.modifiersData.arrow,position:"absolute",adaptive:!1,roundOffsets:t})))),e.attributes.popper=Object.assign({},e.attributes.popper,{"data-popper-placement":e.placement})},data:{}},qi={passive:!0};var zi={name:"eventListeners",enabled:!0,phase:"write",fn:function(){},effect:function(t){var e=t.state,n=t.instance,i=(t=t.options).scroll,o=void 0===i||i,r=void 0===(i=t.resize)||i,s=A(e.elements.popper),a=[].concat(e.scrollParents.reference,e.scrollParents.popper);return o&&a.forEach(function(t){t.addEventListener("scroll",n.update,qi)}),r&&s.addEventListener("resize",n.update,qi),function(){o&&a.forEach(function(t){t.removeEventListener("scroll",n.update,qi)}),r&&s.removeEventListener("resize",n.update,qi)}},data:{}},Qi={left:"right",right:"left",bottom:"top",top:"bottom"};function Vi(t){return t.replace(/left|right|bottom|top/g,function(t){return Qi[t]})}var Ui={start:"end",end:"start"};function Yi(t){return t.replace(/start|end/g,function(t){return Ui[t]})}function Xi(t){t=A(t);return{scrollLeft:t.pageXOffset,scrollTop:t.pageYOffset}}function Ki(t){return Ai(Si(t)).left+Xi(t).scrollLeft}function $i(t){var t=D(t),e=t.overflow,n=t.overflowX,t=t.overflowY;return/auto|scroll|overlay|hidden/.test(e+t+n)}function Gi(t,e){void 0===e&&(e=[]);var n=function t(e){return 0<=["html","body","#document"].indexOf(C(e))?e.ownerDocument.body:T(e)&&$i(e)?e:t(Li(e))}(t),t=n===(null==(t=t.ownerDocument)?void 0:t.body),i=A(n),i=t?[i].concat(i.visualViewport||[],$i(n)?n:[]):n,n=e.concat(i);return t?n:n.concat(Gi(Li(i)))}function Zi(t){return Object.assign({},t,{left:t.x,top:t.y,right:t.x+t.width,bottom:t.y+t.height})}function Ji(t,e,n){return e===ni?Zi((o=n,s=A(i=t),a=Si(i),s=s.visualViewport,c=a.clientWidth,a=a.clientHeight,u=l=0,s&&(c=s.width,a=s.height,(r=Ci())||!r&&"fixed"===o)&&(l=s.offsetLeft,u=s.offsetTop),{width:c,height:a,x:l+Ki(i),y:u})):_i(e)?((o=Ai(r=e,!1,"fixed"===(o=n))).top=o.top+r.clientTop,o.left=o.left+r.clientLeft,o.bottom=o.top+r.clientHeight,o.right=o.left+r.clientWidth,o.width=r.clientWidth,o.height=r.clientHeight,o.x=o.left,o.y=o.top,o):Zi((s=Si(t),c=Si(s),a=Xi(s),l=null==(l=s.ownerDocument)?void 0:l.body,i=yi(c.scrollWidth,c.clientWidth,l?l.scrollWidth:0,l?l.clientWidth:0),u=yi(c.scrollHeight,c.clientHeight,l?l.scrollHeight:0,l?l.clientHeight:0),s=-a.scrollLeft+Ki(s),a=-a.scrollTop,"rtl"===D(l||c).direction&&(s+=yi(c.clientWidth,l?l.clientWidth:0)-i),{width:i,height:u,x:s,y:a}));var i,o,r,s,a,c,l,u}function to(n,t,e,i){var o,r="clippingParents"===t?(s=Gi(Li(r=n)),_i(o=0<=["absolute","fixed"].indexOf(D(r).position)&&T(r)?ki(r):r)?s.filter(function(t){return _i(t)&&Oi(t,o)&&"body"!==C(t)}):[]):[].concat(t),s=[].concat(r,[e]),t=s[0],e=s.reduce(function(t,e){e=Ji(n,e,i);return t.top=yi(e.top,t.top),t.right=wi(e.right,t.right),t.bottom=wi(e.bottom,t.bottom),t.left=yi(e.left,t.left),t},Ji(n,t,i));return e.width=e.right-e.left,e.height=e.bottom-e.top,e.x=e.left,e.y=e.top,e}function eo(t){var e,n=t.reference,i=t.element,t=t.placement,o=t?k(t):null,t=t?Ri(t):null,r=n.x+n.width/2-i.width/2,s=n.y+n.height/2-i.height/2;switch(o){case O:e={x:r,y:n.y-i.height};break;case S:e={x:r,y:n.y+n.height};break;case L:e={x:n.x+n.width,y:s};break;case I:e={x:n.x-i.width,y:s};break;default:e={x:n.x,y:n.y}}var a=o?Di(o):null;if(null!=a){var c="y"===a?"height":"width";switch(t){case Jn:e[a]=e[a]-(n[c]/2-i[c]/2);break;case ti:e[a]=e[a]+(n[c]/2-i[c]/2)}}return e}function no(t,e){var i,e=e=void 0===e?{}:e,n=e.placement,n=void 0===n?t.placement:n,o=e.strategy,o=void 0===o?t.strategy:o,r=e.boundary,r=void 0===r?ei:r,s=e.rootBoundary,s=void 0===s?ni:s,a=e.elementContext,a=void 0===a?ii:a,c=e.altBoundary,c=void 0!==c&&c,e=e.padding,e=void 0===e?0:e,e=Ni("number"!=typeof e?e:Mi(e,Zn)),l=t.rects.popper,c=t.elements[c?a===ii?oi:ii:a],c=to(_i(c)?c:c.contextElement||Si(t.elements.popper),r,s,o),r=Ai(t.elements.reference),s=eo({reference:r,element:l,strategy:"absolute",placement:n}),o=Zi(Object.assign({},l,s)),l=a===ii?o:r,u={top:c.top-l.top+e.top,bottom:l.bottom-c.bottom+e.bottom,left:c.left-l.left+e.left,right:l.right-c.right+e.right},s=t.modifiersData.offset;return a===ii&&s&&(i=s[n],Object.keys(u).forEach(function(t){var e=0<=[L,S].indexOf(t)?1:-1,n=0<=[O,S].indexOf(t)?"y":"x";u[t]+=i[n]*e})),u}var io={name:"flip",enabled:!0,phase:"main",fn:function(t){var h=t.state,e=t.options,t=t.name;if(!h.modifiersData[t]._skip){for(var n=e.mainAxis,i=void 0===n||n,n=e.altAxis,o=void 0===n||n,n=e.fallbackPlacements,d=e.padding,f=e.boundary,p=e.rootBoundary,r=e.altBoundary,s=e.flipVariations,g=void 0===s||s,m=e.allowedAutoPlacements,s=h.options.placement,e=k(s),n=n||(e===s||!g?[Vi(s)]:k(n=s)===Gn?[]:(e=Vi(n),[Yi(n),e,Yi(e)])),a=[s].concat(n).reduce(function(t,e){return t.concat(k(e)===Gn?(n=h,i=(t=t=void 0===(t={placement:e,boundary:f,rootBoundary:p,padding:d,flipVariations:g,allowedAutoPlacements:m})?{}:t).placement,o=t.boundary,r=t.rootBoundary,s=t.padding,a=t.flipVariations,c=void 0===(t=t.allowedAutoPlacements)?si:t,l=Ri(i),t=l?a?ri:ri.filter(function(t){return Ri(t)===l}):Zn,u=(i=0===(i=t.filter(function(t){return 0<=c.indexOf(t)})).length?t:i).reduce(function(t,e){return t[e]=no(n,{placement:e,boundary:o,rootBoundary:r,padding:s})[k(e)],t},{}),Object.keys(u).sort(function(t,e){return u[t]-u[e]})):e);var n,i,o,r,s,a,c,l,u},[]),c=h.rects.reference,l=h.rects.popper,u=new Map,_=!0,v=a[0],b=0;b<a.length;b++){var y=a[b],w=k(y),E=Ri(y)===Jn,x=0<=[O,S].indexOf(w),C=x?"width":"height",A=no(h,{placement:y,boundary:f,rootBoundary:p,altBoundary:r,padding:d}),x=x?E?L:I:E?S:O,E=(c[C]>l[C]&&(x=Vi(x)),Vi(x)),C=[];if(i&&C.push(A[w]<=0),o&&C.push(A[x]<=0,A[E]<=0),C.every(function(t){return t})){v=y,_=!1;break}u.set(y,C)}if(_)for(var T=g?3:1;0<T;T--)if("break"===function(e){var t=a.find(function(t){t=u.get(t);if(t)return t.slice(0,e).every(function(t){return t})});if(t)return v=t,"break"}(T))break;h.placement!==v&&(h.modifiersData[t]._skip=!0,h.placement=v,h.reset=!0)}},requiresIfExists:["offset"],data:{_skip:!1}};function oo(t,e,n){return{top:t.top-e.height-(n=void 0===n?{x:0,y:0}:n).y,right:t.right-e.width+n.x,bottom:t.bottom-e.height+n.y,left:t.left-e.width-n.x}}function ro(e){return[O,L,S,I].some(function(t){return 0<=e[t]})}var so={name:"hide",enabled:!0,phase:"main",requiresIfExists:["preventOverflow"],fn:function(t){var e=t.state,t=t.name,n=e.rects.reference,i=e.rects.popper,o=e.modifiersData.preventOverflow,r=no(e,{elementContext:"reference"}),s=no(e,{altBoundary:!0}),r=oo(r,n),n=oo(s,i,o),s=ro(r),i=ro(n);e.modifiersData[t]={referenceClippingOffsets:r,popperEscapeOffsets:n,isReferenceHidden:s,hasPopperEscaped:i},e.attributes.popper=Object.assign({},e.attributes.popper,{"data-popper-reference-hidden":s,"data-popper-escaped":i})}};var ao={name:"offset",enabled:!0,phase:"main",requires:["popperOffsets"],fn:function(t){var s=t.state,e=t.options,t=t.name,a=void 0===(e=e.offset)?[0,0]:e,e=si.reduce(function(t,e){var n,i,o,r;return t[e]=(e=e,n=s.rects,i=a,o=k(e),r=0<=[I,O].indexOf(o)?-1:1,e=(n="function"==typeof i?i(Object.assign({},n,{placement:e})):i)[0]||0,i=(n[1]||0)*r,0<=[I,L].indexOf(o)?{x:i,y:e}:{x:e,y:i}),t},{}),n=(i=e[s.placement]).x,i=i.y;null!=s.modifiersData.popperOffsets&&(s.modifiersData.popperOffsets.x+=n,s.modifiersData.popperOffsets.y+=i),s.modifiersData[t]=e}};var co={name:"popperOffsets",enabled:!0,phase:"read",fn:function(t){var e=t.state,t=t.name;e.modifiersData[t]=eo({reference:e.rects.reference,element:e.rects.popper,strategy:"absolute",placement:e.placement})},data:{}};var lo={name:"preventOverflow",enabled:!0,phase:"main",fn:function(t){var e,n,i,o,r,s,a,c,l,u=t.state,h=t.options,t=t.name,d=void 0===(d=h.mainAxis)||d,f=void 0!==(f=h.altAxis)&&f,p=h.boundary,g=h.rootBoundary,m=h.altBoundary,_=h.padding,v=void 0===(v=h.tether)||v,h=void 0===(h=h.tetherOffset)?0:h,p=no(u,{boundary:p,rootBoundary:g,padding:_,altBoundary:m}),g=k(u.placement),m=!(_=Ri(u.placement)),b=Di(g),y="x"===b?"y":"x",w=u.modifiersData.popperOffsets,E=u.rects.reference,x=u.rects.popper,h="number"==typeof(h="function"==typeof h?h(Object.assign({},u.rects,{placement:u.placement})):h)?{mainAxis:h,altAxis:h}:Object.assign({mainAxis:0,altAxis:0},h),C=u.modifiersData.offset?u.modifiersData.offset[u.placement]:null,A={x:0,y:0};w&&(d&&(d="y"===b?"height":"width",s=(a=w[b])+p[n="y"===b?O:I],c=a-p[l="y"===b?S:L],e=v?-x[d]/2:0,o=(_===Jn?E:x)[d],_=_===Jn?-x[d]:-E[d],r=u.elements.arrow,r=v&&r?Ti(r):{width:0,height:0},n=(i=u.modifiersData["arrow#persistent"]?u.modifiersData["arrow#persistent"].padding:Pi())[n],i=i[l],l=ji(0,E[d],r[d]),r=m?E[d]/2-e-l-n-h.mainAxis:o-l-n-h.mainAxis,o=m?-E[d]/2+e+l+i+h.mainAxis:_+l+i+h.mainAxis,m=(n=u.elements.arrow&&ki(u.elements.arrow))?"y"===b?n.clientTop||0:n.clientLeft||0:0,_=a+o-(e=null!=(d=null==C?void 0:C[b])?d:0),l=ji(v?wi(s,a+r-e-m):s,a,v?yi(c,_):c),w[b]=l,A[b]=l-a),f&&(i="y"==y?"height":"width",o=(n=w[y])+p["x"===b?O:I],d=n-p["x"===b?S:L],r=-1!==[O,I].indexOf(g),m=null!=(e=null==C?void 0:C[y])?e:0,s=r?o:n-E[i]-x[i]-m+h.altAxis,_=r?n+E[i]+x[i]-m-h.altAxis:d,a=v&&r?(c=ji(c=s,n,l=_),l<c?l:c):ji(v?s:o,n,v?_:d),w[y]=a,A[y]=a-n),u.modifiersData[t]=A)},requiresIfExists:["offset"]};function uo(t,e,n){void 0===n&&(n=!1);var i=T(e),o=T(e)&&(s=(o=e).getBoundingClientRect(),r=Ei(s.width)/o.offsetWidth||1,s=Ei(s.height)/o.offsetHeight||1,1!==r||1!==s),r=Si(e),s=Ai(t,o,n),t={scrollLeft:0,scrollTop:0},a={x:0,y:0};return!i&&n||("body"===C(e)&&!$i(r)||(t=(i=e)!==A(i)&&T(i)?{scrollLeft:i.scrollLeft,scrollTop:i.scrollTop}:Xi(i)),T(e)?((a=Ai(e,!0)).x+=e.clientLeft,a.y+=e.clientTop):r&&(a.x=Ki(r))),{x:s.left+t.scrollLeft-a.x,y:s.top+t.scrollTop-a.y,width:s.width,height:s.height}}function ho(t){var n=new Map,i=new Set,o=[];return t.forEach(function(t){n.set(t.name,t)}),t.forEach(function(t){i.has(t.name)||!function e(t){i.add(t.name),[].concat(t.requires||[],t.requiresIfExists||[]).forEach(function(t){i.has(t)||(t=n.get(t))&&e(t)}),o.push(t)}(t)}),o}var fo={placement:"bottom",modifiers:[],strategy:"absolute"};function po(){for(var t=arguments.length,e=new Array(t),n=0;n<t;n++)e[n]=arguments[n];return!e.some(function(t){return!(t&&"function"==typeof t.getBoundingClientRect)})}function go(t){var t=t=void 0===t?{}:t,e=t.defaultModifiers,h=void 0===e?[]:e,e=t.defaultOptions,d=void 0===e?fo:e;return function(i,o,e){void 0===e&&(e=d);var n,r,s={placement:"bottom",orderedModifiers:[],options:Object.assign({},fo,d),modifiersData:{},elements:{reference:i,popper:o},attributes:{},styles:{}},a=[],c=!1,l={state:s,setOptions:function(t){var n,e,t="function"==typeof t?t(s.options):t,t=(u(),s.options=Object.assign({},d,s.options,t),s.scrollParents={reference:_i(i)?Gi(i):i.contextElement?Gi(i.contextElement):[],popper:Gi(o)},t=[].concat(h,s.options.modifiers),e=t.reduce(function(t,e){var n=t[e.name];return t[e.name]=n?Object.assign({},n,e,{options:Object.assign({},n.options,e.options),data:Object.assign({},n.data,e.data)}):e,t},{}),t=Object.keys(e).map(function(t){return e[t]}),n=ho(t),mi.reduce(function(t,e){return t.concat(n.filter(function(t){return t.phase===e}))},[]));return s.orderedModifiers=t.filter(function(t){return t.enabled}),s.orderedModifiers.forEach(function(t){var e=t.name,n=t.options,t=t.effect;"function"==typeof t&&(t=t({state:s,name:e,instance:l,options:void 0===n?{}:n}),a.push(t||function(){}))}),l.update()},forceUpdate:function(){if(!c){var t=s.elements,e=t.reference,t=t.popper;if(po(e,t)){s.rects={reference:uo(e,ki(t),"fixed"===s.options.strategy),popper:Ti(t)},s.reset=!1,s.placement=s.options.placement,s.orderedModifiers.forEach(function(t){return s.modifiersData[t.name]=Object.assign({},t.data)});for(var n,i,o,r=0;r<s.orderedModifiers.length;r++)!0===s.reset?(s.reset=!1,r=-1):(n=(o=s.orderedModifiers[r]).fn,i=o.options,o=o.name,"function"==typeof n&&(s=n({state:s,options:void 0===i?{}:i,name:o,instance:l})||s))}}},update:(n=function(){return new Promise(function(t){l.forceUpdate(),t(s)})},function(){return r=r||new Promise(function(t){Promise.resolve().then(function(){r=void 0,t(n())})})}),destroy:function(){u(),c=!0}};return po(i,o)&&l.setOptions(e).then(function(t){!c&&e.onFirstUpdate&&e.onFirstUpdate(t)}),l;function u(){a.forEach(function(t){return t()}),a=[]}}}var mo=go(),_o=go({defaultModifiers:[zi,co,Fi,bi,ao,io,lo,Hi,so]}),vo=go({defaultModifiers:[zi,co,Fi,bi]});const bo=new Set(["background","cite","href","itemtype","longdesc","poster","src","xlink:href"]);const yo=/^(?:(?:https?|mailto|ftp|tel|file|sms):|[^#&/:?]*(?:[#/?]|$))/i,wo=/^data:(?:image\/(?:bmp|gif|jpeg|jpg|png|tiff|webp)|video\/(?:mpeg|mp4|ogg|webm)|audio\/(?:mp3|oga|ogg|opus));base64,[\d+/a-z]+=*$/i;E={"*":["class","dir","id","lang","role",/^aria-[\w-]*$/i],a:["target","href","title","rel"],area:[],b:[],br:[],col:[],code:[],div:[],em:[],hr:[],h1:[],h2:[],h3:[],h4:[],h5:[],h6:[],i:[],img:["src","srcset","alt","title","width","height"],li:[],ol:[],p:[],pre:[],s:[],small:[],span:[],sub:[],sup:[],strong:[],u:[],ul:[]};function Eo(t,e,n){if(!t.length)return t;if(n&&"function"==typeof n)return n(t);n=(new window.DOMParser).parseFromString(t,"text/html");for(const s of[].concat(...n.body.querySelectorAll("*"))){var i=s.nodeName.toLowerCase();if(Object.keys(e).includes(i)){var o=[].concat(...s.attributes),r=[].concat(e["*"]||[],e[i]||[]);for(const a of o)((t,e)=>{const n=t.nodeName.toLowerCase();return e.includes(n)?!bo.has(n)||Boolean(yo.test(t.nodeValue)||wo.test(t.nodeValue)):e.filter(t=>t instanceof RegExp).some(t=>t.test(n))})(a,r)||s.removeAttribute(a.nodeName)}else s.remove()}return n.body.innerHTML}const xo={allowList:E,content:{},extraClass:"",html:!1,sanitize:!0,sanitizeFn:null,template:"<div></div>"},Co={allowList:"object",content:"object",extraClass:"(string|function)",html:"boolean",sanitize:"boolean",sanitizeFn:"(null|function)",template:"string"},Ao={entry:"(string|element|function|null)",selector:"(string|element)"};var To=class extends g{constructor(t){super(),this._config=this._getConfig(t)}static get Default(){return xo}static get DefaultType(){return Co}static get NAME(){return"TemplateFactory"}getContent(){return Object.values(this._config.content).map(t=>this._resolvePossibleFunction(t)).filter(Boolean)}hasContent(){return 0<this.getContent().length}changeContent(t){return this._checkContent(t),this._config.content={...this._config.content,...t},this}toHtml(){var t,e,n=document.createElement("div");n.innerHTML=this._maybeSanitize(this._config.template);for([t,e]of Object.entries(this._config.content))this._setContent(n,e,t);var i=n.children[0],o=this._resolvePossibleFunction(this._config.extraClass);return o&&i.classList.add(...o.split(" ")),i}_typeCheckConfig(t){super._typeCheckConfig(t),this._checkContent(t.content)}_checkContent(t){for(var[e,n]of Object.entries(t))super._typeCheckConfig({selector:e,entry:n},Ao)}_setContent(t,e,n){n=y.findOne(n,t);n&&((e=this._resolvePossibleFunction(e))?u(e)?this._putElementInTemplate(ut(e),n):this._config.html?n.innerHTML=this._maybeSanitize(e):n.textContent=e:n.remove())}_maybeSanitize(t){return this._config.sanitize?Eo(t,this._config.allowList,this._config.sanitizeFn):t}_resolvePossibleFunction(t){return"function"==typeof t?t(this):t}_putElementInTemplate(t,e){this._config.html?(e.innerHTML="",e.append(t)):e.textContent=t.textContent}};const Oo=new Set(["sanitize","allowList","sanitizeFn"]),So="fade";const Lo="show",Io=".".concat("modal"),ko="hide.bs.modal",Do="hover",jo="focus",Po={AUTO:"auto",TOP:"top",RIGHT:h()?"left":"right",BOTTOM:"bottom",LEFT:h()?"right":"left"},No={allowList:E,animation:!0,boundary:"clippingParents",container:!1,customClass:"",delay:0,fallbackPlacements:["top","right","bottom","left"],html:!1,offset:[0,0],placement:"top",popperConfig:null,sanitize:!0,sanitizeFn:null,selector:!1,template:'<div class="tooltip" role="tooltip"><div class="tooltip-arrow"></div><div class="tooltip-inner"></div></div>',title:"",trigger:"hover focus"},Mo={allowList:"object",animation:"boolean",boundary:"(string|element)",container:"(string|element|boolean)",customClass:"(string|function)",delay:"(number|object)",fallbackPlacements:"array",html:"boolean",offset:"(array|string|function)",placement:"(string|function)",popperConfig:"(null|object|function)",sanitize:"boolean",sanitizeFn:"(null|function)",selector:"(string|boolean)",template:"string",title:"(string|element|function)",trigger:"string"};class Ho extends m{constructor(t,e){if(void 0===n)throw new TypeError("Bootstrap's tooltips require Popper (https://popper.js.org)");super(t,e),this._isEnabled=!0,this._timeout=0,this._isHovered=null,this._activeTrigger={},this._popper=null,this._templateFactory=null,this._newContent=null,this.tip=null,this._setListeners(),this._config.selector||this._fixTitle()}static get Default(){return No}static get DefaultType(){return Mo}static get NAME(){return"tooltip"}enable(){this._isEnabled=!0}disable(){this._isEnabled=!1}toggleEnabled(){this._isEnabled=!this._isEnabled}toggle(){this._isEnabled&&(this._activeTrigger.click=!this._activeTrigger.click,this._isShown()?this._leave():this._enter())}dispose(){clearTimeout(this._timeout),f.off(this._element.closest(Io),ko,this._hideModalHandler),this._element.getAttribute("data-mdb-original-title")&&this._element.setAttribute("title",this._element.getAttribute("data-mdb-original-title")),this._disposePopper(),super.dispose()}show(){if("none"===this._element.style.display)throw new Error("Please use show on visible elements");if(this._isWithContent()&&this._isEnabled){var t=f.trigger(this._element,this.constructor.eventName("show")),e=(ft(this._element)||this._element.ownerDocument.documentElement).contains(this._element);if(!t.defaultPrevented&&e){this._disposePopper();t=this._getTipElement(),e=(this._element.setAttribute("aria-describedby",t.getAttribute("id")),this._config)["container"];if(this._element.ownerDocument.documentElement.contains(this.tip)||(e.append(t),f.trigger(this._element,this.constructor.eventName("inserted"))),this._popper=this._createPopper(t),t.classList.add(Lo),"ontouchstart"in document.documentElement)for(const n of[].concat(...document.body.children))f.on(n,"mouseover",pt);this._queueCallback(()=>{f.trigger(this._element,this.constructor.eventName("shown")),!1===this._isHovered&&this._leave(),this._isHovered=!1},this.tip,this._isAnimated())}}}hide(){if(this._isShown()){var t=f.trigger(this._element,this.constructor.eventName("hide"));if(!t.defaultPrevented){if(this._getTipElement().classList.remove(Lo),"ontouchstart"in document.documentElement)for(const e of[].concat(...document.body.children))f.off(e,"mouseover",pt);this._activeTrigger.click=!1,this._activeTrigger[jo]=!1,this._activeTrigger[Do]=!1,this._isHovered=null;this._queueCallback(()=>{this._isWithActiveTrigger()||(this._isHovered||this._disposePopper(),this._element.removeAttribute("aria-describedby"),f.trigger(this._element,this.constructor.eventName("hidden")))},this.tip,this._isAnimated())}}}update(){this._popper&&this._popper.update()}_isWithContent(){return Boolean(this._getTitle())}_getTipElement(){return this.tip||(this.tip=this._createTipElement(this._newContent||this._getContentForTemplate())),this.tip}_createTipElement(t){t=this._getTemplateFactory(t).toHtml();if(!t)return null;t.classList.remove(So,Lo),t.classList.add("bs-".concat(this.constructor.NAME,"-auto"));var e=(t=>{for(;t+=Math.floor(1e6*Math.random()),document.getElementById(t););return t})(this.constructor.NAME).toString();return t.setAttribute("id",e),this._isAnimated()&&t.classList.add(So),t}setContent(t){this._newContent=t,this._isShown()&&(this._disposePopper(),this.show())}_getTemplateFactory(t){return this._templateFactory?this._templateFactory.changeContent(t):this._templateFactory=new To({...this._config,content:t,extraClass:this._resolvePossibleFunction(this._config.customClass)}),this._templateFactory}_getContentForTemplate(){return{".tooltip-inner":this._getTitle()}}_getTitle(){return this._resolvePossibleFunction(this._config.title)||this._element.getAttribute("data-mdb-original-title")}_initializeOnDelegatedTarget(t){return this.constructor.getOrCreateInstance(t.delegateTarget,this._getDelegateConfig())}_isAnimated(){return this._config.animation||this.tip&&this.tip.classList.contains(So)}_isShown(){return this.tip&&this.tip.classList.contains(Lo)}_createPopper(t){var e="function"==typeof this._config.placement?this._config.placement.call(this,t,this._element):this._config.placement,e=Po[e.toUpperCase()];return _o(this._element,t,this._getPopperConfig(e))}_getOffset(){const e=this._config["offset"];return"string"==typeof e?e.split(",").map(t=>Number.parseInt(t,10)):"function"==typeof e?t=>e(t,this._element):e}_resolvePossibleFunction(t){return"function"==typeof t?t.call(this._element):t}_getPopperConfig(t){t={placement:t,modifiers:[{name:"flip",options:{fallbackPlacements:this._config.fallbackPlacements}},{name:"offset",options:{offset:this._getOffset()}},{name:"preventOverflow",options:{boundary:this._config.boundary}},{name:"arrow",options:{element:".".concat(this.constructor.NAME,"-arrow")}},{name:"preSetPlacement",enabled:!0,phase:"beforeMain",fn:t=>{this._getTipElement().setAttribute("data-popper-placement",t.state.placement)}}]};return{...t,..."function"==typeof this._config.popperConfig?this._config.popperConfig(t):this._config.popperConfig}}_setListeners(){var t,e;for(const n of this._config.trigger.split(" "))"click"===n?f.on(this._element,this.constructor.eventName("click"),this._config.selector,t=>{this._initializeOnDelegatedTarget(t).toggle()}):"manual"!==n&&(t=n===Do?this.constructor.eventName("mouseenter"):this.constructor.eventName("focusin"),e=n===Do?this.constructor.eventName("mouseleave"):this.constructor.eventName("focusout"),f.on(this._element,t,this._config.selector,t=>{var e=this._initializeOnDelegatedTarget(t);e._activeTrigger["focusin"===t.type?jo:Do]=!0,e._enter()}),f.on(this._element,e,this._config.selector,t=>{var e=this._initializeOnDelegatedTarget(t);e._activeTrigger["focusout"===t.type?jo:Do]=e._element.contains(t.relatedTarget),e._leave()}));this._hideModalHandler=()=>{this._element&&this.hide()},f.on(this._element.closest(Io),ko,this._hideModalHandler)}_fixTitle(){var t=this._element.getAttribute("title");t&&(this._element.getAttribute("aria-label")||this._element.textContent.trim()||this._element.setAttribute("aria-label",t),this._element.setAttribute("data-mdb-original-title",t),this._element.removeAttribute("title"))}_enter(){this._isShown()||this._isHovered?this._isHovered=!0:(this._isHovered=!0,this._setTimeout(()=>{this._isHovered&&this.show()},this._config.delay.show))}_leave(){this._isWithActiveTrigger()||(this._isHovered=!1,this._setTimeout(()=>{this._isHovered||this.hide()},this._config.delay.hide))}_setTimeout(t,e){clearTimeout(this._timeout),this._timeout=setTimeout(t,e)}_isWithActiveTrigger(){return Object.values(this._activeTrigger).includes(!0)}_getConfig(t){var e=p.getDataAttributes(this._element);for(const n of Object.keys(e))Oo.has(n)&&delete e[n];return t={...e,..."object"==typeof t&&t?t:{}},t=this._mergeConfigObj(t),t=this._configAfterMerge(t),this._typeCheckConfig(t),t}_configAfterMerge(t){return t.container=!1===t.container?document.body:ut(t.container),"number"==typeof t.delay&&(t.delay={show:t.delay,hide:t.delay}),"number"==typeof t.title&&(t.title=t.title.toString()),"number"==typeof t.content&&(t.content=t.content.toString()),t}_getDelegateConfig(){var t={};for(const e in this._config)this.constructor.Default[e]!==this._config[e]&&(t[e]=this._config[e]);return t.selector=!1,t.trigger="manual",t}_disposePopper(){this._popper&&(this._popper.destroy(),this._popper=null),this.tip&&(this.tip.remove(),this.tip=null)}static jQueryInterface(e){return this.each(function(){var t=Ho.getOrCreateInstance(this,e);if("string"==typeof e){if(void 0===t[e])throw new TypeError('No method named "'.concat(e,'"'));t[e]()}})}}t(Ho);_=Ho;const Ro={..._.Default,content:"",offset:[0,8],placement:"right",template:'<div class="popover" role="tooltip"><div class="popover-arrow"></div><h3 class="popover-header"></h3><div class="popover-body"></div></div>',trigger:"click"},Bo={..._.DefaultType,content:"(null|string|element|function)"};class Wo extends _{static get Default(){return Ro}static get DefaultType(){return Bo}static get NAME(){return"popover"}_isWithContent(){return this._getTitle()||this._getContent()}_getContentForTemplate(){return{".popover-header":this._getTitle(),".popover-body":this._getContent()}}_getContent(){return this._resolvePossibleFunction(this._config.content)}static jQueryInterface(e){return this.each(function(){var t=Wo.getOrCreateInstance(this,e);if("string"==typeof e){if(void 0===t[e])throw new TypeError('No method named "'.concat(e,'"'));t[e]()}})}}t(Wo);v=Wo;const Fo="popover";w="mdb.".concat(Fo),g=".".concat(w);const qo="show.bs.popover",zo="shown.bs.popover",Qo="hide.bs.popover",Vo="hidden.bs.popover",Uo="inserted.bs.popover",Yo="show".concat(g),Xo="shown".concat(g),Ko="hide".concat(g),$o="hidden".concat(g),Go="inserted".concat(g);class Zo extends v{constructor(t,e){super(t,e),this._init()}dispose(){s.off(this.element,qo),s.off(this.element,zo),s.off(this.element,Qo),s.off(this.element,Vo),s.off(this.element,Uo),super.dispose()}static get NAME(){return Fo}_init(){this._bindShowEvent(),this._bindShownEvent(),this._bindHideEvent(),this._bindHiddenEvent(),this._bindInsertedEvent()}_bindShowEvent(){s.on(this.element,qo,()=>{s.trigger(this.element,Yo)})}_bindShownEvent(){s.on(this.element,zo,()=>{s.trigger(this.element,Xo)})}_bindHideEvent(){s.on(this.element,Qo,()=>{s.trigger(this.element,Ko)})}_bindHiddenEvent(){s.on(this.element,Vo,()=>{s.trigger(this.element,$o)})}_bindInsertedEvent(){s.on(this.element,Uo,()=>{s.trigger(this.element,Go)})}}a.find('[data-mdb-toggle="popover"]').forEach(t=>{var e=Zo.getInstance(t);e||new Zo(t)}),o(()=>{const t=i();if(t){const e=t.fn[Fo];t.fn[Fo]=Zo.jQueryInterface,t.fn[Fo].Constructor=Zo,t.fn[Fo].noConflict=()=>(t.fn[Fo]=e,Zo.jQueryInterface)}});var Jo=Zo;E=".".concat("bs.scrollspy");const tr="activate".concat(E),er="click".concat(E);"load".concat(E).concat(".data-api");const nr="active";const ir="[href]";w=".nav-link";const or="".concat(w,", ").concat(".nav-item"," > ").concat(w,", ").concat(".list-group-item"),rr={offset:null,rootMargin:"0px 0px -25%",smoothScroll:!1,target:null,threshold:[.1,.5,1]},sr={offset:"(number|null)",rootMargin:"string",smoothScroll:"boolean",target:"element",threshold:"array"};class ar extends m{constructor(t,e){super(t,e),this._config.target&&(this._targetLinks=new Map,this._observableSections=new Map,this._rootElement="visible"===getComputedStyle(this._element).overflowY?null:this._element,this._activeTarget=null,this._observer=null,this._previousScrollData={visibleEntryTop:0,parentScrollTop:0},this.refresh())}static get Default(){return rr}static get DefaultType(){return sr}static get NAME(){return"scrollspy"}refresh(){this._initializeTargetsAndObservables(),this._maybeEnableSmoothScroll(),this._observer?this._observer.disconnect():this._observer=this._getNewObserver();for(const t of this._observableSections.values())this._observer.observe(t)}dispose(){this._observer&&this._observer.disconnect(),super.dispose()}_configAfterMerge(t){return t.target=ut(t.target)||document.body,t.rootMargin=t.offset?"".concat(t.offset,"px 0px -30%"):t.rootMargin,"string"==typeof t.threshold&&(t.threshold=t.threshold.split(",").map(t=>Number.parseFloat(t))),t}_maybeEnableSmoothScroll(){this._config.smoothScroll&&(f.off(this._config.target,er),f.on(this._config.target,er,ir,t=>{var e=this._observableSections.get(t.target.hash);e&&(t.preventDefault(),t=this._rootElement||window,e=e.offsetTop-this._element.offsetTop,t.scrollTo?t.scrollTo({top:e,behavior:"smooth"}):t.scrollTop=e)}))}_getNewObserver(){var t={root:this._rootElement,threshold:this._config.threshold,rootMargin:this._config.rootMargin};return new IntersectionObserver(t=>this._observerCallback(t),t)}_observerCallback(t){const e=t=>this._targetLinks.get("#".concat(t.target.id));var n=t=>{this._previousScrollData.visibleEntryTop=t.target.offsetTop,this._process(e(t))},i=(this._rootElement||document.documentElement).scrollTop,o=i>=this._previousScrollData.parentScrollTop;this._previousScrollData.parentScrollTop=i;for(const s of t)if(s.isIntersecting){var r=s.target.offsetTop>=this._previousScrollData.visibleEntryTop;if(o&&r){if(n(s),i)continue;return}o||r||n(s)}else this._activeTarget=null,this._clearActiveClass(e(s))}_initializeTargetsAndObservables(){var t;this._targetLinks=new Map,this._observableSections=new Map;for(const e of y.find(ir,this._config.target))e.hash&&!dt(e)&&(t=y.findOne(e.hash,this._element),ht(t))&&(this._targetLinks.set(e.hash,e),this._observableSections.set(e.hash,t))}_process(t){this._activeTarget!==t&&(this._clearActiveClass(this._config.target),(this._activeTarget=t).classList.add(nr),this._activateParents(t),f.trigger(this._element,tr,{relatedTarget:t}))}_activateParents(t){if(t.classList.contains("dropdown-item"))y.findOne(".dropdown-toggle",t.closest(".dropdown")).classList.add(nr);else for(const e of y.parents(t,".nav, .list-group"))for(const n of y.prev(e,or))n.classList.add(nr)}_clearActiveClass(t){t.classList.remove(nr);for(const e of y.find("".concat(ir,".").concat(nr),t))e.classList.remove(nr)}static jQueryInterface(e){return this.each(function(){var t=ar.getOrCreateInstance(this,e);if("string"==typeof e){if(void 0===t[e]||e.startsWith("_")||"constructor"===e)throw new TypeError('No method named "'.concat(e,'"'));t[e]()}})}}t(ar);g=ar;const cr="scrollspy";v="mdb.".concat(cr),E=".".concat(v);const lr="activate.bs.scrollspy",ur="activate".concat(E);w="load".concat(E).concat(".data-api");const hr="collapsible-scrollspy";const dr=".".concat("active"),fr=".".concat(hr);class pr extends g{constructor(t,e){super(t,e),this._collapsibles=[],this._init()}dispose(){s.off(this._scrollElement,lr),super.dispose()}static get NAME(){return cr}_init(){this._bindActivateEvent(),this._getCollapsibles(),0!==this._collapsibles.length&&(this._showSubsection(),this._hideSubsection())}_getHeight(t){return t.offsetHeight}_hide(t){t=a.findOne("ul",t.parentNode);t.style.overflow="hidden",t.style.height="".concat(0,"px")}_show(t,e){t.style.height=e}_getCollapsibles(){var t=a.find(fr);t&&t.forEach(t=>{var e=t.parentNode,e=a.findOne("ul",e),n=e.offsetHeight;this._collapsibles.push({element:e,relatedTarget:t.getAttribute("href"),height:"".concat(n,"px")})})}_showSubsection(){a.find(dr).filter(t=>c.hasClass(t,hr)).forEach(e=>{var t=a.findOne("ul",e.parentNode),n=this._collapsibles.find(t=>t.relatedTarget=e.getAttribute("href")).height;this._show(t,n)})}_hideSubsection(){a.find(fr).filter(t=>!1===c.hasClass(t,"active")).forEach(t=>{this._hide(t)})}_bindActivateEvent(){s.on(this._element,lr,t=>{this._showSubsection(),this._hideSubsection(),s.trigger(this._element,ur,{relatedTarget:t.relatedTarget})})}}s.on(window,w,()=>{a.find('[data-mdb-spy="scroll"]').forEach(t=>{var e=pr.getInstance(t);e||new pr(t,c.getDataAttributes(t))})}),o(()=>{const t=i();if(t){const e=t.fn[cr];t.fn[cr]=pr.jQueryInterface,t.fn[cr].Constructor=pr,t.fn[cr].noConflict=()=>(t.fn[cr]=e,pr.jQueryInterface)}});var gr=pr;v=".".concat("bs.tab");const mr="hide".concat(v),_r="hidden".concat(v),vr="show".concat(v),br="shown".concat(v);E="click".concat(v);const yr="keydown".concat(v);g="load".concat(v);const wr="ArrowRight",Er="ArrowDown",xr="active",Cr="show";w=":not(.dropdown-toggle)";v=".nav-link".concat(w,", .list-group-item").concat(w,', [role="tab"]').concat(w),w='[data-mdb-toggle="tab"], [data-mdb-toggle="pill"], [data-mdb-toggle="list"]';const Ar="".concat(v,", ").concat(w),Tr=".".concat(xr,'[data-mdb-toggle="tab"], .').concat(xr,'[data-mdb-toggle="pill"], .').concat(xr,'[data-mdb-toggle="list"]');class Or extends m{constructor(t){super(t),this._parent=this._element.closest('.list-group, .nav, [role="tablist"]'),this._parent&&(this._setInitialAttributes(this._parent,this._getChildren()),f.on(this._element,yr,t=>this._keydown(t)))}static get NAME(){return"tab"}show(){var t,e,n=this._element;this._elemIsActive(n)||(e=(t=this._getActiveElem())?f.trigger(t,mr,{relatedTarget:n}):null,f.trigger(n,vr,{relatedTarget:t}).defaultPrevented)||e&&e.defaultPrevented||(this._deactivate(t,n),this._activate(n,t))}_activate(t,e){t&&(t.classList.add(xr),this._activate(l(t)),this._queueCallback(()=>{"tab"!==t.getAttribute("role")?t.classList.add(Cr):(t.removeAttribute("tabindex"),t.setAttribute("aria-selected",!0),this._toggleDropDown(t,!0),f.trigger(t,br,{relatedTarget:e}))},t,t.classList.contains("fade")))}_deactivate(t,e){t&&(t.classList.remove(xr),t.blur(),this._deactivate(l(t)),this._queueCallback(()=>{"tab"!==t.getAttribute("role")?t.classList.remove(Cr):(t.setAttribute("aria-selected",!1),t.setAttribute("tabindex","-1"),this._toggleDropDown(t,!1),f.trigger(t,_r,{relatedTarget:e}))},t,t.classList.contains("fade")))}_keydown(t){var e;["ArrowLeft",wr,"ArrowUp",Er].includes(t.key)&&(t.stopPropagation(),t.preventDefault(),e=[wr,Er].includes(t.key),t=bt(this._getChildren().filter(t=>!dt(t)),t.target,e,!0))&&(t.focus({preventScroll:!0}),Or.getOrCreateInstance(t).show())}_getChildren(){return y.find(Ar,this._parent)}_getActiveElem(){return this._getChildren().find(t=>this._elemIsActive(t))||null}_setInitialAttributes(t,e){this._setAttributeIfNotExists(t,"role","tablist");for(const n of e)this._setInitialAttributesOnChild(n)}_setInitialAttributesOnChild(t){t=this._getInnerElement(t);var e=this._elemIsActive(t),n=this._getOuterElement(t);t.setAttribute("aria-selected",e),n!==t&&this._setAttributeIfNotExists(n,"role","presentation"),e||t.setAttribute("tabindex","-1"),this._setAttributeIfNotExists(t,"role","tab"),this._setInitialAttributesOnTargetPanel(t)}_setInitialAttributesOnTargetPanel(t){var e=l(t);e&&(this._setAttributeIfNotExists(e,"role","tabpanel"),t.id)&&this._setAttributeIfNotExists(e,"aria-labelledby","#".concat(t.id))}_toggleDropDown(t,n){const i=this._getOuterElement(t);i.classList.contains("dropdown")&&((t=(t,e)=>{t=y.findOne(t,i);t&&t.classList.toggle(e,n)})(".dropdown-toggle",xr),t(".dropdown-menu",Cr),i.setAttribute("aria-expanded",n))}_setAttributeIfNotExists(t,e,n){t.hasAttribute(e)||t.setAttribute(e,n)}_elemIsActive(t){return t.classList.contains(xr)}_getInnerElement(t){return t.matches(Ar)?t:y.findOne(Ar,t)}_getOuterElement(t){return t.closest(".nav-item, .list-group-item")||t}static jQueryInterface(e){return this.each(function(){var t=Or.getOrCreateInstance(this);if("string"==typeof e){if(void 0===t[e]||e.startsWith("_")||"constructor"===e)throw new TypeError('No method named "'.concat(e,'"'));t[e]()}})}}f.on(document,E,w,function(t){["A","AREA"].includes(this.tagName)&&t.preventDefault(),dt(this)||Or.getOrCreateInstance(this).show()}),f.on(window,g,()=>{for(const t of y.find(Tr))Or.getOrCreateInstance(t)}),t(Or);v=Or;const Sr="tab";E="mdb.".concat(Sr),w=".".concat(E);const Lr="show.bs.tab",Ir="shown.bs.tab",kr="show".concat(w),Dr="shown".concat(w),jr="hide".concat(w),Pr="hidden".concat(w);class Nr extends v{dispose(){s.off(this._element,Lr),s.off(this._element,Ir),super.dispose()}static get NAME(){return Sr}show(){var n=this._element;if(!this._elemIsActive(n)){var i=this._getActiveElem();let t=null,e=null;i&&(t=s.trigger(i,"hide.bs.tab",{relatedTarget:n}),e=s.trigger(i,jr,{relatedTarget:n}));var o=s.trigger(n,Lr,{relatedTarget:i}),r=s.trigger(n,kr,{relatedTarget:i});o.defaultPrevented&&r.defaultPrevented||t&&t.defaultPrevented&&e&&e.defaultPrevented||(this._deactivate(i,n),this._activate(n,i))}}_activate(t,e){t&&(t.classList.add("active"),this._activate(R(t)),this._queueCallback(()=>{"tab"!==t.getAttribute("role")?t.classList.add("show"):(t.focus(),t.removeAttribute("tabindex"),t.setAttribute("aria-selected",!0),this._toggleDropDown(t,!0),s.trigger(t,Ir,{relatedTarget:e}),s.trigger(t,Dr,{relatedTarget:e}))},t,t.classList.contains("fade")))}_deactivate(t,e){t&&(t.classList.remove("active"),t.blur(),this._deactivate(R(t)),this._queueCallback(()=>{"tab"!==t.getAttribute("role")?t.classList.remove("show"):(t.setAttribute("aria-selected",!1),t.setAttribute("tabindex","-1"),this._toggleDropDown(t,!1),s.trigger(t,"hidden.bs.tab",{relatedTarget:e}),s.trigger(t,Pr,{relatedTarget:e}))},t,t.classList.contains("fade")))}}a.find('[data-mdb-toggle="tab"], [data-mdb-toggle="pill"], [data-mdb-toggle="list"]').forEach(t=>{var e=Nr.getInstance(t);e||new Nr(t)}),o(()=>{const t=i();if(t){const e=t.fn.tab;t.fn.tab=Nr.jQueryInterface,t.fn.tab.Constructor=Nr,t.fn.tab.noConflict=()=>(t.fn.tab=e,Nr.jQueryInterface)}});var Mr=Nr;const Hr="tooltip";g="mdb.".concat(Hr),E=".".concat(g);const Rr="hide.bs.tooltip",Br="hidden.bs.tooltip",Wr="show.bs.tooltip",Fr="shown.bs.tooltip",qr="inserted.bs.tooltip",zr="hide".concat(E),Qr="hidden".concat(E),Vr="show".concat(E),Ur="shown".concat(E),Yr="inserted".concat(E);class Xr extends _{constructor(t,e){super(t,e),this._init()}dispose(){s.off(this._element,Wr),s.off(this._element,Fr),s.off(this._element,Rr),s.off(this._element,Br),s.off(this._element,qr),super.dispose()}static get NAME(){return Hr}_init(){this._bindShowEvent(),this._bindShownEvent(),this._bindHideEvent(),this._bindHiddenEvent(),this._bindHidePreventedEvent()}_bindShowEvent(){s.on(this.element,Wr,()=>{s.trigger(this.element,Vr)})}_bindShownEvent(){s.on(this.element,Fr,()=>{s.trigger(this.element,Ur)})}_bindHideEvent(){s.on(this.element,Rr,()=>{s.trigger(this.element,zr)})}_bindHiddenEvent(){s.on(this.element,Br,()=>{s.trigger(this.element,Qr)})}_bindHidePreventedEvent(){s.on(this.element,qr,()=>{s.trigger(this.element,Yr)})}}a.find('[data-mdb-toggle="tooltip"]').forEach(t=>{var e=Xr.getInstance(t);e||new Xr(t)}),o(()=>{const t=i();if(t){const e=t.fn[Hr];t.fn[Hr]=Xr.jQueryInterface,t.fn[Hr].Constructor=Xr,t.fn[Hr].noConflict=()=>(t.fn[Hr]=e,Xr.jQueryInterface)}});var Kr=Xr;w=".".concat("bs.toast");const $r="mouseover".concat(w),Gr="mouseout".concat(w),Zr="focusin".concat(w),Jr="focusout".concat(w),ts="hide".concat(w),es="hidden".concat(w),ns="show".concat(w),is="shown".concat(w),os="show",rs="showing",ss={animation:"boolean",autohide:"boolean",delay:"number"},as={animation:!0,autohide:!0,delay:5e3};class cs extends m{constructor(t,e){super(t,e),this._timeout=null,this._hasMouseInteraction=!1,this._hasKeyboardInteraction=!1,this._setListeners()}static get Default(){return as}static get DefaultType(){return ss}static get NAME(){return"toast"}show(){f.trigger(this._element,ns).defaultPrevented||(this._clearTimeout(),this._config.animation&&this._element.classList.add("fade"),this._element.classList.remove("hide"),gt(this._element),this._element.classList.add(os,rs),this._queueCallback(()=>{this._element.classList.remove(rs),f.trigger(this._element,is),this._maybeScheduleHide()},this._element,this._config.animation))}hide(){this.isShown()&&!f.trigger(this._element,ts).defaultPrevented&&(this._element.classList.add(rs),this._queueCallback(()=>{this._element.classList.add("hide"),this._element.classList.remove(rs,os),f.trigger(this._element,es)},this._element,this._config.animation))}dispose(){this._clearTimeout(),this.isShown()&&this._element.classList.remove(os),super.dispose()}isShown(){return this._element.classList.contains(os)}_maybeScheduleHide(){!this._config.autohide||this._hasMouseInteraction||this._hasKeyboardInteraction||(this._timeout=setTimeout(()=>{this.hide()},this._config.delay))}_onInteraction(t,e){switch(t.type){case"mouseover":case"mouseout":this._hasMouseInteraction=e;break;case"focusin":case"focusout":this._hasKeyboardInteraction=e}e?this._clearTimeout():(t=t.relatedTarget,this._element===t||this._element.contains(t)||this._maybeScheduleHide())}_setListeners(){f.on(this._element,$r,t=>this._onInteraction(t,!0)),f.on(this._element,Gr,t=>this._onInteraction(t,!1)),f.on(this._element,Zr,t=>this._onInteraction(t,!0)),f.on(this._element,Jr,t=>this._onInteraction(t,!1))}_clearTimeout(){clearTimeout(this._timeout),this._timeout=null}static jQueryInterface(e){return this.each(function(){var t=cs.getOrCreateInstance(this,e);if("string"==typeof e){if(void 0===t[e])throw new TypeError('No method named "'.concat(e,'"'));t[e](this)}})}}ve(cs),t(cs);v=cs;const ls="toast";g="mdb.".concat(ls),E=".".concat(g);const us="show.bs.toast",hs="shown.bs.toast",ds="hide.bs.toast",fs="hidden.bs.toast",ps="show".concat(E),gs="shown".concat(E),ms="hide".concat(E),_s="hidden".concat(E);class vs extends v{constructor(t,e){super(t,e),this._init()}dispose(){s.off(this._element,us),s.off(this._element,hs),s.off(this._element,ds),s.off(this._element,fs),super.dispose()}static get NAME(){return ls}_init(){this._bindShowEvent(),this._bindShownEvent(),this._bindHideEvent(),this._bindHiddenEvent()}_bindShowEvent(){s.on(this._element,us,()=>{s.trigger(this._element,ps)})}_bindShownEvent(){s.on(this._element,hs,()=>{s.trigger(this._element,gs)})}_bindHideEvent(){s.on(this._element,ds,()=>{s.trigger(this._element,ms)})}_bindHiddenEvent(){s.on(this._element,fs,()=>{s.trigger(this._element,_s)})}}a.find(".toast").forEach(t=>{var e=vs.getInstance(t);e||new vs(t)}),o(()=>{const t=i();if(t){const e=t.fn[ls];t.fn[ls]=vs.jQueryInterface,t.fn[ls].Constructor=vs,t.fn[ls].noConflict=()=>(t.fn[ls]=e,vs.jQueryInterface)}});var bs=vs;e(116);const ys="input",ws="mdb.input";_="form-outline";const Es="active",xs="form-notch",Cs="form-notch-leading",As="form-notch-middle";const Ts=".".concat(_," input"),Os=".".concat(_," textarea"),Ss=".".concat(xs),Ls=".".concat(Cs),Is=".".concat(As),ks=".".concat("form-helper");class j{constructor(t){this._element=t,this._label=null,this._labelWidth=0,this._labelMarginLeft=0,this._notchLeading=null,this._notchMiddle=null,this._notchTrailing=null,this._initiated=!1,this._helper=null,this._counter=!1,this._counterElement=null,this._maxLength=0,this._leadingIcon=null,this._element&&(r.setData(t,ws,this),this.init())}static get NAME(){return ys}get input(){return a.findOne("input",this._element)||a.findOne("textarea",this._element)}init(){this._initiated||(this._getLabelData(),this._applyDivs(),this._applyNotch(),this._activate(),this._getHelper(),this._getCounter(),this._initiated=!0)}update(){this._getLabelData(),this._getNotchData(),this._applyNotch(),this._activate(),this._getHelper(),this._getCounter()}forceActive(){c.addClass(this.input,Es)}forceInactive(){c.removeClass(this.input,Es)}dispose(){this._removeBorder(),r.removeData(this._element,ws),this._element=null}_getLabelData(){this._label=a.findOne("label",this._element),null===this._label?this._showPlaceholder():(this._getLabelWidth(),this._getLabelPositionInInputGroup(),this._toggleDefaultDatePlaceholder())}_getHelper(){this._helper=a.findOne(ks,this._element)}_getCounter(){this._counter=c.getDataAttribute(this.input,"showcounter"),this._counter&&(this._maxLength=this.input.maxLength,this._showCounter())}_showCounter(){var t;0<a.find(".form-counter",this._element).length||(this._counterElement=document.createElement("div"),c.addClass(this._counterElement,"form-counter"),t=this.input.value.length,this._counterElement.innerHTML="".concat(t," / ").concat(this._maxLength),this._helper.appendChild(this._counterElement),this._bindCounter())}_bindCounter(){s.on(this.input,"input",()=>{var t=this.input.value.length;this._counterElement.innerHTML="".concat(t," / ").concat(this._maxLength)})}_toggleDefaultDatePlaceholder(){var t=0<arguments.length&&void 0!==arguments[0]?arguments[0]:this.input;"date"===t.getAttribute("type")&&(document.activeElement===t||t.value?t.style.opacity=1:t.style.opacity=0)}_showPlaceholder(){c.addClass(this.input,"placeholder-active")}_getNotchData(){this._notchMiddle=a.findOne(Is,this._element),this._notchLeading=a.findOne(Ls,this._element)}_getLabelWidth(){this._labelWidth=.8*this._label.clientWidth+8}_getLabelPositionInInputGroup(){var t;this._labelMarginLeft=0,this._element.classList.contains("input-group")&&(t=this.input,t=a.prev(t,".input-group-text")[0],this._labelMarginLeft=void 0===t?0:t.offsetWidth-1)}_applyDivs(){var t=a.find(Ss,this._element),e=W("div");c.addClass(e,xs),this._notchLeading=W("div"),c.addClass(this._notchLeading,Cs),this._notchMiddle=W("div"),c.addClass(this._notchMiddle,As),this._notchTrailing=W("div"),c.addClass(this._notchTrailing,"form-notch-trailing"),1<=t.length||(e.append(this._notchLeading),e.append(this._notchMiddle),e.append(this._notchTrailing),this._element.append(e))}_applyNotch(){this._notchMiddle.style.width="".concat(this._labelWidth,"px"),this._notchLeading.style.width="".concat(this._labelMarginLeft+9,"px"),null!==this._label&&(this._label.style.marginLeft="".concat(this._labelMarginLeft,"px"))}_removeBorder(){var t=a.findOne(Ss,this._element);t&&t.remove()}_activate(e){o(()=>{this._getElements(e);var t=e?e.target:this.input;""!==t.value&&c.addClass(t,Es),this._toggleDefaultDatePlaceholder(t)})}_getElements(t){var e;t&&(this._element=t.target.parentNode,this._label=a.findOne("label",this._element)),t&&this._label&&(e=this._labelWidth,this._getLabelData(),e!==this._labelWidth)&&(this._notchMiddle=a.findOne(".form-notch-middle",t.target.parentNode),this._notchLeading=a.findOne(Ls,t.target.parentNode),this._applyNotch())}_deactivate(t){t=t?t.target:this.input;""===t.value&&t.classList.remove(Es),this._toggleDefaultDatePlaceholder(t)}static activate(e){return function(t){e._activate(t)}}static deactivate(e){return function(t){e._deactivate(t)}}static jQueryInterface(n,i){return this.each(function(){let t=r.getData(this,ws);var e="object"==typeof n&&n;if((t||!/dispose/.test(n))&&(t=t||new j(this,e),"string"==typeof n)){if(void 0===t[n])throw new TypeError('No method named "'.concat(n,'"'));t[n](i)}})}static getInstance(t){return r.getData(t,ws)}static getOrCreateInstance(t){var e=1<arguments.length&&void 0!==arguments[1]?arguments[1]:{};return this.getInstance(t)||new this(t,"object"==typeof e?e:null)}}s.on(document,"focus",Ts,j.activate(new j)),s.on(document,"input",Ts,j.activate(new j)),s.on(document,"blur",Ts,j.deactivate(new j)),s.on(document,"focus",Os,j.activate(new j)),s.on(document,"input",Os,j.activate(new j)),s.on(document,"blur",Os,j.deactivate(new j)),s.on(window,"shown.bs.modal",t=>{a.find(Ts,t.target).forEach(t=>{t=j.getInstance(t.parentNode);t&&t.update()}),a.find(Os,t.target).forEach(t=>{t=j.getInstance(t.parentNode);t&&t.update()})}),s.on(window,"shown.bs.dropdown",t=>{t=t.target.parentNode.querySelector(".dropdown-menu");t&&(a.find(Ts,t).forEach(t=>{t=j.getInstance(t.parentNode);t&&t.update()}),a.find(Os,t).forEach(t=>{t=j.getInstance(t.parentNode);t&&t.update()}))}),s.on(window,"shown.bs.tab",t=>{let e;e=(t.target.href||c.getDataAttribute(t.target,"target")).split("#")[1];t=a.findOne("#".concat(e));a.find(Ts,t).forEach(t=>{t=j.getInstance(t.parentNode);t&&t.update()}),a.find(Os,t).forEach(t=>{t=j.getInstance(t.parentNode);t&&t.update()})}),a.find(".".concat(_)).map(t=>new j(t)),s.on(window,"reset",t=>{a.find(Ts,t.target).forEach(t=>{t=j.getInstance(t.parentNode);t&&t.forceInactive()}),a.find(Os,t.target).forEach(t=>{t=j.getInstance(t.parentNode);t&&t.forceInactive()})}),s.on(window,"onautocomplete",t=>{var e=j.getInstance(t.target.parentNode);e&&t.cancelable&&e.forceActive()}),o(()=>{const t=i();if(t){const e=t.fn[ys];t.fn[ys]=j.jQueryInterface,t.fn[ys].Constructor=j,t.fn[ys].noConflict=()=>(t.fn[ys]=e,j.jQueryInterface)}});var Ds=j;w=".".concat("bs.collapse");const js="show".concat(w),Ps="shown".concat(w),Ns="hide".concat(w),Ms="hidden".concat(w);g="click".concat(w).concat(".data-api");const Hs="show",Rs="collapse",Bs="collapsing",Ws=":scope .".concat(Rs," .").concat(Rs),Fs='[data-mdb-toggle="collapse"]',qs={parent:null,toggle:!0},zs={parent:"(null|element)",toggle:"boolean"};class Qs extends m{constructor(t,e){super(t,e),this._isTransitioning=!1,this._triggerArray=[];for(const o of y.find(Fs)){var n=ct(o),i=y.find(n).filter(t=>t===this._element);null!==n&&i.length&&this._triggerArray.push(o)}this._initializeChildren(),this._config.parent||this._addAriaAndCollapsedClass(this._triggerArray,this._isShown()),this._config.toggle&&this.toggle()}static get Default(){return qs}static get DefaultType(){return zs}static get NAME(){return"collapse"}toggle(){this._isShown()?this.hide():this.show()}show(){if(!this._isTransitioning&&!this._isShown()){let t=[];if(!(t=this._config.parent?this._getFirstLevelChildren(".collapse.show, .collapse.collapsing").filter(t=>t!==this._element).map(t=>Qs.getOrCreateInstance(t,{toggle:!1})):t).length||!t[0]._isTransitioning){var e=f.trigger(this._element,js);if(!e.defaultPrevented){for(const i of t)i.hide();const n=this._getDimension();this._element.classList.remove(Rs),this._element.classList.add(Bs),this._element.style[n]=0,this._addAriaAndCollapsedClass(this._triggerArray,!0),this._isTransitioning=!0;e=n[0].toUpperCase()+n.slice(1),e="scroll".concat(e);this._queueCallback(()=>{this._isTransitioning=!1,this._element.classList.remove(Bs),this._element.classList.add(Rs,Hs),this._element.style[n]="",f.trigger(this._element,Ps)},this._element,!0),this._element.style[n]="".concat(this._element[e],"px")}}}}hide(){if(!this._isTransitioning&&this._isShown()){var t=f.trigger(this._element,Ns);if(!t.defaultPrevented){t=this._getDimension();this._element.style[t]="".concat(this._element.getBoundingClientRect()[t],"px"),gt(this._element),this._element.classList.add(Bs),this._element.classList.remove(Rs,Hs);for(const n of this._triggerArray){var e=l(n);e&&!this._isShown(e)&&this._addAriaAndCollapsedClass([n],!1)}this._isTransitioning=!0;this._element.style[t]="",this._queueCallback(()=>{this._isTransitioning=!1,this._element.classList.remove(Bs),this._element.classList.add(Rs),f.trigger(this._element,Ms)},this._element,!0)}}}_isShown(){return(0<arguments.length&&void 0!==arguments[0]?arguments[0]:this._element).classList.contains(Hs)}_configAfterMerge(t){return t.toggle=Boolean(t.toggle),t.parent=ut(t.parent),t}_getDimension(){return this._element.classList.contains("collapse-horizontal")?"width":"height"}_initializeChildren(){if(this._config.parent)for(const e of this._getFirstLevelChildren(Fs)){var t=l(e);t&&this._addAriaAndCollapsedClass([e],this._isShown(t))}}_getFirstLevelChildren(t){const e=y.find(Ws,this._config.parent);return y.find(t,this._config.parent).filter(t=>!e.includes(t))}_addAriaAndCollapsedClass(t,e){if(t.length)for(const n of t)n.classList.toggle("collapsed",!e),n.setAttribute("aria-expanded",e)}static jQueryInterface(e){const n={};return"string"==typeof e&&/show|hide/.test(e)&&(n.toggle=!1),this.each(function(){var t=Qs.getOrCreateInstance(this,n);if("string"==typeof e){if(void 0===t[e])throw new TypeError('No method named "'.concat(e,'"'));t[e]()}})}}f.on(document,g,Fs,function(t){("A"===t.target.tagName||t.delegateTarget&&"A"===t.delegateTarget.tagName)&&t.preventDefault();t=ct(this);for(const e of y.find(t))Qs.getOrCreateInstance(e,{toggle:!1}).toggle()}),t(Qs);E=Qs;const Vs="collapse";v="mdb.".concat(Vs),e=".".concat(v);const Us="show.bs.collapse",Ys="shown.bs.collapse",Xs="hide.bs.collapse",Ks="hidden.bs.collapse",$s="show".concat(e),Gs="shown".concat(e),Zs="hide".concat(e),Js="hidden".concat(e);class ta extends E{constructor(t){super(t,1<arguments.length&&void 0!==arguments[1]?arguments[1]:{}),this._init()}dispose(){s.off(this._element,Us),s.off(this._element,Ys),s.off(this._element,Xs),s.off(this._element,Ks),super.dispose()}static get NAME(){return Vs}_init(){this._bindShowEvent(),this._bindShownEvent(),this._bindHideEvent(),this._bindHiddenEvent()}_bindShowEvent(){s.on(this._element,Us,()=>{s.trigger(this._element,$s)})}_bindShownEvent(){s.on(this._element,Ys,()=>{s.trigger(this._element,Gs)})}_bindHideEvent(){s.on(this._element,Xs,()=>{s.trigger(this._element,Zs)})}_bindHiddenEvent(){s.on(this._element,Ks,()=>{s.trigger(this._element,Js)})}}a.find('[data-mdb-toggle="collapse"]').forEach(t=>{var e=ta.getInstance(t);e||new ta(t,{toggle:!1})}),o(()=>{const t=i();if(t){const e=t.fn[Vs];t.fn[Vs]=ta.jQueryInterface,t.fn[Vs].Constructor=ta,t.fn[Vs].noConflict=()=>(t.fn[Vs]=e,ta.jQueryInterface)}});var ea=ta;const na="dropdown";_=".".concat("bs.dropdown"),w=".data-api";const ia="ArrowDown",oa="hide".concat(_),ra="hidden".concat(_),sa="show".concat(_),aa="shown".concat(_);g="click".concat(_).concat(w),v="keydown".concat(_).concat(w),e="keyup".concat(_).concat(w);const ca="show",la='[data-mdb-toggle="dropdown"]:not(.disabled):not(:disabled)',ua="".concat(la,".").concat(ca),ha=".dropdown-menu",da=h()?"top-end":"top-start",fa=h()?"top-start":"top-end",pa=h()?"bottom-end":"bottom-start",ga=h()?"bottom-start":"bottom-end",ma=h()?"left-start":"right-start",_a=h()?"right-start":"left-start",va={autoClose:!0,boundary:"clippingParents",display:"dynamic",offset:[0,2],popperConfig:null,reference:"toggle"},ba={autoClose:"(boolean|string)",boundary:"(string|element)",display:"string",offset:"(array|string|function)",popperConfig:"(null|object|function)",reference:"(string|element|object)"};class P extends m{constructor(t,e){super(t,e),this._popper=null,this._parent=this._element.parentNode,this._menu=y.next(this._element,ha)[0]||y.prev(this._element,ha)[0]||y.findOne(ha,this._parent),this._inNavbar=this._detectNavbar()}static get Default(){return va}static get DefaultType(){return ba}static get NAME(){return na}toggle(){return this._isShown()?this.hide():this.show()}show(){if(!dt(this._element)&&!this._isShown()){var t={relatedTarget:this._element},e=f.trigger(this._element,sa,t);if(!e.defaultPrevented){if(this._createPopper(),"ontouchstart"in document.documentElement&&!this._parent.closest(".navbar-nav"))for(const n of[].concat(...document.body.children))f.on(n,"mouseover",pt);this._element.focus(),this._element.setAttribute("aria-expanded",!0),this._menu.classList.add(ca),this._element.classList.add(ca),f.trigger(this._element,aa,t)}}}hide(){var t;!dt(this._element)&&this._isShown()&&(t={relatedTarget:this._element},this._completeHide(t))}dispose(){this._popper&&this._popper.destroy(),super.dispose()}update(){this._inNavbar=this._detectNavbar(),this._popper&&this._popper.update()}_completeHide(t){var e=f.trigger(this._element,oa,t);if(!e.defaultPrevented){if("ontouchstart"in document.documentElement)for(const n of[].concat(...document.body.children))f.off(n,"mouseover",pt);this._popper&&this._popper.destroy(),this._menu.classList.remove(ca),this._element.classList.remove(ca),this._element.setAttribute("aria-expanded","false"),p.removeDataAttribute(this._menu,"popper"),f.trigger(this._element,ra,t)}}_getConfig(t){if("object"!=typeof(t=super._getConfig(t)).reference||u(t.reference)||"function"==typeof t.reference.getBoundingClientRect)return t;throw new TypeError("".concat(na.toUpperCase(),': Option "reference" provided type "object" without a required "getBoundingClientRect" method.'))}_createPopper(){if(void 0===n)throw new TypeError("Bootstrap's dropdowns require Popper (https://popper.js.org)");let t=this._element;"parent"===this._config.reference?t=this._parent:u(this._config.reference)?t=ut(this._config.reference):"object"==typeof this._config.reference&&(t=this._config.reference);var e=this._getPopperConfig();this._popper=_o(t,this._menu,e)}_isShown(){return this._menu.classList.contains(ca)}_getPlacement(){var t,e=this._parent;return e.classList.contains("dropend")?ma:e.classList.contains("dropstart")?_a:e.classList.contains("dropup-center")?"top":e.classList.contains("dropdown-center")?"bottom":(t="end"===getComputedStyle(this._menu).getPropertyValue("--bs-position").trim(),e.classList.contains("dropup")?t?fa:da:t?ga:pa)}_detectNavbar(){return null!==this._element.closest(".navbar")}_getOffset(){const e=this._config["offset"];return"string"==typeof e?e.split(",").map(t=>Number.parseInt(t,10)):"function"==typeof e?t=>e(t,this._element):e}_getPopperConfig(){var t={placement:this._getPlacement(),modifiers:[{name:"preventOverflow",options:{boundary:this._config.boundary}},{name:"offset",options:{offset:this._getOffset()}}]};return!this._inNavbar&&"static"!==this._config.display||(p.setDataAttribute(this._menu,"popper","static"),t.modifiers=[{name:"applyStyles",enabled:!1}]),{...t,..."function"==typeof this._config.popperConfig?this._config.popperConfig(t):this._config.popperConfig}}_selectMenuItem(t){var{key:t,target:e}=t,n=y.find(".dropdown-menu .dropdown-item:not(.disabled):not(:disabled)",this._menu).filter(t=>ht(t));n.length&&bt(n,e,t===ia,!n.includes(e)).focus()}static jQueryInterface(e){return this.each(function(){var t=P.getOrCreateInstance(this,e);if("string"==typeof e){if(void 0===t[e])throw new TypeError('No method named "'.concat(e,'"'));t[e]()}})}static clearMenus(t){if(2!==t.button&&("keyup"!==t.type||"Tab"===t.key))for(const o of y.find(ua)){var e,n,i=P.getInstance(o);i&&!1!==i._config.autoClose&&(e=(n=t.composedPath()).includes(i._menu),n.includes(i._element)||"inside"===i._config.autoClose&&!e||"outside"===i._config.autoClose&&e||i._menu.contains(t.target)&&("keyup"===t.type&&"Tab"===t.key||/input|select|option|textarea|form/i.test(t.target.tagName))||(n={relatedTarget:i._element},"click"===t.type&&(n.clickEvent=t),i._completeHide(n)))}}static dataApiKeydownHandler(t){var e=/input|textarea/i.test(t.target.tagName),n="Escape"===t.key,i=["ArrowUp",ia].includes(t.key);!i&&!n||e&&!n||(t.preventDefault(),e=this.matches(la)?this:y.prev(this,la)[0]||y.next(this,la)[0]||y.findOne(la,t.delegateTarget.parentNode),n=P.getOrCreateInstance(e),i?(t.stopPropagation(),n.show(),n._selectMenuItem(t)):n._isShown()&&(t.stopPropagation(),n.hide(),e.focus()))}}f.on(document,v,la,P.dataApiKeydownHandler),f.on(document,v,ha,P.dataApiKeydownHandler),f.on(document,g,P.clearMenus),f.on(document,e,P.clearMenus),f.on(document,g,la,function(t){t.preventDefault(),P.getOrCreateInstance(this).toggle()}),t(P);E=P;const ya="dropdown";_="mdb.".concat(ya),w=".".concat(_);const wa={offset:[0,2],flip:!0,boundary:"clippingParents",reference:"toggle",display:"dynamic",popperConfig:null,dropdownAnimation:"on"},Ea={offset:"(array|string|function)",flip:"boolean",boundary:"(string|element)",reference:"(string|element|object)",display:"string",popperConfig:"(null|object|function)",dropdownAnimation:"string"},xa="hide.bs.dropdown",Ca="hidden.bs.dropdown",Aa="show.bs.dropdown",Ta="shown.bs.dropdown",Oa="hide".concat(w),Sa="hidden".concat(w),La="show".concat(w),Ia="shown".concat(w),ka="animation",Da="fade-in",ja="fade-out";class Pa extends E{constructor(t,e){super(t,e),this._config=this._getConfig(e),this._menuStyle="",this._popperPlacement="",this._mdbPopperConfig="";t=window.matchMedia("(prefers-reduced-motion: reduce)").matches;"on"!==this._config.dropdownAnimation||t||this._init()}dispose(){s.off(this._element,Aa),s.off(this._parent,Ta),s.off(this._parent,xa),s.off(this._parent,Ca),super.dispose()}static get NAME(){return ya}_init(){this._bindShowEvent(),this._bindShownEvent(),this._bindHideEvent(),this._bindHiddenEvent()}_getConfig(t){t={...wa,...c.getDataAttributes(this._element),...t};return B(ya,t,Ea),t}_getOffset(){const e=this._config["offset"];return"string"==typeof e?e.split(",").map(t=>Number.parseInt(t,10)):"function"==typeof e?t=>e(t,this._element):e}_getPopperConfig(){var t={placement:this._getPlacement(),modifiers:[{name:"preventOverflow",options:{altBoundary:this._config.flip,boundary:this._config.boundary}},{name:"offset",options:{offset:this._getOffset()}}]};return"static"===this._config.display&&(t.modifiers=[{name:"applyStyles",enabled:!1}]),{...t,..."function"==typeof this._config.popperConfig?this._config.popperConfig(t):this._config.popperConfig}}_bindShowEvent(){s.on(this._element,Aa,t=>{s.trigger(this._element,La,{relatedTarget:t.relatedTarget}).defaultPrevented?t.preventDefault():this._dropdownAnimationStart("show")})}_bindShownEvent(){s.on(this._parent,Ta,t=>{s.trigger(this._parent,Ia,{relatedTarget:t.relatedTarget}).defaultPrevented&&t.preventDefault()})}_bindHideEvent(){s.on(this._parent,xa,t=>{s.trigger(this._parent,Oa,{relatedTarget:t.relatedTarget}).defaultPrevented?t.preventDefault():(this._menuStyle=this._menu.style.cssText,this._popperPlacement=this._menu.getAttribute("data-popper-placement"),this._mdbPopperConfig=this._menu.getAttribute("data-mdb-popper"))})}_bindHiddenEvent(){s.on(this._parent,Ca,t=>{s.trigger(this._parent,Sa,{relatedTarget:t.relatedTarget}).defaultPrevented?t.preventDefault():("static"!==this._config.display&&""!==this._menuStyle&&(this._menu.style.cssText=this._menuStyle),this._menu.setAttribute("data-popper-placement",this._popperPlacement),this._menu.setAttribute("data-mdb-popper",this._mdbPopperConfig),this._dropdownAnimationStart("hide"))})}_dropdownAnimationStart(t){"show"===t?(this._menu.classList.add(ka,Da),this._menu.classList.remove(ja)):(this._menu.classList.add(ka,ja),this._menu.classList.remove(Da)),this._bindAnimationEnd()}_bindAnimationEnd(){s.one(this._menu,"animationend",()=>{this._menu.classList.remove(ka,ja,Da)})}}a.find('[data-mdb-toggle="dropdown"]').forEach(t=>{var e=Pa.getInstance(t);e||new Pa(t)}),o(()=>{const t=i();if(t){const e=t.fn[ya];t.fn[ya]=Pa.jQueryInterface,t.fn[ya].Constructor=Pa,t.fn[ya].noConflict=()=>(t.fn[ya]=e,Pa.jQueryInterface)}});var Na=Pa;const Ma="ripple",Ha="mdb.ripple",N="ripple-surface",Ra="ripple-wave",Ba="input-wrapper",Wa=[".btn",".ripple"],Fa="ripple-surface-unbound",qa=[0,0,0],za=["primary","secondary","success","danger","warning","info","light","dark"],Qa={rippleCentered:!1,rippleColor:"",rippleDuration:"500ms",rippleRadius:0,rippleUnbound:!1},Va={rippleCentered:"boolean",rippleColor:"string",rippleDuration:"string",rippleRadius:"number",rippleUnbound:"boolean"};class Ua{constructor(t,e){this._element=t,this._options=this._getConfig(e),this._element&&(r.setData(t,Ha,this),c.addClass(this._element,N)),this._clickHandler=this._createRipple.bind(this),this._rippleTimer=null,this._isMinWidthSet=!1,this._rippleInSpan=!1,this.init()}static get NAME(){return Ma}init(){this._addClickEvent(this._element)}dispose(){r.removeData(this._element,Ha),s.off(this._element,"click",this._clickHandler),this._element=null,this._options=null}_autoInit(e){if(Wa.forEach(t=>{a.closest(e.target,t)&&(this._element=a.closest(e.target,t))}),this._options=this._getConfig(),"input"===this._element.tagName.toLowerCase()){var t=this._element.parentNode;if(this._rippleInSpan=!0,"span"===t.tagName.toLowerCase()&&t.classList.contains(N))this._element=t;else{var n=getComputedStyle(this._element).boxShadow;const o=this._element;var i=document.createElement("span");o.classList.contains("btn-block")&&(i.style.display="block"),s.one(i,"mouseup",t=>{0===t.button&&o.click()}),i.classList.add(N,Ba),c.addStyle(i,{border:0,"box-shadow":n}),t.replaceChild(i,this._element),i.appendChild(this._element),this._element=i}this._element.focus()}this._element.style.minWidth||(c.style(this._element,{"min-width":"".concat(getComputedStyle(this._element).width)}),this._isMinWidthSet=!0),c.addClass(this._element,N),this._createRipple(e)}_addClickEvent(t){s.on(t,"mousedown",this._clickHandler)}_getEventLayer(t){return{layerX:Math.round(t.clientX-t.target.getBoundingClientRect().x),layerY:Math.round(t.clientY-t.target.getBoundingClientRect().y)}}_createRipple(t){c.hasClass(this._element,N)||c.addClass(this._element,N);var{layerX:t,layerY:e}=this._getEventLayer(t),n=this._element.offsetHeight,i=this._element.offsetWidth,o=this._durationToMsNumber(this._options.rippleDuration),r={offsetX:this._options.rippleCentered?n/2:t,offsetY:this._options.rippleCentered?i/2:e,height:n,width:i},r=this._getDiameter(r),s=this._options.rippleRadius||r/2,a={delay:.5*o,duration:o-.5*o},i={left:this._options.rippleCentered?"".concat(i/2-s,"px"):"".concat(t-s,"px"),top:this._options.rippleCentered?"".concat(n/2-s,"px"):"".concat(e-s,"px"),height:"".concat(2*this._options.rippleRadius||r,"px"),width:"".concat(2*this._options.rippleRadius||r,"px"),transitionDelay:"0s, ".concat(a.delay,"ms"),transitionDuration:"".concat(o,"ms, ").concat(a.duration,"ms")},t=W("div");this._createHTMLRipple({wrapper:this._element,ripple:t,styles:i}),this._removeHTMLRipple({ripple:t,duration:o})}_createHTMLRipple(t){let{wrapper:e,ripple:n,styles:i}=t;Object.keys(i).forEach(t=>n.style[t]=i[t]),n.classList.add(Ra),""!==this._options.rippleColor&&(this._removeOldColorClasses(e),this._addColor(n,e)),this._toggleUnbound(e),this._appendRipple(n,e)}_removeHTMLRipple(t){let{ripple:e,duration:n}=t;this._rippleTimer&&(clearTimeout(this._rippleTimer),this._rippleTimer=null),this._rippleTimer=setTimeout(()=>{e&&(e.remove(),this._element)&&(a.find(".".concat(Ra),this._element).forEach(t=>{t.remove()}),this._isMinWidthSet&&(c.style(this._element,{"min-width":""}),this._isMinWidthSet=!1),this._rippleInSpan&&this._element.classList.contains(Ba)?this._removeWrapperSpan():c.removeClass(this._element,N))},n)}_removeWrapperSpan(){var t=this._element.firstChild;this._element.replaceWith(t),this._element=t,this._element.focus(),this._rippleInSpan=!1}_durationToMsNumber(t){return Number(t.replace("ms","").replace("s","000"))}_getConfig(){var t=0<arguments.length&&void 0!==arguments[0]?arguments[0]:{},e=c.getDataAttributes(this._element),t={...Qa,...e,...t};return B(Ma,t,Va),t}_getDiameter(t){var{offsetX:t,offsetY:e,height:n,width:i}=t,o=e<=n/2,r=t<=i/2,s=(t,e)=>Math.sqrt(t**2+e**2),a=e===n/2&&t===i/2;const c=!0==o&&!1==r,l=!0==o&&!0==r,u=!1==o&&!0==r,h=!1==o&&!1==r;o={topLeft:s(t,e),topRight:s(i-t,e),bottomLeft:s(t,n-e),bottomRight:s(i-t,n-e)};let d=0;return a||h?d=o.topLeft:u?d=o.topRight:l?d=o.bottomRight:c&&(d=o.bottomLeft),2*d}_appendRipple(t,e){e.appendChild(t),setTimeout(()=>{c.addClass(t,"active")},50)}_toggleUnbound(t){!0===this._options.rippleUnbound?c.addClass(t,Fa):t.classList.remove(Fa)}_addColor(t,e){za.find(t=>t===this._options.rippleColor.toLowerCase())?c.addClass(e,"".concat(N,"-").concat(this._options.rippleColor.toLowerCase())):(e=this._colorToRGB(this._options.rippleColor).join(","),e="rgba({{color}}, 0.2) 0, rgba({{color}}, 0.3) 40%, rgba({{color}}, 0.4) 50%, rgba({{color}}, 0.5) 60%, rgba({{color}}, 0) 70%".split("{{color}}").join("".concat(e)),t.style.backgroundImage="radial-gradient(circle, ".concat(e,")"))}_removeOldColorClasses(e){var t=new RegExp("".concat(N,"-[a-z]+"),"gi");(e.classList.value.match(t)||[]).forEach(t=>{e.classList.remove(t)})}_colorToRGB(t){var e,n,i;return"transparent"===t.toLowerCase()?qa:"#"===t[0]?((e=t).length<7&&(e="#".concat(e[1]).concat(e[1]).concat(e[2]).concat(e[2]).concat(e[3]).concat(e[3])),[parseInt(e.substr(1,2),16),parseInt(e.substr(3,2),16),parseInt(e.substr(5,2),16)]):(-1===t.indexOf("rgb")&&(e=t,n=document.body.appendChild(document.createElement("fictum")),i="rgb(1, 2, 3)",n.style.color=i,t=n.style.color!==i||(n.style.color=e,n.style.color===i)||""===n.style.color?qa:(e=getComputedStyle(n).color,document.body.removeChild(n),e)),0===t.indexOf("rgb")?((i=(i=t).match(/[.\d]+/g).map(t=>+Number(t))).length=3,i):qa)}static autoInitial(e){return function(t){e._autoInit(t)}}static jQueryInterface(t){return this.each(function(){return r.getData(this,Ha)?null:new Ua(this,t)})}static getInstance(t){return r.getData(t,Ha)}static getOrCreateInstance(t){var e=1<arguments.length&&void 0!==arguments[1]?arguments[1]:{};return this.getInstance(t)||new this(t,"object"==typeof e?e:null)}}Wa.forEach(t=>{s.one(document,"mousedown",t,Ua.autoInitial(new Ua))}),o(()=>{const t=i();if(t){const e=t.fn[Ma];t.fn[Ma]=Ua.jQueryInterface,t.fn[Ma].Constructor=Ua,t.fn[Ma].noConflict=()=>(t.fn[Ma]=e,Ua.jQueryInterface)}});var Ya=Ua;const Xa="range",Ka="mdb.range";const $a="thumb-active";const Ga=".".concat("thumb-value"),Za=".".concat("thumb");m=".".concat("range");class Ja{constructor(t){this._element=t,this._initiated=!1,this._thumb=null,this._element&&(r.setData(t,Ka,this),this.init())}static get NAME(){return Xa}get rangeInput(){return a.findOne("input[type=range]",this._element)}init(){this._initiated||(this._addThumb(),this._thumbUpdate(),this._handleEvents(),this._initiated=!0)}dispose(){this._disposeEvents(),r.removeData(this._element,Ka),this._element=null,this._thumb=null}_addThumb(){var t=W("span");c.addClass(t,"thumb"),t.innerHTML='<span class="thumb-value"></span>',this._element.append(t),this._thumb=a.findOne(Za,this._element)}_handleEvents(){s.on(this.rangeInput,"mousedown",()=>this._showThumb()),s.on(this.rangeInput,"mouseup",()=>this._hideThumb()),s.on(this.rangeInput,"touchstart",()=>this._showThumb()),s.on(this.rangeInput,"touchend",()=>this._hideThumb()),s.on(this.rangeInput,"input",()=>this._thumbUpdate())}_disposeEvents(){s.off(this.rangeInput,"mousedown",this._showThumb),s.off(this.rangeInput,"mouseup",this._hideThumb),s.off(this.rangeInput,"touchstart",this._showThumb),s.off(this.rangeInput,"touchend",this._hideThumb),s.off(this.rangeInput,"input",this._thumbUpdate)}_showThumb(){c.addClass(this._thumb,$a)}_hideThumb(){c.removeClass(this._thumb,$a)}_thumbUpdate(){var t=this.rangeInput,e=t.value,n=t.min||0,t=t.max||100,e=(a.findOne(Ga,this._thumb).textContent=e,Number(100*(e-n)/(t-n)));c.style(this._thumb,{left:"calc(".concat(e,"% + (").concat(8-.15*e,"px))")})}static getInstance(t){return r.getData(t,Ka)}static getOrCreateInstance(t){var e=1<arguments.length&&void 0!==arguments[1]?arguments[1]:{};return this.getInstance(t)||new this(t,"object"==typeof e?e:null)}static jQueryInterface(n,i){return this.each(function(){let t=r.getData(this,Ka);var e="object"==typeof n&&n;if((t||!/dispose/.test(n))&&(t=t||new Ja(this,e),"string"==typeof n)){if(void 0===t[n])throw new TypeError('No method named "'.concat(n,'"'));t[n](i)}})}}a.find(m).map(t=>new Ja(t)),o(()=>{const t=i();if(t){const e=t.fn[Xa];t.fn[Xa]=Ja.jQueryInterface,t.fn[Xa].Constructor=Ja,t.fn[Xa].noConflict=()=>(t.fn[Xa]=e,Ja.jQueryInterface)}});var tc=Ja}],i={},o.m=n,o.c=i,o.d=function(t,e,n){o.o(t,e)||Object.defineProperty(t,e,{enumerable:!0,get:n})},o.r=function(t){"undefined"!=typeof Symbol&&Symbol.toStringTag&&Object.defineProperty(t,Symbol.toStringTag,{value:"Module"}),Object.defineProperty(t,"__esModule",{value:!0})},o.t=function(e,t){if(1&t&&(e=o(e)),8&t)return e;if(4&t&&"object"==typeof e&&e&&e.__esModule)return e;var n=Object.create(null);if(o.r(n),Object.defineProperty(n,"default",{enumerable:!0,value:e}),2&t&&"string"!=typeof e)for(var i in e)o.d(n,i,function(t){return e[t]}.bind(null,i));return n},o.n=function(t){var e=t&&t.__esModule?function(){return t.default}:function(){return t};return o.d(e,"a",e),e},o.o=function(t,e){return Object.prototype.hasOwnProperty.call(t,e)},o.p="",o(o.s=119);function o(t){var e;return(i[t]||(e=i[t]={i:t,l:!1,exports:{}},n[t].call(e.exports,e,e.exports,o),e.l=!0,e)).exports}var n,i});
 //# sourceMappingURL=mdb.min.js.map
\ No newline at end of file
@@ -0,0 +1,265 @@
+// Refreshes the code shown to the room when it changes, and the seconds left on it
+let checkInRefresh = null;
+let checkInCountdown = null;
+
+// A code given in the link of the QR code is submitted once, as soon as the check-in is loaded
+let checkInLinkCode = new URLSearchParams(window.location.search).get('check-in');
+
+// Escape text so it is shown as typed rather than parsed as HTML
+function escapeCheckInText(text) {
+    const div = document.createElement('div');
+    div.textContent = text;
+    return div.innerHTML;
+}
+
+function classSessionID() {
+    return document.getElementById('class-session-ID').value;
+}
+
+function stopCheckInTimers() {
+    clearTimeout(checkInRefresh);
+    clearInterval(checkInCountdown);
+    checkInRefresh = null;
+    checkInCountdown = null;
+}
+
+// Show the code and QR code of an open check-in to the staff, until it changes
+function renderCheckInCode(data) {
+    const display = document.getElementById('check-in-display');
+    document.getElementById('check-in-open-btn').textContent = data.open ? 'New codes' : 'Open check-in';
+    document.getElementById('check-in-close-btn').classList.toggle('hidden', !data.open);
+    display.classList.toggle('hidden', !data.open);
+    stopCheckInTimers();
+    if (!data.open) {
+        return;
+    }
+
+    document.getElementById('check-in-code').textContent = data.code;
+    // The QR code is rendered by the server, which never caches it
+    document.getElementById('check-in-qr').src = `/api/check-in/${classSessionID()}/qr?format=svg&v=${data.code}`;
+
+    const expires = Date.now() + data.expiresIn * 1000;
+    const expiresText = document.getElementById('check-in-expires');
+    const tick = () => {
+        const seconds = Math.max(0, Math.ceil((expires - Date.now()) / 1000));
+        expiresText.textContent = `Changes in ${seconds}s`;
+    };
+    tick();
+    checkInCountdown = setInterval(tick, 1000);
+    checkInRefresh = setTimeout(loadCheckIn, data.expiresIn * 1000 + 100);
+}
+
+// Show the codes that were refused, so staff can spot students checking in from elsewhere
+function renderCheckInAttempts(attempts) {
+    const container = document.getElementById('check-in-attempts');
+    if (!attempts || attempts.length == 0) {
+        container.innerHTML = '';
+        return;
+    }
+    container.innerHTML = `
+    <p class="mb-1">Refused codes</p>
+    <table class="table table-sm check-in-attempts-table">
+      <tbody>
+        ${attempts.map(attempt => `
+        <tr>
+          <td>${escapeCheckInText(attempt.FirstName)} ${escapeCheckInText(attempt.LastName)}</td>
+          <td>${escapeCheckInText(attempt.Code)}</td>
+          <td>${attempt.Result}</td>
+          <td>${attempt.CreatedAt}</td>
+        </tr>`).join('')}
+      </tbody>
+    </table>`;
+}
+
+// Show students the form to check in while the check-in is open
+function renderCheckInForm(data) {
+    const form = document.getElementById('check-in-form');
+    const done = document.getElementById('check-in-done');
+    done.classList.toggle('hidden', !data.checkedIn);
+    form.classList.toggle('hidden', data.checkedIn || !data.open);
+}
+
+// Load the check-in of the class session
+export function loadCheckIn() {
+    if (!document.getElementById('check-in')) {
+        return;
+    }
+
+    fetch(`/api/check-in/${classSessionID()}`, {
+        method: 'GET'
+    })
+        .then(response => response.json())
+        .then(data => {
+            if (data.staff) {
+                renderCheckInCode(data);
+                renderCheckInAttempts(data.attempts);
+                return;
+            }
+            renderCheckInForm(data);
+            loadExcuseRequest(data);
+            if (checkInLinkCode && !data.checkedIn) {
+                document.getElementById('check-in-code-input').value = checkInLinkCode;
+                sendCheckIn(checkInLinkCode);
+            }
+            checkInLinkCode = null;
+        })
+        .catch(error => {
+            console.log(error);
+        });
+}
+
+// Open the check-in, or start over with new codes when it is open
+export function openCheckIn() {
+    fetch(`/api/check-in/${classSessionID()}/open`, {
+        method: 'POST',
+        headers: {
+            'Content-Type': 'application/json'
+        },
+        body: JSON.stringify({ period: Number(document.getElementById('check-in-period').value) })
+    })
+        .then(response => response.json().then(data => ({ status: response.status, data: data })))
+        .then(({ status, data }) => {
+            if (status != 200) {
+                alert(data.error);
+                return;
+            }
+            renderCheckInCode(data);
+        })
+        .catch(error => {
+            console.log(error);
+        });
+}
+
+// Stop taking codes
+export function closeCheckIn() {
+    fetch(`/api/check-in/${classSessionID()}/close`, {
+        method: 'POST'
+    })
+        .then(response => response.json().then(data => ({ status: response.status, data: data })))
+        .then(({ status, data }) => {
+            if (status != 200) {
+                alert(data.error);
+                return;
+            }
+            loadCheckIn();
+        })
+        .catch(error => {
+            console.log(error);
+        });
+}
+
+// Submit a code over the class session websocket when it is open
+function sendCheckIn(code) {
+    const message = document.getElementById('check-in-message');
+
+    sendClassAction('check-in', { code: code }, () => fetch(`/api/check-in/${classSessionID()}`, {
+        method: 'POST',
+        headers: {
+            'Content-Type': 'application/json'
+        },
+        body: JSON.stringify({ code: code })
+    }))
+        .then(({ status, data }) => {
+            if (status == 200) {
+                renderCheckInForm({ open: true, checkedIn: true });
+                return;
+            }
+            message.textContent = data.error;
+            if (status == 409) {
+                renderCheckInForm({ open: false, checkedIn: false });
+            }
+        })
+        .catch(error => {
+            console.log(error);
+        });
+}
+
+// Check in with the code typed
+export function submitCheckIn(event) {
+    event.preventDefault();
+    const input = document.getElementById('check-in-code-input');
+    const code = input.value.trim();
+    if (code == '') {
+        input.focus();
+        return;
+    }
+    sendCheckIn(code);
+}
+
+// Show students the answer to their latest excuse request, and the form to ask for one
+// unless they are checked in, excused or waiting for an answer
+function loadExcuseRequest(data) {
+    const form = document.getElementById('excuse-form');
+    const message = document.getElementById('excuse-message');
+    if (!form) {
+        return;
+    }
+    form.dataset.attendanceid = data.attendanceID;
+
+    fetch(`/api/excuse-requests/${data.attendanceID}`, {
+        method: 'GET'
+    })
+        .then(response => response.json())
+        .then(excuses => {
+            const latest = (excuses.excuses || [])[0];
+            const pending = latest && latest.Status == 'pending';
+            form.classList.toggle('hidden', data.checkedIn || data.attendanceStatus == 'excused' || pending);
+            if (!latest) {
+                message.textContent = '';
+                return;
+            }
+            message.textContent = pending ? 'Your excuse request is waiting for an answer.'
+                : `Your excuse request was ${latest.Status}${latest.Response ? ': ' + latest.Response : '.'}`;
+        })
+        .catch(error => {
+            console.log(error);
+        });
+}
+
+// Ask to be excused from the class session
+export function submitExcuseRequest(event) {
+    event.preventDefault();
+    const form = document.getElementById('excuse-form');
+    const input = document.getElementById('excuse-reason');
+    const reason = input.value.trim();
+    if (reason == '') {
+        input.focus();
+        return;
+    }
+
+    fetch(`/api/excuse-requests/${form.dataset.attendanceid}`, {
+        method: 'POST',
+        headers: {
+            'Content-Type': 'application/json'
+        },
+        body: JSON.stringify({ reason: reason })
+    })
+        .then(response => response.json().then(data => ({ status: response.status, data: data })))
+        .then(({ status, data }) => {
+            if (status != 200) {
+                document.getElementById('excuse-message').textContent = data.error;
+                return;
+            }
+            input.value = '';
+            loadCheckIn();
+        })
+        .catch(error => {
+            console.log(error);
+        });
+}
+
+// Handle a check-in-opened event: show students the form
+export function handleCheckInOpened(input) {
+    const message = document.getElementById('check-in-message');
+    if (message) {
+        message.textContent = 'Enter the code shown by your instructor.';
+    }
+    loadCheckIn();
+}
+
+// Handle a check-in-closed event: hide the form and the code
+export function handleCheckInClosed(input) {
+    loadCheckIn();
+}
+
+loadCheckIn();
@@ -0,0 +1,176 @@
+// Escape text so it is shown as typed rather than parsed as HTML
+function escapeModerationText(text) {
+    const div = document.createElement('div');
+    div.textContent = text;
+    return div.innerHTML;
+}
+
+// Render a pending question with controls to edit, approve or reject it
+function renderPendingCard(question) {
+    const card = document.createElement('div');
+    card.className = 'card session-card-wrapper pending-card';
+    card.setAttribute('data-question-id', question.ID);
+    card.innerHTML = `
+    <div class="question-card-time">
+      ${formatTime24To12(question.CreatedAt)}
+    </div>
+    <div class="card-body dark-card-body">
+      <textarea class="form-control pending-question-text" rows="2" maxlength="140">${escapeModerationText(question.Text)}</textarea>
+    </div>
+    <div class="card-footer session-card-footer dark-card-footer pending-card-footer">
+      <input type="text" class="form-control pending-reject-reason" maxlength="1000" placeholder="Reason for rejecting">
+      <button onclick="rejectQuestion(event)" value="${question.ID}" class="reject-question-btn">Reject</button>
+      <button onclick="approveQuestion(event)" value="${question.ID}" class="mark-answered-btn">Approve</button>
+    </div>`;
+    return card;
+}
+
+// Update the number of questions waiting in the queue
+function updatePendingCount() {
+    const pendingCount = document.getElementById('pending-count');
+    pendingCount.textContent = document.querySelectorAll('#pending-cards .pending-card').length;
+}
+
+// Load the moderation queue of the class session
+export function loadModerationQueue() {
+    const queue = document.getElementById('moderation-queue');
+    if (!queue) {
+        return;
+    }
+    const classSessionID = document.getElementById('class-session-ID').value;
+
+    fetch(`/api/moderation-queue/${classSessionID}`, {
+        method: 'GET'
+    })
+        .then(response => response.json())
+        .then(data => {
+            const container = document.getElementById('pending-cards');
+            container.innerHTML = '';
+            (data.questions || []).forEach(question => {
+                container.appendChild(renderPendingCard(question));
+            });
+            updatePendingCount();
+        })
+        .catch(error => {
+            console.log(error);
+        });
+}
+
+// Approve a pending question, sending the text as edited in its card
+export function approveQuestion(event) {
+    const button = event.currentTarget;
+    const questionID = button.value;
+    const card = button.closest('.pending-card');
+    const text = card.querySelector('.pending-question-text').value.trim();
+
+    fetch(`/api/moderation/${questionID}/approve`, {
+        method: 'POST',
+        headers: {
+            'Content-Type': 'application/json'
+        },
+        body: JSON.stringify({ text: text })
+    })
+        .then(response => {
+            if (response.status == 200 || response.status == 409) {
+                removePendingCard({ questionID: questionID });
+            }
+        })
+        .catch(error => {
+            console.log(error);
+        });
+}
+
+// Reject a pending question with the reason typed in its card
+export function rejectQuestion(event) {
+    const button = event.currentTarget;
+    const questionID = button.value;
+    const card = button.closest('.pending-card');
+    const reasonInput = card.querySelector('.pending-reject-reason');
+    const reason = reasonInput.value.trim();
+
+    if (reason == '') {
+        reasonInput.focus();
+        return;
+    }
+
+    fetch(`/api/moderation/${questionID}/reject`, {
+        method: 'POST',
+        headers: {
+            'Content-Type': 'application/json'
+        },
+        body: JSON.stringify({ reason: reason })
+    })
+        .then(response => {
+            if (response.status == 200 || response.status == 409) {
+                removePendingCard({ questionID: questionID });
+            }
+        })
+        .catch(error => {
+            console.log(error);
+        });
+}
+
+// Turn pre-moderation of the section on or off
+export function togglePremoderation(event) {
+    const toggle = event.currentTarget;
+    const sectionID = document.getElementById('moderation-queue').dataset.sectionId;
+
+    fetch(`/api/premoderation/${sectionID}`, {
+        method: 'PUT',
+        headers: {
+            'Content-Type': 'application/json'
+        },
+        body: JSON.stringify({ enabled: toggle.checked })
+    })
+        .then(response => {
+            if (response.status != 200) {
+                toggle.checked = !toggle.checked;
+            }
+        })
+        .catch(error => {
+            toggle.checked = !toggle.checked;
+            console.log(error);
+        });
+}
+
+// Remove a question from the moderation queue
+function removePendingCard(input) {
+    const card = document.querySelector(`#pending-cards .pending-card[data-question-id="${input.questionID}"]`);
+    if (card) {
+        card.remove();
+        updatePendingCount();
+    }
+}
+
+// Handle a question-pending action: moderators reload their queue
+export function handlePendingQuestion(input) {
+    loadModerationQueue();
+}
+
+// Handle a question-moderated action: drop it from the queue and tell its author if it was rejected
+export function handleQuestionModerated(input) {
+    if (document.getElementById('moderation-queue')) {
+        removePendingCard(input);
+    }
+
+    const userID = document.getElementById('userID').value;
+    if (input.status == 'rejected' && String(input.userID) == userID) {
+        const rejectedAlert = document.getElementById('question-rejected-alert');
+        rejectedAlert.textContent = `Your question was not approved: ${input.reason}`;
+        rejectedAlert.classList.remove('hidden');
+        setTimeout(function () {
+            rejectedAlert.classList.add('hidden');
+        }, 10000);
+    }
+}
+
+// Tell a student their question is waiting for approval
+export function showQuestionPending() {
+    const pendingAlert = document.getElementById('question-pending-alert');
+    pendingAlert.classList.remove('hidden');
+    setTimeout(function () {
+        pendingAlert.classList.add('hidden');
+    }, 5000);
+}
+
+loadModerationQueue();
@@ -0,0 +1,264 @@
+// The polls of the class session by id, kept up to date by the poll events
+const polls = new Map();
+
+// Escape text so it is shown as typed rather than parsed as HTML
+function escapePollText(text) {
+    const div = document.createElement('div');
+    div.textContent = text;
+    return div.innerHTML;
+}
+
+// Moderators get the form to create polls and see results before answering
+function isPollModerator() {
+    return document.getElementById('poll-form') !== null;
+}
+
+// Keep a poll as returned by the API
+function storePoll(poll) {
+    polls.set(poll.ID, {
+        id: poll.ID,
+        question: poll.Question,
+        kind: poll.Kind,
+        status: poll.Status,
+        responses: poll.Responses,
+        userChoice: poll.UserChoice,
+        options: (poll.Options || []).map(option => ({ id: option.ID, text: option.Text, votes: option.Votes })),
+    });
+}
+
+// Update the results of a stored poll from a poll event
+function updatePollResults(poll, input) {
+    poll.responses = input.responses;
+    poll.options = input.options.map(option => ({ id: option.optionID, text: option.text, votes: option.votes }));
+}
+
+// Render the results of a poll as bars, marking the option the user chose
+function renderPollResults(poll) {
+    return poll.options.map(option => {
+        const percent = poll.responses > 0 ? Math.round(100 * option.votes / poll.responses) : 0;
+        const chosen = option.id == poll.userChoice ? ' poll-option-chosen' : '';
+        return `
+        <div class="poll-result${chosen}">
+          <div class="d-flex justify-content-between">
+            <span>${escapePollText(option.text)}</span>
+            <span>${option.votes} (${percent}%)</span>
+          </div>
+          <div class="poll-result-bar"><div style="width: ${percent}%"></div></div>
+        </div>`;
+    }).join('');
+}
+
+// Render the options of an open poll as buttons to answer it
+function renderPollChoices(poll) {
+    return poll.options.map(option => `
+        <button onclick="answerPoll(event)" data-poll-id="${poll.id}" value="${option.id}" class="poll-choice-btn">
+          ${escapePollText(option.text)}
+        </button>`).join('');
+}
+
+// Render a poll card. Students answer open polls before they see the results.
+function renderPollCard(poll) {
+    const moderator = isPollModerator();
+    let card = document.querySelector(`#poll-cards .poll-card[data-poll-id="${poll.id}"]`);
+    if (!card) {
+        card = document.createElement('div');
+        card.className = 'card session-card-wrapper poll-card';
+        card.setAttribute('data-poll-id', poll.id);
+        document.getElementById('poll-cards').prepend(card);
+    }
+
+    let body;
+    if (poll.status == 'open' && !moderator && !poll.userChoice) {
+        body = `<div class="poll-choices">${renderPollChoices(poll)}</div>`;
+    } else {
+        body = renderPollResults(poll);
+    }
+
+    let controls = '';
+    if (moderator && poll.status == 'draft') {
+        controls = `<button onclick="openPoll(event)" value="${poll.id}" class="mark-answered-btn">Open</button>`;
+    } else if (moderator && poll.status == 'open') {
+        controls = `<button onclick="closePoll(event)" value="${poll.id}" class="reject-question-btn">Close</button>`;
+    }
+
+    card.innerHTML = `
+    <div class="card-body dark-card-body">
+      <div class="d-flex justify-content-between align-items-center">
+        <p class="poll-question">${escapePollText(poll.question)}</p>
+        <span class="badge rounded-pill poll-status-${poll.status}">${poll.status}</span>
+      </div>
+      ${body}
+    </div>
+    <div class="card-footer session-card-footer dark-card-footer poll-card-footer">
+      <span class="poll-responses">${poll.responses} ${poll.responses == 1 ? 'response' : 'responses'}</span>
+      ${controls}
+    </div>`;
+}
+
+// Load the polls of the class session
+export function loadPolls() {
+    const container = document.getElementById('poll-cards');
+    if (!container) {
+        return;
+    }
+    const classSessionID = document.getElementById('class-session-ID').value;
+
+    fetch(`/api/polls/${classSessionID}`, {
+        method: 'GET'
+    })
+        .then(response => response.json())
+        .then(data => {
+            polls.clear();
+            container.innerHTML = '';
+            (data.polls || []).forEach(poll => {
+                storePoll(poll);
+                renderPollCard(polls.get(poll.ID));
+            });
+        })
+        .catch(error => {
+            console.log(error);
+        });
+}
+
+// Show the option inputs only for multiple choice polls
+export function togglePollOptions() {
+    const kind = document.getElementById('poll-kind').value;
+    document.getElementById('poll-options').classList.toggle('hidden', kind != 'choice');
+}
+
+// Create a poll from the form, opening it to the class unless it is saved as a draft
+export function createPoll(event, open = true) {
+    event.preventDefault();
+    const questionInput = document.getElementById('poll-question');
+    const question = questionInput.value.trim();
+    if (question == '') {
+        questionInput.focus();
+        return;
+    }
+
+    const classSessionID = document.getElementById('class-session-ID').value;
+    const options = document.getElementById('poll-options').value.split('\n');
+
+    fetch(`/api/polls/${classSessionID}`, {
+        method: 'POST',
+        headers: {
+            'Content-Type': 'application/json'
+        },
+        body: JSON.stringify({
+            question: question,
+            kind: document.getElementById('poll-kind').value,
+            options: options,
+            open: open
+        })
+    })
+        .then(response => response.json().then(data => ({ status: response.status, data: data })))
+        .then(({ status, data }) => {
+            if (status != 200) {
+                alert(data.error);
+            }
+            if (!data.pollID) {
+                return;
+            }
+            document.getElementById('poll-form').reset();
+            togglePollOptions();
+            // Open polls arrive with their event; drafts are only shown here
+            loadPolls();
+        })
+        .catch(error => {
+            console.log(error);
+        });
+}
+
+// Open a draft poll to the class
+export function openPoll(event) {
+    changePollStatus(event.currentTarget.value, 'open');
+}
+
+// Stop a poll from taking answers
+export function closePoll(event) {
+    changePollStatus(event.currentTarget.value, 'close');
+}
+
+function changePollStatus(pollID, change) {
+    fetch(`/api/poll/${pollID}/${change}`, {
+        method: 'POST'
+    })
+        .then(response => response.json().then(data => ({ status: response.status, data: data })))
+        .then(({ status, data }) => {
+            if (status != 200) {
+                alert(data.error);
+                loadPolls();
+            }
+        })
+        .catch(error => {
+            console.log(error);
+        });
+}
+
+// Answer a poll with the option clicked, over the class session websocket when it is open
+export function answerPoll(event) {
+    const button = event.currentTarget;
+    const pollID = Number(button.dataset.pollId);
+    const optionID = Number(button.value);
+
+    sendClassAction('poll-response', { pollID: pollID, optionID: optionID }, () => fetch(`/api/poll/${pollID}/respond`, {
+        method: 'POST',
+        headers: {
+            'Content-Type': 'application/json'
+        },
+        body: JSON.stringify({ optionID: optionID })
+    }))
+        .then(({ status, data }) => {
+            const poll = polls.get(pollID);
+            if (status == 200 && poll) {
+                poll.userChoice = optionID;
+                renderPollCard(poll);
+            } else if (status == 409) {
+                // Closed or already answered elsewhere
+                loadPolls();
+            } else if (data.error) {
+                alert(data.error);
+            }
+        })
+        .catch(error => {
+            console.log(error);
+        });
+}
+
+// Handle a poll-opened event: show the poll to the class
+export function handlePollOpened(input) {
+    if (!document.getElementById('poll-cards')) {
+        return;
+    }
+    const poll = polls.get(input.pollID) || { id: input.pollID, responses: 0, userChoice: 0 };
+    poll.question = input.question;
+    poll.kind = input.kind;
+    poll.status = 'open';
+    updatePollResults(poll, { responses: poll.responses, options: input.options });
+    polls.set(poll.id, poll);
+    renderPollCard(poll);
+}
+
+// Handle a poll-results event: update the results as answers come in
+export function handlePollResults(input) {
+    const poll = polls.get(input.pollID);
+    if (!poll) {
+        return;
+    }
+    updatePollResults(poll, input);
+    renderPollCard(poll);
+}
+
+// Handle a poll-closed event: show the final results
+export function handlePollClosed(input) {
+    const poll = polls.get(input.pollID);
+    if (!poll) {
+        loadPolls();
+        return;
+    }
+    poll.status = 'closed';
+    updatePollResults(poll, input);
+    renderPollCard(poll);
+}
+
+loadPolls();
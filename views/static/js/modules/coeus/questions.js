@@ -1,143 +1,263 @@
-// renderQuestionCard renders a question card with the given question data
-function renderQuestionCard(question, moderatorStatus, timezone) {
-
-  const formattedTime = formatTime24To12(question.createdAt, timezone);
-  const card = document.createElement('div');
-  card.className = 'card session-card-wrapper';
-  card.setAttribute('data-question-id', question.questionID);
-  card.innerHTML = `
-  <div class="question-card-time">
-  ${formattedTime}
-</div>
-<div class="card-body dark-card-body">
-<strong class="session-q-text">Q.</strong>
-<p class="card-text session-card-text dark-card-text">
-    ${question.text}
-  </p>
-</div>
-<div class="card-footer session-card-footer dark-card-footer ">
-  <button onclick="voteUp(event)" value="${question.questionID}" class="vote-up-btn">
-    <img src="/static/images/icon-arrow-up-${question.answered}.svg" alt="">
-    <p class="m-0 session-votes-font">${question.votes}</p>
-  </button>
-  ${moderatorStatus == "student" ? '' : question.answered == true ? '' : `<button onclick="markQuestionAnswered(event)" value="${question.questionID}" class="mark-answered-btn">Answered?</button>`}
-  <div class="session-answered-text">Answered:
-    ${question.answered ? '<span class="answered-true"> Yes </span>' : `<span class="answered-false" data-question-id="${question.questionID}" > No </span>`}
-  </div>
-</div>`;
-  return card;
-}
-
-function renderUnansweredQuestionCard(question, timezone) {
-  const formattedTime = formatTime24To12(question.createdAt, timezone);
-  const card = document.createElement('div');
-  card.className = 'card session-card-wrapper';
-  card.setAttribute('data-question-id', question.questionID);
-  card.innerHTML = `
-  <div class="question-card-time">
-  ${formattedTime}
-</div>
-<div class="card-body dark-card-body">
-<strong class="session-q-text">Q.</strong>
-  <p class="card-text session-card-text dark-card-text">
-    ${question.text}
-  </p>
-</div>
-<div class="card-footer session-card-footer dark-card-footer">
-    <p class="m-0 unanswered-vote-count session-votes-font" data-vote-value="${question.questionID}">${question.votes} votes</p>
-  ${`<button onclick="markQuestionAnswered(event)" value="${question.questionID}" class="mark-answered-btn">Mark Answered</button>`}
-</div>`;
-  return card;
-}
-
-// Render a new question card in the UI
-export function renderNewQuestion(question) {
-  const welcomeAlert = document.getElementById('welcome-alert');
-  if (welcomeAlert) {
-    welcomeAlert.remove();
-  }
-
-  const moderatorStatus = document.getElementById('moderator-status').value;
-  const containerTime = document.getElementById('created-cards');
-  const containerVote = document.getElementById('votes-cards');
-  const containerTimeUnanswered = document.getElementById('unanswered-created-cards');
-  const containerVoteUnanswered = document.getElementById('unanswered-votes-cards');
-  const timezone = document.getElementById('timezone').value;
-
-  const cardTime = renderQuestionCard(question, moderatorStatus, timezone);
-  const cardVote = renderQuestionCard(question, moderatorStatus, timezone);
-
-  const cardTimeUnanswered = renderUnansweredQuestionCard(question, timezone);
-  const cardVoteUnanswered = renderUnansweredQuestionCard(question, timezone);
-
-  if (containerTime.firstChild) {
-    containerTime.insertBefore(cardTime, containerTime.firstChild);
-    containerTimeUnanswered.insertBefore(cardTimeUnanswered, containerTimeUnanswered.firstChild);
-  } else {
-    containerTime.appendChild(cardTime);
-    containerTimeUnanswered.appendChild(cardTimeUnanswered);
-  }
-  containerVote.appendChild(cardVote);
-  containerVoteUnanswered.appendChild(cardVoteUnanswered);
-}
-
-
-// Remove the 'answered' button and update the 'answered' status in the UI
-export function removeAnsweredBtn(question) {
-  const markAnsweredBtns = document.querySelectorAll(`.mark-answered-btn[value="${question.questionID}"]`);
-  if (markAnsweredBtns) {
-    markAnsweredBtns.forEach(btn => btn.style.display = "none");
-  }
-
-  const answeredStatuses = document.querySelectorAll(`.answered-false[data-question-id="${question.questionID}"]`);
-  if (answeredStatuses) {
-    answeredStatuses.forEach(status => {
-      status.innerHTML = "Yes";
-      status.classList.remove("answered-false");
-      status.classList.add("answered-true");
-    });
-  }
-}
-
-// Remove the question card from the unanswered questions tab when it is marked as answered
-export function removeCard(question) {
-  const unansweredTopTab = document.getElementById('unanswered-votes-cards');
-  const unansweredNewestTab = document.getElementById('unanswered-created-cards');
-
-  const topTabCard = unansweredTopTab.querySelector(`.session-card-wrapper[data-question-id="${question.questionID}"]`);
-  const newestTabCard = unansweredNewestTab.querySelector(`.session-card-wrapper[data-question-id="${question.questionID}"]`);
-
-  // Add fade-out class to the cards
-  topTabCard.classList.add('fade-out');
-  newestTabCard.classList.add('fade-out');
-
-  // Remove the cards after the fade-out animation is complete
-  setTimeout(() => {
-    topTabCard.remove();
-    newestTabCard.remove();
-  }, 300);
-}
-
-
-// Show a welcome message if there are no questions
-export function checkEmptyCards() {
-  const createdCards = document.getElementById("created-cards");
-
-  if (!createdCards.innerHTML.trim()) {
-    const message = `
-        <div id="welcome-alert" class="alert alert-info" role="alert">
-          Welcome! It's time to ask your first question!
-        </div>
-      `;
-
-    createdCards.innerHTML = message;
-  }
-}
-
-// Call the checkEmptyCards function when the document is ready and the URL contains 'class-session'
-document.addEventListener("DOMContentLoaded", function () {
-  if (window.location.href.indexOf("class-session") !== -1) {
-    // Wait for a moment before calling the checkEmptyCards function
-    setTimeout(checkEmptyCards, 250);
-  }
+// renderQuestionCard renders a question card with the given question data
+function renderQuestionCard(question, moderatorStatus, timezone) {
+
+  const formattedTime = formatTime24To12(question.createdAt, timezone);
+  const card = document.createElement('div');
+  card.className = 'card session-card-wrapper';
+  card.setAttribute('data-question-id', question.questionID);
+  card.innerHTML = `
+  <div class="question-card-time">
+  <span class="question-number">#${question.questionID}</span> ${formattedTime}
+</div>
+<div class="card-body dark-card-body">
+<strong class="session-q-text">Q.</strong>
+<p class="card-text session-card-text dark-card-text">
+    ${question.text}
+  </p>
+</div>
+<div class="card-footer session-card-footer dark-card-footer ">
+  <button onclick="voteUp(event)" value="${question.questionID}" class="vote-up-btn">
+    <img src="/static/images/icon-arrow-up-${question.answered}.svg" alt="">
+    <p class="m-0 session-votes-font">${question.votes}</p>
+  </button>
+  ${moderatorStatus == "student" ? '' : question.answered == true ? '' : `<button onclick="markQuestionAnswered(event)" value="${question.questionID}" class="mark-answered-btn">Answered?</button>`}
+  ${moderatorStatus == "student" ? '' : `<button onclick="mergeQuestionClick(event)" value="${question.questionID}" class="merge-question-btn">Merge</button>`}
+  <div class="session-answered-text">Answered:
+    ${question.answered ? '<span class="answered-true"> Yes </span>' : `<span class="answered-false" data-question-id="${question.questionID}" > No </span>`}
+  </div>
+</div>
+${renderAnswerThread(question.questionID, [], moderatorStatus)}`;
+  return card;
+}
+
+function renderUnansweredQuestionCard(question, timezone) {
+  const formattedTime = formatTime24To12(question.createdAt, timezone);
+  const card = document.createElement('div');
+  card.className = 'card session-card-wrapper';
+  card.setAttribute('data-question-id', question.questionID);
+  card.innerHTML = `
+  <div class="question-card-time">
+  ${formattedTime}
+</div>
+<div class="card-body dark-card-body">
+<strong class="session-q-text">Q.</strong>
+  <p class="card-text session-card-text dark-card-text">
+    ${question.text}
+  </p>
+</div>
+<div class="card-footer session-card-footer dark-card-footer">
+    <p class="m-0 unanswered-vote-count session-votes-font" data-vote-value="${question.questionID}">${question.votes} votes</p>
+  ${`<button onclick="markQuestionAnswered(event)" value="${question.questionID}" class="mark-answered-btn">Mark Answered</button>`}
+</div>`;
+  return card;
+}
+
+// Escape text so it is shown as typed rather than parsed as HTML
+function escapeHTML(text) {
+  const div = document.createElement('div');
+  div.textContent = text;
+  return div.innerHTML;
+}
+
+// renderAnswer renders one answer to a question
+function renderAnswer(answer, timezone) {
+  const role = answer.moderatorType ? ` (${escapeHTML(answer.moderatorType)})` : '';
+  return `
+  <div class="question-answer" data-answer-id="${answer.answerID}">
+    <p class="question-answer-author">${escapeHTML(answer.firstName)} ${escapeHTML(answer.lastName)}${role}
+      <span class="question-answer-time">${formatTime24To12(answer.createdAt, timezone)}</span>
+    </p>
+    <p class="question-answer-text">${escapeHTML(answer.text)}</p>
+  </div>`;
+}
+
+// renderAnswerThread renders the answers of a question and, for moderators, a form to reply
+export function renderAnswerThread(questionID, answers, moderatorStatus, timezone = null) {
+  const thread = (answers || []).map(answer => renderAnswer(answer, timezone)).join('');
+  const form = moderatorStatus == "student" ? '' : `
+  <form class="answer-form" data-question-id="${questionID}" onsubmit="submitAnswer(event)">
+    <input type="text" class="form-control answer-input" name="answerText" maxlength="1000" placeholder="Write an answer" required>
+    <button type="submit" class="mark-answered-btn answer-submit-btn">Reply</button>
+  </form>`;
+  return `
+<div class="question-answers" data-question-id="${questionID}">${thread}</div>${form}`;
+}
+
+// Post an answer to a question
+export function submitAnswer(event) {
+  event.preventDefault();
+  const form = event.currentTarget;
+  const questionID = form.dataset.questionId;
+  const input = form.querySelector('.answer-input');
+  const formData = new FormData();
+  formData.append("answerText", input.value.trim());
+
+  fetch(`/api/answers/${questionID}`, {
+    method: "POST",
+    body: formData
+  })
+    .then(response => {
+      if (response.status == 200) {
+        // The answer arrives with the new-answer websocket action
+        document.querySelectorAll(`.answer-form[data-question-id="${questionID}"] .answer-input`).forEach(answerInput => {
+          answerInput.value = '';
+        });
+      }
+    })
+    .catch(error => {
+      console.log(error);
+    });
+}
+
+// Render a new answer under every card of its question
+export function renderNewAnswer(answer) {
+  const timezone = document.getElementById('timezone').value;
+  const threads = document.querySelectorAll(`.question-answers[data-question-id="${answer.questionID}"]`);
+  threads.forEach(thread => {
+    if (!thread.querySelector(`.question-answer[data-answer-id="${answer.answerID}"]`)) {
+      thread.insertAdjacentHTML('beforeend', renderAnswer(answer, timezone));
+    }
+  });
+}
+
+// Merge a question into a duplicate, suggesting the most similar open question
+export function mergeQuestionClick(event) {
+  const questionID = event.currentTarget.value;
+
+  fetch(`/api/similar-questions/${questionID}`, {
+    method: 'GET'
+  })
+    .then(response => response.json())
+    .then(data => {
+      const suggested = data.similar && data.similar.length > 0 ? data.similar[0].ID : '';
+      const targetID = prompt(`Merge question #${questionID} into question number:`, suggested);
+      if (!targetID) {
+        return;
+      }
+
+      return fetch(`/api/merge-question/${questionID}`, {
+        method: 'POST',
+        headers: {
+          'Content-Type': 'application/json'
+        },
+        body: JSON.stringify({ targetID: parseInt(targetID, 10) })
+      }).then(response => {
+        if (response.status != 200) {
+          response.json().then(data => alert(data.error));
+        }
+      });
+    })
+    .catch(error => {
+      console.log(error);
+    });
+}
+
+// Handle a merge-question action: drop the merged question and update the one it was merged into
+export function handleMergeQuestion(input) {
+  document.querySelectorAll(`.session-card-wrapper[data-question-id="${input.sourceID}"]`).forEach(card => {
+    card.remove();
+  });
+
+  document.querySelectorAll(`.session-card-wrapper[data-question-id="${input.questionID}"] .session-card-text`).forEach(text => {
+    text.textContent = input.text;
+  });
+
+  updateVoteCount(input);
+}
+
+// Render a new question card in the UI
+export function renderNewQuestion(question) {
+  // A question that arrived with a snapshot is already shown
+  if (document.querySelector(`#created-cards .session-card-wrapper[data-question-id="${question.questionID}"]`)) {
+    return;
+  }
+
+  const welcomeAlert = document.getElementById('welcome-alert');
+  if (welcomeAlert) {
+    welcomeAlert.remove();
+  }
+
+  const moderatorStatus = document.getElementById('moderator-status').value;
+  const containerTime = document.getElementById('created-cards');
+  const containerVote = document.getElementById('votes-cards');
+  const containerTimeUnanswered = document.getElementById('unanswered-created-cards');
+  const containerVoteUnanswered = document.getElementById('unanswered-votes-cards');
+  const timezone = document.getElementById('timezone').value;
+
+  const cardTime = renderQuestionCard(question, moderatorStatus, timezone);
+  const cardVote = renderQuestionCard(question, moderatorStatus, timezone);
+
+  const cardTimeUnanswered = renderUnansweredQuestionCard(question, timezone);
+  const cardVoteUnanswered = renderUnansweredQuestionCard(question, timezone);
+
+  if (containerTime.firstChild) {
+    containerTime.insertBefore(cardTime, containerTime.firstChild);
+    containerTimeUnanswered.insertBefore(cardTimeUnanswered, containerTimeUnanswered.firstChild);
+  } else {
+    containerTime.appendChild(cardTime);
+    containerTimeUnanswered.appendChild(cardTimeUnanswered);
+  }
+  containerVote.appendChild(cardVote);
+  containerVoteUnanswered.appendChild(cardVoteUnanswered);
+}
+
+
+// Remove the 'answered' button and update the 'answered' status in the UI
+export function removeAnsweredBtn(question) {
+  const markAnsweredBtns = document.querySelectorAll(`.mark-answered-btn[value="${question.questionID}"]`);
+  if (markAnsweredBtns) {
+    markAnsweredBtns.forEach(btn => btn.style.display = "none");
+  }
+
+  const answeredStatuses = document.querySelectorAll(`.answered-false[data-question-id="${question.questionID}"]`);
+  if (answeredStatuses) {
+    answeredStatuses.forEach(status => {
+      status.innerHTML = "Yes";
+      status.classList.remove("answered-false");
+      status.classList.add("answered-true");
+    });
+  }
+}
+
+// Remove the question card from the unanswered questions tab when it is marked as answered
+export function removeCard(question) {
+  const unansweredTopTab = document.getElementById('unanswered-votes-cards');
+  const unansweredNewestTab = document.getElementById('unanswered-created-cards');
+
+  const topTabCard = unansweredTopTab.querySelector(`.session-card-wrapper[data-question-id="${question.questionID}"]`);
+  const newestTabCard = unansweredNewestTab.querySelector(`.session-card-wrapper[data-question-id="${question.questionID}"]`);
+
+  // Add fade-out class to the cards
+  topTabCard.classList.add('fade-out');
+  newestTabCard.classList.add('fade-out');
+
+  // Remove the cards after the fade-out animation is complete
+  setTimeout(() => {
+    topTabCard.remove();
+    newestTabCard.remove();
+  }, 300);
+}
+
+
+// Show a welcome message if there are no questions
+export function checkEmptyCards() {
+  const createdCards = document.getElementById("created-cards");
+
+  if (!createdCards.innerHTML.trim()) {
+    const message = `
+        <div id="welcome-alert" class="alert alert-info" role="alert">
+          Welcome! It's time to ask your first question!
+        </div>
+      `;
+
+    createdCards.innerHTML = message;
+  }
+}
+
+// Call the checkEmptyCards function when the document is ready and the URL contains 'class-session'
+document.addEventListener("DOMContentLoaded", function () {
+  if (window.location.href.indexOf("class-session") !== -1) {
+    // Wait for a moment before calling the checkEmptyCards function
+    setTimeout(checkEmptyCards, 250);
+  }
 });
\ No newline at end of file
@@ -0,0 +1,416 @@
+// The quizzes of the class session by id, and the submissions of the user by quiz id
+const quizzes = new Map();
+const quizSubmissions = new Map();
+
+// When the time limit of each open quiz runs out, in milliseconds since the epoch
+const quizDeadlines = new Map();
+let quizTimer = null;
+
+// Escape text so it is shown as typed rather than parsed as HTML
+function escapeQuizText(text) {
+    const div = document.createElement('div');
+    div.textContent = text;
+    return div.innerHTML;
+}
+
+// Moderators get the form to create quizzes and see the answers
+function isQuizModerator() {
+    return document.getElementById('quiz-form') !== null;
+}
+
+// Keep a quiz as returned by the API
+function storeQuiz(quiz) {
+    quizzes.set(quiz.ID, {
+        id: quiz.ID,
+        title: quiz.Title,
+        status: quiz.Status,
+        timeLimit: quiz.TimeLimit,
+        maxScore: quiz.MaxScore,
+        submissions: quiz.Submissions,
+        questions: (quiz.Questions || []).map(question => ({
+            id: question.ID,
+            text: question.Text,
+            points: question.Points,
+            options: (question.Options || []).map(option => ({ id: option.ID, text: option.Text, correct: option.Correct })),
+        })),
+    });
+    if (quiz.Status == 'open' && quiz.Remaining > 0) {
+        quizDeadlines.set(quiz.ID, Date.now() + quiz.Remaining * 1000);
+    } else {
+        quizDeadlines.delete(quiz.ID);
+    }
+}
+
+// Format a number of seconds as minutes and seconds
+function formatQuizTime(seconds) {
+    const minutes = Math.floor(seconds / 60);
+    const rest = seconds % 60;
+    return `${minutes}:${rest < 10 ? '0' : ''}${rest}`;
+}
+
+// Update the time left of every open quiz each second
+function tickQuizTimers() {
+    document.querySelectorAll('#quiz-cards .quiz-timer').forEach(timer => {
+        const deadline = quizDeadlines.get(Number(timer.dataset.quizId));
+        if (!deadline) {
+            return;
+        }
+        const seconds = Math.max(0, Math.round((deadline - Date.now()) / 1000));
+        timer.textContent = seconds > 0 ? `${formatQuizTime(seconds)} left` : "Time's up";
+    });
+}
+
+function startQuizTimer() {
+    if (quizTimer === null) {
+        quizTimer = setInterval(tickQuizTimers, 1000);
+    }
+}
+
+// Render the questions of a quiz for a student to answer
+function renderQuizForm(quiz) {
+    const questions = quiz.questions.map((question, i) => `
+        <fieldset class="quiz-question">
+          <legend>${i + 1}. ${escapeQuizText(question.text)} <span class="quiz-points">${question.points} ${question.points == 1 ? 'point' : 'points'}</span></legend>
+          ${question.options.map(option => `
+          <div class="form-check">
+            <input class="form-check-input" type="radio" name="quiz-${quiz.id}-question-${question.id}" id="quiz-option-${option.id}" value="${option.id}" data-question-id="${question.id}">
+            <label class="form-check-label" for="quiz-option-${option.id}">${escapeQuizText(option.text)}</label>
+          </div>`).join('')}
+        </fieldset>`).join('');
+
+    return `
+      <form class="quiz-form" data-quiz-id="${quiz.id}" onsubmit="submitQuiz(event)">
+        ${questions}
+        <div class="d-flex justify-content-end">
+          <button type="submit" class="mark-answered-btn">Submit</button>
+        </div>
+      </form>`;
+}
+
+// Render the questions of a quiz with the option chosen, and the correct ones when they are known
+function renderQuizAnswers(quiz, submission) {
+    const answers = submission ? submission.Answers || {} : {};
+    return quiz.questions.map((question, i) => `
+        <div class="quiz-question">
+          <p class="mb-1">${i + 1}. ${escapeQuizText(question.text)} <span class="quiz-points">${question.points} ${question.points == 1 ? 'point' : 'points'}</span></p>
+          <ul class="quiz-options">
+            ${question.options.map(option => {
+                const classes = [];
+                if (option.correct) {
+                    classes.push('quiz-option-correct');
+                }
+                if (answers[question.id] == option.id) {
+                    classes.push('quiz-option-chosen');
+                }
+                return `<li class="${classes.join(' ')}">${escapeQuizText(option.text)}</li>`;
+            }).join('')}
+          </ul>
+        </div>`).join('');
+}
+
+// Render a quiz card. Students answer open quizzes, then see their score, and the answers once the quiz is closed.
+function renderQuizCard(quiz) {
+    const moderator = isQuizModerator();
+    const submission = quizSubmissions.get(quiz.id);
+    let card = document.querySelector(`#quiz-cards .quiz-card[data-quiz-id="${quiz.id}"]`);
+    if (!card) {
+        card = document.createElement('div');
+        card.className = 'card session-card-wrapper quiz-card';
+        card.setAttribute('data-quiz-id', quiz.id);
+        document.getElementById('quiz-cards').prepend(card);
+    }
+
+    let body;
+    if (moderator || quiz.status == 'closed') {
+        body = renderQuizAnswers(quiz, submission);
+    } else if (submission) {
+        body = '<p class="mb-0">Submitted. The answers are shown when the quiz closes.</p>';
+    } else {
+        body = renderQuizForm(quiz);
+    }
+
+    let score = '';
+    if (submission) {
+        score = `<span class="quiz-score">Your score: ${submission.Score}/${submission.MaxScore}</span>`;
+    }
+
+    let timer = '';
+    if (quiz.status == 'open' && quizDeadlines.has(quiz.id)) {
+        timer = `<span class="quiz-timer" data-quiz-id="${quiz.id}"></span>`;
+        startQuizTimer();
+    }
+
+    let controls = '';
+    if (moderator && quiz.status == 'draft') {
+        controls = `<button onclick="openQuiz(event)" value="${quiz.id}" class="mark-answered-btn">Open</button>`;
+    } else if (moderator && quiz.status == 'open') {
+        controls = `<button onclick="closeQuiz(event)" value="${quiz.id}" class="reject-question-btn">Close</button>`;
+    }
+    if (moderator && quiz.status != 'draft') {
+        controls += `<button onclick="showQuizResults(event)" value="${quiz.id}" class="merge-question-btn">Scores</button>`;
+    }
+
+    card.innerHTML = `
+    <div class="card-body dark-card-body">
+      <div class="d-flex justify-content-between align-items-center">
+        <p class="quiz-title">${escapeQuizText(quiz.title)}</p>
+        <div class="d-flex align-items-center">
+          ${timer}
+          <span class="badge rounded-pill quiz-status-${quiz.status}">${quiz.status}</span>
+        </div>
+      </div>
+      ${body}
+      <div class="quiz-results"></div>
+    </div>
+    <div class="card-footer session-card-footer dark-card-footer quiz-card-footer">
+      <span class="quiz-submissions">${quiz.submissions} ${quiz.submissions == 1 ? 'submission' : 'submissions'} · ${quiz.maxScore} ${quiz.maxScore == 1 ? 'point' : 'points'}</span>
+      ${score}
+      <div>${controls}</div>
+    </div>`;
+    tickQuizTimers();
+}
+
+// Load the quizzes of the class session
+export function loadQuizzes() {
+    const container = document.getElementById('quiz-cards');
+    if (!container) {
+        return;
+    }
+    const classSessionID = document.getElementById('class-session-ID').value;
+
+    fetch(`/api/quizzes/${classSessionID}`, {
+        method: 'GET'
+    })
+        .then(response => response.json())
+        .then(data => {
+            quizzes.clear();
+            quizSubmissions.clear();
+            container.innerHTML = '';
+            Object.entries(data.submissions || {}).forEach(([quizID, submission]) => {
+                quizSubmissions.set(Number(quizID), submission);
+            });
+            (data.quizzes || []).forEach(quiz => {
+                storeQuiz(quiz);
+                renderQuizCard(quizzes.get(quiz.ID));
+            });
+        })
+        .catch(error => {
+            console.log(error);
+        });
+}
+
+// Read the questions typed in the quiz form. Questions are separated by a blank line; the first line of
+// each is the question, optionally ending with its points in brackets, and the next lines are its options,
+// the correct one starting with *.
+function parseQuizQuestions(text) {
+    return text.split(/\n\s*\n/).map(block => block.split('\n').map(line => line.trim()).filter(line => line != ''))
+        .filter(lines => lines.length > 0)
+        .map(lines => {
+            let questionText = lines[0];
+            let points = 1;
+            const pointsMatch = questionText.match(/\[(\d+)\]$/);
+            if (pointsMatch) {
+                points = Number(pointsMatch[1]);
+                questionText = questionText.slice(0, -pointsMatch[0].length).trim();
+            }
+
+            let correct = -1;
+            const options = lines.slice(1).map((line, i) => {
+                if (line.startsWith('*')) {
+                    correct = i;
+                    return line.slice(1).trim();
+                }
+                return line;
+            });
+
+            return { text: questionText, points: points, options: options, correct: correct };
+        });
+}
+
+// Create a quiz from the form, opening it to the class unless it is saved as a draft
+export function createQuiz(event, open = true) {
+    event.preventDefault();
+    const titleInput = document.getElementById('quiz-title');
+    const title = titleInput.value.trim();
+    if (title == '') {
+        titleInput.focus();
+        return;
+    }
+
+    const classSessionID = document.getElementById('class-session-ID').value;
+    const questions = parseQuizQuestions(document.getElementById('quiz-questions').value);
+
+    fetch(`/api/quizzes/${classSessionID}`, {
+        method: 'POST',
+        headers: {
+            'Content-Type': 'application/json'
+        },
+        body: JSON.stringify({
+            title: title,
+            timeLimit: Number(document.getElementById('quiz-time-limit').value),
+            questions: questions,
+            open: open
+        })
+    })
+        .then(response => response.json().then(data => ({ status: response.status, data: data })))
+        .then(({ status, data }) => {
+            if (status != 200) {
+                alert(data.error);
+            }
+            if (!data.quizID) {
+                return;
+            }
+            document.getElementById('quiz-form').reset();
+            // Open quizzes arrive with their event; drafts are only shown here
+            loadQuizzes();
+        })
+        .catch(error => {
+            console.log(error);
+        });
+}
+
+// Open a draft quiz to the class
+export function openQuiz(event) {
+    changeQuizStatus(event.currentTarget.value, 'open');
+}
+
+// Stop a quiz from taking submissions
+export function closeQuiz(event) {
+    changeQuizStatus(event.currentTarget.value, 'close');
+}
+
+function changeQuizStatus(quizID, change) {
+    fetch(`/api/quiz/${quizID}/${change}`, {
+        method: 'POST'
+    })
+        .then(response => response.json().then(data => ({ status: response.status, data: data })))
+        .then(({ status, data }) => {
+            if (status != 200) {
+                alert(data.error);
+                loadQuizzes();
+            }
+        })
+        .catch(error => {
+            console.log(error);
+        });
+}
+
+// Show the score of every student who submitted a quiz under it
+export function showQuizResults(event) {
+    const quizID = event.currentTarget.value;
+    const results = document.querySelector(`#quiz-cards .quiz-card[data-quiz-id="${quizID}"] .quiz-results`);
+
+    fetch(`/api/quiz/${quizID}/results`, {
+        method: 'GET'
+    })
+        .then(response => response.json())
+        .then(data => {
+            const submissions = data.submissions || [];
+            if (submissions.length == 0) {
+                results.innerHTML = '<p class="mb-0">No submissions yet</p>';
+                return;
+            }
+            results.innerHTML = `
+            <table class="table table-sm quiz-results-table">
+              <tbody>
+                ${submissions.map(submission => `
+                <tr>
+                  <td>${escapeQuizText(submission.FirstName)} ${escapeQuizText(submission.LastName)}</td>
+                  <td>${submission.Score}/${submission.MaxScore}</td>
+                  <td>${submission.SubmittedAt}</td>
+                </tr>`).join('')}
+              </tbody>
+            </table>`;
+        })
+        .catch(error => {
+            console.log(error);
+        });
+}
+
+// Submit the answers chosen to a quiz, over the class session websocket when it is open
+export function submitQuiz(event) {
+    event.preventDefault();
+    const form = event.currentTarget;
+    const quizID = Number(form.dataset.quizId);
+    const answers = {};
+    form.querySelectorAll('input[type="radio"]:checked').forEach(input => {
+        answers[input.dataset.questionId] = Number(input.value);
+    });
+
+    const quiz = quizzes.get(quizID);
+    if (quiz && Object.keys(answers).length < quiz.questions.length && !confirm('Submit without answering every question?')) {
+        return;
+    }
+
+    sendClassAction('quiz-submit', { quizID: quizID, answers: answers }, () => fetch(`/api/quiz/${quizID}/submit`, {
+        method: 'POST',
+        headers: {
+            'Content-Type': 'application/json'
+        },
+        body: JSON.stringify({ answers: answers })
+    }))
+        .then(({ status, data }) => {
+            if (status == 200 && quiz) {
+                quizSubmissions.set(quizID, { Score: data.score, MaxScore: data.maxScore, Answers: answers });
+                renderQuizCard(quiz);
+            } else if (status == 409) {
+                alert(data.error);
+                // Closed or already submitted elsewhere
+                loadQuizzes();
+            } else if (data.error) {
+                alert(data.error);
+            }
+        })
+        .catch(error => {
+            console.log(error);
+        });
+}
+
+// Handle a quiz-opened event: show the quiz to the class
+export function handleQuizOpened(input) {
+    if (!document.getElementById('quiz-cards')) {
+        return;
+    }
+    const quiz = quizzes.get(input.quizID) || { id: input.quizID, submissions: 0 };
+    quiz.title = input.title;
+    quiz.status = 'open';
+    quiz.timeLimit = input.timeLimit;
+    quiz.questions = input.questions.map(question => ({
+        id: question.questionID,
+        text: question.text,
+        points: question.points,
+        options: question.options.map(option => ({ id: option.optionID, text: option.text, correct: false })),
+    }));
+    quiz.maxScore = quiz.questions.reduce((total, question) => total + question.points, 0);
+    if (input.timeLimit > 0) {
+        quizDeadlines.set(quiz.id, Date.now() + input.timeLimit * 1000);
+    }
+    quizzes.set(quiz.id, quiz);
+    if (isQuizModerator()) {
+        // Moderators keep the answers they already have
+        loadQuizzes();
+        return;
+    }
+    renderQuizCard(quiz);
+}
+
+// Handle a quiz-submitted event: update the number of submissions
+export function handleQuizSubmitted(input) {
+    const quiz = quizzes.get(input.quizID);
+    if (!quiz) {
+        return;
+    }
+    quiz.submissions = input.submissions;
+    const count = document.querySelector(`#quiz-cards .quiz-card[data-quiz-id="${quiz.id}"] .quiz-submissions`);
+    if (count) {
+        count.textContent = `${quiz.submissions} ${quiz.submissions == 1 ? 'submission' : 'submissions'} · ${quiz.maxScore} ${quiz.maxScore == 1 ? 'point' : 'points'}`;
+    }
+}
+
+// Handle a quiz-closed event: reload the quizzes to show the answers
+export function handleQuizClosed(input) {
+    quizDeadlines.delete(input.quizID);
+    loadQuizzes();
+}
+
+loadQuizzes();
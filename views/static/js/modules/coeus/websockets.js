@@ -1,114 +1,282 @@
-// Create a new WebSocket connection for general messages
-const protocol = location.protocol === 'https:' ? 'wss:' : 'ws:';
-const host = location.host;
-let generalWs = new WebSocket(`${protocol}//${host}/ws`);
-
-generalWs.onopen = function () {
-    // WebSocket connection opened
-};
-
-generalWs.onmessage = function (event) {
-    // Parse the received JSON message
-    const input = JSON.parse(event.data);
-
-    // Check the action of the received input
-    switch (input.action) {
-        case "start-session":
-            handleStartSession(input);
-            break;
-        case "end-session":
-            handleEndSession(input);
-            break;
-        case "new-logo":
-            // Update the logo image
-            const logoImg = document.getElementById("organization-logo");
-
-            logoImg.src = `${input.logoPath}`;
-            break;
-        case "demo-warning-banner":
-            // Show the demo warning modal
-            toggleBanner()
-            setTimeout(() => {
-                toggleBanner()
-            }, 30000); // 30 seconds delay
-
-            let countdown = document.getElementById("reseed-countdown");
-            let count = 30;
-            countdown.innerHTML = count;
-
-            let timer = setInterval(() => {
-                count--;
-                countdown.innerHTML = count;
-                if (count <= 0) {
-                    
-                    clearInterval(timer);
-                    location.reload();
-                }
-            }, 1000);
-           
-            break;
-        default:
-            console.log("Unknown action:", input.action);
-    }
-};
-
-let classSessionID;
-let classWs;
-
-// If the class session page has a class-session-ID element, create a websocket connection
-if (document.getElementById("class-session-ID")) {
-    // Get the class session ID from the class-session-ID element
-    classSessionID = document.getElementById("class-session-ID").value;
-    classWs = new WebSocket(`${protocol}//${host}/ws/${classSessionID}`);
-
-    classWs.onopen = function () {
-        // WebSocket connection opened
-    };
-
-    classWs.onmessage = function (event) {
-        // Parse the received JSON message
-        const input = JSON.parse(event.data);
-
-        // Check the action of the received input
-        switch (input.action) {
-            case "vote-up":
-                updateVoteCount(input);
-                break;
-            case "new-question":
-                renderNewQuestion(input);
-                break;
-            case "mark-question":
-                removeAnsweredBtn(input);
-                removeCard(input);
-                break;
-            case "start-session":
-                startClassSession(input);
-                break;
-            case "end-session":
-                endClassSession(input);
-                break;
-            case "participant-joined":
-                participantJoined(input);
-                break;
-            case "participant-left":
-                participantLeft();
-                break;
-            default:
-                console.log("Unknown action:", input.action);
-        }
-    };
-
-    classWs.onclose = function () {
-        // WebSocket connection closed
-    };
-
-    classWs.onerror = function (error) {
-        console.log("WebSocket error:", error);
-    };
-}
-
-function toggleBanner() {
-    const banner = document.getElementById('banner');
-    banner.classList.toggle('banner-visible');
-    banner.classList.toggle('banner-hidden');
+// Create a new WebSocket connection for general messages
+const protocol = location.protocol === 'https:' ? 'wss:' : 'ws:';
+const host = location.host;
+let generalWs;
+let generalReconnectAttempts = 0;
+
+// Wait a little longer after every failed attempt to reconnect, up to 30 seconds
+function reconnectDelay(attempts) {
+    return Math.min(30000, 1000 * 2 ** attempts);
+}
+
+// Open the websocket for general messages, reopening it whenever it drops
+function connectGeneralWs() {
+    generalWs = new WebSocket(`${protocol}//${host}/ws`);
+
+    generalWs.onopen = function () {
+        generalReconnectAttempts = 0;
+    };
+
+    generalWs.onmessage = function (message) {
+        // Parse the received event, whose payload depends on its type
+        const event = JSON.parse(message.data);
+        const input = event.payload;
+
+        switch (event.type) {
+            case "start-session":
+                handleStartSession(input, event.classSessionID);
+                break;
+            case "end-session":
+                handleEndSession(input);
+                break;
+            case "new-logo":
+                // Update the logo image
+                const logoImg = document.getElementById("organization-logo");
+
+                logoImg.src = `${input.logoPath}`;
+                break;
+            case "demo-warning-banner":
+                // Show the demo warning modal
+                toggleBanner()
+                setTimeout(() => {
+                    toggleBanner()
+                }, 30000); // 30 seconds delay
+
+                let countdown = document.getElementById("reseed-countdown");
+                let count = 30;
+                countdown.innerHTML = count;
+
+                let timer = setInterval(() => {
+                    count--;
+                    countdown.innerHTML = count;
+                    if (count <= 0) {
+                    
+                        clearInterval(timer);
+                        location.reload();
+                    }
+                }, 1000);
+           
+                break;
+            default:
+                console.log("Unknown event:", event.type);
+        }
+    };
+
+    generalWs.onclose = function (event) {
+        if (event.code == 1008) {
+            return;
+        }
+        setTimeout(connectGeneralWs, reconnectDelay(generalReconnectAttempts++));
+    };
+}
+
+// Websockets are only open to signed in users
+if (document.body.dataset.signedIn) {
+    connectGeneralWs();
+}
+
+let classSessionID;
+let classWs;
+// The sequence number of the last class session event received, and the stream of the server
+// instance that numbered it
+let lastSeq = null;
+let lastStream = null;
+let classReconnectAttempts = 0;
+let classSessionEnded = false;
+// The event stream used instead of the websocket when it can't be opened
+let classEvents = null;
+let classWsFailures = 0;
+const maxClassWsFailures = 2;
+// Actions sent over the class session websocket that are waiting for a reply, by request id
+const pendingRequests = new Map();
+let nextRequestId = 1;
+
+// Send an action to the class session over its websocket and resolve with the status and data
+// of the reply. When the websocket isn't open, fallback makes the same request over HTTP and
+// returns its fetch promise instead.
+export function sendClassAction(action, fields, fallback) {
+    if (!classWs || classWs.readyState !== WebSocket.OPEN) {
+        return fallback().then(response => response.json().then(data => ({ status: response.status, data: data })));
+    }
+
+    const requestId = String(nextRequestId++);
+    return new Promise(resolve => {
+        pendingRequests.set(requestId, resolve);
+        classWs.send(JSON.stringify({ action: action, requestId: requestId, ...fields }));
+    });
+}
+
+// Resolve the pending action a reply answers
+function resolveRequest(input) {
+    const resolve = pendingRequests.get(input.requestId);
+    if (resolve) {
+        pendingRequests.delete(input.requestId);
+        resolve({ status: input.status, data: input.data || { error: input.error } });
+    }
+}
+
+// Handle an event of the class session, from the websocket or the event stream.
+// The handlers are given the payload of the event, whose fields depend on its type.
+function handleClassEvent(event) {
+    // Skip events already seen after a reconnection; a snapshot or another instance starts over.
+    // Replies to actions are not numbered.
+    if (event.stream) {
+        if (event.type != "snapshot" && event.stream == lastStream && lastSeq !== null && event.seq <= lastSeq) {
+            return;
+        }
+        lastSeq = event.seq;
+        lastStream = event.stream;
+    }
+
+    const input = event.payload;
+    switch (event.type) {
+        case "ack":
+        case "error":
+            resolveRequest(input);
+            break;
+        case "snapshot":
+            handleSnapshot(input);
+            break;
+        case "vote-up":
+            updateVoteCount(input);
+            break;
+        case "new-question":
+            renderNewQuestion(input);
+            break;
+        case "mark-question":
+            removeAnsweredBtn(input);
+            removeCard(input);
+            break;
+        case "new-answer":
+            renderNewAnswer(input);
+            break;
+        case "merge-question":
+            handleMergeQuestion(input);
+            break;
+        case "question-pending":
+            handlePendingQuestion(input);
+            break;
+        case "question-moderated":
+            handleQuestionModerated(input);
+            break;
+        case "poll-opened":
+            handlePollOpened(input);
+            break;
+        case "poll-results":
+            handlePollResults(input);
+            break;
+        case "poll-closed":
+            handlePollClosed(input);
+            break;
+        case "quiz-opened":
+            handleQuizOpened(input);
+            break;
+        case "quiz-submitted":
+            handleQuizSubmitted(input);
+            break;
+        case "quiz-closed":
+            handleQuizClosed(input);
+            break;
+        case "check-in-opened":
+            handleCheckInOpened(input);
+            break;
+        case "check-in-closed":
+            handleCheckInClosed(input);
+            break;
+        case "start-session":
+            startClassSession(input);
+            break;
+        case "end-session":
+            classSessionEnded = true;
+            if (classEvents) {
+                classEvents.close();
+            }
+            endClassSession(input);
+            break;
+        case "participant-joined":
+            participantJoined(input);
+            break;
+        case "participant-left":
+            participantLeft(input);
+            break;
+        default:
+            console.log("Unknown event:", event.type);
+    }
+}
+
+// Open the class session websocket. When reconnecting, the server replays the events
+// missed since lastSeq, or sends a snapshot of the class session if they are too old.
+function connectClassWs() {
+    const resume = lastSeq === null ? '' : `?lastSeq=${lastSeq}&stream=${lastStream}`;
+    classWs = new WebSocket(`${protocol}//${host}/ws/${classSessionID}${resume}`);
+    let opened = false;
+
+    classWs.onopen = function () {
+        opened = true;
+        classReconnectAttempts = 0;
+        classWsFailures = 0;
+    };
+
+    classWs.onmessage = function (event) {
+        handleClassEvent(JSON.parse(event.data));
+    };
+
+    classWs.onclose = function (event) {
+        // Replies to pending actions won't come anymore
+        pendingRequests.forEach(resolve => resolve({ status: 0, data: {} }));
+        pendingRequests.clear();
+
+        // Leave the class session when the server revokes access to it
+        if (event.code == 1008) {
+            location.href = '/';
+            return;
+        }
+        if (classSessionEnded) {
+            return;
+        }
+
+        // A network that never lets the websocket open gets the event stream instead
+        if (!opened && ++classWsFailures >= maxClassWsFailures) {
+            connectClassEvents();
+            return;
+        }
+        setTimeout(connectClassWs, reconnectDelay(classReconnectAttempts++));
+    };
+
+    classWs.onerror = function (error) {
+        console.log("WebSocket error:", error);
+    };
+}
+
+// Follow the class session over Server-Sent Events. The browser reopens the stream by itself
+// and sends the id of the last event it saw, so the server resumes from there.
+function connectClassEvents() {
+    const resume = lastSeq === null ? '' : `?lastSeq=${lastSeq}&stream=${lastStream}`;
+    classEvents = new EventSource(`/sse/${classSessionID}${resume}`);
+
+    classEvents.onmessage = function (event) {
+        handleClassEvent(JSON.parse(event.data));
+    };
+
+    // The server says why it dropped the stream, like a websocket close code
+    classEvents.addEventListener('close', function (event) {
+        const input = JSON.parse(event.data);
+        if (input.code == 1008) {
+            classEvents.close();
+            location.href = '/';
+        }
+    });
+}
+
+// If the class session page has a class-session-ID element, create a websocket connection
+if (document.getElementById("class-session-ID")) {
+    // Get the class session ID from the class-session-ID element
+    classSessionID = document.getElementById("class-session-ID").value;
+    connectClassWs();
+}
+
+function toggleBanner() {
+    const banner = document.getElementById('banner');
+    banner.classList.toggle('banner-visible');
+    banner.classList.toggle('banner-hidden');
 }
\ No newline at end of file
@@ -0,0 +1,126 @@
+import { initializeMDBInputs } from './course-modals.js';
+
+// Load the at-risk students on the instructor dashboard
+document.addEventListener("DOMContentLoaded", function () {
+    if (window.location.pathname === "/instructor") {
+        loadAttendanceAlerts();
+    }
+});
+
+// escapeAlertText escapes names before they are put in the page
+function escapeAlertText(text) {
+    const div = document.createElement('div');
+    div.textContent = text;
+    return div.innerHTML;
+}
+
+// loadAttendanceAlerts shows the students who crossed an attendance threshold of the sections the user teaches
+export function loadAttendanceAlerts() {
+    const container = document.getElementById("attendance-alerts");
+
+    fetch("/api/attendance-alerts")
+        .then(response => response.json())
+        .then(data => {
+            if (!data.alerts || data.alerts.length == 0) {
+                container.innerHTML = "";
+                return;
+            }
+
+            container.innerHTML = `
+                <h5>At-risk students <span class="badge badge-danger">${data.alerts.length}</span></h5>
+                <ul class="list-group list-group-light">
+                    ${data.alerts.map(alert => `
+                    <li class="list-group-item d-flex justify-content-between align-items-center">
+                        <div>
+                            <p class="fw-bold mb-1">${escapeAlertText(alert.FirstName)} ${escapeAlertText(alert.LastName)}
+                                <small class="text-muted fw-normal">${escapeAlertText(alert.CourseNumber)} - Section ${escapeAlertText(alert.SectionName)}</small>
+                            </p>
+                            <p class="mb-0">${alert.Kind == 'rate'
+                                ? `Attendance fell to ${alert.Observed}% (alert below ${alert.Threshold}%)`
+                                : `${alert.Observed} absences (alert at ${alert.Threshold})`}
+                                <small class="text-muted">${alert.CreatedAt}</small>
+                            </p>
+                        </div>
+                        <button type="button" class="btn btn-sm btn-light" onclick="dismissAttendanceAlert(${alert.ID})">Dismiss</button>
+                    </li>`).join('')}
+                </ul>`;
+        })
+        .catch(error => {
+            console.log(error);
+        });
+}
+
+// dismissAttendanceAlert takes an alert off the dashboard once the student was followed up
+export function dismissAttendanceAlert(alertID) {
+    fetch(`/api/attendance-alert/${alertID}/dismiss`, {
+        method: 'POST'
+    })
+        .then(response => response.json().then(data => ({ status: response.status, data: data })))
+        .then(({ status, data }) => {
+            if (status != 200) {
+                alert(data.error);
+            }
+            loadAttendanceAlerts();
+        })
+        .catch(error => {
+            console.log(error);
+        });
+}
+
+// openAttendanceAlertsModal fills the attendance alerts modal with the thresholds of a section
+export function openAttendanceAlertsModal(button) {
+    const sectionID = button.getAttribute("data-section-id");
+    const courseNumber = button.getAttribute("data-course-number");
+    const sectionName = button.getAttribute("data-section-number");
+
+    document.getElementById("attendance-alerts-modal-section").textContent = `${courseNumber} - Section ${sectionName}`;
+    document.getElementById("saveAttendanceThresholdsButton").setAttribute("data-section-id", sectionID);
+    document.getElementById("attendanceAlertsError").classList.remove("show");
+
+    fetch(`/api/attendance-thresholds/${sectionID}`)
+        .then(response => response.json())
+        .then(data => {
+            if (!data.thresholds) {
+                return;
+            }
+            document.getElementById("alert-absences").value = data.thresholds.Absences;
+            document.getElementById("alert-rate").value = data.thresholds.Rate;
+            document.getElementById("alert-email").checked = data.thresholds.Email;
+            initializeMDBInputs();
+        })
+        .catch(error => {
+            console.log(error);
+        });
+}
+
+// saveAttendanceThresholds is called when the Save button of the attendance alerts modal is clicked
+export function saveAttendanceThresholds(e) {
+    e.preventDefault();
+
+    const sectionID = document.getElementById("saveAttendanceThresholdsButton").getAttribute("data-section-id");
+    const thresholds = {
+        absences: parseInt(document.getElementById("alert-absences").value || "0", 10),
+        rate: parseInt(document.getElementById("alert-rate").value || "0", 10),
+        email: document.getElementById("alert-email").checked
+    };
+
+    fetch(`/api/attendance-thresholds/${sectionID}`, {
+        method: 'PUT',
+        headers: {
+            'Content-Type': 'application/json'
+        },
+        body: JSON.stringify(thresholds)
+    })
+        .then(response => response.json().then(data => ({ status: response.status, data: data })))
+        .then(({ status, data }) => {
+            if (status == 200) {
+                mdb.Modal.getInstance(document.getElementById("attendance-alerts-modal")).hide();
+                return;
+            }
+            document.getElementById("attendanceAlertsErrorText").textContent = data.error;
+            document.getElementById("attendanceAlertsError").classList.add("show");
+        })
+        .catch(error => {
+            console.log(error);
+        });
+}
@@ -1,87 +1,274 @@
-export function displaySections(button) {
-    let courseID = button.dataset.courseid;  
-
-    let instructorCourses = document.getElementById("instructor-courses");
-    instructorCourses.classList.remove("show-courses");
-
-    let sectionTable = document.getElementById("instructor-sections-table");
-    sectionTable.style.display = "block";
-
-    let sectionsTableBody = document.getElementById("sections-table-body");
-
-    fetch(`/api/attendance/${courseID.trim()}`)
-        .then(response => response.json())
-        .then(data => {
-            sectionsTableBody.innerHTML = "";
-
-            // Display the sections in a table
-            data.sections.forEach(element => {
-                let row = `
-                <tr onclick="displayStudentRecords(${element.AttendanceID}, event)" class="">
-                    <td>${element.Date}</td>
-                    <td>${element.CourseNumber}</td>
-                    <td>${element.CourseTitle}</td>
-                    <td>${element.SectionName}</td>
-                </tr>
-              `
-                sectionsTableBody.innerHTML += row;
-            });
-        })
-}
-
-
-export function displayStudentRecords(attendanceID, e) {
-    e.preventDefault();
-
-    let studentRecordsList = document.getElementById("student-records-list-body");
-
-    let studentRecords = document.getElementById("student-records");
-    studentRecords.style.display = "block";
-
-    let sectionTable = document.getElementById("instructor-sections-table");
-    sectionTable.style.display = "none";
-
-    fetch(`/api/attendance/students/${attendanceID}`)
-        .then(response => response.json())
-        .then(data => {
-            studentRecordsList.innerHTML = "";
-
-            // Display the sections in a table
-            data.students.forEach(element => {
-
-                let listItem = `
-                    <li class="list-group-item d-flex justify-content-between align-items-center">
-                        <div class="d-flex align-items-center">
-                            <div class="ms-3">
-                                <p class="fw-bold mb-1">${element.FirstName} ${element.LastName}</p>
-                            </div>
-                        </div>
-                        ${element.Status == 'present' ? '<span class="badge rounded-pill badge-success">Present</span>'
-                        :
-                        '<span class="badge rounded-pill badge-danger">Absent</span>'}
-                    </li>
-              `
-                studentRecordsList.innerHTML += listItem;
-            });
-        })
-}
-
-export function showInstructorCourses(e) {
-    e.preventDefault();
-
-    let instructorCourses = document.getElementById("instructor-courses");
-    instructorCourses.classList.add("show-courses");
-
-    let sectionTable = document.getElementById("instructor-sections-table");
-    sectionTable.style.display = "none";
-}
-
-export function showSectionTable(e) {
-    e.preventDefault();
-
-    let studentRecords = document.getElementById("student-records");
-    studentRecords.style.display = "none";
-
-    let sectionTable = document.getElementById("instructor-sections-table");
-    sectionTable.style.display = "block";
-}
\ No newline at end of file
+export function displaySections(button) {
+    let courseID = button.dataset.courseid;  
+
+    let instructorCourses = document.getElementById("instructor-courses");
+    instructorCourses.classList.remove("show-courses");
+
+    let sectionTable = document.getElementById("instructor-sections-table");
+    sectionTable.style.display = "block";
+
+    let sectionsTableBody = document.getElementById("sections-table-body");
+
+    fetch(`/api/attendance/${courseID.trim()}`)
+        .then(response => response.json())
+        .then(data => {
+            sectionsTableBody.innerHTML = "";
+
+            // Display the sections in a table
+            data.sections.forEach(element => {
+                let row = `
+                <tr onclick="displayStudentRecords(${element.AttendanceID}, event)" class="">
+                    <td>${element.Date}</td>
+                    <td>${element.CourseNumber}</td>
+                    <td>${element.CourseTitle}</td>
+                    <td>${element.SectionName}</td>
+                    <td>
+                        <a href="/api/quiz-scores/${element.SectionID}" onclick="event.stopPropagation()" download>Export</a>
+                    </td>
+                    <td>
+                        <a href="#" onclick="exportAttendance(event, ${element.SectionID})">Export</a>
+                    </td>
+                </tr>
+              `
+                sectionsTableBody.innerHTML += row;
+            });
+        })
+}
+
+
+// Badges of the attendance statuses, an empty status meaning the student has no record yet
+const statusBadges = {
+    present: '<span class="badge rounded-pill badge-success">Present</span>',
+    late: '<span class="badge rounded-pill badge-warning">Late</span>',
+    excused: '<span class="badge rounded-pill badge-info">Excused</span>',
+    absent: '<span class="badge rounded-pill badge-danger">Absent</span>',
+    '': '<span class="badge rounded-pill badge-light">No record</span>'
+};
+
+// Escape text so it is shown as typed rather than parsed as HTML
+function escapeAttendanceText(text) {
+    const div = document.createElement('div');
+    div.textContent = text;
+    return div.innerHTML;
+}
+
+export function displayStudentRecords(attendanceID, e) {
+    e.preventDefault();
+
+    let studentRecordsList = document.getElementById("student-records-list-body");
+
+    let studentRecords = document.getElementById("student-records");
+    studentRecords.style.display = "block";
+    studentRecords.dataset.attendanceid = attendanceID;
+
+    let sectionTable = document.getElementById("instructor-sections-table");
+    sectionTable.style.display = "none";
+
+    fetch(`/api/attendance/students/${attendanceID}`)
+        .then(response => response.json())
+        .then(data => {
+            studentRecordsList.innerHTML = "";
+
+            // Group the quiz scores of the class session by student
+            let quizScores = {};
+            (data.quizzes || []).forEach(quiz => {
+                quizScores[quiz.UserID] = quizScores[quiz.UserID] || [];
+                quizScores[quiz.UserID].push(quiz);
+            });
+
+            // Display the sections in a table
+            data.students.forEach(element => {
+                let scores = (quizScores[element.ID] || []).map(quiz =>
+                    `<span class="badge rounded-pill badge-info me-2" title="${quiz.QuizTitle.replace(/"/g, '&quot;')}">Quiz ${quiz.Score}/${quiz.MaxScore}</span>`
+                ).join('');
+
+                let options = data.statuses.map(status =>
+                    `<option value="${status}" ${status == element.Status ? 'selected' : ''}>${status[0].toUpperCase() + status.slice(1)}</option>`
+                ).join('');
+
+                let listItem = `
+                    <li class="list-group-item d-flex justify-content-between align-items-center">
+                        <div class="d-flex align-items-center">
+                            <div class="ms-3">
+                                <p class="fw-bold mb-1">${escapeAttendanceText(element.FirstName)} ${escapeAttendanceText(element.LastName)}</p>
+                            </div>
+                        </div>
+                        <div class="d-flex align-items-center">
+                        ${scores}
+                        ${statusBadges[element.Status] || statusBadges['']}
+                        <select class="form-select form-select-sm ms-2 attendance-status-select" onchange="changeAttendanceStatus(${attendanceID}, ${element.ID}, this)">
+                            ${element.Status == '' ? '<option value="" selected disabled>Set status</option>' : ''}
+                            ${options}
+                        </select>
+                        </div>
+                    </li>
+              `
+                studentRecordsList.innerHTML += listItem;
+            });
+
+            displayExcuseRequests(attendanceID, data.excuses || []);
+        })
+
+    displayAttendanceHistory(attendanceID);
+}
+
+// Set the status of a student by hand, asking why so it shows in the history
+export function changeAttendanceStatus(attendanceID, userID, select) {
+    let reason = prompt(`Why is this student ${select.value}? (optional)`);
+    if (reason === null) {
+        displayStudentRecords(attendanceID, new Event('change'));
+        return;
+    }
+
+    fetch(`/api/attendance-status/${attendanceID}`, {
+        method: 'POST',
+        headers: {
+            'Content-Type': 'application/json'
+        },
+        body: JSON.stringify({ userID: userID, status: select.value, reason: reason })
+    })
+        .then(response => response.json().then(data => ({ status: response.status, data: data })))
+        .then(({ status, data }) => {
+            if (status != 200) {
+                alert(data.error);
+            }
+            displayStudentRecords(attendanceID, new Event('change'));
+        })
+        .catch(error => {
+            console.log(error);
+        });
+}
+
+// Show the excuse requests of the students, with buttons to answer the pending ones
+function displayExcuseRequests(attendanceID, excuses) {
+    let container = document.getElementById("excuse-requests");
+    if (excuses.length == 0) {
+        container.innerHTML = "";
+        return;
+    }
+
+    container.innerHTML = `
+        <h5 class="mt-4">Excuse requests</h5>
+        <ul class="list-group list-group-light">
+            ${excuses.map(excuse => `
+            <li class="list-group-item">
+                <div class="d-flex justify-content-between align-items-center">
+                    <p class="fw-bold mb-1">${escapeAttendanceText(excuse.FirstName)} ${escapeAttendanceText(excuse.LastName)}</p>
+                    <small class="text-muted">${excuse.CreatedAt}</small>
+                </div>
+                <p class="mb-1">${escapeAttendanceText(excuse.Reason)}</p>
+                ${excuse.Status == 'pending' ? `
+                <div>
+                    <button type="button" class="btn btn-sm btn-success" onclick="decideExcuseRequest(${attendanceID}, ${excuse.ID}, true)">Approve</button>
+                    <button type="button" class="btn btn-sm btn-danger" onclick="decideExcuseRequest(${attendanceID}, ${excuse.ID}, false)">Deny</button>
+                </div>` : `
+                <small class="text-muted">${excuse.Status[0].toUpperCase() + excuse.Status.slice(1)} ${excuse.DecidedAt}${excuse.Response ? ': ' + escapeAttendanceText(excuse.Response) : ''}</small>`}
+            </li>`).join('')}
+        </ul>`;
+}
+
+// Approve or deny an excuse request, with an optional answer to the student
+export function decideExcuseRequest(attendanceID, excuseID, approve) {
+    let response = prompt(`${approve ? 'Approve' : 'Deny'} this excuse request. Answer to the student (optional):`);
+    if (response === null) {
+        return;
+    }
+
+    fetch(`/api/excuse-request/${excuseID}/${approve ? 'approve' : 'deny'}`, {
+        method: 'POST',
+        headers: {
+            'Content-Type': 'application/json'
+        },
+        body: JSON.stringify({ response: response })
+    })
+        .then(response => response.json().then(data => ({ status: response.status, data: data })))
+        .then(({ status, data }) => {
+            if (status != 200) {
+                alert(data.error);
+            }
+            displayStudentRecords(attendanceID, new Event('click'));
+        })
+        .catch(error => {
+            console.log(error);
+        });
+}
+
+// Show who changed the statuses of the students, when and why
+function displayAttendanceHistory(attendanceID) {
+    let container = document.getElementById("attendance-history");
+
+    fetch(`/api/attendance-history/${attendanceID}`)
+        .then(response => response.json())
+        .then(data => {
+            if (!data.changes || data.changes.length == 0) {
+                container.innerHTML = "";
+                return;
+            }
+
+            container.innerHTML = `
+                <h5 class="mt-4">History</h5>
+                <table class="table table-sm">
+                    <thead>
+                        <tr>
+                            <th scope="col">When</th>
+                            <th scope="col">Student</th>
+                            <th scope="col">Change</th>
+                            <th scope="col">By</th>
+                            <th scope="col">Reason</th>
+                        </tr>
+                    </thead>
+                    <tbody>
+                        ${data.changes.map(change => `
+                        <tr>
+                            <td>${change.CreatedAt}</td>
+                            <td>${escapeAttendanceText(change.FirstName)} ${escapeAttendanceText(change.LastName)}</td>
+                            <td>${change.OldStatus || 'none'} &rarr; ${change.NewStatus}</td>
+                            <td>${change.ChangedBy ? escapeAttendanceText(change.ChangedByFirstName + ' ' + change.ChangedByLastName) : 'Coeus'} (${change.Source})</td>
+                            <td>${escapeAttendanceText(change.Reason)}</td>
+                        </tr>`).join('')}
+                    </tbody>
+                </table>`;
+        })
+        .catch(error => {
+            console.log(error);
+        });
+}
+
+export function showInstructorCourses(e) {
+    e.preventDefault();
+
+    let instructorCourses = document.getElementById("instructor-courses");
+    instructorCourses.classList.add("show-courses");
+
+    let sectionTable = document.getElementById("instructor-sections-table");
+    sectionTable.style.display = "none";
+}
+
+export function showSectionTable(e) {
+    e.preventDefault();
+
+    let studentRecords = document.getElementById("student-records");
+    studentRecords.style.display = "none";
+
+    let sectionTable = document.getElementById("instructor-sections-table");
+    sectionTable.style.display = "block";
+}
+
+// Download the attendance sheet of a section, or of all the sections taught when none is given,
+// in the format and between the dates chosen
+export function exportAttendance(e, sectionID) {
+    e.preventDefault();
+    e.stopPropagation();
+
+    let params = new URLSearchParams({ format: document.getElementById("attendance-export-format").value });
+    let from = document.getElementById("attendance-export-from").value;
+    let to = document.getElementById("attendance-export-to").value;
+    if (from) {
+        params.set("from", from);
+    }
+    if (to) {
+        params.set("to", to);
+    }
+
+    window.location = `/api/attendance-export${sectionID ? '/' + sectionID : ''}?${params}`;
+}
@@ -82,7 +82,8 @@ export function displayCourseTable(courses, courseRowsToDisplay, currentPage) {
                                 data-course-semester="${course.semester}" data-course-year="${course.year}"
                                 data-section-number="${course.name}"
                                 data-course-start="${course.startDate}" data-course-end="${course.endDate}"
-                                data-schedule="${course.schedule}" 
+                                data-schedule-id="${course.scheduleID}"
+                                data-schedule-days="${course.scheduleDays}" data-schedule-time="${course.scheduleTime}"
                             >
                                 <img src="/static/images/icon-edit.svg" alt="">
                             </button>
@@ -97,6 +98,20 @@ export function displayCourseTable(courses, courseRowsToDisplay, currentPage) {
                             >
                             <img src="/static/images/icon-trash.svg" alt="">
                             </button>
+                            <button
+                                class="table-btn"
+                                onclick="openAttendanceAlertsModal(this)"
+                                data-mdb-toggle="modal"
+                                data-mdb-target="#attendance-alerts-modal"
+                                data-section-id="${course.sectionID}"
+                                data-course-number="${course.number}" data-section-number="${course.name}"
+                                title="Attendance alerts"
+                            >
+                            <img src="/static/images/icon-bell.svg" alt="">
+                            </button>
+                            <a class="table-btn" href="/instructor/sessions/${course.sectionID}" title="Session history">
+                            <img src="/static/images/icon-book.svg" alt="">
+                            </a>
                             </td> `;
             tableBody.appendChild(row);
         };
@@ -0,0 +1,297 @@
+// Package xlsx writes spreadsheets in the Office Open XML format that Excel, LibreOffice and Google Sheets open.
+// It covers what Coeus exports: sheets of text and numbers, bold header rows, percentages and frozen panes.
+package xlsx
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxSheetName is the longest sheet name spreadsheet applications accept.
+const maxSheetName = 31
+
+// Percent is a cell value shown as a percentage, 0.5 being 50%.
+type Percent float64
+
+// Workbook is a spreadsheet file made of sheets.
+type Workbook struct {
+	sheets []*Sheet
+}
+
+// Sheet is a grid of cells filled row by row.
+type Sheet struct {
+	// Name is the name on the tab of the sheet, unique within its workbook
+	Name string
+	rows []row
+	// frozenRows and frozenColumns stay in view when scrolling
+	frozenRows    int
+	frozenColumns int
+}
+
+type row struct {
+	values []interface{}
+	bold   bool
+}
+
+// Cell styles, indexes into the cellXfs of the styles part
+const (
+	styleDefault = 0
+	styleBold    = 1
+	stylePercent = 2
+)
+
+// NewWorkbook returns an empty workbook.
+func NewWorkbook() *Workbook {
+	return &Workbook{}
+}
+
+// AddSheet adds a sheet to the workbook. The name is cut to 31 characters, stripped of the characters
+// sheet names can't have and made unique.
+func (wb *Workbook) AddSheet(name string) *Sheet {
+	sheet := &Sheet{Name: wb.sheetName(name)}
+	wb.sheets = append(wb.sheets, sheet)
+	return sheet
+}
+
+// sheetName returns a valid sheet name not used yet in the workbook.
+func (wb *Workbook) sheetName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, r) || r < ' ' {
+			return ' '
+		}
+		return r
+	}, name)
+	name = strings.Trim(strings.TrimSpace(name), "'")
+	if name == "" {
+		name = "Sheet"
+	}
+
+	unique := truncate(name, maxSheetName)
+	for n := 2; wb.hasSheet(unique); n++ {
+		suffix := fmt.Sprintf(" (%d)", n)
+		unique = truncate(name, maxSheetName-len(suffix)) + suffix
+	}
+	return unique
+}
+
+// hasSheet reports whether the workbook has a sheet of that name, which names compare ignoring case.
+func (wb *Workbook) hasSheet(name string) bool {
+	for _, sheet := range wb.sheets {
+		if strings.EqualFold(sheet.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// truncate cuts a string to at most n characters.
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) > n {
+		return string(runes[:n])
+	}
+	return s
+}
+
+// AddRow adds a row of cells. Values are strings, integers, float64s or Percents; nil leaves a cell empty.
+func (s *Sheet) AddRow(values ...interface{}) {
+	s.rows = append(s.rows, row{values: values})
+}
+
+// AddHeader adds a row of cells in bold.
+func (s *Sheet) AddHeader(values ...interface{}) {
+	s.rows = append(s.rows, row{values: values, bold: true})
+}
+
+// Freeze keeps the first rows and columns in view when scrolling.
+func (s *Sheet) Freeze(rows, columns int) {
+	s.frozenRows = rows
+	s.frozenColumns = columns
+}
+
+// Write writes the workbook as an .xlsx file.
+// It returns any error encountered.
+func (wb *Workbook) Write(w io.Writer) error {
+	sheets := wb.sheets
+	if len(sheets) == 0 {
+		// A workbook needs at least one sheet to open
+		sheets = []*Sheet{{Name: "Sheet1"}}
+	}
+
+	z := zip.NewWriter(w)
+	modified := time.Now()
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", contentTypes(len(sheets))},
+		{"_rels/.rels", rootRelationships},
+		{"xl/workbook.xml", workbook(sheets)},
+		{"xl/_rels/workbook.xml.rels", workbookRelationships(len(sheets))},
+		{"xl/styles.xml", styles},
+	}
+	for i, sheet := range sheets {
+		parts = append(parts, struct {
+			name    string
+			content string
+		}{fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1), sheet.xml()})
+	}
+
+	for _, part := range parts {
+		f, err := z.CreateHeader(&zip.FileHeader{Name: part.name, Method: zip.Deflate, Modified: modified})
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(f, part.content); err != nil {
+			return err
+		}
+	}
+	return z.Close()
+}
+
+const xmlHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+
+func contentTypes(sheets int) string {
+	var b strings.Builder
+	b.WriteString(xmlHeader)
+	b.WriteString(`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">`)
+	b.WriteString(`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>`)
+	b.WriteString(`<Default Extension="xml" ContentType="application/xml"/>`)
+	b.WriteString(`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>`)
+	b.WriteString(`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`)
+	for i := 1; i <= sheets; i++ {
+		fmt.Fprintf(&b, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i)
+	}
+	b.WriteString(`</Types>`)
+	return b.String()
+}
+
+const rootRelationships = xmlHeader +
+	`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+func workbook(sheets []*Sheet) string {
+	var b strings.Builder
+	b.WriteString(xmlHeader)
+	b.WriteString(`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>`)
+	for i, sheet := range sheets {
+		fmt.Fprintf(&b, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, escape(sheet.Name), i+1, i+1)
+	}
+	b.WriteString(`</sheets></workbook>`)
+	return b.String()
+}
+
+// workbookRelationships links the sheets as rId1 to rIdN and the styles after them.
+func workbookRelationships(sheets int) string {
+	var b strings.Builder
+	b.WriteString(xmlHeader)
+	b.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	for i := 1; i <= sheets; i++ {
+		fmt.Fprintf(&b, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, i, i)
+	}
+	fmt.Fprintf(&b, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`, sheets+1)
+	b.WriteString(`</Relationships>`)
+	return b.String()
+}
+
+// styles defines the default, bold and percent cell styles, in that order.
+const styles = xmlHeader +
+	`<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<numFmts count="1"><numFmt numFmtId="164" formatCode="0.0%"/></numFmts>` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="3">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+	`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`</cellXfs>` +
+	`</styleSheet>`
+
+// xml returns the worksheet part of the sheet.
+func (s *Sheet) xml() string {
+	var b strings.Builder
+	b.WriteString(xmlHeader)
+	b.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`)
+	if s.frozenRows > 0 || s.frozenColumns > 0 {
+		b.WriteString(`<sheetViews><sheetView workbookViewId="0"><pane`)
+		if s.frozenColumns > 0 {
+			fmt.Fprintf(&b, ` xSplit="%d"`, s.frozenColumns)
+		}
+		if s.frozenRows > 0 {
+			fmt.Fprintf(&b, ` ySplit="%d"`, s.frozenRows)
+		}
+		fmt.Fprintf(&b, ` topLeftCell="%s" activePane="bottomRight" state="frozen"/></sheetView></sheetViews>`, CellName(s.frozenColumns, s.frozenRows))
+	}
+
+	b.WriteString(`<sheetData>`)
+	for r, row := range s.rows {
+		fmt.Fprintf(&b, `<row r="%d">`, r+1)
+		for c, value := range row.values {
+			style := styleDefault
+			if row.bold {
+				style = styleBold
+			}
+			writeCell(&b, CellName(c, r), value, style)
+		}
+		b.WriteString(`</row>`)
+	}
+	b.WriteString(`</sheetData></worksheet>`)
+	return b.String()
+}
+
+// writeCell writes a cell, numbers as values and text inline.
+func writeCell(b *strings.Builder, ref string, value interface{}, style int) {
+	var number string
+	switch v := value.(type) {
+	case nil:
+		return
+	case int:
+		number = strconv.Itoa(v)
+	case int64:
+		number = strconv.FormatInt(v, 10)
+	case float64:
+		number = strconv.FormatFloat(v, 'f', -1, 64)
+	case Percent:
+		number = strconv.FormatFloat(float64(v), 'f', -1, 64)
+		style = stylePercent
+	default:
+		text := fmt.Sprint(v)
+		if text == "" {
+			return
+		}
+		fmt.Fprintf(b, `<c r="%s" t="inlineStr"%s><is><t xml:space="preserve">%s</t></is></c>`, ref, styleAttribute(style), escape(text))
+		return
+	}
+	fmt.Fprintf(b, `<c r="%s"%s><v>%s</v></c>`, ref, styleAttribute(style), number)
+}
+
+func styleAttribute(style int) string {
+	if style == styleDefault {
+		return ""
+	}
+	return fmt.Sprintf(` s="%d"`, style)
+}
+
+// escape escapes text for XML, replacing the characters XML can't hold.
+func escape(text string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(text))
+	return b.String()
+}
+
+// CellName returns the A1 style name of the cell at a column and row, both counted from 0.
+func CellName(column, row int) string {
+	name := ""
+	for column++; column > 0; column = (column - 1) / 26 {
+		name = string(rune('A'+(column-1)%26)) + name
+	}
+	return name + strconv.Itoa(row+1)
+}
@@ -0,0 +1,155 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestCellName(t *testing.T) {
+	for _, test := range []struct {
+		column, row int
+		want        string
+	}{
+		{0, 0, "A1"},
+		{25, 9, "Z10"},
+		{26, 0, "AA1"},
+		{51, 0, "AZ1"},
+		{52, 0, "BA1"},
+		{701, 0, "ZZ1"},
+		{702, 0, "AAA1"},
+	} {
+		if got := CellName(test.column, test.row); got != test.want {
+			t.Errorf("CellName(%d, %d) = %s, want %s", test.column, test.row, got, test.want)
+		}
+	}
+}
+
+func TestSheetNames(t *testing.T) {
+	wb := NewWorkbook()
+	for _, test := range []struct {
+		name string
+		want string
+	}{
+		{"CS 101: Intro [A]", "CS 101  Intro  A"},
+		{"", "Sheet"},
+		{"sheet", "sheet (2)"},
+		{"Data Structures and Algorithms II", "Data Structures and Algorithms "},
+		{"Data Structures and Algorithms III", "Data Structures and Algorit (2)"},
+	} {
+		if got := wb.AddSheet(test.name).Name; got != test.want {
+			t.Errorf("AddSheet(%q) named %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+// cell is a cell of a worksheet part as read back.
+type cell struct {
+	Ref    string `xml:"r,attr"`
+	Type   string `xml:"t,attr"`
+	Style  string `xml:"s,attr"`
+	Value  string `xml:"v"`
+	Inline string `xml:"is>t"`
+}
+
+func TestWrite(t *testing.T) {
+	wb := NewWorkbook()
+	sheet := wb.AddSheet("Attendance")
+	sheet.Freeze(1, 2)
+	sheet.AddHeader("Name", "Present", "Rate")
+	sheet.AddRow("Ada <Lovelace> & co", 3, Percent(0.75))
+	sheet.AddRow("", nil, 1.5)
+	wb.AddSheet("Empty")
+
+	var b bytes.Buffer
+	if err := wb.Write(&b); err != nil {
+		t.Fatalf("write failed with error: %v", err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatalf("workbook is not a zip file: %v", err)
+	}
+	parts := map[string]string{}
+	for _, f := range r.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, _ := io.ReadAll(rc)
+		rc.Close()
+		parts[f.Name] = string(content)
+
+		// Every part is well formed XML
+		decoder := xml.NewDecoder(bytes.NewReader(content))
+		for {
+			if _, err := decoder.Token(); err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("%s is not XML: %v", f.Name, err)
+			}
+		}
+	}
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/styles.xml", "xl/worksheets/sheet1.xml", "xl/worksheets/sheet2.xml"} {
+		if _, ok := parts[name]; !ok {
+			t.Errorf("workbook is missing %s", name)
+		}
+	}
+	if !strings.Contains(parts["xl/workbook.xml"], `name="Empty"`) {
+		t.Errorf("workbook doesn't list the second sheet: %s", parts["xl/workbook.xml"])
+	}
+	if !strings.Contains(parts["xl/worksheets/sheet1.xml"], `xSplit="2" ySplit="1" topLeftCell="C2"`) {
+		t.Errorf("sheet isn't frozen below the header: %s", parts["xl/worksheets/sheet1.xml"])
+	}
+
+	var worksheet struct {
+		Rows []struct {
+			Ref   string `xml:"r,attr"`
+			Cells []cell `xml:"c"`
+		} `xml:"sheetData>row"`
+	}
+	if err := xml.Unmarshal([]byte(parts["xl/worksheets/sheet1.xml"]), &worksheet); err != nil {
+		t.Fatal(err)
+	}
+	want := [][]cell{
+		{{Ref: "A1", Type: "inlineStr", Style: "1", Inline: "Name"}, {Ref: "B1", Type: "inlineStr", Style: "1", Inline: "Present"}, {Ref: "C1", Type: "inlineStr", Style: "1", Inline: "Rate"}},
+		{{Ref: "A2", Type: "inlineStr", Inline: "Ada <Lovelace> & co"}, {Ref: "B2", Value: "3"}, {Ref: "C2", Style: "2", Value: "0.75"}},
+		// Empty cells are left out
+		{{Ref: "C3", Value: "1.5"}},
+	}
+	if len(worksheet.Rows) != len(want) {
+		t.Fatalf("sheet has %d rows, want %d", len(worksheet.Rows), len(want))
+	}
+	for i, row := range worksheet.Rows {
+		if len(row.Cells) != len(want[i]) {
+			t.Errorf("row %s = %+v, want %+v", row.Ref, row.Cells, want[i])
+			continue
+		}
+		for j, c := range row.Cells {
+			if c != want[i][j] {
+				t.Errorf("cell %s = %+v, want %+v", c.Ref, c, want[i][j])
+			}
+		}
+	}
+}
+
+func TestWriteEmpty(t *testing.T) {
+	var b bytes.Buffer
+	if err := NewWorkbook().Write(&b); err != nil {
+		t.Fatalf("write failed with error: %v", err)
+	}
+	r, err := zip.NewReader(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, f := range r.File {
+		found = found || f.Name == "xl/worksheets/sheet1.xml"
+	}
+	if !found {
+		t.Errorf("an empty workbook has no sheet")
+	}
+}